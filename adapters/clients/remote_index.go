@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -37,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type RemoteIndex struct {
@@ -630,6 +632,109 @@ func (c *RemoteIndex) GetShardQueueSize(ctx context.Context,
 	return size, c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) ReadChanges(ctx context.Context,
+	hostName, indexName, shardName string, after uint64, limit int, withObjects bool,
+) ([]changefeed.Change, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/changes", indexName, shardName)
+	method := http.MethodGet
+	params := url.Values{}
+	params.Set("after", strconv.FormatUint(after, 10))
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("objects", strconv.FormatBool(withObjects))
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: params.Encode()}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var changes []changefeed.Change
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			if code == http.StatusGone {
+				return false, fmt.Errorf("%w: %s", changefeed.ErrTruncated, body)
+			}
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.Changes.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		changes, err = clusterapi.IndicesPayloads.Changes.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return changes, c.retry(ctx, 9, try)
+}
+
+// OpenExportObjects opens a stream of the objects of a shard. It returns
+// once the remote node has taken the snapshot of the shard. It is not
+// retried, because the snapshot has to be taken at the start of the export.
+func (c *RemoteIndex) OpenExportObjects(ctx context.Context,
+	hostName, indexName, shardName, exportID string,
+) (sharding.ObjectStream, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects:export", indexName, shardName)
+	method := http.MethodGet
+	params := url.Values{}
+	params.Set("export_id", exportID)
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: params.Encode()}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	if code := res.StatusCode; code != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		return nil, fmt.Errorf("status code: %v body: (%s)", code, body)
+	}
+
+	if ct, ok := clusterapi.IndicesPayloads.ExportObjects.CheckContentTypeHeader(res); !ok {
+		res.Body.Close()
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	if err := clusterapi.IndicesPayloads.ExportObjects.ReadSnapshot(res.Body); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return &exportStream{body: res.Body}, nil
+}
+
+// exportStream reads the objects of a shard from an export response
+type exportStream struct {
+	body io.ReadCloser
+}
+
+func (s *exportStream) Read(fn func(*storobj.Object) error) error {
+	return clusterapi.IndicesPayloads.ExportObjects.Read(s.body, fn)
+}
+
+func (s *exportStream) Close() error {
+	return s.body.Close()
+}
+
 func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
@@ -810,6 +915,68 @@ func (c *RemoteIndex) ReInitShard(ctx context.Context,
 	return c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) Reshard(ctx context.Context,
+	hostName, indexName string, r scaler.ReshardRequest,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/reshard:%s", indexName, r.Phase)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.Reshard.Marshall(r)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) SyncReplica(ctx context.Context,
+	hostName, indexName, shardName string,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/shards/%s/replicas:sync", indexName, shardName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	hostName, indexName string, dist scaler.ShardDist,
 ) error {
@@ -24,6 +24,7 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -32,8 +33,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestRemoteIndexIncreaseRF(t *testing.T) {
@@ -207,6 +214,119 @@ func TestRemoteIndexShardStatus(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexReadChanges(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		path = "/indices/C1/shards/S1/changes"
+		fs   = newFakeRemoteIndexServer(t, http.MethodGet, path)
+		obj  = storobj.FromObject(&models.Object{
+			Class: "C1",
+			ID:    "a0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		}, nil, nil)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	n := 0
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5", r.URL.Query().Get("after"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "true", r.URL.Query().Get("objects"))
+		if n == 0 {
+			w.WriteHeader(http.StatusGone)
+		} else {
+			clusterapi.IndicesPayloads.Changes.SetContentTypeHeader(w)
+			bytes, _ := clusterapi.IndicesPayloads.Changes.Marshal([]changefeed.Change{
+				{Sequence: 6, Operation: changefeed.OperationInsert, UUID: obj.ID(), Timestamp: 1, Object: obj},
+				{Sequence: 7, Operation: changefeed.OperationDelete, UUID: obj.ID(), Timestamp: 2},
+			})
+			w.Write(bytes)
+		}
+		n++
+	}
+
+	t.Run("Truncated", func(t *testing.T) {
+		_, err := client.ReadChanges(ctx, fs.host, "C1", "S1", 5, 10, true)
+		assert.ErrorIs(t, err, changefeed.ErrTruncated)
+		assert.Equal(t, 1, n, "truncated feeds are not retried")
+	})
+	t.Run("Success", func(t *testing.T) {
+		changes, err := client.ReadChanges(ctx, fs.host, "C1", "S1", 5, 10, true)
+		assert.Nil(t, err)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, uint64(6), changes[0].Sequence)
+		assert.Equal(t, obj.ID(), changes[0].Object.ID())
+		assert.Equal(t, changefeed.OperationDelete, changes[1].Operation)
+		assert.Nil(t, changes[1].Object)
+	})
+}
+
+func TestRemoteIndexExportObjects(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		path = "/indices/C1/shards/S1/objects:export"
+		fs   = newFakeRemoteIndexServer(t, http.MethodGet, path)
+		obj  = storobj.FromObject(&models.Object{
+			Class: "C1",
+			ID:    "a0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		}, nil, nil)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	n := 0
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "export-1", r.URL.Query().Get("export_id"))
+		clusterapi.IndicesPayloads.ExportObjects.SetContentTypeHeader(w)
+		defer func() { n++ }()
+		if n == 0 {
+			clusterapi.IndicesPayloads.ExportObjects.WriteEnd(w, errors.New("shard not found"))
+			return
+		}
+		clusterapi.IndicesPayloads.ExportObjects.WriteSnapshot(w)
+		clusterapi.IndicesPayloads.ExportObjects.WriteObject(w, obj)
+		if n == 1 {
+			clusterapi.IndicesPayloads.ExportObjects.WriteEnd(w, errors.New("shard shut down"))
+		} else {
+			clusterapi.IndicesPayloads.ExportObjects.WriteEnd(w, nil)
+		}
+	}
+
+	read := func(stream sharding.ObjectStream) ([]strfmt.UUID, error) {
+		defer stream.Close()
+		var ids []strfmt.UUID
+		err := stream.Read(func(obj *storobj.Object) error {
+			ids = append(ids, obj.ID())
+			return nil
+		})
+		return ids, err
+	}
+
+	t.Run("ErrorBeforeSnapshot", func(t *testing.T) {
+		_, err := client.OpenExportObjects(ctx, fs.host, "C1", "S1", "export-1")
+		assert.ErrorContains(t, err, "shard not found")
+		assert.Equal(t, 1, n, "exports are not retried")
+	})
+	t.Run("ErrorAfterSnapshot", func(t *testing.T) {
+		stream, err := client.OpenExportObjects(ctx, fs.host, "C1", "S1", "export-1")
+		require.Nil(t, err)
+		ids, err := read(stream)
+		assert.ErrorContains(t, err, "shard shut down")
+		assert.Equal(t, []strfmt.UUID{obj.ID()}, ids)
+	})
+	t.Run("Success", func(t *testing.T) {
+		stream, err := client.OpenExportObjects(ctx, fs.host, "C1", "S1", "export-1")
+		require.Nil(t, err)
+		ids, err := read(stream)
+		assert.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{obj.ID()}, ids)
+	})
+}
+
 func TestRemoteIndexPutFile(t *testing.T) {
 	t.Parallel()
 	var (
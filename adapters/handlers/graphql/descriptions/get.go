@@ -48,3 +48,8 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const FilterStrategy = "Overrides how the vector index applies the 'where' filter for this query. " +
+	"Can be 'sweeping', 'acorn' or 'flat' (brute force over the filtered objects)"
+
+const ExplainVectorSearch = "How the vector index executed the search on the shard the object was found on"
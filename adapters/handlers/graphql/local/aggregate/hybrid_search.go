@@ -54,6 +54,18 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Search weight",
 			Type:        graphql.Float,
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Maximum edit distance (0-2) of the terms matched by a query term in the keyword search",
+			Type:        graphql.Int,
+		},
+		"fuzzyPrefixLength": &graphql.InputObjectFieldConfig{
+			Description: "Number of leading characters a term has to share with a query term to be matched fuzzily in the keyword search",
+			Type:        graphql.Int,
+		},
+		"prefix": &graphql.InputObjectFieldConfig{
+			Description: "Whether to also match terms starting with a query term in the keyword search",
+			Type:        graphql.Boolean,
+		},
 		"maxVectorDistance": &graphql.InputObjectFieldConfig{
 			Description: "Removes all results that have a vector distance larger than the given value",
 			Type:        graphql.Float,
@@ -25,5 +25,17 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Maximum edit distance (0-2) of the terms matched by a query term",
+			Type:        graphql.Int,
+		},
+		"fuzzyPrefixLength": &graphql.InputObjectFieldConfig{
+			Description: "Number of leading characters a term has to share with a query term to be matched fuzzily",
+			Type:        graphql.Int,
+		},
+		"prefix": &graphql.InputObjectFieldConfig{
+			Description: "Whether to also match terms starting with a query term",
+			Type:        graphql.Boolean,
+		},
 	}
 }
@@ -31,6 +31,18 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	if fuzziness, ok := source["fuzziness"]; ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
+	if fuzzyPrefixLength, ok := source["fuzzyPrefixLength"]; ok {
+		args.FuzzyPrefixLength = fuzzyPrefixLength.(int)
+	}
+
+	if prefix, ok := source["prefix"]; ok {
+		args.Prefix = prefix.(bool)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -13,8 +13,10 @@ package common_filters
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/weaviate/weaviate/entities/searchparams"
 )
@@ -132,6 +134,24 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		args.Query = query.(string)
 	}
 
+	if fuzziness, ok := source["fuzziness"]; ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+	if args.Fuzziness < 0 || args.Fuzziness > searchparams.MaxFuzziness {
+		return nil, nil, fmt.Errorf("fuzziness should be between 0 and %d", searchparams.MaxFuzziness)
+	}
+
+	if fuzzyPrefixLength, ok := source["fuzzyPrefixLength"]; ok {
+		args.FuzzyPrefixLength = fuzzyPrefixLength.(int)
+	}
+	if args.FuzzyPrefixLength < 0 {
+		return nil, nil, fmt.Errorf("fuzzyPrefixLength should not be negative")
+	}
+
+	if prefix, ok := source["prefix"]; ok {
+		args.Prefix = prefix.(bool)
+	}
+
 	fusionType, ok := source["fusionType"]
 	if ok {
 		args.FusionAlgorithm = fusionType.(int)
@@ -154,6 +174,16 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if sparseVector, ok := source["sparseVector"]; ok {
+		args.SparseVector, err = extractSparseVector(sparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if sparseTargetVector, ok := source["sparseTargetVector"]; ok {
+		args.SparseTargetVector = sparseTargetVector.(string)
+	}
+
 	args.Type = "hybrid"
 
 	if args.NearTextParams != nil && args.NearVectorParams != nil {
@@ -168,3 +198,25 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 
 	return &args, combination, nil
 }
+
+func extractSparseVector(source map[string]interface{}) (*models.SparseVector, error) {
+	indices, _ := source["indices"].([]interface{})
+	values, _ := source["values"].([]interface{})
+	if len(indices) != len(values) {
+		return nil, fmt.Errorf("sparseVector has %d indices but %d values", len(indices), len(values))
+	}
+
+	out := &models.SparseVector{
+		Indices: make([]uint32, len(indices)),
+		Values:  make([]float32, len(values)),
+	}
+	for i := range indices {
+		index := indices[i].(int)
+		if index < 0 || index > math.MaxUint32 {
+			return nil, fmt.Errorf("sparseVector index %d is out of range", index)
+		}
+		out.Indices[i] = uint32(index)
+		out.Values[i] = float32(values[i].(float64))
+	}
+	return out, nil
+}
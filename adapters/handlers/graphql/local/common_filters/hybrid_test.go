@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
@@ -48,6 +49,32 @@ func TestHybrid(t *testing.T) {
 			output:            &searchparams.HybridSearch{NearVectorParams: &searchparams.NearVector{Vectors: [][]float32{{1, 2, 3}, {1, 2}}}, TargetVectors: []string{"target1", "target2"}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
 			outputCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: nilweights},
 		},
+		{
+			input:  map[string]interface{}{"query": "wetaher", "fuzziness": 2, "prefix": true},
+			output: &searchparams.HybridSearch{Query: "wetaher", Fuzziness: 2, Prefix: true, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
+		},
+		{
+			input: map[string]interface{}{"query": "wetaher", "fuzziness": 3},
+			error: true,
+		},
+		{
+			input: map[string]interface{}{
+				"sparseVector":       map[string]interface{}{"indices": []interface{}{3, 17}, "values": []interface{}{0.5, 1.5}},
+				"sparseTargetVector": "splade",
+			},
+			output: &searchparams.HybridSearch{
+				SparseVector:       &models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				SparseTargetVector: "splade", SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1,
+			},
+		},
+		{
+			input: map[string]interface{}{"sparseVector": map[string]interface{}{"indices": []interface{}{3, 17}, "values": []interface{}{0.5}}},
+			error: true,
+		},
+		{
+			input: map[string]interface{}{"sparseVector": map[string]interface{}{"indices": []interface{}{-1}, "values": []interface{}{0.5}}},
+			error: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -176,6 +176,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["explainVectorSearch"] = b.additionalExplainVectorSearchField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -273,6 +274,23 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalExplainVectorSearchField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.ExplainVectorSearch,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalExplainVectorSearch", class.Class),
+			Fields: graphql.Fields{
+				"shard":                &graphql.Field{Type: graphql.String},
+				"allowListSize":        &graphql.Field{Type: graphql.Int},
+				"filterStrategy":       &graphql.Field{Type: graphql.String},
+				"nodesVisited":         &graphql.Field{Type: graphql.Int},
+				"distanceComputations": &graphql.Field{Type: graphql.Int},
+				"rescoredCandidates":   &graphql.Field{Type: graphql.Int},
+			},
+		}),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
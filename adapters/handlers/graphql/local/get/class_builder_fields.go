@@ -233,6 +233,8 @@ func buildGetClassField(classObject *graphql.Object,
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+
+			"filterStrategy": filterStrategyArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -452,6 +454,10 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		tenant = tk.(string)
 	}
 
+	if fs, ok := p.Args["filterStrategy"]; ok {
+		addlProps.FilterStrategy = fs.(string)
+	}
+
 	params := dto.GetParams{
 		Filters:                 filters,
 		ClassName:               className,
@@ -579,7 +585,7 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "explainVectorSearch" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -686,6 +692,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "explainVectorSearch" {
+							additionalProps.ExplainVectorSearch = true
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func filterStrategyArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.FilterStrategy,
+		Type: graphql.NewEnum(graphql.EnumConfig{
+			Name: fmt.Sprintf("%sFilterStrategyEnum", className),
+			Values: graphql.EnumValueConfigMap{
+				hnswent.FilterStrategySweeping: &graphql.EnumValueConfig{},
+				hnswent.FilterStrategyAcorn:    &graphql.EnumValueConfig{},
+				hnswent.FilterStrategyFlat:     &graphql.EnumValueConfig{},
+			},
+		}),
+	}
+}
@@ -411,6 +411,42 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional explainVectorSearch",
+			query: "{ Get { SomeAction { _additional { explainVectorSearch { shard allowListSize filterStrategy nodesVisited distanceComputations rescoredCandidates } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					ExplainVectorSearch: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"explainVectorSearch": &additional.VectorSearchExplain{
+							Shard:                "abc",
+							AllowListSize:        120,
+							FilterStrategy:       "acorn",
+							NodesVisited:         30,
+							DistanceComputations: 400,
+							RescoredCandidates:   10,
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"explainVectorSearch": map[string]interface{}{
+						"shard":                "abc",
+						"allowListSize":        120,
+						"filterStrategy":       "acorn",
+						"nodesVisited":         30,
+						"distanceComputations": 400,
+						"rescoredCandidates":   10,
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional vector",
 			query: "{ Get { SomeAction { _additional { vector } } } }",
@@ -2461,6 +2497,36 @@ func TestNearVectorNoModules(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("with filter strategy override", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+							}, filterStrategy: acorn) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vectors: [][]float32{{0.123, 0.984}},
+			},
+			AdditionalProperties: additional.Properties{
+				FilterStrategy: "acorn",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an invalid filter strategy", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+							}, filterStrategy: bruteforce) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -55,6 +55,18 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Search weight",
 			Type:        graphql.Float,
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Maximum edit distance (0-2) of the terms matched by a query term in the keyword search",
+			Type:        graphql.Int,
+		},
+		"fuzzyPrefixLength": &graphql.InputObjectFieldConfig{
+			Description: "Number of leading characters a term has to share with a query term to be matched fuzzily in the keyword search",
+			Type:        graphql.Int,
+		},
+		"prefix": &graphql.InputObjectFieldConfig{
+			Description: "Whether to also match terms starting with a query term in the keyword search",
+			Type:        graphql.Boolean,
+		},
 		"maxVectorDistance": &graphql.InputObjectFieldConfig{
 			Description: "Removes all results that have a vector distance larger than the given value",
 			Type:        graphql.Float,
@@ -75,6 +87,26 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"sparseVector": &graphql.InputObjectFieldConfig{
+			Description: "Sparse vector searched in a sparse target vector instead of the keyword search of the query",
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSparseVectorInpObj", prefixName),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"indices": &graphql.InputObjectFieldConfig{
+						Description: "Dimensions with a non-zero weight",
+						Type:        graphql.NewList(graphql.Int),
+					},
+					"values": &graphql.InputObjectFieldConfig{
+						Description: "Weights of the dimensions",
+						Type:        graphql.NewList(graphql.Float),
+					},
+				},
+			}),
+		},
+		"sparseTargetVector": &graphql.InputObjectFieldConfig{
+			Description: "Sparse target vector searched with the sparse vector, can be omitted if the class has a single one",
+			Type:        graphql.String,
+		},
 
 		"searches": &graphql.InputObjectFieldConfig{
 			Description: "Subsearch list",
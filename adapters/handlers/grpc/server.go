@@ -82,6 +82,15 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		&state.ServerConfig.Config,
 		state.Logger,
 	)
+	if state.MemWatch != nil && state.DB != nil {
+		weaviateV1.SetBatchStreamBackpressure(state.MemWatch, state.DB)
+	}
+	if state.ServerConfig.Config.Persistence.ChangeFeedEnabled && state.DB != nil {
+		weaviateV1.SetChangeFeed(state.DB, state.Authorizer)
+	}
+	if state.Exporter != nil {
+		weaviateV1.SetExporter(state.Exporter)
+	}
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
 	pbv1.RegisterWeaviateServer(s, weaviateV1)
 	grpc_health_v1.RegisterHealthServer(s, weaviateV1)
@@ -13,6 +13,7 @@ package v1
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -81,28 +82,74 @@ func BatchFromProto(req *pb.BatchObjectsRequest, getClass func(string) *models.C
 			vector = obj.Vector
 		}
 
-		var vectors models.Vectors = nil
-		if len(obj.Vectors) > 0 {
-			vectors = make(models.Vectors, len(obj.Vectors))
-			for _, vec := range obj.Vectors {
-				vectors[vec.Name] = byteops.Float32FromByteVector(vec.VectorBytes)
-			}
-		}
+		vectors, multiVectors := extractVectors(obj.Vectors)
 
 		objOriginalIndex[insertCounter] = i
 		objs = append(objs, &models.Object{
-			Class:      obj.Collection,
-			Tenant:     obj.Tenant,
-			Vector:     vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
-			Vectors:    vectors,
+			Class:         obj.Collection,
+			Tenant:        obj.Tenant,
+			Vector:        vector,
+			Properties:    props,
+			ID:            strfmt.UUID(obj.Uuid),
+			Vectors:       vectors,
+			MultiVectors:  multiVectors,
+			SparseVectors: extractSparseVectors(obj.SparseVectors),
 		})
 		insertCounter += 1
 	}
 	return objs[:insertCounter], objOriginalIndex, objectErrors
 }
 
+// extractVectors splits the named vectors of a batch object into regular and
+// multi vectors. A multi vector is sent as several entries sharing the same
+// name, ordered by their index.
+func extractVectors(in []*pb.Vectors) (models.Vectors, models.MultiVectors) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	byName := make(map[string][]*pb.Vectors, len(in))
+	for _, vec := range in {
+		byName[vec.Name] = append(byName[vec.Name], vec)
+	}
+
+	var vectors models.Vectors
+	var multiVectors models.MultiVectors
+	for name, entries := range byName {
+		if len(entries) == 1 && entries[0].Index == 0 {
+			if vectors == nil {
+				vectors = make(models.Vectors, len(byName))
+			}
+			vectors[name] = byteops.Float32FromByteVector(entries[0].VectorBytes)
+			continue
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Index < entries[j].Index })
+		multiVector := make(models.MultiVector, len(entries))
+		for i, entry := range entries {
+			multiVector[i] = byteops.Float32FromByteVector(entry.VectorBytes)
+		}
+		if multiVectors == nil {
+			multiVectors = make(models.MultiVectors, len(byName))
+		}
+		multiVectors[name] = multiVector
+	}
+
+	return vectors, multiVectors
+}
+
+func extractSparseVectors(in []*pb.SparseVector) models.SparseVectors {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(models.SparseVectors, len(in))
+	for _, vec := range in {
+		out[vec.Name] = models.SparseVector{Indices: vec.Indices, Values: vec.Values}
+	}
+	return out
+}
+
 func extractSingleRefTarget(class *models.Class, properties []*pb.BatchObject_SingleTargetRefProps, props map[string]interface{}) error {
 	for _, refSingle := range properties {
 		propName := refSingle.GetPropName()
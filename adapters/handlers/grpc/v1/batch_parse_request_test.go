@@ -137,6 +137,46 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "Named multi vecs",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "custom",
+					VectorBytes: byteVector([]float32{0.1, 0.2, 0.3}),
+				},
+				{
+					Name:        "colbert",
+					Index:       1,
+					VectorBytes: byteVector([]float32{0.4, 0.5}),
+				},
+				{
+					Name:        "colbert",
+					Index:       0,
+					VectorBytes: byteVector([]float32{0.1, 0.2}),
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"custom": []float32{0.1, 0.2, 0.3},
+				},
+				MultiVectors: models.MultiVectors{
+					"colbert": {{0.1, 0.2}, {0.4, 0.5}},
+				},
+			}},
+		},
+		{
+			name: "Named sparse vecs",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, SparseVectors: []*pb.SparseVector{
+				{Name: "splade", Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				SparseVectors: models.SparseVectors{
+					"splade": {Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				},
+			}},
+		},
 		{
 			name: "only mult ref",
 			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Properties: &pb.BatchObject_Properties{
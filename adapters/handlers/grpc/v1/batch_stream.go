@@ -0,0 +1,361 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// below this memory ratio the batch size is not throttled
+	batchStreamMemoryLowWatermark = 0.8
+	// at this memory ratio clients are asked to pause. It is lower than the
+	// ratio at which memwatch rejects allocations, so that clients back off
+	// before imports start to fail.
+	batchStreamMemoryHighWatermark = 0.95
+	// how often the batch size is re-evaluated while the client is idle
+	batchStreamBackoffInterval = time.Second
+)
+
+var errBatchStreamOverloaded = errors.New("node is overloaded, retry after the next backoff message")
+
+// memoryMonitor reports the ratio of used to available memory, see
+// memwatch.Monitor
+type memoryMonitor interface {
+	Ratio() float64
+}
+
+// indexQueueSizer reports the number of vectors waiting to be indexed
+type indexQueueSizer interface {
+	IndexQueueSize() int64
+}
+
+type batchStreamManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal, objects []*models.Object,
+		fields []*string, repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+	AddReferences(ctx context.Context, principal *models.Principal, refs []*models.BatchReference,
+		repl *additional.ReplicationProperties) (objects.BatchReferences, error)
+}
+
+// batchStreamLimiter decides how many objects a BatchStream client may send
+// next. The full batch size is offered while the node is idle, it shrinks
+// linearly when memory usage or the index queues approach their limits.
+type batchStreamLimiter struct {
+	memory       memoryMonitor
+	queue        indexQueueSizer
+	maxBatchSize int
+	maxQueueSize int
+}
+
+func (l *batchStreamLimiter) batchSize() int32 {
+	factor := 1.0
+	if l.memory != nil {
+		factor = math.Min(factor, throttle(l.memory.Ratio(),
+			batchStreamMemoryLowWatermark, batchStreamMemoryHighWatermark))
+	}
+	if l.queue != nil && l.maxQueueSize > 0 {
+		factor = math.Min(factor, throttle(float64(l.queue.IndexQueueSize()),
+			float64(l.maxQueueSize)/2, float64(l.maxQueueSize)))
+	}
+	return int32(math.Ceil(factor * float64(l.maxBatchSize)))
+}
+
+// throttle is 1 up to low, 0 from high onwards and decreases linearly in
+// between
+func throttle(value, low, high float64) float64 {
+	switch {
+	case value <= low:
+		return 1
+	case value >= high:
+		return 0
+	default:
+		return (high - value) / (high - low)
+	}
+}
+
+// SetBatchStreamBackpressure sets the sources the BatchStream batch size is
+// derived from. Without them the maximum batch size is always offered.
+func (s *Service) SetBatchStreamBackpressure(memory memoryMonitor, queue indexQueueSizer) {
+	s.batchStreamLimiter.memory = memory
+	s.batchStreamLimiter.queue = queue
+}
+
+func (s *Service) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		errInner = s.batchStream(stream)
+	}, s.logger); err != nil {
+		return err
+	}
+
+	return errInner
+}
+
+func (s *Service) batchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "the first message of a batch stream must be start")
+	}
+
+	h := &batchStreamHandler{
+		stream:    stream,
+		manager:   s.batchManager,
+		limiter:   s.batchStreamLimiter,
+		principal: principal,
+		repl:      extractReplicationProperties(start.ConsistencyLevel),
+		getClass:  s.schemaManager.ReadOnlyClass,
+		logger:    s.logger,
+	}
+	return h.run(ctx)
+}
+
+type batchStreamHandler struct {
+	stream    pb.Weaviate_BatchStreamServer
+	manager   batchStreamManager
+	limiter   *batchStreamLimiter
+	principal *models.Principal
+	repl      *additional.ReplicationProperties
+	getClass  func(string) *models.Class
+	logger    logrus.FieldLogger
+
+	lastBatchSize int32
+}
+
+func (h *batchStreamHandler) run(ctx context.Context) error {
+	if err := h.stream.Send(&pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Started_{Started: &pb.BatchStreamReply_Started{}},
+	}); err != nil {
+		return err
+	}
+	if err := h.sendBackoff(true); err != nil {
+		return err
+	}
+
+	// requests are received in the background, so that backoff messages can
+	// be sent while the client is waiting for the batch size to increase
+	requests := make(chan *pb.BatchStreamRequest)
+	recvErr := make(chan error, 1)
+	enterrors.GoWrapper(func() {
+		for {
+			req, err := h.stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}, h.logger)
+
+	ticker := time.NewTicker(batchStreamBackoffInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-ticker.C:
+			if err := h.sendBackoff(false); err != nil {
+				return err
+			}
+		case req := <-requests:
+			var err error
+			switch msg := req.Message.(type) {
+			case *pb.BatchStreamRequest_Objects_:
+				err = h.handleObjects(ctx, msg.Objects.GetValues())
+			case *pb.BatchStreamRequest_References_:
+				err = h.handleReferences(ctx, msg.References.GetValues())
+			case *pb.BatchStreamRequest_Stop_:
+				return h.stream.Send(&pb.BatchStreamReply{
+					Message: &pb.BatchStreamReply_Stopped_{Stopped: &pb.BatchStreamReply_Stopped{}},
+				})
+			case *pb.BatchStreamRequest_Start_:
+				err = status.Error(codes.InvalidArgument, "batch stream was already started")
+			default:
+				err = status.Errorf(codes.InvalidArgument, "unknown batch stream message %T", msg)
+			}
+			if err != nil {
+				return err
+			}
+			if err := h.sendBackoff(true); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendBackoff sends the current batch size. Unless forced, it is only sent
+// if it changed since the last backoff message.
+func (h *batchStreamHandler) sendBackoff(force bool) error {
+	batchSize := h.limiter.batchSize()
+	if !force && batchSize == h.lastBatchSize {
+		return nil
+	}
+	h.lastBatchSize = batchSize
+
+	return h.stream.Send(&pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Backoff_{Backoff: &pb.BatchStreamReply_Backoff{BatchSize: batchSize}},
+	})
+}
+
+func (h *batchStreamHandler) sendResults(results *pb.BatchStreamReply_Results) error {
+	return h.stream.Send(&pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Results_{Results: results},
+	})
+}
+
+func (h *batchStreamHandler) handleObjects(ctx context.Context, values []*pb.BatchObject) error {
+	results := &pb.BatchStreamReply_Results{}
+	objs, objOriginalIndex, objectParsingErrors := BatchFromProto(
+		&pb.BatchObjectsRequest{Objects: values}, h.getClass)
+	for i, err := range objectParsingErrors {
+		results.Errors = append(results.Errors, objectStreamError(values[i].Uuid, err))
+	}
+
+	if len(objs) == 0 {
+		return h.sendResults(results)
+	}
+
+	// the client was asked to pause but kept sending, reject the objects
+	// instead of risking to run out of memory
+	if h.limiter.batchSize() == 0 {
+		for i := range objs {
+			results.Errors = append(results.Errors,
+				objectStreamError(values[objOriginalIndex[i]].Uuid, errBatchStreamOverloaded))
+		}
+		return h.sendResults(results)
+	}
+
+	all := "ALL"
+	response, err := h.manager.AddObjects(ctx, h.principal, objs, []*string{&all}, h.repl)
+	if err != nil {
+		// the stream stays open, the client can retry the objects
+		for i := range objs {
+			results.Errors = append(results.Errors, objectStreamError(values[objOriginalIndex[i]].Uuid, err))
+		}
+		return h.sendResults(results)
+	}
+
+	for i, obj := range response {
+		uuid := values[objOriginalIndex[i]].Uuid
+		if obj.Err != nil {
+			results.Errors = append(results.Errors, objectStreamError(uuid, obj.Err))
+			continue
+		}
+		results.Successes = append(results.Successes, &pb.BatchStreamReply_Results_Success{
+			Detail: &pb.BatchStreamReply_Results_Success_Uuid{Uuid: uuid},
+		})
+	}
+	return h.sendResults(results)
+}
+
+func (h *batchStreamHandler) handleReferences(ctx context.Context, values []*pb.BatchReference) error {
+	results := &pb.BatchStreamReply_Results{}
+	if len(values) == 0 {
+		return h.sendResults(results)
+	}
+
+	if h.limiter.batchSize() == 0 {
+		for _, ref := range values {
+			results.Errors = append(results.Errors, referenceStreamError(ref, errBatchStreamOverloaded))
+		}
+		return h.sendResults(results)
+	}
+
+	response, err := h.manager.AddReferences(ctx, h.principal, batchReferencesFromProto(values), h.repl)
+	if err != nil {
+		for _, ref := range values {
+			results.Errors = append(results.Errors, referenceStreamError(ref, err))
+		}
+		return h.sendResults(results)
+	}
+
+	for _, res := range response {
+		ref := values[res.OriginalIndex]
+		if res.Err != nil {
+			results.Errors = append(results.Errors, referenceStreamError(ref, res.Err))
+			continue
+		}
+		results.Successes = append(results.Successes, &pb.BatchStreamReply_Results_Success{
+			Detail: &pb.BatchStreamReply_Results_Success_Reference{Reference: ref},
+		})
+	}
+	return h.sendResults(results)
+}
+
+func batchReferencesFromProto(values []*pb.BatchReference) []*models.BatchReference {
+	refs := make([]*models.BatchReference, len(values))
+	for i, ref := range values {
+		to := BEACON_START + ref.ToUuid
+		if ref.ToCollection != nil && *ref.ToCollection != "" {
+			to = BEACON_START + *ref.ToCollection + "/" + ref.ToUuid
+		}
+		refs[i] = &models.BatchReference{
+			From:   strfmt.URI(BEACON_START + ref.FromCollection + "/" + ref.FromUuid + "/" + ref.Name),
+			To:     strfmt.URI(to),
+			Tenant: ref.Tenant,
+		}
+	}
+	return refs
+}
+
+func objectStreamError(uuid string, err error) *pb.BatchStreamReply_Results_Error {
+	return &pb.BatchStreamReply_Results_Error{
+		Error:  err.Error(),
+		Detail: &pb.BatchStreamReply_Results_Error_Uuid{Uuid: uuid},
+	}
+}
+
+func referenceStreamError(ref *pb.BatchReference, err error) *pb.BatchStreamReply_Results_Error {
+	return &pb.BatchStreamReply_Results_Error{
+		Error:  err.Error(),
+		Detail: &pb.BatchStreamReply_Results_Error_Reference{Reference: ref},
+	}
+}
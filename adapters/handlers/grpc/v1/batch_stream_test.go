@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeMemoryMonitor float64
+
+func (f fakeMemoryMonitor) Ratio() float64 { return float64(f) }
+
+type fakeIndexQueueSizer int64
+
+func (f fakeIndexQueueSizer) IndexQueueSize() int64 { return int64(f) }
+
+func TestBatchStreamLimiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		memory   memoryMonitor
+		queue    indexQueueSizer
+		expected int32
+	}{
+		{name: "no sources", expected: 1000},
+		{name: "idle", memory: fakeMemoryMonitor(0.2), queue: fakeIndexQueueSizer(0), expected: 1000},
+		{name: "memory throttled", memory: fakeMemoryMonitor(0.875), queue: fakeIndexQueueSizer(0), expected: 500},
+		{name: "memory exhausted", memory: fakeMemoryMonitor(0.96), queue: fakeIndexQueueSizer(0), expected: 0},
+		{name: "queue throttled", memory: fakeMemoryMonitor(0.2), queue: fakeIndexQueueSizer(7500), expected: 500},
+		{name: "queue full", memory: fakeMemoryMonitor(0.2), queue: fakeIndexQueueSizer(10000), expected: 0},
+		{name: "most restrictive wins", memory: fakeMemoryMonitor(0.875), queue: fakeIndexQueueSizer(8750), expected: 250},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &batchStreamLimiter{memory: tt.memory, queue: tt.queue, maxBatchSize: 1000, maxQueueSize: 10000}
+			assert.Equal(t, tt.expected, l.batchSize())
+		})
+	}
+}
+
+type fakeBatchStreamServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests chan *pb.BatchStreamRequest
+	replies  []*pb.BatchStreamReply
+}
+
+func (f *fakeBatchStreamServer) Context() context.Context { return f.ctx }
+
+func (f *fakeBatchStreamServer) Send(reply *pb.BatchStreamReply) error {
+	f.replies = append(f.replies, reply)
+	return nil
+}
+
+func (f *fakeBatchStreamServer) Recv() (*pb.BatchStreamRequest, error) {
+	req, ok := <-f.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+type fakeBatchStreamManager struct {
+	objects []*models.Object
+	refs    []*models.BatchReference
+}
+
+func (f *fakeBatchStreamManager) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	f.objects = append(f.objects, objs...)
+	out := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		out[i] = objects.BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+		if obj.Class == "Invalid" {
+			out[i].Err = errors.New("class not found")
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeBatchStreamManager) AddReferences(ctx context.Context, principal *models.Principal,
+	refs []*models.BatchReference, repl *additional.ReplicationProperties,
+) (objects.BatchReferences, error) {
+	f.refs = append(f.refs, refs...)
+	out := make(objects.BatchReferences, len(refs))
+	for i := range refs {
+		out[i] = objects.BatchReference{OriginalIndex: i}
+	}
+	return out, nil
+}
+
+func newBatchStreamTestHandler(stream *fakeBatchStreamServer, manager batchStreamManager,
+	limiter *batchStreamLimiter,
+) *batchStreamHandler {
+	logger, _ := test.NewNullLogger()
+	return &batchStreamHandler{
+		stream:   stream,
+		manager:  manager,
+		limiter:  limiter,
+		getClass: func(string) *models.Class { return nil },
+		logger:   logger,
+	}
+}
+
+func TestBatchStream(t *testing.T) {
+	const (
+		uuid1 = "a0b55b05-bc5b-4cc9-b646-1452d1390a62"
+		uuid2 = "b0b55b05-bc5b-4cc9-b646-1452d1390a62"
+	)
+	toCollection := "Target"
+
+	t.Run("objects and references", func(t *testing.T) {
+		stream := &fakeBatchStreamServer{ctx: context.Background(), requests: make(chan *pb.BatchStreamRequest, 4)}
+		manager := &fakeBatchStreamManager{}
+		h := newBatchStreamTestHandler(stream, manager, &batchStreamLimiter{maxBatchSize: 100})
+
+		stream.requests <- &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Objects_{
+			Objects: &pb.BatchStreamRequest_Objects{Values: []*pb.BatchObject{
+				{Collection: "Valid", Uuid: uuid1},
+				{Collection: "Valid", Uuid: "not-a-uuid"},
+				{Collection: "Invalid", Uuid: uuid2},
+			}},
+		}}
+		stream.requests <- &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_References_{
+			References: &pb.BatchStreamRequest_References{Values: []*pb.BatchReference{
+				{Name: "ref", FromCollection: "Valid", FromUuid: uuid1, ToCollection: &toCollection, ToUuid: uuid2},
+				{Name: "ref", FromCollection: "Valid", FromUuid: uuid1, ToUuid: uuid2, Tenant: "t1"},
+			}},
+		}}
+		stream.requests <- &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Stop_{Stop: &pb.BatchStreamRequest_Stop{}}}
+
+		require.Nil(t, h.run(context.Background()))
+		require.Len(t, stream.replies, 7)
+
+		assert.NotNil(t, stream.replies[0].GetStarted())
+		assert.Equal(t, int32(100), stream.replies[1].GetBackoff().GetBatchSize())
+
+		objResults := stream.replies[2].GetResults()
+		require.NotNil(t, objResults)
+		require.Len(t, objResults.Successes, 1)
+		assert.Equal(t, uuid1, objResults.Successes[0].GetUuid())
+		require.Len(t, objResults.Errors, 2)
+		assert.Equal(t, "not-a-uuid", objResults.Errors[0].GetUuid())
+		assert.Equal(t, uuid2, objResults.Errors[1].GetUuid())
+		assert.Equal(t, "class not found", objResults.Errors[1].Error)
+		assert.Len(t, manager.objects, 2)
+
+		assert.Equal(t, int32(100), stream.replies[3].GetBackoff().GetBatchSize())
+
+		refResults := stream.replies[4].GetResults()
+		require.NotNil(t, refResults)
+		require.Len(t, refResults.Successes, 2)
+		assert.Empty(t, refResults.Errors)
+		require.Len(t, manager.refs, 2)
+		assert.Equal(t, "weaviate://localhost/Valid/"+uuid1+"/ref", manager.refs[0].From.String())
+		assert.Equal(t, "weaviate://localhost/Target/"+uuid2, manager.refs[0].To.String())
+		assert.Equal(t, "weaviate://localhost/"+uuid2, manager.refs[1].To.String())
+		assert.Equal(t, "t1", manager.refs[1].Tenant)
+
+		assert.NotNil(t, stream.replies[5].GetBackoff())
+		assert.NotNil(t, stream.replies[6].GetStopped())
+	})
+
+	t.Run("objects are rejected while paused", func(t *testing.T) {
+		stream := &fakeBatchStreamServer{ctx: context.Background(), requests: make(chan *pb.BatchStreamRequest, 1)}
+		manager := &fakeBatchStreamManager{}
+		limiter := &batchStreamLimiter{memory: fakeMemoryMonitor(0.99), maxBatchSize: 100}
+		h := newBatchStreamTestHandler(stream, manager, limiter)
+
+		stream.requests <- &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Objects_{
+			Objects: &pb.BatchStreamRequest_Objects{Values: []*pb.BatchObject{{Collection: "Valid", Uuid: uuid1}}},
+		}}
+		close(stream.requests)
+
+		require.Nil(t, h.run(context.Background()))
+		require.Len(t, stream.replies, 4)
+		assert.Equal(t, int32(0), stream.replies[1].GetBackoff().GetBatchSize())
+		results := stream.replies[2].GetResults()
+		require.Len(t, results.Errors, 1)
+		assert.Equal(t, errBatchStreamOverloaded.Error(), results.Errors[0].Error)
+		assert.Empty(t, manager.objects)
+	})
+
+	t.Run("start must not be repeated", func(t *testing.T) {
+		stream := &fakeBatchStreamServer{ctx: context.Background(), requests: make(chan *pb.BatchStreamRequest, 1)}
+		h := newBatchStreamTestHandler(stream, &fakeBatchStreamManager{}, &batchStreamLimiter{maxBatchSize: 100})
+
+		stream.requests <- &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Start_{Start: &pb.BatchStreamRequest_Start{}}}
+
+		assert.NotNil(t, h.run(context.Background()))
+	})
+}
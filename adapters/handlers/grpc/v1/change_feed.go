@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/changefeed"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type changeFeedReader interface {
+	ChangeFeed(ctx context.Context, class, tenant string, position changefeed.Position,
+		withObjects bool, fn func(shard string, change changefeed.Change) error) error
+}
+
+// SetChangeFeed enables the ChangeFeed endpoint. Without a reader the
+// endpoint is reported as unimplemented.
+func (s *Service) SetChangeFeed(reader changeFeedReader, authorizer authorization.Authorizer) {
+	s.changeFeedReader = reader
+	s.authorizer = authorizer
+}
+
+func (s *Service) ChangeFeed(req *pb.ChangeFeedRequest, stream pb.Weaviate_ChangeFeedServer) error {
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		errInner = s.changeFeed(req, stream)
+	}, s.logger); err != nil {
+		return err
+	}
+
+	return errInner
+}
+
+func (s *Service) changeFeed(req *pb.ChangeFeedRequest, stream pb.Weaviate_ChangeFeedServer) error {
+	if s.changeFeedReader == nil {
+		return status.Error(codes.Unimplemented, "change feed is not enabled")
+	}
+
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	class := schema.UppercaseClassName(req.Collection)
+	tenant := ""
+	if req.Tenant != nil {
+		tenant = *req.Tenant
+	}
+
+	if err := s.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, "")); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	position, err := changefeed.PositionFromToken(req.GetResumeToken())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.changeFeedReader.ChangeFeed(ctx, class, tenant, position, req.IncludeObject,
+		func(shard string, change changefeed.Change) error {
+			reply, err := changeFeedReply(class, tenant, shard, change, position)
+			if err != nil {
+				return err
+			}
+			return stream.Send(reply)
+		})
+	switch {
+	case err == nil || errors.Is(err, context.Canceled):
+		return nil
+	case errors.Is(err, changefeed.ErrTruncated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return fmt.Errorf("change feed: %w", err)
+	}
+}
+
+// changeFeedReply converts a change, the position must already include it
+func changeFeedReply(class, tenant, shard string, change changefeed.Change,
+	position changefeed.Position,
+) (*pb.ChangeFeedReply, error) {
+	reply := &pb.ChangeFeedReply{
+		Operation:   changeFeedOperation(change.Operation),
+		Uuid:        change.UUID.String(),
+		Collection:  class,
+		Shard:       shard,
+		Sequence:    change.Sequence,
+		Timestamp:   change.Timestamp,
+		ResumeToken: position.Token(),
+	}
+	if tenant != "" {
+		reply.Tenant = &tenant
+	}
+
+	if change.Object != nil {
+		obj, err := json.Marshal(changeFeedObject(change.Object))
+		if err != nil {
+			return nil, fmt.Errorf("marshal object %s: %w", change.UUID, err)
+		}
+		reply.Object = obj
+	}
+
+	return reply, nil
+}
+
+func changeFeedOperation(op changefeed.Operation) pb.ChangeFeedOperation {
+	switch op {
+	case changefeed.OperationInsert:
+		return pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_INSERT
+	case changefeed.OperationUpdate:
+		return pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_UPDATE
+	case changefeed.OperationDelete:
+		return pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_DELETE
+	default:
+		return pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_UNSPECIFIED
+	}
+}
+
+func changeFeedObject(in *storobj.Object) *models.Object {
+	obj := in.Object
+	obj.Vector = in.Vector
+	if len(in.Vectors) > 0 {
+		obj.Vectors = make(models.Vectors, len(in.Vectors))
+		for name, vec := range in.Vectors {
+			obj.Vectors[name] = vec
+		}
+	}
+	if len(in.MultiVectors) > 0 {
+		obj.MultiVectors = make(models.MultiVectors, len(in.MultiVectors))
+		for name, vecs := range in.MultiVectors {
+			multiVector := make(models.MultiVector, len(vecs))
+			for i, vec := range vecs {
+				multiVector[i] = vec
+			}
+			obj.MultiVectors[name] = multiVector
+		}
+	}
+	if len(in.SparseVectors) > 0 {
+		obj.SparseVectors = make(models.SparseVectors, len(in.SparseVectors))
+		for name, vec := range in.SparseVectors {
+			obj.SparseVectors[name] = vec
+		}
+	}
+	return &obj
+}
@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type fakeChangeFeedServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	replies []*pb.ChangeFeedReply
+}
+
+func (f *fakeChangeFeedServer) Context() context.Context { return f.ctx }
+
+func (f *fakeChangeFeedServer) Send(reply *pb.ChangeFeedReply) error {
+	f.replies = append(f.replies, reply)
+	return nil
+}
+
+type fakeChangeFeedReader struct {
+	changes  map[string][]changefeed.Change
+	class    string
+	tenant   string
+	position changefeed.Position
+	err      error
+}
+
+func (f *fakeChangeFeedReader) ChangeFeed(ctx context.Context, class, tenant string,
+	position changefeed.Position, withObjects bool, fn func(string, changefeed.Change) error,
+) error {
+	f.class, f.tenant = class, tenant
+	f.position = changefeed.Position{}
+	for shard, pos := range position {
+		f.position[shard] = pos
+	}
+	if f.err != nil {
+		return f.err
+	}
+
+	for _, shard := range []string{"shard1", "shard2"} {
+		for _, change := range f.changes[shard] {
+			if change.Sequence <= position[shard].Sequence {
+				continue
+			}
+			if !withObjects {
+				change.Object = nil
+			}
+			position[shard] = changefeed.ShardPosition{Node: "node1", Sequence: change.Sequence}
+			if err := fn(shard, change); err != nil {
+				return err
+			}
+		}
+	}
+	return context.Canceled
+}
+
+type fakeAuthorizer struct {
+	err       error
+	resources []string
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	f.resources = append(f.resources, resources...)
+	return f.err
+}
+
+func TestChangeFeed(t *testing.T) {
+	const uuid1 = "a0b55b05-bc5b-4cc9-b646-1452d1390a62"
+
+	obj := &storobj.Object{
+		Object: models.Object{
+			Class:      "Article",
+			ID:         uuid1,
+			Properties: map[string]interface{}{"title": "hello"},
+		},
+		Vector: []float32{1, 2},
+	}
+	reader := &fakeChangeFeedReader{changes: map[string][]changefeed.Change{
+		"shard1": {
+			{Sequence: 1, Operation: changefeed.OperationInsert, UUID: uuid1, Timestamp: 10, Object: obj},
+			{Sequence: 2, Operation: changefeed.OperationDelete, UUID: uuid1, Timestamp: 20},
+		},
+		"shard2": {
+			{Sequence: 1, Operation: changefeed.OperationUpdate, UUID: uuid1, Timestamp: 30, Object: obj},
+		},
+	}}
+
+	newService := func(authorizer authorization.Authorizer) *Service {
+		logger, _ := test.NewNullLogger()
+		s := &Service{allowAnonymousAccess: true, logger: logger}
+		s.SetChangeFeed(reader, authorizer)
+		return s
+	}
+
+	t.Run("not enabled", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		s := &Service{allowAnonymousAccess: true, logger: logger}
+		err := s.ChangeFeed(&pb.ChangeFeedRequest{Collection: "Article"},
+			&fakeChangeFeedServer{ctx: context.Background()})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("all changes", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		stream := &fakeChangeFeedServer{ctx: context.Background()}
+		err := newService(authorizer).ChangeFeed(&pb.ChangeFeedRequest{Collection: "article", IncludeObject: true}, stream)
+		require.Nil(t, err)
+
+		assert.Equal(t, "Article", reader.class)
+		assert.Equal(t, []string{authorization.Objects("Article", "", "")}, authorizer.resources)
+
+		require.Len(t, stream.replies, 3)
+		first := stream.replies[0]
+		assert.Equal(t, pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_INSERT, first.Operation)
+		assert.Equal(t, uuid1, first.Uuid)
+		assert.Equal(t, "shard1", first.Shard)
+		assert.Equal(t, uint64(1), first.Sequence)
+		assert.Equal(t, int64(10), first.Timestamp)
+		require.NotNil(t, first.Object)
+
+		var decoded models.Object
+		require.Nil(t, json.Unmarshal(first.Object, &decoded))
+		assert.Equal(t, "hello", decoded.Properties.(map[string]interface{})["title"])
+		assert.Equal(t, models.C11yVector{1, 2}, decoded.Vector)
+
+		assert.Equal(t, pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_DELETE, stream.replies[1].Operation)
+		assert.Nil(t, stream.replies[1].Object)
+		assert.Equal(t, pb.ChangeFeedOperation_CHANGE_FEED_OPERATION_UPDATE, stream.replies[2].Operation)
+
+		t.Run("resume", func(t *testing.T) {
+			token := stream.replies[1].ResumeToken
+			resumed := &fakeChangeFeedServer{ctx: context.Background()}
+			err := newService(&fakeAuthorizer{}).ChangeFeed(&pb.ChangeFeedRequest{
+				Collection: "Article", ResumeToken: &token,
+			}, resumed)
+			require.Nil(t, err)
+
+			assert.Equal(t, changefeed.Position{"shard1": {Node: "node1", Sequence: 2}}, reader.position)
+			require.Len(t, resumed.replies, 1)
+			assert.Equal(t, "shard2", resumed.replies[0].Shard)
+			assert.Nil(t, resumed.replies[0].Object, "objects were not requested")
+			assert.Equal(t, changefeed.Position{
+				"shard1": {Node: "node1", Sequence: 2},
+				"shard2": {Node: "node1", Sequence: 1},
+			}.Token(), resumed.replies[0].ResumeToken)
+		})
+	})
+
+	t.Run("empty and null tokens", func(t *testing.T) {
+		for _, token := range []string{"", base64.RawURLEncoding.EncodeToString([]byte("null"))} {
+			stream := &fakeChangeFeedServer{ctx: context.Background()}
+			err := newService(&fakeAuthorizer{}).ChangeFeed(&pb.ChangeFeedRequest{
+				Collection: "Article", ResumeToken: &token,
+			}, stream)
+			require.Nil(t, err)
+			assert.Empty(t, reader.position)
+			assert.Len(t, stream.replies, 3)
+		}
+	})
+
+	t.Run("tenant", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		tenant := "tenant1"
+		err := newService(authorizer).ChangeFeed(&pb.ChangeFeedRequest{Collection: "Article", Tenant: &tenant},
+			&fakeChangeFeedServer{ctx: context.Background()})
+		require.Nil(t, err)
+		assert.Equal(t, "tenant1", reader.tenant)
+		assert.Equal(t, []string{authorization.Objects("Article", "tenant1", "")}, authorizer.resources)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		err := newService(&fakeAuthorizer{err: errors.New("forbidden")}).ChangeFeed(
+			&pb.ChangeFeedRequest{Collection: "Article"}, &fakeChangeFeedServer{ctx: context.Background()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		token := "not a token"
+		err := newService(&fakeAuthorizer{}).ChangeFeed(&pb.ChangeFeedRequest{Collection: "Article", ResumeToken: &token},
+			&fakeChangeFeedServer{ctx: context.Background()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		truncated := &fakeChangeFeedReader{err: changefeed.ErrTruncated}
+		logger, _ := test.NewNullLogger()
+		s := &Service{allowAnonymousAccess: true, logger: logger}
+		s.SetChangeFeed(truncated, &fakeAuthorizer{})
+		err := s.ChangeFeed(&pb.ChangeFeedRequest{Collection: "Article"}, &fakeChangeFeedServer{ctx: context.Background()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+)
+
+type exporter interface {
+	Export(ctx context.Context, principal *models.Principal, req *export.Request) (*export.Descriptor, error)
+	Status(ctx context.Context, principal *models.Principal, backend, id, bucket, path string) (*export.Descriptor, error)
+}
+
+// SetExporter enables the export endpoints. Without an exporter the
+// endpoints are reported as unimplemented.
+func (s *Service) SetExporter(exporter exporter) {
+	s.exporter = exporter
+}
+
+func (s *Service) ExportCreate(ctx context.Context, req *pb.ExportCreateRequest) (*pb.ExportReply, error) {
+	if s.exporter == nil {
+		return nil, status.Error(codes.Unimplemented, "export is not enabled")
+	}
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	format, err := exportFormatFromProto(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	desc, err := s.exporter.Export(ctx, principal, &export.Request{
+		ID:      req.Id,
+		Backend: req.Backend,
+		Class:   req.Collection,
+		Tenant:  req.GetTenant(),
+		Format:  format,
+		Bucket:  req.GetBucket(),
+		Path:    req.GetPath(),
+	})
+	if err != nil {
+		return nil, exportError(err)
+	}
+	return exportReply(desc), nil
+}
+
+func (s *Service) ExportStatus(ctx context.Context, req *pb.ExportStatusRequest) (*pb.ExportReply, error) {
+	if s.exporter == nil {
+		return nil, status.Error(codes.Unimplemented, "export is not enabled")
+	}
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	desc, err := s.exporter.Status(ctx, principal, req.Backend, req.Id, req.GetBucket(), req.GetPath())
+	if err != nil {
+		return nil, exportError(err)
+	}
+	return exportReply(desc), nil
+}
+
+func exportFormatFromProto(format pb.ExportFormat) (export.Format, error) {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_JSONL:
+		return export.FormatJSONL, nil
+	case pb.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return export.FormatParquet, nil
+	default:
+		return "", fmt.Errorf("unknown export format %v", format)
+	}
+}
+
+func exportError(err error) error {
+	var forbidden authzerrors.Forbidden
+	switch {
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &backup.ErrUnprocessable{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &backup.ErrNotFound{}):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func exportReply(desc *export.Descriptor) *pb.ExportReply {
+	reply := &pb.ExportReply{
+		Id:         desc.ID,
+		Backend:    desc.Backend,
+		Collection: desc.Class,
+		Format:     pb.ExportFormat_EXPORT_FORMAT_JSONL,
+		Path:       desc.Path,
+		Status:     string(desc.Status),
+		StartedAt:  timestamppb.New(desc.StartedAt),
+		Shards:     make([]*pb.ExportReply_Shard, len(desc.Shards)),
+	}
+	if desc.Format == export.FormatParquet {
+		reply.Format = pb.ExportFormat_EXPORT_FORMAT_PARQUET
+	}
+	if desc.Tenant != "" {
+		reply.Tenant = &desc.Tenant
+	}
+	if desc.Error != "" {
+		reply.Error = &desc.Error
+	}
+	if !desc.CompletedAt.IsZero() {
+		reply.CompletedAt = timestamppb.New(desc.CompletedAt)
+	}
+	for i, shard := range desc.Shards {
+		reply.Shards[i] = &pb.ExportReply_Shard{Name: shard.Name, Key: shard.Key, Objects: shard.Objects}
+	}
+	return reply
+}
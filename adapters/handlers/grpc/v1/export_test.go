@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+)
+
+func TestExportReply(t *testing.T) {
+	started := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	reply := exportReply(&export.Descriptor{
+		ID:        "export-1",
+		Backend:   "s3",
+		Class:     "Article",
+		Tenant:    "tenant1",
+		Format:    export.FormatParquet,
+		Path:      "s3://bucket/export-1",
+		Status:    backup.Transferring,
+		StartedAt: started,
+		Shards:    []export.ShardDescriptor{{Name: "tenant1", Key: "Article/tenant1.parquet", Objects: 3}},
+	})
+
+	assert.Equal(t, "export-1", reply.Id)
+	assert.Equal(t, "tenant1", reply.GetTenant())
+	assert.Equal(t, pb.ExportFormat_EXPORT_FORMAT_PARQUET, reply.Format)
+	assert.Equal(t, "TRANSFERRING", reply.Status)
+	assert.Equal(t, started, reply.StartedAt.AsTime())
+	assert.Nil(t, reply.CompletedAt)
+	assert.Nil(t, reply.Error)
+	require.Len(t, reply.Shards, 1)
+	assert.Equal(t, int64(3), reply.Shards[0].Objects)
+}
+
+func TestExportFormatFromProto(t *testing.T) {
+	format, err := exportFormatFromProto(pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED)
+	require.Nil(t, err)
+	assert.Equal(t, export.FormatJSONL, format)
+
+	format, err = exportFormatFromProto(pb.ExportFormat_EXPORT_FORMAT_PARQUET)
+	require.Nil(t, err)
+	assert.Equal(t, export.FormatParquet, format)
+
+	_, err = exportFormatFromProto(pb.ExportFormat(42))
+	assert.Error(t, err)
+}
+
+func TestExportError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: authzerrors.NewForbidden(&models.Principal{}, "READ", "collections/Article"), code: codes.PermissionDenied},
+		{err: backup.NewErrUnprocessable(errors.New("invalid id")), code: codes.InvalidArgument},
+		{err: backup.NewErrNotFound(errors.New("not found")), code: codes.NotFound},
+		{err: errors.New("backend unavailable"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, status.Code(exportError(tt.err)), tt.err)
+	}
+}
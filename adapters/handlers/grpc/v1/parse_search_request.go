@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/byteops"
 	additional2 "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
@@ -96,8 +97,24 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		out.AdditionalProperties.NoProps = true
 	}
 
+	out.AdditionalProperties.FilterStrategy, err = extractFilterStrategy(req.FilterStrategy)
+	if err != nil {
+		return dto.GetParams{}, errors.Wrap(err, "extract filter strategy")
+	}
+
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Query:                  bm25.Query,
+			Properties:             schema.LowercaseFirstLetterOfStrings(bm25.Properties),
+			Type:                   "bm25",
+			AdditionalExplanations: out.AdditionalProperties.ExplainScore,
+			Fuzziness:              int(bm25.Fuzziness),
+			FuzzyPrefixLength:      int(bm25.FuzzyPrefixLength),
+			Prefix:                 bm25.Prefix,
+		}
+		if bm25.Fuzziness > searchparams.MaxFuzziness {
+			return dto.GetParams{}, fmt.Errorf("bm25 fuzziness should be between 0 and %d", searchparams.MaxFuzziness)
+		}
 	}
 
 	if nv := req.NearVector; nv != nil {
@@ -263,14 +280,27 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		nearVec := req.HybridSearch.NearVector
 
 		out.HybridSearch = &searchparams.HybridSearch{
-			Query:           hs.Query,
-			Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
-			Vector:          vector,
-			Alpha:           float64(hs.Alpha),
-			FusionAlgorithm: fusionType,
-			TargetVectors:   targetVectors,
-			Distance:        distance,
-			WithDistance:    withDistance,
+			Query:             hs.Query,
+			Properties:        schema.LowercaseFirstLetterOfStrings(hs.Properties),
+			Vector:            vector,
+			Alpha:             float64(hs.Alpha),
+			FusionAlgorithm:   fusionType,
+			TargetVectors:     targetVectors,
+			Distance:          distance,
+			WithDistance:      withDistance,
+			Fuzziness:         int(hs.Fuzziness),
+			FuzzyPrefixLength: int(hs.FuzzyPrefixLength),
+			Prefix:            hs.Prefix,
+		}
+		if hs.Fuzziness > searchparams.MaxFuzziness {
+			return dto.GetParams{}, fmt.Errorf("hybrid fuzziness should be between 0 and %d", searchparams.MaxFuzziness)
+		}
+		if sv := hs.SparseVector; sv != nil {
+			if len(sv.Indices) != len(sv.Values) {
+				return dto.GetParams{}, fmt.Errorf("hybrid sparse vector has %d indices but %d values", len(sv.Indices), len(sv.Values))
+			}
+			out.HybridSearch.SparseVector = &models.SparseVector{Indices: sv.Indices, Values: sv.Values}
+			out.HybridSearch.SparseTargetVector = sv.Name
 		}
 
 		if nearVec != nil {
@@ -872,6 +902,8 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		ExplainScore:       prop.ExplainScore,
 		IsConsistent:       prop.IsConsistent,
 		Vectors:            prop.Vectors,
+
+		ExplainVectorSearch: prop.ExplainVectorSearch,
 	}
 
 	if vectorSearch && configvalidation.CheckCertaintyCompatibility(class, targetVectors) != nil {
@@ -903,7 +935,27 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		!metadata.ExplainVectorSearch)
+}
+
+func extractFilterStrategy(strategy *pb.FilterStrategy) (string, error) {
+	if strategy == nil {
+		return "", nil
+	}
+
+	switch *strategy {
+	case pb.FilterStrategy_FILTER_STRATEGY_UNSPECIFIED:
+		return "", nil
+	case pb.FilterStrategy_FILTER_STRATEGY_SWEEPING:
+		return hnswent.FilterStrategySweeping, nil
+	case pb.FilterStrategy_FILTER_STRATEGY_ACORN:
+		return hnswent.FilterStrategyAcorn, nil
+	case pb.FilterStrategy_FILTER_STRATEGY_FLAT:
+		return hnswent.FilterStrategyFlat, nil
+	default:
+		return "", fmt.Errorf("unknown filter strategy %v", *strategy)
+	}
 }
 
 func getAllNonRefNonBlobProperties(getClass func(string) *models.Class, className string) ([]search.SelectProperty, error) {
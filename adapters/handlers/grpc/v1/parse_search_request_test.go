@@ -320,6 +320,28 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Filter strategy and vector search explanation",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{ExplainVectorSearch: true},
+				FilterStrategy: pb.FilterStrategy_FILTER_STRATEGY_ACORN.Enum(),
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties: defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					ExplainVectorSearch: true,
+					FilterStrategy:      "acorn",
+				},
+			},
+			error: false,
+		},
+		{
+			name:  "Unknown filter strategy",
+			req:   &pb.SearchRequest{Collection: classname, FilterStrategy: pb.FilterStrategy(42).Enum()},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Metadata ID only query",
 			req:  &pb.SearchRequest{Collection: classname, Properties: &pb.PropertiesRequest{}, Metadata: &pb.MetadataRequest{Uuid: true}},
@@ -575,6 +597,38 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "hybrid sparse vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					Query: "query", FusionType: pb.Hybrid_FUSION_TYPE_RANKED, Alpha: 0.75,
+					SparseVector: &pb.SparseVector{Name: "splade", Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, HybridSearch: &searchparams.HybridSearch{
+					Query: "query", FusionAlgorithm: common_filters.HybridRankedFusion, Alpha: 0.75,
+					SparseVector:       &models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+					SparseTargetVector: "splade",
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "hybrid sparse vector with mismatching lengths",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					Query: "query", Alpha: 0.75,
+					SparseVector: &pb.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5}},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "hybrid ranked groupby",
 			req: &pb.SearchRequest{
@@ -319,6 +319,19 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.ExplainVectorSearch {
+		if explain, ok := additionalPropertiesMap["explainVectorSearch"].(*additional.VectorSearchExplain); ok {
+			metadata.ExplainVectorSearch = &pb.VectorSearchExplain{
+				Shard:                explain.Shard,
+				AllowListSize:        int64(explain.AllowListSize),
+				FilterStrategy:       explain.FilterStrategy,
+				NodesVisited:         explain.NodesVisited,
+				DistanceComputations: explain.DistanceComputations,
+				RescoredCandidates:   explain.RescoredCandidates,
+			}
+		}
+	}
+
 	if additionalPropsParams.Score {
 		metadata.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
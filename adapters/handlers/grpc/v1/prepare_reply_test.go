@@ -213,6 +213,25 @@ func TestGRPCReply(t *testing.T) {
 			},
 			usesWeaviateStruct: true,
 		},
+		{
+			name: "vector search explanation",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"explainVectorSearch": &additional.VectorSearchExplain{
+						Shard: "abc", AllowListSize: 120, FilterStrategy: "acorn", NodesVisited: 30,
+						DistanceComputations: 400, RescoredCandidates: 10,
+					}},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{ExplainVectorSearch: true}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{ExplainVectorSearch: &pb.VectorSearchExplain{
+					Shard: "abc", AllowListSize: 120, FilterStrategy: "acorn", NodesVisited: 30,
+					DistanceComputations: 400, RescoredCandidates: 10,
+				}}, Properties: &pb.PropertiesResult{}},
+			},
+			usesWeaviateStruct: true,
+		},
 		{
 			name: "named vector only",
 			res: []interface{}{
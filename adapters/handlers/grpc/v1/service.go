@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -41,6 +42,10 @@ type Service struct {
 	batchManager         *objects.BatchManager
 	config               *config.Config
 	logger               logrus.FieldLogger
+	batchStreamLimiter   *batchStreamLimiter
+	changeFeedReader     changeFeedReader
+	exporter             exporter
+	authorizer           authorization.Authorizer
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
@@ -55,6 +60,10 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		batchManager:         batchManager,
 		config:               config,
 		logger:               logger,
+		batchStreamLimiter: &batchStreamLimiter{
+			maxBatchSize: config.GRPC.BatchStreamMaxBatchSize,
+			maxQueueSize: config.GRPC.BatchStreamMaxQueueSize,
+		},
 	}
 }
 
@@ -12,6 +12,7 @@
 package clusterapi
 
 import (
+	"bufio"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -30,6 +31,7 @@ import (
 	reposdb "github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changefeed"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	entschema "github.com/weaviate/weaviate/entities/schema"
@@ -50,6 +52,7 @@ type indices struct {
 	maintenanceModeEnabled          bool
 	regexpObjects                   *regexp.Regexp
 	regexpObjectsOverwrite          *regexp.Regexp
+	regexpObjectsExport             *regexp.Regexp
 	regexObjectsDigest              *regexp.Regexp
 	regexObjectsDigestsInTokenRange *regexp.Regexp
 	regexObjectsHashTreeLevel       *regexp.Regexp
@@ -61,6 +64,7 @@ type indices struct {
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpShardChanges        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
@@ -78,6 +82,8 @@ const (
 		`\/shards\/(` + sh + `)\/objects`
 	urlPatternObjectsOverwrite = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects:overwrite`
+	urlPatternObjectsExport = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects:export`
 	urlPatternObjectsDigest = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects:digest`
 	urlPatternObjectsDigestsInTokenRange = `\/indices\/(` + cl + `)` +
@@ -98,6 +104,8 @@ const (
 		`\/shards\/(` + sh + `)\/queuesize`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternShardChanges = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/changes`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShard = `\/indices\/(` + cl + `)` +
@@ -140,6 +148,10 @@ type shards interface {
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
+	ReadChanges(ctx context.Context, indexName, shardName string,
+		after uint64, limit int, withObjects bool) ([]changefeed.Change, error)
+	ExportObjects(ctx context.Context, indexName, shardName, exportID string,
+		onSnapshot func() error, fn func(*storobj.Object) error) error
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -166,6 +178,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled bool, lo
 	return &indices{
 		regexpObjects:                   regexp.MustCompile(urlPatternObjects),
 		regexpObjectsOverwrite:          regexp.MustCompile(urlPatternObjectsOverwrite),
+		regexpObjectsExport:             regexp.MustCompile(urlPatternObjectsExport),
 		regexObjectsDigest:              regexp.MustCompile(urlPatternObjectsDigest),
 		regexObjectsDigestsInTokenRange: regexp.MustCompile(urlPatternObjectsDigestsInTokenRange),
 		regexObjectsHashTreeLevel:       regexp.MustCompile(urlPatternHashTreeLevel),
@@ -177,6 +190,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled bool, lo
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:     regexp.MustCompile(urlPatternShardsQueueSize),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
+		regexpShardChanges:        regexp.MustCompile(urlPatternShardChanges),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
@@ -233,6 +247,13 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 
 			i.putOverwriteObjects().ServeHTTP(w, r)
+		case i.regexpObjectsExport.MatchString(path):
+			if r.Method != http.MethodGet {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.getExportObjects().ServeHTTP(w, r)
 		case i.regexObjectsDigest.MatchString(path):
 			if r.Method != http.MethodGet {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -312,6 +333,14 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case i.regexpShardChanges.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getChanges().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.postShardFile().ServeHTTP(w, r)
@@ -1197,6 +1226,125 @@ func (i *indices) getGetShardQueueSize() http.Handler {
 	})
 }
 
+func (i *indices) getChanges() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardChanges.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		query := r.URL.Query()
+		after, err := strconv.ParseUint(query.Get("after"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid url param 'after': "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil {
+			http.Error(w, "invalid url param 'limit': "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		withObjects := query.Get("objects") == "true"
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":  shard,
+			"action": "ReadChanges",
+		}).Debug("reading changes ...")
+
+		changes, err := i.shards.ReadChanges(r.Context(), index, shard, after, limit, withObjects)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil && errors.Is(err, changefeed.ErrTruncated) {
+			http.Error(w, err.Error(), http.StatusGone)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		changesBytes, err := IndicesPayloads.Changes.Marshal(changes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.Changes.SetContentTypeHeader(w)
+		w.Write(changesBytes)
+	})
+}
+
+func (i *indices) getExportObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsExport.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		exportID := r.URL.Query().Get("export_id")
+		if exportID == "" {
+			http.Error(w, "missing required url param 'export_id'", http.StatusBadRequest)
+			return
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":     shard,
+			"action":    "ExportObjects",
+			"export_id": exportID,
+		}).Debug("exporting objects ...")
+
+		// the status can only be reported until the snapshot of the shard was
+		// taken, later errors are passed on in the stream. The snapshot frame
+		// is flushed right away, the coordinator waits for it before it reads
+		// the objects of any shard.
+		started := false
+		bw := bufio.NewWriter(w)
+		onSnapshot := func() error {
+			IndicesPayloads.ExportObjects.SetContentTypeHeader(w)
+			started = true
+			if err := IndicesPayloads.ExportObjects.WriteSnapshot(bw); err != nil {
+				return err
+			}
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			return nil
+		}
+		err := i.shards.ExportObjects(r.Context(), index, shard, exportID, onSnapshot,
+			func(obj *storobj.Object) error {
+				return IndicesPayloads.ExportObjects.WriteObject(bw, obj)
+			})
+		if err != nil && !started {
+			if errors.As(err, &enterrors.ErrUnprocessable{}) {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ExportObjects.SetContentTypeHeader(w)
+		if err := IndicesPayloads.ExportObjects.WriteEnd(bw, err); err == nil {
+			bw.Flush()
+		}
+	})
+}
+
 func (i *indices) getGetShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -63,6 +64,9 @@ type indicesPayloads struct {
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	Reshard                   reshardPayload
+	Changes                   changesPayload
+	ExportObjects             exportObjectsPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -89,6 +93,20 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+type reshardPayload struct{}
+
+func (p reshardPayload) Marshall(req scaler.ReshardRequest) ([]byte, error) {
+	return json.Marshal(req)
+}
+
+func (p reshardPayload) Unmarshal(in []byte) (scaler.ReshardRequest, error) {
+	var req scaler.ReshardRequest
+	if err := json.Unmarshal(in, &req); err != nil {
+		return req, fmt.Errorf("unmarshal reshard payload: %w", err)
+	}
+	return req, nil
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
@@ -827,6 +845,189 @@ func (p getShardQueueSizeResultsPayload) CheckContentTypeHeader(r *http.Response
 	return ct, ct == p.MIME()
 }
 
+type changesPayload struct{}
+
+type changePayload struct {
+	Sequence  uint64               `json:"seq"`
+	Operation changefeed.Operation `json:"op"`
+	UUID      strfmt.UUID          `json:"id"`
+	Timestamp int64                `json:"ts"`
+	Object    []byte               `json:"obj,omitempty"`
+}
+
+func (p changesPayload) Marshal(in []changefeed.Change) ([]byte, error) {
+	out := make([]changePayload, len(in))
+	for i, change := range in {
+		out[i] = changePayload{
+			Sequence:  change.Sequence,
+			Operation: change.Operation,
+			UUID:      change.UUID,
+			Timestamp: change.Timestamp,
+		}
+		if change.Object != nil {
+			objBytes, err := change.Object.MarshalBinary()
+			if err != nil {
+				return nil, fmt.Errorf("marshal object of change %d: %w", change.Sequence, err)
+			}
+			out[i].Object = objBytes
+		}
+	}
+	return json.Marshal(out)
+}
+
+func (p changesPayload) Unmarshal(in []byte) ([]changefeed.Change, error) {
+	var changes []changePayload
+	if err := json.Unmarshal(in, &changes); err != nil {
+		return nil, err
+	}
+
+	out := make([]changefeed.Change, len(changes))
+	for i, change := range changes {
+		out[i] = changefeed.Change{
+			Sequence:  change.Sequence,
+			Operation: change.Operation,
+			UUID:      change.UUID,
+			Timestamp: change.Timestamp,
+		}
+		if len(change.Object) > 0 {
+			obj, err := storobj.FromBinary(change.Object)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal object of change %d: %w", change.Sequence, err)
+			}
+			out[i].Object = obj
+		}
+	}
+	return out, nil
+}
+
+func (p changesPayload) MIME() string {
+	return "application/vnd.weaviate.changes+json"
+}
+
+func (p changesPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p changesPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+// exportObjectsPayload streams the objects of a shard. Every frame starts
+// with its kind and the length of its content. The stream starts with a
+// snapshot frame once the snapshot of the shard is taken, and is terminated
+// by an end frame, or an error frame if the export failed after it started.
+type exportObjectsPayload struct{}
+
+const (
+	exportFrameObject = byte(iota + 1)
+	exportFrameEnd
+	exportFrameError
+	exportFrameSnapshot
+)
+
+func (p exportObjectsPayload) MIME() string {
+	return "application/vnd.weaviate.storobj.stream+octet-stream"
+}
+
+func (p exportObjectsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p exportObjectsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p exportObjectsPayload) writeFrame(w io.Writer, kind byte, content []byte) error {
+	var header [9]byte
+	header[0] = kind
+	binary.LittleEndian.PutUint64(header[1:], uint64(len(content)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(content)
+	return err
+}
+
+// WriteSnapshot starts the stream once the snapshot of the shard is taken
+func (p exportObjectsPayload) WriteSnapshot(w io.Writer) error {
+	return p.writeFrame(w, exportFrameSnapshot, nil)
+}
+
+func (p exportObjectsPayload) readFrame(r io.Reader) (byte, []byte, error) {
+	var header [9]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, fmt.Errorf("read frame: %w", err)
+	}
+	content := make([]byte, binary.LittleEndian.Uint64(header[1:]))
+	if _, err := io.ReadFull(r, content); err != nil {
+		return 0, nil, fmt.Errorf("read frame: %w", err)
+	}
+	return header[0], content, nil
+}
+
+// ReadSnapshot reads the snapshot frame the stream starts with
+func (p exportObjectsPayload) ReadSnapshot(r io.Reader) error {
+	kind, content, err := p.readFrame(r)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case exportFrameSnapshot:
+		return nil
+	case exportFrameError:
+		return errors.New(string(content))
+	default:
+		return fmt.Errorf("unexpected frame kind %d, expected snapshot", kind)
+	}
+}
+
+func (p exportObjectsPayload) WriteObject(w io.Writer, obj *storobj.Object) error {
+	b, err := obj.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+	}
+	return p.writeFrame(w, exportFrameObject, b)
+}
+
+// WriteEnd terminates the stream, err is passed on to the reader
+func (p exportObjectsPayload) WriteEnd(w io.Writer, err error) error {
+	if err != nil {
+		return p.writeFrame(w, exportFrameError, []byte(err.Error()))
+	}
+	return p.writeFrame(w, exportFrameEnd, nil)
+}
+
+// Read calls fn for every object of the stream following its snapshot
+// frame. It fails if the stream ends without an end frame.
+func (p exportObjectsPayload) Read(r io.Reader, fn func(*storobj.Object) error) error {
+	for {
+		kind, content, err := p.readFrame(r)
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case exportFrameObject:
+			obj, err := storobj.FromBinary(content)
+			if err != nil {
+				return fmt.Errorf("unmarshal object: %w", err)
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		case exportFrameEnd:
+			return nil
+		case exportFrameError:
+			return errors.New(string(content))
+		default:
+			return fmt.Errorf("unknown frame kind %d", kind)
+		}
+	}
+}
+
 type getShardStatusParamsPayload struct{}
 
 func (p getShardStatusParamsPayload) MIME() string {
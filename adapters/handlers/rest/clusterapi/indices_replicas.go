@@ -66,6 +66,9 @@ type replicator interface {
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalReshard(ctx context.Context, className string,
+		req scaler.ReshardRequest) error
+	LocalSyncReplica(ctx context.Context, className, shardName string) error
 }
 
 type replicatedIndices struct {
@@ -94,6 +97,10 @@ var (
 		`\/shards\/(` + sh + `)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/replication-factor:increase`)
+	regxSyncReplica = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/replicas:sync`)
+	regxReshard = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/reshard:(start|finish|abort)`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
@@ -207,6 +214,24 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxSyncReplica.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.syncReplica().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case regxReshard.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.reshard().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -295,6 +320,56 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) syncReplica() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxSyncReplica.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		if err := i.scaler.LocalSyncReplica(r.Context(), index, shard); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (i *replicatedIndices) reshard() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxReshard.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, phase := args[1], args[2]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		req, err := IndicesPayloads.Reshard.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Phase = scaler.ReshardPhase(phase)
+
+		if err := i.scaler.LocalReshard(r.Context(), index, req); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
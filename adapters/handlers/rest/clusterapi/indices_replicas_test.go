@@ -46,6 +46,8 @@ func TestMaintenanceModeReplicatedIndices(t *testing.T) {
 		{"POST", "/objects"},
 		{"DELETE", "/objects"},
 		{"PUT", "/replication-factor:increase"},
+		{"PUT", "/reshard:start"},
+		{"PUT", "/replicas:sync"},
 		{"POST", ":commit"},
 		{"POST", ":abort"},
 	}
@@ -51,6 +51,8 @@ func TestMaintenanceModeIndices(t *testing.T) {
 		{"GET", "/queuesize"},
 		{"GET", "/status"},
 		{"POST", "/status"},
+		{"GET", "/changes"},
+		{"GET", "/objects:export"},
 		{"POST", "/files/myfile"},
 		{"POST", ""},
 		{"PUT", ":reinit"},
@@ -109,11 +109,13 @@ import (
 	modvoyageai "github.com/weaviate/weaviate/modules/text2vec-voyageai"
 	modweaviateembed "github.com/weaviate/weaviate/modules/text2vec-weaviate"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/build"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/export"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -143,7 +145,7 @@ type vectorRepo interface {
 	objects.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
-	scaler.BackUpper
+	scaler.Source
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -328,6 +330,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		SeparateObjectsCompactions:     appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
 		MaxSegmentSize:                 appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
 		HNSWMaxLogSize:                 appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		ChangeFeedEnabled:              appState.ServerConfig.Config.Persistence.ChangeFeedEnabled,
+		ChangeFeedRetention:            appState.ServerConfig.Config.Persistence.ChangeFeedRetention,
+		ObjectsTTLDeleteInterval:       time.Duration(appState.ServerConfig.Config.ObjectsTTLDeleteIntervalSeconds) * time.Second,
+		VectorRecallMonitoring:         appState.ServerConfig.Config.VectorRecallMonitoring,
 		HNSWWaitForCachePrefill:        appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
 		HNSWFlatSearchConcurrency:      appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
 		VisitedListPoolMaxSize:         appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
@@ -335,6 +341,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		QueryLimit:                     appState.ServerConfig.Config.QueryDefaults.Limit,
 		QueryMaximumResults:            appState.ServerConfig.Config.QueryMaximumResults,
 		QueryNestedRefLimit:            appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
+		QueryTermExpansionScanLimit:    appState.ServerConfig.Config.QueryTermExpansionScanLimit,
 		MaxImportGoroutinesFactor:      appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:          appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:                  appState.ServerConfig.Config.ResourceUsage,
@@ -512,6 +519,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	appState.ClusterService = rCluster.New(rConfig)
 	migrator.SetCluster(appState.ClusterService.Raft)
+	if authorizer, ok := appState.Authorizer.(*rbac.Authorizer); ok {
+		authorizer.SetRolesReader(appState.ClusterService.Raft)
+	}
 
 	executor := schema.NewExecutor(migrator,
 		appState.ClusterService.SchemaReader(),
@@ -693,7 +703,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Authorizer,
 		appState.Logger, appState.Modules)
 
-	setupAuthZHandlers(api, appState.Metrics, appState.Authorizer, appState.Logger)
+	var rbacController rbac.Controller
+	if appState.ServerConfig.Config.Authorization.Rbac.Enabled {
+		rbacController = appState.ClusterService.Raft
+	}
+	setupAuthZHandlers(api, rbacController, appState.Metrics, appState.Authorizer, appState.Logger)
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
 	objectsManager := objects.NewManager(appState.Locks,
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
@@ -709,6 +723,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
 	backupScheduler := startBackupScheduler(appState)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
+	backupSchedule := startBackupSchedule(appState, backupScheduler)
+	appState.Exporter = export.NewExporter(appState.Authorizer, appState.Modules, appState.DB, appState.Logger)
+	setupExportHandlers(api, appState.Exporter, appState.Metrics, appState.Logger)
+	setupImportHandlers(api, export.NewImporter(appState.Authorizer, appState.Modules,
+		appState.BatchManager, os.TempDir(), appState.Logger), appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
 
 	grpcServer := createGrpcServer(appState)
@@ -747,6 +766,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
 
+		if backupSchedule != nil {
+			backupSchedule.Close()
+		}
+
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
@@ -778,6 +801,22 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 	return backupScheduler
 }
 
+// startBackupSchedule starts taking backups periodically if a schedule is configured
+func startBackupSchedule(appState *state.State, scheduler *backup.Scheduler) *backup.Schedule {
+	cfg := appState.ServerConfig.Config.BackupSchedule
+	if cfg.Cron == "" {
+		return nil
+	}
+	schedule, err := backup.NewSchedule(scheduler, cfg, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid backup schedule")
+	}
+	schedule.Start()
+	return schedule
+}
+
 // TODO: Split up and don't write into global variables. Instead return an appState
 func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup) *state.State {
 	appState := &state.State{}
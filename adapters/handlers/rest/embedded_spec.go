@@ -1403,19 +1403,28 @@ func init() {
         ]
       }
     },
-    "/cluster/statistics": {
-      "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
+    "/cluster/async-replication/{className}/pause": {
+      "put": {
+        "description": "Stops comparing and repairing the replicas of the collection in the background, e.g. to reduce load during an incident. Changes are still tracked, so replicas are repaired once async replication is resumed. Manual repairs are still possible.",
         "tags": [
           "cluster"
         ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
+        "summary": "Pause async replication of a collection.",
+        "operationId": "cluster.asyncReplication.pause",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the collection.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Cluster statistics successfully returned",
+            "description": "Async replication successfully paused",
             "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
+              "$ref": "#/definitions/ReplicationConfig"
             }
           },
           "401": {
@@ -1427,8 +1436,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1441,34 +1456,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
+          "weaviate.cluster.asyncReplication.pause"
         ]
       }
     },
-    "/graphql": {
+    "/cluster/async-replication/{className}/repair": {
       "post": {
-        "description": "Get a response based on a GraphQL query",
+        "description": "Compares the replicas of each shard of the collection, or of the given shard only, and propagates the objects missing or outdated on any of them, until all replicas hold the same objects. The repair runs in the background, the request returns once it is started. Its progress is reported per replica by the async replication status in the verbose output of the nodes API.",
         "tags": [
-          "graphql"
+          "cluster"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Repair the replicas of the shards of a collection.",
+        "operationId": "cluster.asyncReplication.repair",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
+            "type": "string",
+            "description": "The name of the collection.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the shard to repair. All shards are repaired if not set.",
+            "name": "shard",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Repair of the shards successfully started",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/AsyncReplicationRepairResponse"
             }
           },
           "401": {
@@ -1480,8 +1499,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection or shard not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1493,40 +1518,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.cluster.asyncReplication.repair"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/cluster/async-replication/{className}/resume": {
+      "put": {
+        "description": "Resumes comparing and repairing the replicas of the collection in the background after it was paused.",
         "tags": [
-          "graphql"
+          "cluster"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Resume async replication of a collection.",
+        "operationId": "cluster.asyncReplication.resume",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "The name of the collection.",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Async replication successfully resumed",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/ReplicationConfig"
             }
           },
           "401": {
@@ -1538,8 +1556,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1551,29 +1575,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.cluster.asyncReplication.resume"
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/cluster/nodes/{nodeName}/drain": {
+      "post": {
+        "description": "Moves every shard replica held by the node to other storage nodes. Like a replica move, each target is added to the replicas of the shard and synced with async replication before the node is removed from the replicas and deletes its local copy, so every collection with replicas on the node needs async replication. Replicas of inactive tenants are skipped and listed in the response. Once no replica is left, the node can be removed from the cluster.",
         "tags": [
-          "meta"
+          "cluster"
+        ],
+        "summary": "Move all shard replicas off a node.",
+        "operationId": "cluster.drain.node",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node to drain.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Node successfully drained",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/NodeDrainResponse"
             }
           },
           "401": {
@@ -1585,6 +1613,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Node not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The node cannot be drained, e.g. because no other node is left to hold its replicas or a collection with replicas on the node does not have async replication enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1592,31 +1632,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.cluster.nodes.drain"
         ]
       }
     },
-    "/nodes": {
-      "get": {
-        "description": "Returns node information for the entire database.",
+    "/cluster/rebalance": {
+      "post": {
+        "description": "Proposes shard replica moves from the nodes storing the most objects, as reported by the nodes endpoint, to the nodes storing the fewest. Unless a dry run is requested, the moves are executed one after another. Only replicas of collections with async replication enabled are moved.",
         "tags": [
-          "nodes"
+          "cluster"
         ],
-        "summary": "Node information for the database.",
-        "operationId": "nodes.get",
+        "summary": "Even out the number of objects stored by nodes.",
+        "operationId": "cluster.rebalance",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClusterRebalanceRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "The proposed or executed moves",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/ClusterRebalanceResponse"
             }
           },
           "401": {
@@ -1629,13 +1672,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Collection or node not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "A move cannot be executed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1648,34 +1691,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.cluster.rebalance"
         ]
       }
     },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns node information for the nodes relevant to the collection.",
+    "/cluster/replicas/move": {
+      "post": {
+        "description": "Copies the replica of a shard from the source node to the target node and adds the target node to the replicas of the shard. Unless only a copy is requested, the source replica is removed afterwards. Writes made while the replica is copied are propagated by async replication, which must be enabled for the collection to move a replica.",
         "tags": [
-          "nodes"
+          "cluster"
         ],
-        "summary": "Node information for a collection.",
-        "operationId": "nodes.get.class",
+        "summary": "Move or copy a shard replica to another node.",
+        "operationId": "cluster.replicas.move",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReplicaMoveRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Replica successfully moved or copied",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/ReplicaMoveResponse"
             }
           },
           "401": {
@@ -1688,13 +1730,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Collection or node not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "The replica cannot be moved, e.g. because the target node already holds it.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1707,55 +1749,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.cluster.replicas.move"
         ]
       }
     },
-    "/objects": {
+    "/cluster/statistics": {
       "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
-        "parameters": [
-          {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonSortParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonClassParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
-          }
+          "cluster"
         ],
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Cluster statistics successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ClusterStatisticsResponse"
             }
           },
           "401": {
@@ -1767,11 +1777,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1783,43 +1790,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.cluster.statistics.get"
         ]
-      },
+      }
+    },
+    "/export/{backend}": {
       "post": {
-        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+        "description": "Export a point-in-time snapshot of a collection, or of a single tenant, to JSONL or Parquet files through a backup backend. The snapshot of all shards is taken when the export starts, writes made afterwards are not included. Shards without a replica on the node receiving the request are exported from one of their replicas on other nodes.",
         "tags": [
-          "objects"
+          "exports"
         ],
-        "summary": "Create a new object.",
-        "operationId": "objects.create",
+        "summary": "Start an export",
+        "operationId": "exports.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ExportCreateRequest"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Export successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ExportStatusResponse"
             }
           },
           "401": {
@@ -1832,7 +1837,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid export request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1844,34 +1849,112 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+    "/export/{backend}/{id}": {
+      "get": {
+        "description": "Returns the status of an export and the data files written so far.",
         "tags": [
-          "objects"
+          "exports"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Get export status",
+        "operationId": "exports.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the export.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Path or key prefix within the bucket",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Export status successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/ExportStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Export does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export status request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get a response based on a GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1898,57 +1981,37 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -1960,11 +2023,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1979,28 +2039,26 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+      }
+    },
+    "/import/{backend}": {
+      "post": {
+        "description": "Import objects from JSONL or Parquet files on a backup backend, e.g. the files written by an export. The import runs in the background and ingests the objects in batches. Rows which cannot be imported are reported in the status of the import. Starting an import with the ID of a failed or canceled import resumes it after the last processed rows.",
         "tags": [
-          "objects"
+          "imports"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Start or resume an import",
+        "operationId": "imports.create",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
@@ -2009,18 +2067,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ImportCreateRequest"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Import successfully started.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ImportStatusResponse"
             }
           },
           "401": {
@@ -2032,11 +2087,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid import request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2048,49 +2100,52 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
-      },
-      "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
+      }
+    },
+    "/import/{backend}/{id}": {
+      "get": {
+        "description": "Returns the progress of an import and the errors of rows which could not be imported.",
         "tags": [
-          "objects"
+          "imports"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Get import status",
+        "operationId": "imports.status",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of the import.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "string",
+            "description": "Path or key prefix within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Import status successfully returned.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ImportStatusResponse"
             }
           },
           "401": {
@@ -2103,10 +2158,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Import does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid import status request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2118,45 +2176,36 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
       },
-      "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+      "delete": {
+        "description": "Cancels a running import. It can be resumed by starting it again.",
         "tags": [
-          "objects"
+          "imports"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Cancel an import",
+        "operationId": "imports.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "The ID of the import.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Object exists."
+            "description": "Successfully canceled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2168,10 +2217,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Not Found - Import is not running",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2183,55 +2229,67 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "objects"
+          "meta"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns node information for the entire database.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Node information for the database.",
+        "operationId": "nodes.get",
+        "parameters": [
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2244,10 +2302,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2259,67 +2320,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns node information for the nodes relevant to the collection.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Node information for a collection.",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2332,10 +2361,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2347,52 +2379,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonLimitParameterQuery"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -2400,7 +2420,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2418,10 +2441,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2436,57 +2459,35 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      "post": {
+        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Create a new object.",
+        "operationId": "objects.create",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2503,14 +2504,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2525,20 +2520,76 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
       }
     },
-    "/objects/{id}": {
-      "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2549,6 +2600,15 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -2576,6 +2636,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2590,18 +2656,23 @@ func init() {
         ]
       },
       "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
@@ -2657,14 +2728,19 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2684,6 +2760,12 @@ func init() {
           "204": {
             "description": "Successfully deleted."
           },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2696,6 +2778,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2710,21 +2798,33 @@ func init() {
         ]
       },
       "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -2743,6 +2843,12 @@ func init() {
           "404": {
             "description": "Object doesn't exist."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2753,22 +2859,28 @@ func init() {
         "x-available-in-mqtt": true,
         "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.local.manipulate"
         ]
       },
       "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
         "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "operationId": "objects.class.patch",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
@@ -2790,7 +2902,10 @@ func init() {
             "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "The patch-JSON is malformed."
+            "description": "The patch-JSON is malformed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2824,16 +2939,22 @@ func init() {
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
+    "/objects/{className}/{id}/references/{propertyName}": {
       "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
         "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "operationId": "objects.class.references.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2857,6 +2978,9 @@ func init() {
               "$ref": "#/definitions/MultipleRef"
             }
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
@@ -2865,6 +2989,12 @@ func init() {
           "200": {
             "description": "Successfully replaced all the references."
           },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2874,6 +3004,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -2894,14 +3027,20 @@ func init() {
         ]
       },
       "post": {
-        "description": "Add a cross-reference.",
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
         "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2925,6 +3064,9 @@ func init() {
               "$ref": "#/definitions/SingleRef"
             }
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
@@ -2933,6 +3075,12 @@ func init() {
           "200": {
             "description": "Successfully added the reference."
           },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2942,6 +3090,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -2967,9 +3118,15 @@ func init() {
           "objects"
         ],
         "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2993,6 +3150,9 @@ func init() {
               "$ref": "#/definitions/SingleRef"
             }
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
@@ -3001,6 +3161,12 @@ func init() {
           "204": {
             "description": "Successfully deleted."
           },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -3016,6 +3182,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3030,28 +3202,39 @@ func init() {
         ]
       }
     },
-    "/schema": {
+    "/objects/{id}": {
       "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3063,6 +3246,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3070,32 +3256,46 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
         "parameters": [
           {
-            "name": "objectClass",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -3107,8 +3307,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3120,39 +3323,39 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}": {
-      "get": {
+      },
+      "delete": {
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3164,7 +3367,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3173,39 +3376,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3217,16 +3414,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object doesn't exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3235,34 +3423,47 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.objects.check"
         ]
       },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
+          "204": {
+            "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3273,6 +3474,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3280,22 +3490,35 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -3304,16 +3527,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3325,7 +3548,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3337,38 +3560,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/shards": {
-      "get": {
-        "description": "Get the status of every shard in the cluster.",
+      },
+      "post": {
+        "description": "Add a cross-reference.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3379,8 +3615,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3392,29 +3628,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update a shard status.",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "shardName",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -3423,16 +3663,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Shard status was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3444,13 +3684,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3462,26 +3696,22 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema": {
       "get": {
-        "description": "get all tenants from a specific class",
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the list of tenants.",
-        "operationId": "tenants.get",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "boolean",
             "default": true,
@@ -3492,12 +3722,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -3509,54 +3736,39 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       },
-      "put": {
-        "description": "Update tenant of a specific class",
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a tenant.",
-        "operationId": "tenants.update",
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3569,7 +3781,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3580,15 +3792,19 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "post": {
-        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/{className}": {
+      "get": {
         "tags": [
           "schema"
         ],
-        "summary": "Create a new tenant",
-        "operationId": "tenants.create",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -3597,25 +3813,18 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added new tenants to the specified class",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3627,11 +3836,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3639,14 +3845,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       },
-      "delete": {
-        "description": "delete tenants from a specific class",
+      "put": {
+        "description": "Add a property to an existing collection.",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
@@ -3655,20 +3865,20 @@ func init() {
             "required": true
           },
           {
-            "name": "tenants",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3679,8 +3889,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3691,43 +3907,34 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "/schema/{className}/tenants/{tenantName}": {
-      "get": {
-        "description": "get a specific tenant for the given class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a specific tenant",
-        "operationId": "tenants.get.one",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenantName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "load the tenant given the specified class",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "$ref": "#/definitions/TenantResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3739,30 +3946,25 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Tenant not found"
-          },
-          "422": {
-            "description": "Invalid tenant or class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "head": {
-        "description": "Check if a tenant exists for a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
         "tags": [
           "schema"
         ],
-        "summary": "Check whether a tenant exists",
-        "operationId": "tenant.exists",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
@@ -3770,23 +3972,79 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/reshard": {
+      "post": {
+        "description": "Split the shards of a collection without multi-tenancy into the requested number of shards while it keeps serving reads and writes. The objects which move to the new shards are copied in the background, all changes made to them meanwhile are mirrored. Once the copy has caught up, the new sharding state is committed and the moved objects are deleted from their previous shards. The number of shards can only be increased, up to the number of virtual shards of the collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Change the number of shards of a collection",
+        "operationId": "schema.objects.reshard",
+        "parameters": [
           {
             "type": "string",
-            "name": "tenantName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReshardRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "The tenant exists in the specified class"
+            "description": "The collection was resharded",
+            "schema": {
+              "$ref": "#/definitions/ReshardResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3798,10 +4056,13 @@ func init() {
             }
           },
           "404": {
-            "description": "The tenant not found"
+            "description": "Class to be resharded does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid reshard attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3812,965 +4073,771 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "(Response only) Additional meta information about a single object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupConfig": {
-      "description": "Backup custom configuration",
-      "type": "object",
-      "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
-        },
-        "ChunkSize": {
-          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
-          "type": "integer",
-          "default": 128,
-          "maximum": 512,
-          "minimum": 2,
-          "x-nullable": false
-        },
-        "CompressionLevel": {
-          "description": "compression level used by compression algorithm",
-          "type": "string",
-          "default": "DefaultCompression",
-          "enum": [
-            "DefaultCompression",
-            "BestSpeed",
-            "BestCompression"
-          ],
-          "x-nullable": false
-        },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
-          "type": "string"
         },
-        "Path": {
-          "description": "Path or key within the bucket",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object",
-          "$ref": "#/definitions/BackupConfig"
-        },
-        "exclude": {
-          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/shards": {
+      "get": {
+        "description": "Get the status of every shard in the cluster.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "query"
           }
-        },
-        "id": {
-          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "Path within bucket of backup",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BackupListResponse": {
-      "description": "The definition of a backup create response body",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classes": {
-            "description": "The list of classes for which the existed backup process",
-            "type": "array",
-            "items": {
-              "type": "string"
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a shard status.",
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
             }
           },
-          "id": {
-            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "path": {
-            "description": "destination path of backup files proper to selected backend",
-            "type": "string"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "status": {
-            "description": "status of backup process",
-            "type": "string",
-            "enum": [
-              "STARTED",
-              "TRANSFERRING",
-              "TRANSFERRED",
-              "SUCCESS",
-              "FAILED",
-              "CANCELED"
-            ]
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object",
-          "$ref": "#/definitions/RestoreConfig"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the list of tenants.",
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
-        },
-        "node_mapping": {
-          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
-      }
-    },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true
-        },
-        "dryRun": {
-          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        }
-      }
-    },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true
-        },
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
               "type": "array",
               "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
+                "$ref": "#/definitions/Tenant"
               }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
             }
           }
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
-    },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "FAILED"
-                  ]
-                }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
-          }
-        }
-      ]
-    },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
-      "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
-          "type": "number",
-          "format": "int",
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
-        },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
           },
-          "word": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
               "type": "array",
               "items": {
-                "format": "string"
+                "type": "string"
               }
             }
           }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
       }
     },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the collection for metadata purposes.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules in a collection context.",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
-        },
-        "properties": {
-          "description": "Define properties of the collection.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+    "/schema/{className}/tenants/{tenantName}": {
+      "get": {
+        "description": "get a specific tenant for the given class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a specific tenant",
+        "operationId": "tenants.get.one",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
-        },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorConfig": {
-          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/VectorConfig"
+        ],
+        "responses": {
+          "200": {
+            "description": "load the tenant given the specified class",
+            "schema": {
+              "$ref": "#/definitions/TenantResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Tenant not found"
+          },
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "head": {
+        "description": "Check if a tenant exists for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Check whether a tenant exists",
+        "operationId": "tenant.exists",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenant exists in the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The tenant not found"
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/vectors/reindex": {
+      "post": {
+        "description": "Rebuild the vector index of a collection, or of one of its named vectors, with a new configuration, including settings that cannot be updated in place and the index type. A new index is built from the stored objects in the background of every shard while the current index keeps serving queries and writes. Once a shard has caught up, the new index atomically replaces the old one. The progress is reported by the nodes endpoint.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rebuild the vector index of a collection with a new configuration",
+        "operationId": "schema.objects.vectors.reindex",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorIndexReindexRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The rebuild was started, the class has the new vector index configuration",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be reindexed does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid reindex attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "(Response only) Additional meta information about a single object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "AsyncReplicationRepairResponse": {
+      "description": "The shards whose replicas are repaired in the background",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+        "shards": {
+          "description": "The names of the shards whose repair was started.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
+    "AsyncReplicationStatus": {
+      "description": "The state of async replication of a shard replica, as seen by the node holding it",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "lastComparedHosts": {
+          "description": "The hosts of the replicas compared in the last hashbeat.",
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "example": [
-            "description"
-          ]
+          }
         },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+        "lastError": {
+          "description": "The error of the last hashbeat, if it failed.",
+          "type": "string"
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
+        "lastHashbeatUnixMillis": {
+          "description": "The time of the last hashbeat, in milliseconds since epoch UTC. 0 if there was none yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+        "lastSyncUnixMillis": {
+          "description": "The time of the last hashbeat which compared all replicas without errors, in milliseconds since epoch UTC. 0 if there was none yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "objectsPropagated": {
+          "description": "The number of objects propagated to other replicas since the shard was loaded.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        "repairError": {
+          "description": "The error of the last repair, if it failed.",
+          "type": "string"
         },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+        "repairFinishedUnixMillis": {
+          "description": "The time the last repair finished, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "repairStartedUnixMillis": {
+          "description": "The time the last repair started, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "repairStatus": {
+          "description": "The state of the last repair of the shard started on this replica. Empty if there was none since the shard was loaded.",
+          "type": "string",
+          "enum": [
+            "RUNNING",
+            "SUCCEEDED",
+            "FAILED"
+          ]
         },
         "status": {
-          "description": "status of this classification",
+          "description": "Whether the replica is still building its hashtree, compares itself with the other replicas or is paused.",
           "type": "string",
           "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
+            "INITIALIZING",
+            "ACTIVE",
+            "PAUSED"
+          ]
         },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
+        "targets": {
+          "description": "The result of the last hashbeat per replica which differed from this one.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AsyncReplicationTargetStatus"
+          }
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "AsyncReplicationTargetStatus": {
+      "description": "The result of the last hashbeat comparing a shard replica with another one",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
+        "differences": {
+          "description": "The number of hashtree leaf ranges which differed between the replicas.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "error": {
+          "description": "The reason the replicas could not be compared or repaired, if any.",
+          "type": "string"
+        },
+        "host": {
+          "description": "The host of the other replica.",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "x-omitempty": false
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
+        "localObjects": {
+          "description": "The number of local objects in the differing ranges.",
           "type": "integer",
-          "example": 147
+          "format": "int64",
+          "x-omitempty": false
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
+        "objectsPropagated": {
+          "description": "The number of objects propagated to the other replica.",
           "type": "integer",
-          "example": 7
+          "format": "int64",
+          "x-omitempty": false
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "remoteObjects": {
+          "description": "The number of objects of the other replica in the differing ranges.",
           "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "ClusterStatisticsResponse": {
-      "description": "The cluster statistics of all of the Weaviate nodes",
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
       "properties": {
-        "statistics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Statistics"
-          }
+        "b": {
+          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
+          "type": "number",
+          "format": "float"
         },
-        "synchronized": {
-          "type": "boolean",
-          "x-omitempty": false
+        "k1": {
+          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "Deprecation": {
+    "BackupConfig": {
+      "description": "Backup custom configuration",
       "type": "object",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
           "type": "string"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
+        "ChunkSize": {
+          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
+          "type": "integer",
+          "default": 128,
+          "maximum": 512,
+          "minimum": 2,
+          "x-nullable": false
         },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
+        "CompressionLevel": {
+          "description": "compression level used by compression algorithm",
           "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+          "default": "DefaultCompression",
+          "enum": [
+            "DefaultCompression",
+            "BestSpeed",
+            "BestCompression"
+          ],
+          "x-nullable": false
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "IncrementalBaseBackupId": {
+          "description": "ID of a successful backup on the same backend. If set, LSM segments and HNSW commit logs which did not change since that backup are not uploaded again, they are restored from the backup chain instead.",
           "type": "string"
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+        "Path": {
+          "description": "Path or key within the bucket",
           "type": "string"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
-            }
-          }
-        }
-      }
-    },
-    "GeoCoordinates": {
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
       "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
+        "exclude": {
+          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
-            }
+            "type": "string"
           }
         },
-        "message": {
+        "id": {
+          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "path": {
+        "include": {
+          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
           "type": "array",
           "items": {
             "type": "string"
@@ -4778,393 +4845,388 @@ func init() {
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
-        },
-        "errors": {
-          "description": "Array with errors.",
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
-        }
-      }
-    },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate (default: 60).",
-      "type": "object",
-      "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+            "type": "string"
+          }
         },
-        "indexNullState": {
-          "description": "Index each object with the null state (default: 'false').",
-          "type": "boolean"
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
         },
-        "indexPropertyLength": {
-          "description": "Index length of properties (default: 'false').",
-          "type": "boolean"
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps (default: 'false').",
-          "type": "boolean"
+        "path": {
+          "description": "Path within bucket of backup",
+          "type": "string"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "href": {
-          "description": "target of the link",
+        "error": {
+          "description": "error message if creation failed",
           "type": "string"
         },
-        "name": {
-          "description": "human readable name of the resource group",
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
           "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "grpcMaxMessageSize": {
-          "description": "Max message size for GRPC connection in bytes.",
-          "type": "integer"
-        },
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information.",
-          "type": "object"
-        },
-        "version": {
-          "description": "The Weaviate server version.",
-          "type": "string"
+    "BackupListResponse": {
+      "description": "The definition of a backup create response body",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classes": {
+            "description": "The list of classes for which the existed backup process",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "id": {
+            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "type": "string"
+          },
+          "path": {
+            "description": "destination path of backup files proper to selected backend",
+            "type": "string"
+          },
+          "status": {
+            "description": "status of backup process",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
+          }
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
       "properties": {
-        "autoTenantActivation": {
-          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object",
+          "$ref": "#/definitions/RestoreConfig"
         },
-        "autoTenantCreation": {
-          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "node_mapping": {
+          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NestedProperty": {
-      "type": "object",
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
       "properties": {
-        "dataType": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "description": {
+        "error": {
+          "description": "error message if restoration failed",
           "type": "string"
         },
-        "indexFilterable": {
-          "type": "boolean",
-          "x-nullable": true
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "indexRangeFilters": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "name": {
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
           "type": "string"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
-        },
-        "tokenization": {
+        "status": {
+          "description": "phase of backup restoration process",
           "type": "string",
+          "default": "STARTED",
           "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field",
-            "trigram",
-            "gse",
-            "kagome_kr",
-            "kagome_ja"
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
-      "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "compressed": {
-          "description": "The status of vector compression/quantization.",
-          "format": "boolean",
-          "x-omitempty": false
-        },
-        "loaded": {
-          "description": "The load status of the shard.",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "vectorIndexingStatus": {
-          "description": "The status of the vector indexing process.",
-          "format": "string",
-          "x-omitempty": false
-        },
-        "vectorQueueLength": {
-          "description": "The length of the vector indexing queue.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "shardCount": {
-          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "name": {
-          "description": "The name of the node.",
+        "error": {
+          "description": "error message if restoration failed",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
-          }
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
+          "type": "string"
         },
         "status": {
-          "description": "Node's status.",
+          "description": "phase of backup restoration process",
           "type": "string",
-          "default": "HEALTHY",
+          "default": "STARTED",
           "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
           ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
-          "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
-      "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
-          }
-        }
-      }
-    },
-    "Object": {
+    "BatchDelete": {
       "type": "object",
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
           "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
-          "type": "string"
+          "format": "int64",
+          "x-nullable": true
         },
-        "vector": {
-          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
-          "$ref": "#/definitions/C11yVector"
+        "dryRun": {
+          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
+          "type": "boolean",
+          "default": false
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
         },
-        "vectors": {
-          "description": "This field returns vectors associated with the Object.",
-          "$ref": "#/definitions/Vectors"
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
         }
       }
     },
-    "ObjectsGetResponse": {
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+      "properties": {
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
           "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
         },
-        {
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
           "properties": {
             "result": {
-              "description": "Results for this specific Object.",
+              "description": "Results for this specific reference.",
               "format": "object",
               "properties": {
                 "errors": {
@@ -5184,1180 +5246,3178 @@ func init() {
         }
       ]
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
-      "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
-        },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-nullable": true,
+          "x-omitempty": true
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
           "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+          "example": "foobarium"
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
-        },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
+    "C11yVector": {
+      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "type": "number",
+        "format": "float"
       }
     },
-    "Permission": {
-      "description": "permissions attached to a role.",
-      "type": "object",
-      "required": [
-        "action"
-      ],
-      "properties": {
-        "action": {
-          "description": "allowed actions in weaviate.",
-          "type": "string",
-          "enum": [
-            "manage_roles",
-            "read_roles",
-            "manage_cluster",
-            "create_collections",
-            "read_collections",
-            "update_collections",
-            "delete_collections",
-            "create_tenants",
-            "read_tenants",
-            "update_tenants",
-            "delete_tenants",
-            "create_objects_collection",
-            "read_objects_collection",
-            "update_objects_collection",
-            "delete_objects_collection",
-            "create_objects_tenant",
-            "read_objects_tenant",
-            "update_objects_tenant",
-            "delete_objects_tenant"
-          ]
-        },
-        "collection": {
-          "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-          "type": "string",
-          "default": "*"
-        },
-        "object": {
-          "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
-          "type": "string",
-          "default": "*"
-        },
-        "role": {
-          "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
-          "type": "string",
-          "default": "*"
-        },
-        "tenant": {
-          "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
-          "type": "string",
-          "default": "*"
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
         }
       }
     },
-    "PhoneNumber": {
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
-    },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
+        "individualWords": {
+          "description": "Weighted results for per individual word",
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
           }
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
         }
       }
     },
-    "Property": {
+    "Class": {
       "type": "object",
       "properties": {
-        "dataType": {
-          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "class": {
+          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
+          "type": "string"
         },
         "description": {
-          "description": "Description of the property.",
+          "description": "Description of the collection for metadata purposes.",
           "type": "string"
         },
-        "indexFilterable": {
-          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexRangeFilters": {
-          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
         },
         "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "description": "Configuration specific to modules in a collection context.",
           "type": "object"
         },
-        "name": {
-          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
-          "type": "string"
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
+        "properties": {
+          "description": "Define properties of the collection.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
+            "$ref": "#/definitions/Property"
+          }
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field",
-            "trigram",
-            "gse",
-            "kagome_kr",
-            "kagome_ja"
-          ]
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
-      "type": "object"
-    },
-    "RaftStatistics": {
-      "description": "The definition of Raft statistics.",
-      "properties": {
-        "appliedIndex": {
-          "type": "string"
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
         },
-        "commitIndex": {
-          "type": "string"
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
         },
-        "fsmPending": {
-          "type": "string"
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
         },
-        "lastContact": {
-          "type": "string"
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
         },
-        "lastLogIndex": {
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
           "type": "string"
         },
-        "lastLogTerm": {
-          "type": "string"
-        },
-        "lastSnapshotIndex": {
-          "type": "string"
-        },
-        "lastSnapshotTerm": {
-          "type": "string"
-        },
-        "latestConfiguration": {
-          "description": "Weaviate Raft nodes.",
-          "type": "object"
-        },
-        "latestConfigurationIndex": {
-          "type": "string"
-        },
-        "numPeers": {
-          "type": "string"
-        },
-        "protocolVersion": {
-          "type": "string"
-        },
-        "protocolVersionMax": {
-          "type": "string"
-        },
-        "protocolVersionMin": {
-          "type": "string"
-        },
-        "snapshotVersionMax": {
-          "type": "string"
-        },
-        "snapshotVersionMin": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
-        },
-        "term": {
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
           "type": "string"
         }
       }
     },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
         },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
         },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
         },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
         },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
         },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
         },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
       "type": "object",
       "properties": {
-        "asyncEnabled": {
-          "description": "Enable asynchronous replication (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "deletionStrategy": {
-          "description": "Conflict resolution strategy for deleted objects.",
+        "completed": {
+          "description": "time when this classification finished",
           "type": "string",
-          "enum": [
-            "NoAutomatedResolution",
-            "DeleteOnConflict",
-            "TimeBasedResolution"
-          ],
-          "x-omitempty": true
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "factor": {
-          "description": "Number of times a class is replicated (default: 1).",
-          "type": "integer"
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "RestoreConfig": {
-      "description": "Backup custom configuration",
+    "ClusterRebalanceMove": {
+      "description": "A shard replica move to rebalance the cluster",
       "type": "object",
       "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
+        "class": {
+          "description": "The name of the collection.",
           "type": "string"
         },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
+        "objectCount": {
+          "description": "The number of objects of the replica.",
           "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
+          "format": "int64"
         },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+        "shard": {
+          "description": "The name of the shard.",
           "type": "string"
         },
-        "Path": {
-          "description": "Path within the bucket",
+        "sourceNode": {
+          "description": "The node the replica is moved from.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node the replica is moved to.",
           "type": "string"
         }
       }
     },
-    "Role": {
+    "ClusterRebalanceRequest": {
+      "description": "Request to even out the number of objects stored by nodes",
       "type": "object",
-      "required": [
-        "name",
-        "permissions"
-      ],
       "properties": {
-        "name": {
-          "description": "role name",
-          "type": "string"
+        "dryRun": {
+          "description": "Only propose the moves without executing them.",
+          "type": "boolean"
         },
-        "permissions": {
-          "type": "array",
-          "items": {
-            "description": "list of permissions (level, action, resource)",
-            "type": "object",
-            "$ref": "#/definitions/Permission"
-          }
+        "maxMoves": {
+          "description": "The maximum number of replicas to move. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "RolesListResponse": {
-      "description": "list of roles",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Role"
-      }
-    },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+    "ClusterRebalanceResponse": {
+      "description": "The replica moves proposed or executed to rebalance the cluster",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
+        "dryRun": {
+          "description": "Whether the moves were only proposed.",
+          "type": "boolean"
+        },
+        "moves": {
+          "description": "The replica moves, in the order they are executed.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Class"
+            "$ref": "#/definitions/ClusterRebalanceMove"
           }
-        },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
         }
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
+    "ClusterStatisticsResponse": {
+      "description": "The cluster statistics of all of the Weaviate nodes",
       "type": "object",
       "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
+        "statistics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Statistics"
+          }
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+        "synchronized": {
           "type": "boolean",
           "x-omitempty": false
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
         },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
           "type": "string"
         },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
         }
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
       "properties": {
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
         }
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
+    "ExportConfig": {
+      "description": "Location of an export within the backup backend",
+      "type": "object",
       "properties": {
-        "name": {
-          "description": "Name of the shard",
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the shard",
+        "path": {
+          "description": "Path or key prefix within the bucket",
           "type": "string"
-        },
-        "vectorQueueSize": {
-          "description": "Size of the vector queue of the shard",
-          "type": "integer",
-          "x-omitempty": false
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "ExportCreateRequest": {
+      "description": "Request body for exporting a collection",
       "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
+        "collection": {
+          "description": "Name of the collection to export",
+          "type": "string"
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "config": {
+          "description": "Custom location of the export",
+          "$ref": "#/definitions/ExportConfig"
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+        "format": {
+          "description": "File format of the exported data",
           "type": "string",
-          "format": "uri"
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "Limits the export to the given tenant of a multi-tenant collection. Without a tenant all active tenants are exported.",
+          "type": "string"
         }
       }
     },
-    "Statistics": {
-      "description": "The definition of node statistics.",
+    "ExportStatusResponse": {
+      "description": "Status of an export",
       "properties": {
-        "bootstrapped": {
-          "type": "boolean"
-        },
-        "candidates": {
-          "type": "object"
-        },
-        "dbLoaded": {
-          "type": "boolean"
-        },
-        "initialLastAppliedIndex": {
-          "type": "number",
-          "format": "uint64"
+        "backend": {
+          "description": "Backup backend name the export is written to e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "isVoter": {
-          "type": "boolean"
+        "collection": {
+          "description": "Name of the exported collection",
+          "type": "string"
         },
-        "lastAppliedIndex": {
-          "type": "number"
+        "completedAt": {
+          "description": "Time the export completed",
+          "type": "string",
+          "format": "date-time"
         },
-        "leaderAddress": {
-          "type": "object"
+        "error": {
+          "description": "Error message if the export failed",
+          "type": "string"
         },
-        "leaderId": {
-          "type": "object"
+        "format": {
+          "description": "File format of the exported data",
+          "type": "string"
         },
-        "name": {
-          "description": "The name of the node.",
+        "id": {
+          "description": "The ID of the export",
           "type": "string"
         },
-        "open": {
-          "type": "boolean"
+        "path": {
+          "description": "Destination path of the exported files",
+          "type": "string"
         },
-        "raft": {
-          "description": "Weaviate Raft statistics.",
-          "type": "object",
-          "$ref": "#/definitions/RaftStatistics"
+        "shards": {
+          "description": "Data files of the exported shards",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "key": {
+                "description": "Key of the data file relative to the export",
+                "type": "string"
+              },
+              "name": {
+                "description": "Name of the shard",
+                "type": "string"
+              },
+              "objects": {
+                "description": "Number of exported objects",
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
         },
-        "ready": {
-          "type": "boolean"
+        "startedAt": {
+          "description": "Time the export was started",
+          "type": "string",
+          "format": "date-time"
         },
         "status": {
-          "description": "Node's status.",
+          "description": "phase of the export",
           "type": "string",
-          "default": "HEALTHY",
+          "default": "STARTED",
           "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
           ]
+        },
+        "tenant": {
+          "description": "Exported tenant, empty if all tenants are exported",
+          "type": "string"
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
-      "type": "object",
+    "GeoCoordinates": {
       "properties": {
-        "additions": {
-          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "preset": {
-          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
-          "type": "string"
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
         },
-        "removals": {
-          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "ImportCreateRequest": {
+      "description": "Request body for importing files from a backup backend",
+      "properties": {
+        "batchSize": {
+          "description": "Number of objects ingested at once",
+          "type": "integer",
+          "format": "int64",
+          "default": 100
+        },
+        "collection": {
+          "description": "Collection to import the objects into, overrides the collection of the records",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom location of the files",
+          "$ref": "#/definitions/ExportConfig"
+        },
+        "files": {
+          "description": "Files to import relative to the source. All data files of the export are imported if not set.",
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
-          "type": "string",
-          "enum": [
-            "ACTIVE",
-            "INACTIVE",
-            "OFFLOADED",
-            "OFFLOADING",
-            "ONLOADING",
-            "HOT",
-            "COLD",
-            "FROZEN",
-            "FREEZING",
-            "UNFREEZING"
-          ]
         },
-        "name": {
-          "description": "The name of the tenant (required).",
-          "type": "string"
-        }
+        "format": {
+          "description": "File format of the files. The format of the export or the file extensions are used if not set.",
+          "type": "string",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the import. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed. Starting an import with the ID of a failed or canceled import resumes it.",
+          "type": "string"
+        },
+        "source": {
+          "description": "Directory of the files on the backend, usually the ID of an export",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "Tenant to import the objects into, overrides the tenant of the records",
+          "type": "string"
+        }
+      }
+    },
+    "ImportStatusResponse": {
+      "description": "Status of an import",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name the files are read from e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "collection": {
+          "description": "Collection the objects are imported into, empty if the collection of the records is used",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "Time the import completed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Error message if the import failed",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Errors of single rows, at most 1000 errors are reported",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "error": {
+                "description": "Reason the row could not be imported",
+                "type": "string"
+              },
+              "id": {
+                "description": "ID of the object, if the row could be parsed",
+                "type": "string"
+              },
+              "key": {
+                "description": "Key of the file of the row",
+                "type": "string"
+              },
+              "line": {
+                "description": "One based line of the row in JSONL files",
+                "type": "integer",
+                "format": "int64"
+              },
+              "row": {
+                "description": "Zero based index of the row in the file",
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "files": {
+          "description": "Progress of the imported files",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "done": {
+                "description": "Whether all rows of the file were processed",
+                "type": "boolean"
+              },
+              "failed": {
+                "description": "Number of rows which could not be imported",
+                "type": "integer",
+                "format": "int64"
+              },
+              "format": {
+                "description": "File format of the file",
+                "type": "string"
+              },
+              "imported": {
+                "description": "Number of imported objects",
+                "type": "integer",
+                "format": "int64"
+              },
+              "key": {
+                "description": "Key of the file relative to the source",
+                "type": "string"
+              },
+              "rows": {
+                "description": "Number of rows processed, a resumed import continues after them",
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "id": {
+          "description": "The ID of the import",
+          "type": "string"
+        },
+        "source": {
+          "description": "Directory of the files on the backend",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "Time the import was started or resumed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of the import",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        },
+        "tenant": {
+          "description": "Tenant the objects are imported into, empty if the tenant of the records is used",
+          "type": "string"
+        }
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate (default: 60).",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "indexNullState": {
+          "description": "Index each object with the null state (default: 'false').",
+          "type": "boolean"
+        },
+        "indexPropertyLength": {
+          "description": "Index length of properties (default: 'false').",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps (default: 'false').",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "grpcMaxMessageSize": {
+          "description": "Max message size for GRPC connection in bytes.",
+          "type": "integer"
+        },
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information.",
+          "type": "object"
+        },
+        "version": {
+          "description": "The Weaviate server version.",
+          "type": "string"
+        }
+      }
+    },
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "properties": {
+        "autoTenantActivation": {
+          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "autoTenantCreation": {
+          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultiVector": {
+      "description": "A list of vectors representing a single object, e.g. one vector per token for late interaction (ColBERT) models.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
+    "MultiVectors": {
+      "description": "A map of named multi vectors, one entry per target vector configured as multivector.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/MultiVector"
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
+    "NodeDrainMovedShard": {
+      "description": "A shard replica which was moved off a drained node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node which holds the replica now.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeDrainResponse": {
+      "description": "The shard replicas which were moved off a drained node",
+      "type": "object",
+      "properties": {
+        "movedShards": {
+          "description": "The shard replicas which were moved to other nodes.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDrainMovedShard"
+          }
+        },
+        "node": {
+          "description": "The name of the drained node.",
+          "type": "string"
+        },
+        "skippedShards": {
+          "description": "The shard replicas which are still held by the node, e.g. replicas of inactive tenants. Activate the tenants and drain the node again to move them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDrainSkippedShard"
+          }
+        }
+      }
+    },
+    "NodeDrainSkippedShard": {
+      "description": "A shard replica which was not moved off a drained node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the replica was not moved.",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "asyncReplicationStatus": {
+          "description": "The state of async replication of the shard replica. Not set if async replication is disabled.",
+          "$ref": "#/definitions/AsyncReplicationStatus"
+        },
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "compressed": {
+          "description": "The status of vector compression/quantization.",
+          "format": "boolean",
+          "x-omitempty": false
+        },
+        "loaded": {
+          "description": "The load status of the shard.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "format": "string",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorReindexes": {
+          "description": "The vector indexes of the shard that are being rebuilt online.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorReindexStatus"
+          }
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardCount": {
+          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
+        },
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "multiVectors": {
+          "description": "This field returns the multi vectors associated with the Object.",
+          "$ref": "#/definitions/MultiVectors"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "sparseVectors": {
+          "description": "This field returns the sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This field returns vectors associated with the Object.",
+          "$ref": "#/definitions/Vectors"
+        }
+      }
+    },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic deletion of expired objects",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "The age in seconds after which an object expires.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "The timestamp the age of an object is determined from. Either ` + "`" + `_creationTimeUnix` + "`" + `, ` + "`" + `_lastUpdateTimeUnix` + "`" + ` or the name of a filterable date property (default: ` + "`" + `_creationTimeUnix` + "`" + `).",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Delete objects once they are older than the default TTL (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Permission": {
+      "description": "permissions attached to a role.",
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "allowed actions in weaviate.",
+          "type": "string",
+          "enum": [
+            "manage_roles",
+            "read_roles",
+            "manage_cluster",
+            "create_collections",
+            "read_collections",
+            "update_collections",
+            "delete_collections",
+            "create_tenants",
+            "read_tenants",
+            "update_tenants",
+            "delete_tenants",
+            "create_objects_collection",
+            "read_objects_collection",
+            "update_objects_collection",
+            "delete_objects_collection",
+            "create_objects_tenant",
+            "read_objects_tenant",
+            "update_objects_tenant",
+            "delete_objects_tenant"
+          ]
+        },
+        "collection": {
+          "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+          "type": "string",
+          "default": "*"
+        },
+        "object": {
+          "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
+          "type": "string",
+          "default": "*"
+        },
+        "role": {
+          "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
+          "type": "string",
+          "default": "*"
+        },
+        "tenant": {
+          "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
+          "type": "string",
+          "default": "*"
+        }
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Whether to store the positions of the terms of this property in addition to the searchable index. Enables phrase (` + "`" + `\"exact phrase\"` + "`" + `) and proximity (` + "`" + `term NEAR/3 term` + "`" + `) matching in bm25 and hybrid search. Applicable only to properties of data type text and text[] with ` + "`" + `indexSearchable` + "`" + ` enabled. Defaults to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
+      "type": "object"
+    },
+    "RaftStatistics": {
+      "description": "The definition of Raft statistics.",
+      "properties": {
+        "appliedIndex": {
+          "type": "string"
+        },
+        "commitIndex": {
+          "type": "string"
+        },
+        "fsmPending": {
+          "type": "string"
+        },
+        "lastContact": {
+          "type": "string"
+        },
+        "lastLogIndex": {
+          "type": "string"
+        },
+        "lastLogTerm": {
+          "type": "string"
+        },
+        "lastSnapshotIndex": {
+          "type": "string"
+        },
+        "lastSnapshotTerm": {
+          "type": "string"
+        },
+        "latestConfiguration": {
+          "description": "Weaviate Raft nodes.",
+          "type": "object"
+        },
+        "latestConfigurationIndex": {
+          "type": "string"
+        },
+        "numPeers": {
+          "type": "string"
+        },
+        "protocolVersion": {
+          "type": "string"
+        },
+        "protocolVersionMax": {
+          "type": "string"
+        },
+        "protocolVersionMin": {
+          "type": "string"
+        },
+        "snapshotVersionMax": {
+          "type": "string"
+        },
+        "snapshotVersionMin": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "term": {
+          "type": "string"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReplicaMoveRequest": {
+      "description": "Request to move or copy a shard replica to another node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "copy": {
+          "description": "Keep the replica on the source node.",
+          "type": "boolean"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node holding the replica.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node to copy the replica to.",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicaMoveResponse": {
+      "description": "The replicas of a shard after moving or copying one",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "The nodes holding a replica of the shard.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "asyncEnabled": {
+          "description": "Enable asynchronous replication (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "asyncPaused": {
+          "description": "Pause asynchronous replication. Replicas are not compared and repaired until it is resumed, but changes are still tracked (default: false).",
+          "type": "boolean"
+        },
+        "deletionStrategy": {
+          "description": "Conflict resolution strategy for deleted objects.",
+          "type": "string",
+          "enum": [
+            "NoAutomatedResolution",
+            "DeleteOnConflict",
+            "TimeBasedResolution"
+          ],
+          "x-omitempty": true
+        },
+        "factor": {
+          "description": "Number of times a class is replicated (default: 1).",
+          "type": "integer"
+        }
+      }
+    },
+    "ReshardRequest": {
+      "description": "Request body to change the number of shards of a collection.",
+      "type": "object",
+      "properties": {
+        "shardCount": {
+          "description": "The number of shards after resharding. Must be larger than the current number of shards.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ReshardResponse": {
+      "description": "The result of resharding a collection.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the resharded collection.",
+          "type": "string"
+        },
+        "newShards": {
+          "description": "The names of the shards added by resharding.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shardCount": {
+          "description": "The number of shards of the collection.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "RestoreConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
+        },
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
+        },
+        "Path": {
+          "description": "Path within the bucket",
+          "type": "string"
+        }
+      }
+    },
+    "Role": {
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
+      "properties": {
+        "name": {
+          "description": "role name",
+          "type": "string"
+        },
+        "permissions": {
+          "type": "array",
+          "items": {
+            "description": "list of permissions (level, action, resource)",
+            "type": "object",
+            "$ref": "#/definitions/Permission"
+          }
+        }
+      }
+    },
+    "RolesListResponse": {
+      "description": "list of roles",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Role"
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
+        },
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
+        },
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        },
+        "vectorQueueSize": {
+          "description": "Size of the vector queue of the shard",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "SparseVector": {
+      "description": "A sparse vector, e.g. the output of a learned sparse (SPLADE) model. Only the non-zero dimensions are listed, values[i] is the weight of dimension indices[i].",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The non-zero dimensions of the vector.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the non-zero dimensions, in the order of indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors, one entry per target vector configured with the sparse vector index type.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
+    "Statistics": {
+      "description": "The definition of node statistics.",
+      "properties": {
+        "bootstrapped": {
+          "type": "boolean"
+        },
+        "candidates": {
+          "type": "object"
+        },
+        "dbLoaded": {
+          "type": "boolean"
+        },
+        "initialLastAppliedIndex": {
+          "type": "number",
+          "format": "uint64"
+        },
+        "isVoter": {
+          "type": "boolean"
+        },
+        "lastAppliedIndex": {
+          "type": "number"
+        },
+        "leaderAddress": {
+          "type": "object"
+        },
+        "leaderId": {
+          "type": "object"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "open": {
+          "type": "boolean"
+        },
+        "raft": {
+          "description": "Weaviate Raft statistics.",
+          "type": "object",
+          "$ref": "#/definitions/RaftStatistics"
+        },
+        "ready": {
+          "type": "boolean"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
+          ]
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
+          "type": "string"
+        },
+        "removals": {
+          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
+          "type": "string",
+          "enum": [
+            "ACTIVE",
+            "INACTIVE",
+            "OFFLOADED",
+            "OFFLOADING",
+            "ONLOADING",
+            "HOT",
+            "COLD",
+            "FROZEN",
+            "FREEZING",
+            "UNFREEZING"
+          ]
+        },
+        "name": {
+          "description": "The name of the tenant (required).",
+          "type": "string"
+        }
+      }
+    },
+    "TenantResponse": {
+      "description": "attributes representing a single tenant response within weaviate",
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Tenant"
+        },
+        {
+          "properties": {
+            "belongsToNodes": {
+              "description": "The list of nodes that owns that tenant data.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "dataVersion": {
+              "description": "Experimental. The data version of the tenant is a monotonically increasing number starting from 0 which is incremented each time a tenant's data is offloaded to cloud storage.",
+              "type": "integer",
+              "default": 0,
+              "x-nullable": true,
+              "example": 3
+            }
+          }
+        }
+      ]
+    },
+    "Vector": {
+      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
+    "VectorIndexReindexRequest": {
+      "description": "Request body to rebuild the vector index of a collection with a new configuration.",
+      "type": "object",
+      "properties": {
+        "targetVector": {
+          "description": "Name of the target vector whose index is rebuilt. Leave empty for collections without named vectors.",
+          "type": "string"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config of the rebuilt index, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to build, eg. (HNSW). Defaults to the current index type.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorReindexStatus": {
+      "description": "The progress of an online rebuild of a vector index in a shard",
+      "properties": {
+        "error": {
+          "description": "The reason the rebuild failed, if any.",
+          "type": "string"
+        },
+        "indexed": {
+          "description": "The number of vectors copied from the object store into the new index.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The state of the rebuild.",
+          "type": "string",
+          "enum": [
+            "BUILDING",
+            "SWAPPING",
+            "FAILED"
+          ]
+        },
+        "targetVector": {
+          "description": "The name of the target vector being rebuilt. Empty for the legacy vector.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "total": {
+          "description": "The number of objects in the shard when the rebuild started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonOutputVerbosityParameterQuery": {
+      "type": "string",
+      "default": "minimal",
+      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+      "name": "output",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.28.0-dev"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Get links to other endpoints to help discover the REST API",
+        "summary": "List available endpoints",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "summary": "Get application liveness.",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "summary": "Get application readiness.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/authz/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get all roles",
+        "operationId": "getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles"
+        ]
+      },
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "create new role",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.create.role"
+        ]
+      }
+    },
+    "/authz/roles/add-permission": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Add permission to a role, it will be upsert if the role doesn't exists it will be created.",
+        "operationId": "addPermission",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "name": {},
+                "permissions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Permission added successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.add.role.permission"
+        ]
+      }
+    },
+    "/authz/roles/remove-permission": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "remove permission from a role",
+        "operationId": "removedPermission",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "name": {},
+                "permissions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Permission removed successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.remove.role.permission"
+        ]
+      }
+    },
+    "/authz/roles/{id}": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get a role",
+        "operationId": "getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.role"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Delete role",
+        "operationId": "deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.delete.role"
+        ]
       }
     },
-    "TenantResponse": {
-      "description": "attributes representing a single tenant response within weaviate",
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Tenant"
-        },
-        {
-          "properties": {
-            "belongsToNodes": {
-              "description": "The list of nodes that owns that tenant data.",
+    "/authz/roles/{id}/users": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get users or a keys assigned to role",
+        "operationId": "getUsersForRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Users assigned to this role",
+            "schema": {
               "type": "array",
               "items": {
                 "type": "string"
               }
-            },
-            "dataVersion": {
-              "description": "Experimental. The data version of the tenant is a monotonically increasing number starting from 0 which is incremented each time a tenant's data is offloaded to cloud storage.",
-              "type": "integer",
-              "default": 0,
-              "x-nullable": true,
-              "example": 3
             }
-          }
-        }
-      ]
-    },
-    "Vector": {
-      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "VectorConfig": {
-      "type": "object",
-      "properties": {
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
-        },
-        "vectorizer": {
-          "description": "Configuration of a specific vectorizer used by this vector",
-          "type": "object"
-        }
-      }
-    },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "Vectors": {
-      "description": "A map of named vectors for multi-vector representations.",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/Vector"
-      }
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
-          }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueBooleanArray": {
-          "description": "value as boolean",
-          "type": "array",
-          "items": {
-            "type": "boolean"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
-          ]
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueDateArray": {
-          "description": "value as date (as string)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueIntArray": {
-          "description": "value as integer",
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
-        },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
-        },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueTextArray": {
-          "description": "value as text",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        }
-      }
-    },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+          "404": {
+            "description": "no role found for user/key"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.get.roles.users"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonOutputVerbosityParameterQuery": {
-      "type": "string",
-      "default": "minimal",
-      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-      "name": "output",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
-      "name": "sort",
-      "in": "query"
-    },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
     },
-    "version": "1.28.0-dev"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Get links to other endpoints to help discover the REST API",
-        "summary": "List available endpoints",
-        "operationId": "weaviate.root",
+    "/authz/users/{id}/assign": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Assign a role to a user or key",
+        "operationId": "assignRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user or key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "properties": {
+                "roles": {
+                  "description": "the roles that assigned to the key or user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Role assigned successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role is not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "summary": "Get application liveness.",
-        "operationId": "weaviate.wellknown.liveness",
-        "responses": {
-          "200": {
-            "description": "The application is able to respond to HTTP requests"
-          }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assign.role"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+    "/authz/users/{id}/revoke": {
+      "post": {
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "authz"
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
-        "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user or key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "object",
               "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
+                "roles": {
+                  "description": "the roles that revoked from the key or user",
                   "type": "array",
                   "items": {
                     "type": "string"
-                  },
-                  "x-omitempty": true
+                  }
                 }
               }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6366,44 +8426,144 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role"
+        ]
       }
     },
-    "/.well-known/ready": {
+    "/authz/users/{id}/roles": {
       "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "summary": "Get application readiness.",
-        "operationId": "weaviate.wellknown.readiness",
+        "tags": [
+          "authz"
+        ],
+        "summary": "get roles assigned to user or a key",
+        "operationId": "getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user or key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+            "description": "Role assigned users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found for user/key"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.users.roles"
+        ]
       }
     },
-    "/authz/roles": {
+    "/backups/{backend}": {
       "get": {
+        "description": "[Coming soon] List all backups in progress not implemented yet.",
         "tags": [
-          "authz"
+          "backups"
+        ],
+        "summary": "List backups in progress",
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Get all roles",
-        "operationId": "getRoles",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Existed backups",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/BackupListResponse"
             }
           },
-          "400": {
-            "description": "Malformed request.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Start a backup process",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6414,6 +8574,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6422,28 +8588,52 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles"
+          "weaviate.local.backup"
         ]
-      },
-      "post": {
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "create new role",
-        "operationId": "createRole",
+        "summary": "Get backup process status",
+        "operationId": "backups.create.status",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Role"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Role created successfully"
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6454,8 +8644,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6468,39 +8664,47 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.create.role"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/add-permission": {
-      "post": {
+      },
+      "delete": {
+        "description": "Cancel created backup with specified ID",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Add permission to a role, it will be upsert if the role doesn't exists it will be created.",
-        "operationId": "addPermission",
+        "summary": "Cancel backup",
+        "operationId": "backups.cancel",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "name": {},
-                "permissions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Permission added successfully"
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6512,7 +8716,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6525,39 +8729,52 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.add.role.permission"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/remove-permission": {
-      "post": {
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "remove permission from a role",
-        "operationId": "removedPermission",
+        "summary": "Get restore process status",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "name": {},
-                "permissions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Permission removed successfully"
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6568,8 +8785,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Not Found - Backup does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6582,37 +8799,45 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.remove.role.permission"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}": {
-      "get": {
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Get a role",
-        "operationId": "getRole",
+        "summary": "Start a restoration process",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "role ID",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Role"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup restoration process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -6625,7 +8850,16 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6635,30 +8869,70 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.role"
+          "weaviate.local.backup"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/batch/objects": {
+      "post": {
+        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "Delete role",
-        "operationId": "deleteRole",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
           {
             "type": "string",
-            "description": "role ID",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6672,6 +8946,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6679,39 +8959,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.delete.role"
+          "weaviate.local.add"
         ]
-      }
-    },
-    "/authz/roles/{id}/users": {
-      "get": {
+      },
+      "delete": {
+        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "get users or a keys assigned to role",
-        "operationId": "getUsersForRole",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
           {
             "type": "string",
-            "description": "role ID",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Users assigned to this role",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6725,8 +9017,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found for user/key"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6735,49 +9030,54 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/authz/users/{id}/assign": {
+    "/batch/references": {
       "post": {
+        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
         "tags": [
-          "authz"
+          "batch",
+          "references"
         ],
-        "summary": "Assign a role to a user or key",
-        "operationId": "assignRole",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "user or key ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that assigned to the key or user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
               }
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned successfully"
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6791,8 +9091,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "role is not found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6801,49 +9104,41 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.assign.role"
+          "weaviate.local.add"
         ]
       }
     },
-    "/authz/users/{id}/revoke": {
+    "/classifications/": {
       "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "authz"
+          "classifications"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRole",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "user or key ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that revoked from the key or user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Classification"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Role revoked successfully"
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6865,21 +9160,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/authz/users/{id}/roles": {
+    "/classifications/{id}": {
       "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "authz"
+          "classifications"
         ],
-        "summary": "get roles assigned to user or a key",
-        "operationId": "getRolesForUser",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "user or key ID",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
@@ -6887,15 +9183,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Role assigned users",
-            "schema": {
-              "$ref": "#/definitions/RolesListResponse"
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -6908,7 +9198,7 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found for user/key"
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6918,32 +9208,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/backups/{backend}": {
-      "get": {
-        "description": "[Coming soon] List all backups in progress not implemented yet.",
+    "/cluster/async-replication/{className}/pause": {
+      "put": {
+        "description": "Stops comparing and repairing the replicas of the collection in the background, e.g. to reduce load during an incident. Changes are still tracked, so replicas are repaired once async replication is resumed. Manual repairs are still possible.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "List backups in progress",
-        "operationId": "backups.list",
+        "summary": "Pause async replication of a collection.",
+        "operationId": "cluster.asyncReplication.pause",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "The name of the collection.",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Existed backups",
+            "description": "Async replication successfully paused",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "$ref": "#/definitions/ReplicationConfig"
             }
           },
           "401": {
@@ -6955,8 +9245,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup list.",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6969,38 +9265,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.asyncReplication.pause"
         ]
-      },
+      }
+    },
+    "/cluster/async-replication/{className}/repair": {
       "post": {
-        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+        "description": "Compares the replicas of each shard of the collection, or of the given shard only, and propagates the objects missing or outdated on any of them, until all replicas hold the same objects. The repair runs in the background, the request returns once it is started. Its progress is reported per replica by the async replication status in the verbose output of the nodes API.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Start a backup process",
-        "operationId": "backups.create",
+        "summary": "Repair the replicas of the shards of a collection.",
+        "operationId": "cluster.asyncReplication.repair",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
+            "description": "The name of the collection.",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
+            "type": "string",
+            "description": "The name of the shard to repair. All shards are repaired if not set.",
+            "name": "shard",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Repair of the shards successfully started",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/AsyncReplicationRepairResponse"
             }
           },
           "401": {
@@ -7012,8 +9308,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection or shard not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7026,51 +9328,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.asyncReplication.repair"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+    "/cluster/async-replication/{className}/resume": {
+      "put": {
+        "description": "Resumes comparing and repairing the replicas of the collection in the background after it was paused.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Get backup process status",
-        "operationId": "backups.create.status",
+        "summary": "Resume async replication of a collection.",
+        "operationId": "cluster.asyncReplication.resume",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "description": "The name of the collection.",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Async replication successfully resumed",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/ReplicationConfig"
             }
           },
           "401": {
@@ -7083,13 +9366,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Collection not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Async replication is disabled for the collection.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7102,47 +9385,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.asyncReplication.resume"
         ]
-      },
-      "delete": {
-        "description": "Cancel created backup with specified ID",
+      }
+    },
+    "/cluster/nodes/{nodeName}/drain": {
+      "post": {
+        "description": "Moves every shard replica held by the node to other storage nodes. Like a replica move, each target is added to the replicas of the shard and synced with async replication before the node is removed from the replicas and deletes its local copy, so every collection with replicas on the node needs async replication. Replicas of inactive tenants are skipped and listed in the response. Once no replica is left, the node can be removed from the cluster.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Cancel backup",
-        "operationId": "backups.cancel",
+        "summary": "Move all shard replicas off a node.",
+        "operationId": "cluster.drain.node",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "description": "The name of the node to drain.",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Node successfully drained",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7153,8 +9422,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Node not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup cancellation attempt.",
+            "description": "The node cannot be drained, e.g. because no other node is left to hold its replicas or a collection with replicas on the node does not have async replication enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7167,51 +9442,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.nodes.drain"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
+    "/cluster/rebalance": {
+      "post": {
+        "description": "Proposes shard replica moves from the nodes storing the most objects, as reported by the nodes endpoint, to the nodes storing the fewest. Unless a dry run is requested, the moves are executed one after another. Only replicas of collections with async replication enabled are moved.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Get restore process status",
-        "operationId": "backups.restore.status",
+        "summary": "Even out the number of objects stored by nodes.",
+        "operationId": "cluster.rebalance",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClusterRebalanceRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "The proposed or executed moves",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/ClusterRebalanceResponse"
             }
           },
           "401": {
@@ -7224,7 +9481,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Collection or node not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "A move cannot be executed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7237,45 +9500,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.rebalance"
         ]
-      },
+      }
+    },
+    "/cluster/replicas/move": {
       "post": {
-        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
+        "description": "Copies the replica of a shard from the source node to the target node and adds the target node to the replicas of the shard. Unless only a copy is requested, the source replica is removed afterwards. Writes made while the replica is copied are propagated by async replication, which must be enabled for the collection to move a replica.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Start a restoration process",
-        "operationId": "backups.restore",
+        "summary": "Move or copy a shard replica to another node.",
+        "operationId": "cluster.replicas.move",
         "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/ReplicaMoveRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Replica successfully moved or copied",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/ReplicaMoveResponse"
             }
           },
           "401": {
@@ -7288,13 +9539,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Collection or node not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "The replica cannot be moved, e.g. because the target node already holds it.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7307,72 +9558,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.replicas.move"
         ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
+    "/cluster/statistics": {
+      "get": {
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
         "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
+          "cluster"
         ],
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Cluster statistics successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ClusterStatisticsResponse"
             }
           },
           "401": {
@@ -7385,7 +9587,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7397,53 +9599,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.cluster.statistics.get"
         ]
-      },
-      "delete": {
-        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+      }
+    },
+    "/export/{backend}": {
+      "post": {
+        "description": "Export a point-in-time snapshot of a collection, or of a single tenant, to JSONL or Parquet files through a backup backend. The snapshot of all shards is taken when the export starts, writes made afterwards are not included. Shards without a replica on the node receiving the request are exported from one of their replicas on other nodes.",
         "tags": [
-          "batch",
-          "objects"
+          "exports"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Start an export",
+        "operationId": "exports.create",
         "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ExportCreateRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Export successfully started.",
             "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ExportStatusResponse"
             }
           },
           "401": {
@@ -7456,7 +9646,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid export request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7468,56 +9658,52 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+    "/export/{backend}/{id}": {
+      "get": {
+        "description": "Returns the status of an export and the data files written so far.",
         "tags": [
-          "batch",
-          "references"
+          "exports"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Get export status",
+        "operationId": "exports.status",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "The ID of the export.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Path or key prefix within the bucket",
+            "name": "path",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Export status successfully returned.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ExportStatusResponse"
             }
           },
           "401": {
@@ -7529,8 +9715,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Export does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid export status request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7542,43 +9734,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/classifications/": {
+    "/graphql": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Get a response based on a GraphQL query",
         "tags": [
-          "classifications"
+          "graphql"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "200": {
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -7590,6 +9774,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7597,33 +9787,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "classifications"
+          "graphql"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -7635,8 +9832,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7645,24 +9845,46 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/cluster/statistics": {
-      "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
+    "/import/{backend}": {
+      "post": {
+        "description": "Import objects from JSONL or Parquet files on a backup backend, e.g. the files written by an export. The import runs in the background and ingests the objects in batches. Rows which cannot be imported are reported in the status of the import. Starting an import with the ID of a failed or canceled import resumes it after the last processed rows.",
         "tags": [
-          "cluster"
+          "imports"
+        ],
+        "summary": "Start or resume an import",
+        "operationId": "imports.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ImportCreateRequest"
+            }
+          }
         ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Cluster statistics successfully returned",
+            "description": "Import successfully started.",
             "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
+              "$ref": "#/definitions/ImportStatusResponse"
             }
           },
           "401": {
@@ -7675,7 +9897,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid import request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7688,34 +9910,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/graphql": {
-      "post": {
-        "description": "Get a response based on a GraphQL query",
+    "/import/{backend}/{id}": {
+      "get": {
+        "description": "Returns the progress of an import and the errors of rows which could not be imported.",
         "tags": [
-          "graphql"
+          "imports"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Get import status",
+        "operationId": "imports.status",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the import.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Path or key prefix within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Import status successfully returned.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/ImportStatusResponse"
             }
           },
           "401": {
@@ -7727,8 +9966,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Import does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid import status request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7740,41 +9985,36 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Cancels a running import. It can be resumed by starting it again.",
         "tags": [
-          "graphql"
+          "imports"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Cancel an import",
+        "operationId": "imports.cancel",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the import.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Successfully canceled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7785,8 +10025,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Not Found - Import is not running",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7798,13 +10038,8 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.backup"
         ]
       }
     },
@@ -9699,6 +11934,70 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/reshard": {
+      "post": {
+        "description": "Split the shards of a collection without multi-tenancy into the requested number of shards while it keeps serving reads and writes. The objects which move to the new shards are copied in the background, all changes made to them meanwhile are mirrored. Once the copy has caught up, the new sharding state is committed and the moved objects are deleted from their previous shards. The number of shards can only be increased, up to the number of virtual shards of the collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Change the number of shards of a collection",
+        "operationId": "schema.objects.reshard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReshardRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The collection was resharded",
+            "schema": {
+              "$ref": "#/definitions/ReshardResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be resharded does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid reshard attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "description": "Get the status of every shard in the cluster.",
@@ -10171,6 +12470,70 @@ func init() {
           }
         }
       }
+    },
+    "/schema/{className}/vectors/reindex": {
+      "post": {
+        "description": "Rebuild the vector index of a collection, or of one of its named vectors, with a new configuration, including settings that cannot be updated in place and the index type. A new index is built from the stored objects in the background of every shard while the current index keeps serving queries and writes. Once a shard has caught up, the new index atomically replaces the old one. The progress is reported by the nodes endpoint.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rebuild the vector index of a collection with a new configuration",
+        "operationId": "schema.objects.vectors.reindex",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorIndexReindexRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The rebuild was started, the class has the new vector index configuration",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be reindexed does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid reindex attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
     }
   },
   "definitions": {
@@ -10181,6 +12544,133 @@ func init() {
         "type": "object"
       }
     },
+    "AsyncReplicationRepairResponse": {
+      "description": "The shards whose replicas are repaired in the background",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The names of the shards whose repair was started.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AsyncReplicationStatus": {
+      "description": "The state of async replication of a shard replica, as seen by the node holding it",
+      "properties": {
+        "lastComparedHosts": {
+          "description": "The hosts of the replicas compared in the last hashbeat.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastError": {
+          "description": "The error of the last hashbeat, if it failed.",
+          "type": "string"
+        },
+        "lastHashbeatUnixMillis": {
+          "description": "The time of the last hashbeat, in milliseconds since epoch UTC. 0 if there was none yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSyncUnixMillis": {
+          "description": "The time of the last hashbeat which compared all replicas without errors, in milliseconds since epoch UTC. 0 if there was none yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsPropagated": {
+          "description": "The number of objects propagated to other replicas since the shard was loaded.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "repairError": {
+          "description": "The error of the last repair, if it failed.",
+          "type": "string"
+        },
+        "repairFinishedUnixMillis": {
+          "description": "The time the last repair finished, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "repairStartedUnixMillis": {
+          "description": "The time the last repair started, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "repairStatus": {
+          "description": "The state of the last repair of the shard started on this replica. Empty if there was none since the shard was loaded.",
+          "type": "string",
+          "enum": [
+            "RUNNING",
+            "SUCCEEDED",
+            "FAILED"
+          ]
+        },
+        "status": {
+          "description": "Whether the replica is still building its hashtree, compares itself with the other replicas or is paused.",
+          "type": "string",
+          "enum": [
+            "INITIALIZING",
+            "ACTIVE",
+            "PAUSED"
+          ]
+        },
+        "targets": {
+          "description": "The result of the last hashbeat per replica which differed from this one.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AsyncReplicationTargetStatus"
+          }
+        }
+      }
+    },
+    "AsyncReplicationTargetStatus": {
+      "description": "The result of the last hashbeat comparing a shard replica with another one",
+      "properties": {
+        "differences": {
+          "description": "The number of hashtree leaf ranges which differed between the replicas.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "error": {
+          "description": "The reason the replicas could not be compared or repaired, if any.",
+          "type": "string"
+        },
+        "host": {
+          "description": "The host of the other replica.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "localObjects": {
+          "description": "The number of local objects in the differing ranges.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsPropagated": {
+          "description": "The number of objects propagated to the other replica.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "remoteObjects": {
+          "description": "The number of objects of the other replica in the differing ranges.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -10236,6 +12726,10 @@ func init() {
           "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
         },
+        "IncrementalBaseBackupId": {
+          "description": "ID of a successful backup on the same backend. If set, LSM segments and HNSW commit logs which did not change since that backup are not uploaded again, they are restored from the backup chain instead.",
+          "type": "string"
+        },
         "Path": {
           "description": "Path or key within the bucket",
           "type": "string"
@@ -10981,6 +13475,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
         "properties": {
           "description": "Define properties of the collection.",
           "type": "array",
@@ -11142,16 +13639,75 @@ func init() {
           "type": "integer",
           "example": 7
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "ClusterRebalanceMove": {
+      "description": "A shard replica move to rebalance the cluster",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects of the replica.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node the replica is moved from.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node the replica is moved to.",
+          "type": "string"
+        }
+      }
+    },
+    "ClusterRebalanceRequest": {
+      "description": "Request to even out the number of objects stored by nodes",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "Only propose the moves without executing them.",
+          "type": "boolean"
+        },
+        "maxMoves": {
+          "description": "The maximum number of replicas to move. Defaults to 10.",
           "type": "integer",
-          "example": 140
+          "format": "int64"
+        }
+      }
+    },
+    "ClusterRebalanceResponse": {
+      "description": "The replica moves proposed or executed to rebalance the cluster",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "Whether the moves were only proposed.",
+          "type": "boolean"
         },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "moves": {
+          "description": "The replica moves, in the order they are executed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterRebalanceMove"
+          }
         }
       }
     },
@@ -11247,6 +13803,129 @@ func init() {
         }
       }
     },
+    "ExportConfig": {
+      "description": "Location of an export within the backup backend",
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path or key prefix within the bucket",
+          "type": "string"
+        }
+      }
+    },
+    "ExportCreateRequest": {
+      "description": "Request body for exporting a collection",
+      "properties": {
+        "collection": {
+          "description": "Name of the collection to export",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom location of the export",
+          "$ref": "#/definitions/ExportConfig"
+        },
+        "format": {
+          "description": "File format of the exported data",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "Limits the export to the given tenant of a multi-tenant collection. Without a tenant all active tenants are exported.",
+          "type": "string"
+        }
+      }
+    },
+    "ExportStatusResponse": {
+      "description": "Status of an export",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name the export is written to e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "collection": {
+          "description": "Name of the exported collection",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "Time the export completed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Error message if the export failed",
+          "type": "string"
+        },
+        "format": {
+          "description": "File format of the exported data",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the export",
+          "type": "string"
+        },
+        "path": {
+          "description": "Destination path of the exported files",
+          "type": "string"
+        },
+        "shards": {
+          "description": "Data files of the exported shards",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExportStatusResponseShardsItems0"
+          }
+        },
+        "startedAt": {
+          "description": "Time the export was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of the export",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        },
+        "tenant": {
+          "description": "Exported tenant, empty if all tenants are exported",
+          "type": "string"
+        }
+      }
+    },
+    "ExportStatusResponseShardsItems0": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "description": "Key of the data file relative to the export",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "objects": {
+          "description": "Number of exported objects",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "GeoCoordinates": {
       "properties": {
         "latitude": {
@@ -11272,82 +13951,252 @@ func init() {
             "$ref": "#/definitions/GraphQLErrorLocationsItems0"
           }
         },
-        "message": {
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLErrorLocationsItems0": {
+      "type": "object",
+      "properties": {
+        "column": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "ImportCreateRequest": {
+      "description": "Request body for importing files from a backup backend",
+      "properties": {
+        "batchSize": {
+          "description": "Number of objects ingested at once",
+          "type": "integer",
+          "format": "int64",
+          "default": 100
+        },
+        "collection": {
+          "description": "Collection to import the objects into, overrides the collection of the records",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom location of the files",
+          "$ref": "#/definitions/ExportConfig"
+        },
+        "files": {
+          "description": "Files to import relative to the source. All data files of the export are imported if not set.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "File format of the files. The format of the export or the file extensions are used if not set.",
+          "type": "string",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the import. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed. Starting an import with the ID of a failed or canceled import resumes it.",
+          "type": "string"
+        },
+        "source": {
+          "description": "Directory of the files on the backend, usually the ID of an export",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "Tenant to import the objects into, overrides the tenant of the records",
+          "type": "string"
+        }
+      }
+    },
+    "ImportStatusResponse": {
+      "description": "Status of an import",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name the files are read from e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "collection": {
+          "description": "Collection the objects are imported into, empty if the collection of the records is used",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "Time the import completed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Error message if the import failed",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Errors of single rows, at most 1000 errors are reported",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportStatusResponseErrorsItems0"
+          }
+        },
+        "files": {
+          "description": "Progress of the imported files",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportStatusResponseFilesItems0"
+          }
+        },
+        "id": {
+          "description": "The ID of the import",
+          "type": "string"
+        },
+        "source": {
+          "description": "Directory of the files on the backend",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "Time the import was started or resumed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of the import",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        },
+        "tenant": {
+          "description": "Tenant the objects are imported into, empty if the tenant of the records is used",
           "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
         }
       }
     },
-    "GraphQLErrorLocationsItems0": {
+    "ImportStatusResponseErrorsItems0": {
       "type": "object",
       "properties": {
-        "column": {
+        "error": {
+          "description": "Reason the row could not be imported",
+          "type": "string"
+        },
+        "id": {
+          "description": "ID of the object, if the row could be parsed",
+          "type": "string"
+        },
+        "key": {
+          "description": "Key of the file of the row",
+          "type": "string"
+        },
+        "line": {
+          "description": "One based line of the row in JSONL files",
           "type": "integer",
           "format": "int64"
         },
-        "line": {
+        "row": {
+          "description": "Zero based index of the row in the file",
           "type": "integer",
           "format": "int64"
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+    "ImportStatusResponseFilesItems0": {
       "type": "object",
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
+        "done": {
+          "description": "Whether all rows of the file were processed",
+          "type": "boolean"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "failed": {
+          "description": "Number of rows which could not be imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "format": {
+          "description": "File format of the file",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
+        "imported": {
+          "description": "Number of imported objects",
+          "type": "integer",
+          "format": "int64"
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+        "key": {
+          "description": "Key of the file relative to the source",
+          "type": "string"
+        },
+        "rows": {
+          "description": "Number of rows processed, a resumed import continues after them",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
@@ -11445,6 +14294,20 @@ func init() {
         }
       }
     },
+    "MultiVector": {
+      "description": "A list of vectors representing a single object, e.g. one vector per token for late interaction (ColBERT) models.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
+    "MultiVectors": {
+      "description": "A map of named multi vectors, one entry per target vector configured as multivector.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/MultiVector"
+      }
+    },
     "MultipleRef": {
       "description": "Multiple instances of references to other objects.",
       "type": "array",
@@ -11502,9 +14365,73 @@ func init() {
         }
       }
     },
+    "NodeDrainMovedShard": {
+      "description": "A shard replica which was moved off a drained node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node which holds the replica now.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeDrainResponse": {
+      "description": "The shard replicas which were moved off a drained node",
+      "type": "object",
+      "properties": {
+        "movedShards": {
+          "description": "The shard replicas which were moved to other nodes.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDrainMovedShard"
+          }
+        },
+        "node": {
+          "description": "The name of the drained node.",
+          "type": "string"
+        },
+        "skippedShards": {
+          "description": "The shard replicas which are still held by the node, e.g. replicas of inactive tenants. Activate the tenants and drain the node again to move them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDrainSkippedShard"
+          }
+        }
+      }
+    },
+    "NodeDrainSkippedShard": {
+      "description": "A shard replica which was not moved off a drained node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the replica was not moved.",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
+        "asyncReplicationStatus": {
+          "description": "The state of async replication of the shard replica. Not set if async replication is disabled.",
+          "$ref": "#/definitions/AsyncReplicationStatus"
+        },
         "class": {
           "description": "The name of shard's class.",
           "type": "string",
@@ -11541,6 +14468,13 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorReindexes": {
+          "description": "The vector indexes of the shard that are being rebuilt online.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorReindexStatus"
+          }
         }
       }
     },
@@ -11643,9 +14577,17 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "multiVectors": {
+          "description": "This field returns the multi vectors associated with the Object.",
+          "$ref": "#/definitions/MultiVectors"
+        },
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVectors": {
+          "description": "This field returns the sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
         "tenant": {
           "description": "Name of the Objects tenant.",
           "type": "string"
@@ -11663,6 +14605,26 @@ func init() {
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic deletion of expired objects",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "The age in seconds after which an object expires.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "The timestamp the age of an object is determined from. Either ` + "`" + `_creationTimeUnix` + "`" + `, ` + "`" + `_lastUpdateTimeUnix` + "`" + ` or the name of a filterable date property (default: ` + "`" + `_creationTimeUnix` + "`" + `).",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Delete objects once they are older than the default TTL (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -11972,6 +14934,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Whether to store the positions of the terms of this property in addition to the searchable index. Enables phrase (` + "`" + `\"exact phrase\"` + "`" + `) and proximity (` + "`" + `term NEAR/3 term` + "`" + `) matching in bm25 and hybrid search. Applicable only to properties of data type text and text[] with ` + "`" + `indexSearchable` + "`" + ` enabled. Defaults to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexRangeFilters": {
           "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
           "type": "boolean",
@@ -12136,6 +15103,53 @@ func init() {
         }
       }
     },
+    "ReplicaMoveRequest": {
+      "description": "Request to move or copy a shard replica to another node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "copy": {
+          "description": "Keep the replica on the source node.",
+          "type": "boolean"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node holding the replica.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node to copy the replica to.",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicaMoveResponse": {
+      "description": "The replicas of a shard after moving or copying one",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "The nodes holding a replica of the shard.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        }
+      }
+    },
     "ReplicationConfig": {
       "description": "Configure how replication is executed in a cluster",
       "type": "object",
@@ -12145,6 +15159,10 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "asyncPaused": {
+          "description": "Pause asynchronous replication. Replicas are not compared and repaired until it is resumed, but changes are still tracked (default: false).",
+          "type": "boolean"
+        },
         "deletionStrategy": {
           "description": "Conflict resolution strategy for deleted objects.",
           "type": "string",
@@ -12161,6 +15179,39 @@ func init() {
         }
       }
     },
+    "ReshardRequest": {
+      "description": "Request body to change the number of shards of a collection.",
+      "type": "object",
+      "properties": {
+        "shardCount": {
+          "description": "The number of shards after resharding. Must be larger than the current number of shards.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ReshardResponse": {
+      "description": "The result of resharding a collection.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the resharded collection.",
+          "type": "string"
+        },
+        "newShards": {
+          "description": "The names of the shards added by resharding.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shardCount": {
+          "description": "The number of shards of the collection.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "RestoreConfig": {
       "description": "Backup custom configuration",
       "type": "object",
@@ -12333,6 +15384,35 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector, e.g. the output of a learned sparse (SPLADE) model. Only the non-zero dimensions are listed, values[i] is the weight of dimension indices[i].",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The non-zero dimensions of the vector.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the non-zero dimensions, in the order of indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors, one entry per target vector configured with the sparse vector index type.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -12492,6 +15572,59 @@ func init() {
         }
       }
     },
+    "VectorIndexReindexRequest": {
+      "description": "Request body to rebuild the vector index of a collection with a new configuration.",
+      "type": "object",
+      "properties": {
+        "targetVector": {
+          "description": "Name of the target vector whose index is rebuilt. Leave empty for collections without named vectors.",
+          "type": "string"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config of the rebuilt index, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to build, eg. (HNSW). Defaults to the current index type.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorReindexStatus": {
+      "description": "The progress of an online rebuild of a vector index in a shard",
+      "properties": {
+        "error": {
+          "description": "The reason the rebuild failed, if any.",
+          "type": "string"
+        },
+        "indexed": {
+          "description": "The number of vectors copied from the object store into the new index.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The state of the rebuild.",
+          "type": "string",
+          "enum": [
+            "BUILDING",
+            "SWAPPING",
+            "FAILED"
+          ]
+        },
+        "targetVector": {
+          "description": "The name of the target vector being rebuilt. Empty for the legacy vector.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "total": {
+          "description": "The number of objects in the shard when the rebuild started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -12,23 +12,33 @@
 package rest
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
+var errRbacDisabled = fmt.Errorf("rbac is not enabled")
+
 type authZHandlers struct {
 	authorizer authorization.Authorizer
+	// controller is nil if rbac is not enabled
+	controller rbac.Controller
 	logger     logrus.FieldLogger
 	metrics    *monitoring.PrometheusMetrics
 }
 
-func setupAuthZHandlers(api *operations.WeaviateAPI, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger) {
-	h := &authZHandlers{authorizer: authorizer, logger: logger, metrics: metrics}
+func setupAuthZHandlers(api *operations.WeaviateAPI, controller rbac.Controller, metrics *monitoring.PrometheusMetrics,
+	authorizer authorization.Authorizer, logger logrus.FieldLogger,
+) {
+	h := &authZHandlers{authorizer: authorizer, controller: controller, logger: logger, metrics: metrics}
 
 	// rbac role handlers
 	api.AuthzCreateRoleHandler = authz.CreateRoleHandlerFunc(h.createRole)
@@ -46,41 +56,232 @@ func setupAuthZHandlers(api *operations.WeaviateAPI, metrics *monitoring.Prometh
 }
 
 func (h *authZHandlers) createRole(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if params.Body.Name == nil || *params.Body.Name == "" {
+		return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(fmt.Errorf("role name is required")))
+	}
+	name := *params.Body.Name
+
+	if err := h.authorizer.Authorize(principal, authorization.CREATE, authorization.Roles(name)...); err != nil {
+		return authz.NewCreateRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := validatePermissions(params.Body.Permissions); err != nil {
+		return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if len(h.controller.GetRoles(name)) > 0 {
+		return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(fmt.Errorf("role %q already exists", name)))
+	}
+
+	roles := map[string][]*models.Permission{name: params.Body.Permissions}
+	if err := h.controller.UpsertRolesPermissions(params.HTTPRequest.Context(), roles); err != nil {
+		return authz.NewCreateRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "create_role",
+		"user":   principalUsername(principal),
+		"role":   name,
+	}).Info("role created")
+	return authz.NewCreateRoleCreated()
 }
 
 func (h *authZHandlers) addPermission(params authz.AddPermissionParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewAddPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	name, ok := params.Body.Name.(string)
+	if !ok || name == "" {
+		return authz.NewAddPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(fmt.Errorf("role name is required")))
+	}
+
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(name)...); err != nil {
+		return authz.NewAddPermissionForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := validatePermissions(params.Body.Permissions); err != nil {
+		return authz.NewAddPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	roles := map[string][]*models.Permission{name: params.Body.Permissions}
+	if err := h.controller.UpsertRolesPermissions(params.HTTPRequest.Context(), roles); err != nil {
+		return authz.NewAddPermissionInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewAddPermissionCreated()
 }
 
 func (h *authZHandlers) removePermission(params authz.RemovedPermissionParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewRemovedPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	name, ok := params.Body.Name.(string)
+	if !ok || name == "" {
+		return authz.NewRemovedPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(fmt.Errorf("role name is required")))
+	}
+
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(name)...); err != nil {
+		return authz.NewRemovedPermissionForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if len(h.controller.GetRoles(name)) == 0 {
+		return authz.NewRemovedPermissionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(fmt.Errorf("role %q not found", name)))
+	}
+
+	if err := h.controller.RemovePermissions(params.HTTPRequest.Context(), name, params.Body.Permissions); err != nil {
+		return authz.NewRemovedPermissionInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRemovedPermissionCreated()
 }
 
 func (h *authZHandlers) getRoles(params authz.GetRolesParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewGetRolesBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles()...); err != nil {
+		return authz.NewGetRolesForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return authz.NewGetRolesOK().WithPayload(rolesToModel(h.controller.GetRoles()))
 }
 
 func (h *authZHandlers) getRole(params authz.GetRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewGetRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewGetRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	roles := rolesToModel(h.controller.GetRoles(params.ID))
+	if len(roles) == 0 {
+		return authz.NewGetRoleNotFound()
+	}
+	return authz.NewGetRoleOK().WithPayload(roles[0])
 }
 
 func (h *authZHandlers) deleteRole(params authz.DeleteRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewDeleteRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.DELETE, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewDeleteRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.controller.DeleteRoles(params.HTTPRequest.Context(), params.ID); err != nil {
+		return authz.NewDeleteRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "delete_role",
+		"user":   principalUsername(principal),
+		"role":   params.ID,
+	}).Info("role deleted")
+	return authz.NewDeleteRoleNoContent()
 }
 
 func (h *authZHandlers) assignRole(params authz.AssignRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewAssignRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if len(params.Body.Roles) == 0 {
+		return authz.NewAssignRoleBadRequest().WithPayload(errPayloadFromSingleErr(fmt.Errorf("roles are required")))
+	}
+
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(params.Body.Roles...)...); err != nil {
+		return authz.NewAssignRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if existing := h.controller.GetRoles(params.Body.Roles...); len(existing) != len(params.Body.Roles) {
+		return authz.NewAssignRoleNotFound()
+	}
+
+	if err := h.controller.AddRolesForUser(params.HTTPRequest.Context(), params.ID, params.Body.Roles); err != nil {
+		return authz.NewAssignRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":      "assign_roles",
+		"user":        principalUsername(principal),
+		"target_user": params.ID,
+		"roles":       params.Body.Roles,
+	}).Info("roles assigned")
+	return authz.NewAssignRoleOK()
 }
 
 func (h *authZHandlers) getRolesForUser(params authz.GetRolesForUserParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewGetRolesForUserBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles()...); err != nil {
+		return authz.NewGetRolesForUserForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return authz.NewGetRolesForUserOK().WithPayload(rolesToModel(h.controller.GetRolesForUser(params.ID)))
 }
 
 func (h *authZHandlers) getUsersForRole(params authz.GetUsersForRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewGetUsersForRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewGetUsersForRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if len(h.controller.GetRoles(params.ID)) == 0 {
+		return authz.NewGetUsersForRoleNotFound()
+	}
+
+	return authz.NewGetUsersForRoleOK().WithPayload(h.controller.GetUsersForRole(params.ID))
 }
 
 func (h *authZHandlers) revokeRole(params authz.RevokeRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.controller == nil {
+		return authz.NewRevokeRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if len(params.Body.Roles) == 0 {
+		return authz.NewRevokeRoleBadRequest().WithPayload(errPayloadFromSingleErr(fmt.Errorf("roles are required")))
+	}
+
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(params.Body.Roles...)...); err != nil {
+		return authz.NewRevokeRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.controller.RevokeRolesForUser(params.HTTPRequest.Context(), params.ID, params.Body.Roles); err != nil {
+		return authz.NewRevokeRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":      "revoke_roles",
+		"user":        principalUsername(principal),
+		"target_user": params.ID,
+		"roles":       params.Body.Roles,
+	}).Info("roles revoked")
+	return authz.NewRevokeRoleOK()
+}
+
+func validatePermissions(permissions []*models.Permission) error {
+	if len(permissions) == 0 {
+		return fmt.Errorf("at least one permission is required")
+	}
+	for _, p := range permissions {
+		if err := rbac.ValidatePermission(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rolesToModel converts the roles to their API representation sorted by name
+func rolesToModel(roles map[string][]*models.Permission) models.RolesListResponse {
+	out := make(models.RolesListResponse, 0, len(roles))
+	for name, permissions := range roles {
+		name := name
+		out = append(out, &models.Role{Name: &name, Permissions: permissions})
+	}
+	sort.Slice(out, func(i, j int) bool { return *out[i].Name < *out[j].Name })
+	return out
+}
+
+func principalUsername(principal *models.Principal) string {
+	if principal == nil {
+		return rbac.AnonymousPrincipalUsername
+	}
+	return principal.Username
 }
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/exports"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type exportHandlers struct {
+	exporter            *export.Exporter
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *exportHandlers) createExport(params exports.ExportsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := &export.Request{
+		ID:      params.Body.ID,
+		Backend: params.Backend,
+		Class:   params.Body.Collection,
+		Tenant:  params.Body.Tenant,
+	}
+	if params.Body.Format != nil {
+		req.Format = export.Format(*params.Body.Format)
+	}
+	if params.Body.Config != nil {
+		req.Bucket = params.Body.Config.Bucket
+		req.Path = params.Body.Config.Path
+	}
+
+	desc, err := h.exporter.Export(params.HTTPRequest.Context(), principal, req)
+	if err != nil {
+		h.metricRequestsTotal.logError(req.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return exports.NewExportsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return exports.NewExportsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return exports.NewExportsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(req.Class)
+	return exports.NewExportsCreateOK().WithPayload(exportStatusResponse(desc))
+}
+
+func (h *exportHandlers) exportStatus(params exports.ExportsStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	bucket := ""
+	if params.Bucket != nil {
+		bucket = *params.Bucket
+	}
+	path := ""
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	desc, err := h.exporter.Status(params.HTTPRequest.Context(), principal,
+		params.Backend, params.ID, bucket, path)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return exports.NewExportsStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return exports.NewExportsStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return exports.NewExportsStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return exports.NewExportsStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return exports.NewExportsStatusOK().WithPayload(exportStatusResponse(desc))
+}
+
+func exportStatusResponse(desc *export.Descriptor) *models.ExportStatusResponse {
+	status := string(desc.Status)
+	resp := &models.ExportStatusResponse{
+		ID:         desc.ID,
+		Backend:    desc.Backend,
+		Collection: desc.Class,
+		Tenant:     desc.Tenant,
+		Format:     string(desc.Format),
+		Path:       desc.Path,
+		Status:     &status,
+		Error:      desc.Error,
+		StartedAt:  strfmt.DateTime(desc.StartedAt),
+		Shards:     make([]*models.ExportStatusResponseShardsItems0, len(desc.Shards)),
+	}
+	if !desc.CompletedAt.IsZero() {
+		resp.CompletedAt = strfmt.DateTime(desc.CompletedAt)
+	}
+	for i, shard := range desc.Shards {
+		resp.Shards[i] = &models.ExportStatusResponseShardsItems0{
+			Name:    shard.Name,
+			Key:     shard.Key,
+			Objects: shard.Objects,
+		}
+	}
+	return resp
+}
+
+func setupExportHandlers(api *operations.WeaviateAPI,
+	exporter *export.Exporter, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &exportHandlers{exporter, newBackupRequestsTotal(metrics, logger)}
+	api.ExportsExportsCreateHandler = exports.ExportsCreateHandlerFunc(h.createExport)
+	api.ExportsExportsStatusHandler = exports.ExportsStatusHandlerFunc(h.exportStatus)
+}
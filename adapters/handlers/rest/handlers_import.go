@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/imports"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type importHandlers struct {
+	importer            *export.Importer
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *importHandlers) createImport(params imports.ImportsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := &export.ImportRequest{
+		ID:      params.Body.ID,
+		Backend: params.Backend,
+		Source:  params.Body.Source,
+		Files:   params.Body.Files,
+		Format:  export.Format(params.Body.Format),
+		Class:   params.Body.Collection,
+		Tenant:  params.Body.Tenant,
+	}
+	if params.Body.BatchSize != nil {
+		req.BatchSize = int(*params.Body.BatchSize)
+	}
+	if params.Body.Config != nil {
+		req.Bucket = params.Body.Config.Bucket
+		req.Path = params.Body.Config.Path
+	}
+
+	desc, err := h.importer.Import(params.HTTPRequest.Context(), principal, req)
+	if err != nil {
+		h.metricRequestsTotal.logError(req.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return imports.NewImportsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return imports.NewImportsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return imports.NewImportsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(req.Class)
+	return imports.NewImportsCreateOK().WithPayload(importStatusResponse(desc))
+}
+
+func (h *importHandlers) importStatus(params imports.ImportsStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	bucket := ""
+	if params.Bucket != nil {
+		bucket = *params.Bucket
+	}
+	path := ""
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	desc, err := h.importer.Status(params.HTTPRequest.Context(), principal,
+		params.Backend, params.ID, bucket, path)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return imports.NewImportsStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return imports.NewImportsStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return imports.NewImportsStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return imports.NewImportsStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return imports.NewImportsStatusOK().WithPayload(importStatusResponse(desc))
+}
+
+func (h *importHandlers) cancelImport(params imports.ImportsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.importer.Cancel(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return imports.NewImportsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return imports.NewImportsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return imports.NewImportsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return imports.NewImportsCancelNoContent()
+}
+
+func importStatusResponse(desc *export.ImportDescriptor) *models.ImportStatusResponse {
+	status := string(desc.Status)
+	resp := &models.ImportStatusResponse{
+		ID:         desc.ID,
+		Backend:    desc.Backend,
+		Source:     desc.Source,
+		Collection: desc.Class,
+		Tenant:     desc.Tenant,
+		Status:     &status,
+		Error:      desc.Error,
+		StartedAt:  strfmt.DateTime(desc.StartedAt),
+		Files:      make([]*models.ImportStatusResponseFilesItems0, len(desc.Files)),
+		Errors:     make([]*models.ImportStatusResponseErrorsItems0, len(desc.Errors)),
+	}
+	if !desc.CompletedAt.IsZero() {
+		resp.CompletedAt = strfmt.DateTime(desc.CompletedAt)
+	}
+	for i, file := range desc.Files {
+		resp.Files[i] = &models.ImportStatusResponseFilesItems0{
+			Key:      file.Key,
+			Format:   string(file.Format),
+			Rows:     file.Rows,
+			Imported: file.Imported,
+			Failed:   file.Failed,
+			Done:     file.Done,
+		}
+	}
+	for i, rowErr := range desc.Errors {
+		resp.Errors[i] = &models.ImportStatusResponseErrorsItems0{
+			Key:   rowErr.Key,
+			Row:   rowErr.Row,
+			Line:  rowErr.Line,
+			ID:    rowErr.ID,
+			Error: rowErr.Error,
+		}
+	}
+	return resp
+}
+
+func setupImportHandlers(api *operations.WeaviateAPI,
+	importer *export.Importer, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &importHandlers{importer, newBackupRequestsTotal(metrics, logger)}
+	api.ImportsImportsCreateHandler = imports.ImportsCreateHandlerFunc(h.createImport)
+	api.ImportsImportsStatusHandler = imports.ImportsStatusHandlerFunc(h.importStatus)
+	api.ImportsImportsCancelHandler = imports.ImportsCancelHandlerFunc(h.cancelImport)
+}
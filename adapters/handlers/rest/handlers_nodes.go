@@ -27,11 +27,13 @@ import (
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	nodesUC "github.com/weaviate/weaviate/usecases/nodes"
+	"github.com/weaviate/weaviate/usecases/scaler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
 type nodesHandlers struct {
 	manager             *nodesUC.Manager
+	schemaManager       *schemaUC.Manager
 	metricRequestsTotal restApiRequestsTotal
 }
 
@@ -99,6 +101,160 @@ func (n *nodesHandlers) getNodesStatistics(params cluster.ClusterGetStatisticsPa
 	return cluster.NewClusterGetStatisticsOK().WithPayload(statistics)
 }
 
+func (n *nodesHandlers) drainNode(params cluster.ClusterDrainNodeParams, principal *models.Principal) middleware.Responder {
+	resp, err := n.schemaManager.DrainNode(params.HTTPRequest.Context(), principal, params.NodeName)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterDrainNodeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterDrainNodeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, scaler.ErrNoDrainTarget), errors.Is(err, scaler.ErrInvalidReplicaMove):
+			return cluster.NewClusterDrainNodeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterDrainNodeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterDrainNodeOK().WithPayload(resp)
+}
+
+func (n *nodesHandlers) moveReplica(params cluster.ClusterReplicasMoveParams, principal *models.Principal) middleware.Responder {
+	resp, err := n.schemaManager.MoveReplica(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterReplicasMoveForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterReplicasMoveNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, scaler.ErrInvalidReplicaMove):
+			return cluster.NewClusterReplicasMoveUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterReplicasMoveInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterReplicasMoveOK().WithPayload(resp)
+}
+
+func (n *nodesHandlers) rebalance(params cluster.ClusterRebalanceParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	nodeStatuses, err := n.manager.GetNodeStatus(ctx, principal, "", verbosity.OutputVerbose)
+	if err != nil {
+		return n.handleGetNodesError(err)
+	}
+
+	resp, err := n.schemaManager.Rebalance(ctx, principal, nodeStatuses, params.Body)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterRebalanceForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterRebalanceNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, scaler.ErrInvalidReplicaMove):
+			return cluster.NewClusterRebalanceUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterRebalanceInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterRebalanceOK().WithPayload(resp)
+}
+
+func (n *nodesHandlers) repairAsyncReplication(params cluster.ClusterAsyncReplicationRepairParams, principal *models.Principal) middleware.Responder {
+	var shard string
+	if params.Shard != nil {
+		shard = *params.Shard
+	}
+	resp, err := n.schemaManager.RepairAsyncReplication(params.HTTPRequest.Context(), principal, params.ClassName, shard)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterAsyncReplicationRepairForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterAsyncReplicationRepairNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrAsyncReplicationDisabled):
+			return cluster.NewClusterAsyncReplicationRepairUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterAsyncReplicationRepairInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterAsyncReplicationRepairOK().WithPayload(resp)
+}
+
+func (n *nodesHandlers) pauseAsyncReplication(params cluster.ClusterAsyncReplicationPauseParams, principal *models.Principal) middleware.Responder {
+	resp, err := n.schemaManager.PauseAsyncReplication(params.HTTPRequest.Context(), principal, params.ClassName, true)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterAsyncReplicationPauseForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterAsyncReplicationPauseNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrAsyncReplicationDisabled):
+			return cluster.NewClusterAsyncReplicationPauseUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterAsyncReplicationPauseInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterAsyncReplicationPauseOK().WithPayload(resp)
+}
+
+func (n *nodesHandlers) resumeAsyncReplication(params cluster.ClusterAsyncReplicationResumeParams, principal *models.Principal) middleware.Responder {
+	resp, err := n.schemaManager.PauseAsyncReplication(params.HTTPRequest.Context(), principal, params.ClassName, false)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterAsyncReplicationResumeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return cluster.NewClusterAsyncReplicationResumeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrAsyncReplicationDisabled):
+			return cluster.NewClusterAsyncReplicationResumeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterAsyncReplicationResumeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterAsyncReplicationResumeOK().WithPayload(resp)
+}
+
 func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	n.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -123,13 +279,25 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
 		repo, schemaManger)
 
-	h := &nodesHandlers{nodesManager, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
+	h := &nodesHandlers{nodesManager, schemaManger, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
+	api.ClusterClusterDrainNodeHandler = cluster.
+		ClusterDrainNodeHandlerFunc(h.drainNode)
+	api.ClusterClusterReplicasMoveHandler = cluster.
+		ClusterReplicasMoveHandlerFunc(h.moveReplica)
+	api.ClusterClusterRebalanceHandler = cluster.
+		ClusterRebalanceHandlerFunc(h.rebalance)
+	api.ClusterClusterAsyncReplicationRepairHandler = cluster.
+		ClusterAsyncReplicationRepairHandlerFunc(h.repairAsyncReplication)
+	api.ClusterClusterAsyncReplicationPauseHandler = cluster.
+		ClusterAsyncReplicationPauseHandlerFunc(h.pauseAsyncReplication)
+	api.ClusterClusterAsyncReplicationResumeHandler = cluster.
+		ClusterAsyncReplicationResumeHandlerFunc(h.resumeAsyncReplication)
 }
 
 type nodesRequestsTotal struct {
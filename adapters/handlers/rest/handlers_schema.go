@@ -75,6 +75,56 @@ func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	return schema.NewSchemaObjectsUpdateOK().WithPayload(params.ObjectClass)
 }
 
+func (s *schemaHandlers) reindexVectorIndex(params schema.SchemaObjectsVectorsReindexParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class, err := s.manager.ReindexVectorIndex(params.HTTPRequest.Context(), principal, params.ClassName,
+		params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsVectorsReindexNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorsReindexForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsReindexUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsReindexOK().WithPayload(class)
+}
+
+func (s *schemaHandlers) reshard(params schema.SchemaObjectsReshardParams,
+	principal *models.Principal,
+) middleware.Responder {
+	resp, err := s.manager.Reshard(params.HTTPRequest.Context(), principal, params.ClassName,
+		params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsReshardNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReshardForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReshardUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsReshardOK().WithPayload(resp)
+}
+
 func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -353,6 +403,10 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
+	api.SchemaSchemaObjectsVectorsReindexHandler = schema.
+		SchemaObjectsVectorsReindexHandlerFunc(h.reindexVectorIndex)
+	api.SchemaSchemaObjectsReshardHandler = schema.
+		SchemaObjectsReshardHandlerFunc(h.reshard)
 
 	api.SchemaSchemaObjectsGetHandler = schema.
 		SchemaObjectsGetHandlerFunc(h.getClass)
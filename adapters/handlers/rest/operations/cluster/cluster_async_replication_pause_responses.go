@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationPauseOKCode is the HTTP code returned for type ClusterAsyncReplicationPauseOK
+const ClusterAsyncReplicationPauseOKCode int = 200
+
+/*
+ClusterAsyncReplicationPauseOK Async replication successfully paused
+
+swagger:response clusterAsyncReplicationPauseOK
+*/
+type ClusterAsyncReplicationPauseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationConfig `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationPauseOK creates ClusterAsyncReplicationPauseOK with default headers values
+func NewClusterAsyncReplicationPauseOK() *ClusterAsyncReplicationPauseOK {
+
+	return &ClusterAsyncReplicationPauseOK{}
+}
+
+// WithPayload adds the payload to the cluster async replication pause o k response
+func (o *ClusterAsyncReplicationPauseOK) WithPayload(payload *models.ReplicationConfig) *ClusterAsyncReplicationPauseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication pause o k response
+func (o *ClusterAsyncReplicationPauseOK) SetPayload(payload *models.ReplicationConfig) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationPauseUnauthorizedCode is the HTTP code returned for type ClusterAsyncReplicationPauseUnauthorized
+const ClusterAsyncReplicationPauseUnauthorizedCode int = 401
+
+/*
+ClusterAsyncReplicationPauseUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterAsyncReplicationPauseUnauthorized
+*/
+type ClusterAsyncReplicationPauseUnauthorized struct {
+}
+
+// NewClusterAsyncReplicationPauseUnauthorized creates ClusterAsyncReplicationPauseUnauthorized with default headers values
+func NewClusterAsyncReplicationPauseUnauthorized() *ClusterAsyncReplicationPauseUnauthorized {
+
+	return &ClusterAsyncReplicationPauseUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterAsyncReplicationPauseForbiddenCode is the HTTP code returned for type ClusterAsyncReplicationPauseForbidden
+const ClusterAsyncReplicationPauseForbiddenCode int = 403
+
+/*
+ClusterAsyncReplicationPauseForbidden Forbidden
+
+swagger:response clusterAsyncReplicationPauseForbidden
+*/
+type ClusterAsyncReplicationPauseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationPauseForbidden creates ClusterAsyncReplicationPauseForbidden with default headers values
+func NewClusterAsyncReplicationPauseForbidden() *ClusterAsyncReplicationPauseForbidden {
+
+	return &ClusterAsyncReplicationPauseForbidden{}
+}
+
+// WithPayload adds the payload to the cluster async replication pause forbidden response
+func (o *ClusterAsyncReplicationPauseForbidden) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationPauseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication pause forbidden response
+func (o *ClusterAsyncReplicationPauseForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationPauseNotFoundCode is the HTTP code returned for type ClusterAsyncReplicationPauseNotFound
+const ClusterAsyncReplicationPauseNotFoundCode int = 404
+
+/*
+ClusterAsyncReplicationPauseNotFound Collection not found.
+
+swagger:response clusterAsyncReplicationPauseNotFound
+*/
+type ClusterAsyncReplicationPauseNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationPauseNotFound creates ClusterAsyncReplicationPauseNotFound with default headers values
+func NewClusterAsyncReplicationPauseNotFound() *ClusterAsyncReplicationPauseNotFound {
+
+	return &ClusterAsyncReplicationPauseNotFound{}
+}
+
+// WithPayload adds the payload to the cluster async replication pause not found response
+func (o *ClusterAsyncReplicationPauseNotFound) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationPauseNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication pause not found response
+func (o *ClusterAsyncReplicationPauseNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationPauseUnprocessableEntityCode is the HTTP code returned for type ClusterAsyncReplicationPauseUnprocessableEntity
+const ClusterAsyncReplicationPauseUnprocessableEntityCode int = 422
+
+/*
+ClusterAsyncReplicationPauseUnprocessableEntity Async replication is disabled for the collection.
+
+swagger:response clusterAsyncReplicationPauseUnprocessableEntity
+*/
+type ClusterAsyncReplicationPauseUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationPauseUnprocessableEntity creates ClusterAsyncReplicationPauseUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationPauseUnprocessableEntity() *ClusterAsyncReplicationPauseUnprocessableEntity {
+
+	return &ClusterAsyncReplicationPauseUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster async replication pause unprocessable entity response
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationPauseUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication pause unprocessable entity response
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationPauseInternalServerErrorCode is the HTTP code returned for type ClusterAsyncReplicationPauseInternalServerError
+const ClusterAsyncReplicationPauseInternalServerErrorCode int = 500
+
+/*
+ClusterAsyncReplicationPauseInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterAsyncReplicationPauseInternalServerError
+*/
+type ClusterAsyncReplicationPauseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationPauseInternalServerError creates ClusterAsyncReplicationPauseInternalServerError with default headers values
+func NewClusterAsyncReplicationPauseInternalServerError() *ClusterAsyncReplicationPauseInternalServerError {
+
+	return &ClusterAsyncReplicationPauseInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster async replication pause internal server error response
+func (o *ClusterAsyncReplicationPauseInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationPauseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication pause internal server error response
+func (o *ClusterAsyncReplicationPauseInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationPauseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
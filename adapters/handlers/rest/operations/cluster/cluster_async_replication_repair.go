@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationRepairHandlerFunc turns a function with the right signature into a cluster async replication repair handler
+type ClusterAsyncReplicationRepairHandlerFunc func(ClusterAsyncReplicationRepairParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ClusterAsyncReplicationRepairHandlerFunc) Handle(params ClusterAsyncReplicationRepairParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ClusterAsyncReplicationRepairHandler interface for that can handle valid cluster async replication repair params
+type ClusterAsyncReplicationRepairHandler interface {
+	Handle(ClusterAsyncReplicationRepairParams, *models.Principal) middleware.Responder
+}
+
+// NewClusterAsyncReplicationRepair creates a new http.Handler for the cluster async replication repair operation
+func NewClusterAsyncReplicationRepair(ctx *middleware.Context, handler ClusterAsyncReplicationRepairHandler) *ClusterAsyncReplicationRepair {
+	return &ClusterAsyncReplicationRepair{Context: ctx, Handler: handler}
+}
+
+/*
+	ClusterAsyncReplicationRepair swagger:route POST /cluster/async-replication/{className}/repair cluster clusterAsyncReplicationRepair
+
+Repair the replicas of the shards of a collection.
+
+Compares the replicas of each shard of the collection, or of the given shard only, and propagates the objects missing or outdated on any of them, until all replicas hold the same objects. The repair runs in the background, the request returns once it is started. Its progress is reported per replica by the async replication status in the verbose output of the nodes API.
+*/
+type ClusterAsyncReplicationRepair struct {
+	Context *middleware.Context
+	Handler ClusterAsyncReplicationRepairHandler
+}
+
+func (o *ClusterAsyncReplicationRepair) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewClusterAsyncReplicationRepairParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
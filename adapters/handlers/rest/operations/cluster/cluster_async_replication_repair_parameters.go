@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewClusterAsyncReplicationRepairParams creates a new ClusterAsyncReplicationRepairParams object
+//
+// There are no default values defined in the spec.
+func NewClusterAsyncReplicationRepairParams() ClusterAsyncReplicationRepairParams {
+
+	return ClusterAsyncReplicationRepairParams{}
+}
+
+// ClusterAsyncReplicationRepairParams contains all the bound params for the cluster async replication repair operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters cluster.asyncReplication.repair
+type ClusterAsyncReplicationRepairParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The name of the collection.
+	  Required: true
+	  In: path
+	*/
+	ClassName string
+	/*The name of the shard to repair. All shards are repaired if not set.
+	  In: query
+	*/
+	Shard *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewClusterAsyncReplicationRepairParams() beforehand.
+func (o *ClusterAsyncReplicationRepairParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClassName, rhkClassName, _ := route.Params.GetOK("className")
+	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qShard, qhkShard, _ := qs.GetOK("shard")
+	if err := o.bindShard(qShard, qhkShard, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClassName binds and validates parameter ClassName from path.
+func (o *ClusterAsyncReplicationRepairParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ClassName = raw
+
+	return nil
+}
+
+// bindShard binds and validates parameter Shard from query.
+func (o *ClusterAsyncReplicationRepairParams) bindShard(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Shard = &raw
+
+	return nil
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationRepairOKCode is the HTTP code returned for type ClusterAsyncReplicationRepairOK
+const ClusterAsyncReplicationRepairOKCode int = 200
+
+/*
+ClusterAsyncReplicationRepairOK Repair of the shards successfully started
+
+swagger:response clusterAsyncReplicationRepairOK
+*/
+type ClusterAsyncReplicationRepairOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AsyncReplicationRepairResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationRepairOK creates ClusterAsyncReplicationRepairOK with default headers values
+func NewClusterAsyncReplicationRepairOK() *ClusterAsyncReplicationRepairOK {
+
+	return &ClusterAsyncReplicationRepairOK{}
+}
+
+// WithPayload adds the payload to the cluster async replication repair o k response
+func (o *ClusterAsyncReplicationRepairOK) WithPayload(payload *models.AsyncReplicationRepairResponse) *ClusterAsyncReplicationRepairOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication repair o k response
+func (o *ClusterAsyncReplicationRepairOK) SetPayload(payload *models.AsyncReplicationRepairResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationRepairUnauthorizedCode is the HTTP code returned for type ClusterAsyncReplicationRepairUnauthorized
+const ClusterAsyncReplicationRepairUnauthorizedCode int = 401
+
+/*
+ClusterAsyncReplicationRepairUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterAsyncReplicationRepairUnauthorized
+*/
+type ClusterAsyncReplicationRepairUnauthorized struct {
+}
+
+// NewClusterAsyncReplicationRepairUnauthorized creates ClusterAsyncReplicationRepairUnauthorized with default headers values
+func NewClusterAsyncReplicationRepairUnauthorized() *ClusterAsyncReplicationRepairUnauthorized {
+
+	return &ClusterAsyncReplicationRepairUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterAsyncReplicationRepairForbiddenCode is the HTTP code returned for type ClusterAsyncReplicationRepairForbidden
+const ClusterAsyncReplicationRepairForbiddenCode int = 403
+
+/*
+ClusterAsyncReplicationRepairForbidden Forbidden
+
+swagger:response clusterAsyncReplicationRepairForbidden
+*/
+type ClusterAsyncReplicationRepairForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationRepairForbidden creates ClusterAsyncReplicationRepairForbidden with default headers values
+func NewClusterAsyncReplicationRepairForbidden() *ClusterAsyncReplicationRepairForbidden {
+
+	return &ClusterAsyncReplicationRepairForbidden{}
+}
+
+// WithPayload adds the payload to the cluster async replication repair forbidden response
+func (o *ClusterAsyncReplicationRepairForbidden) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationRepairForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication repair forbidden response
+func (o *ClusterAsyncReplicationRepairForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationRepairNotFoundCode is the HTTP code returned for type ClusterAsyncReplicationRepairNotFound
+const ClusterAsyncReplicationRepairNotFoundCode int = 404
+
+/*
+ClusterAsyncReplicationRepairNotFound Collection or shard not found.
+
+swagger:response clusterAsyncReplicationRepairNotFound
+*/
+type ClusterAsyncReplicationRepairNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationRepairNotFound creates ClusterAsyncReplicationRepairNotFound with default headers values
+func NewClusterAsyncReplicationRepairNotFound() *ClusterAsyncReplicationRepairNotFound {
+
+	return &ClusterAsyncReplicationRepairNotFound{}
+}
+
+// WithPayload adds the payload to the cluster async replication repair not found response
+func (o *ClusterAsyncReplicationRepairNotFound) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationRepairNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication repair not found response
+func (o *ClusterAsyncReplicationRepairNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationRepairUnprocessableEntityCode is the HTTP code returned for type ClusterAsyncReplicationRepairUnprocessableEntity
+const ClusterAsyncReplicationRepairUnprocessableEntityCode int = 422
+
+/*
+ClusterAsyncReplicationRepairUnprocessableEntity Async replication is disabled for the collection.
+
+swagger:response clusterAsyncReplicationRepairUnprocessableEntity
+*/
+type ClusterAsyncReplicationRepairUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationRepairUnprocessableEntity creates ClusterAsyncReplicationRepairUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationRepairUnprocessableEntity() *ClusterAsyncReplicationRepairUnprocessableEntity {
+
+	return &ClusterAsyncReplicationRepairUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster async replication repair unprocessable entity response
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationRepairUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication repair unprocessable entity response
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationRepairInternalServerErrorCode is the HTTP code returned for type ClusterAsyncReplicationRepairInternalServerError
+const ClusterAsyncReplicationRepairInternalServerErrorCode int = 500
+
+/*
+ClusterAsyncReplicationRepairInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterAsyncReplicationRepairInternalServerError
+*/
+type ClusterAsyncReplicationRepairInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationRepairInternalServerError creates ClusterAsyncReplicationRepairInternalServerError with default headers values
+func NewClusterAsyncReplicationRepairInternalServerError() *ClusterAsyncReplicationRepairInternalServerError {
+
+	return &ClusterAsyncReplicationRepairInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster async replication repair internal server error response
+func (o *ClusterAsyncReplicationRepairInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationRepairInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication repair internal server error response
+func (o *ClusterAsyncReplicationRepairInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationRepairInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
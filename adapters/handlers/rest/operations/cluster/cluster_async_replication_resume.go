@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationResumeHandlerFunc turns a function with the right signature into a cluster async replication resume handler
+type ClusterAsyncReplicationResumeHandlerFunc func(ClusterAsyncReplicationResumeParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ClusterAsyncReplicationResumeHandlerFunc) Handle(params ClusterAsyncReplicationResumeParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ClusterAsyncReplicationResumeHandler interface for that can handle valid cluster async replication resume params
+type ClusterAsyncReplicationResumeHandler interface {
+	Handle(ClusterAsyncReplicationResumeParams, *models.Principal) middleware.Responder
+}
+
+// NewClusterAsyncReplicationResume creates a new http.Handler for the cluster async replication resume operation
+func NewClusterAsyncReplicationResume(ctx *middleware.Context, handler ClusterAsyncReplicationResumeHandler) *ClusterAsyncReplicationResume {
+	return &ClusterAsyncReplicationResume{Context: ctx, Handler: handler}
+}
+
+/*
+	ClusterAsyncReplicationResume swagger:route PUT /cluster/async-replication/{className}/resume cluster clusterAsyncReplicationResume
+
+Resume async replication of a collection.
+
+Resumes comparing and repairing the replicas of the collection in the background after it was paused.
+*/
+type ClusterAsyncReplicationResume struct {
+	Context *middleware.Context
+	Handler ClusterAsyncReplicationResumeHandler
+}
+
+func (o *ClusterAsyncReplicationResume) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewClusterAsyncReplicationResumeParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
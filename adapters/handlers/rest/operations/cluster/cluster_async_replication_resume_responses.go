@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationResumeOKCode is the HTTP code returned for type ClusterAsyncReplicationResumeOK
+const ClusterAsyncReplicationResumeOKCode int = 200
+
+/*
+ClusterAsyncReplicationResumeOK Async replication successfully resumed
+
+swagger:response clusterAsyncReplicationResumeOK
+*/
+type ClusterAsyncReplicationResumeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationConfig `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationResumeOK creates ClusterAsyncReplicationResumeOK with default headers values
+func NewClusterAsyncReplicationResumeOK() *ClusterAsyncReplicationResumeOK {
+
+	return &ClusterAsyncReplicationResumeOK{}
+}
+
+// WithPayload adds the payload to the cluster async replication resume o k response
+func (o *ClusterAsyncReplicationResumeOK) WithPayload(payload *models.ReplicationConfig) *ClusterAsyncReplicationResumeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication resume o k response
+func (o *ClusterAsyncReplicationResumeOK) SetPayload(payload *models.ReplicationConfig) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationResumeUnauthorizedCode is the HTTP code returned for type ClusterAsyncReplicationResumeUnauthorized
+const ClusterAsyncReplicationResumeUnauthorizedCode int = 401
+
+/*
+ClusterAsyncReplicationResumeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterAsyncReplicationResumeUnauthorized
+*/
+type ClusterAsyncReplicationResumeUnauthorized struct {
+}
+
+// NewClusterAsyncReplicationResumeUnauthorized creates ClusterAsyncReplicationResumeUnauthorized with default headers values
+func NewClusterAsyncReplicationResumeUnauthorized() *ClusterAsyncReplicationResumeUnauthorized {
+
+	return &ClusterAsyncReplicationResumeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterAsyncReplicationResumeForbiddenCode is the HTTP code returned for type ClusterAsyncReplicationResumeForbidden
+const ClusterAsyncReplicationResumeForbiddenCode int = 403
+
+/*
+ClusterAsyncReplicationResumeForbidden Forbidden
+
+swagger:response clusterAsyncReplicationResumeForbidden
+*/
+type ClusterAsyncReplicationResumeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationResumeForbidden creates ClusterAsyncReplicationResumeForbidden with default headers values
+func NewClusterAsyncReplicationResumeForbidden() *ClusterAsyncReplicationResumeForbidden {
+
+	return &ClusterAsyncReplicationResumeForbidden{}
+}
+
+// WithPayload adds the payload to the cluster async replication resume forbidden response
+func (o *ClusterAsyncReplicationResumeForbidden) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationResumeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication resume forbidden response
+func (o *ClusterAsyncReplicationResumeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationResumeNotFoundCode is the HTTP code returned for type ClusterAsyncReplicationResumeNotFound
+const ClusterAsyncReplicationResumeNotFoundCode int = 404
+
+/*
+ClusterAsyncReplicationResumeNotFound Collection not found.
+
+swagger:response clusterAsyncReplicationResumeNotFound
+*/
+type ClusterAsyncReplicationResumeNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationResumeNotFound creates ClusterAsyncReplicationResumeNotFound with default headers values
+func NewClusterAsyncReplicationResumeNotFound() *ClusterAsyncReplicationResumeNotFound {
+
+	return &ClusterAsyncReplicationResumeNotFound{}
+}
+
+// WithPayload adds the payload to the cluster async replication resume not found response
+func (o *ClusterAsyncReplicationResumeNotFound) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationResumeNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication resume not found response
+func (o *ClusterAsyncReplicationResumeNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationResumeUnprocessableEntityCode is the HTTP code returned for type ClusterAsyncReplicationResumeUnprocessableEntity
+const ClusterAsyncReplicationResumeUnprocessableEntityCode int = 422
+
+/*
+ClusterAsyncReplicationResumeUnprocessableEntity Async replication is disabled for the collection.
+
+swagger:response clusterAsyncReplicationResumeUnprocessableEntity
+*/
+type ClusterAsyncReplicationResumeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationResumeUnprocessableEntity creates ClusterAsyncReplicationResumeUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationResumeUnprocessableEntity() *ClusterAsyncReplicationResumeUnprocessableEntity {
+
+	return &ClusterAsyncReplicationResumeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster async replication resume unprocessable entity response
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationResumeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication resume unprocessable entity response
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterAsyncReplicationResumeInternalServerErrorCode is the HTTP code returned for type ClusterAsyncReplicationResumeInternalServerError
+const ClusterAsyncReplicationResumeInternalServerErrorCode int = 500
+
+/*
+ClusterAsyncReplicationResumeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterAsyncReplicationResumeInternalServerError
+*/
+type ClusterAsyncReplicationResumeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterAsyncReplicationResumeInternalServerError creates ClusterAsyncReplicationResumeInternalServerError with default headers values
+func NewClusterAsyncReplicationResumeInternalServerError() *ClusterAsyncReplicationResumeInternalServerError {
+
+	return &ClusterAsyncReplicationResumeInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster async replication resume internal server error response
+func (o *ClusterAsyncReplicationResumeInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterAsyncReplicationResumeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster async replication resume internal server error response
+func (o *ClusterAsyncReplicationResumeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterAsyncReplicationResumeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterDrainNodeHandlerFunc turns a function with the right signature into a cluster drain node handler
+type ClusterDrainNodeHandlerFunc func(ClusterDrainNodeParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ClusterDrainNodeHandlerFunc) Handle(params ClusterDrainNodeParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ClusterDrainNodeHandler interface for that can handle valid cluster drain node params
+type ClusterDrainNodeHandler interface {
+	Handle(ClusterDrainNodeParams, *models.Principal) middleware.Responder
+}
+
+// NewClusterDrainNode creates a new http.Handler for the cluster drain node operation
+func NewClusterDrainNode(ctx *middleware.Context, handler ClusterDrainNodeHandler) *ClusterDrainNode {
+	return &ClusterDrainNode{Context: ctx, Handler: handler}
+}
+
+/*
+	ClusterDrainNode swagger:route POST /cluster/nodes/{nodeName}/drain cluster clusterDrainNode
+
+Move all shard replicas off a node.
+
+Moves every shard replica held by the node to other storage nodes. Like a replica move, each target is added to the replicas of the shard and synced with async replication before the node is removed from the replicas and deletes its local copy, so every collection with replicas on the node needs async replication. Replicas of inactive tenants are skipped and listed in the response. Once no replica is left, the node can be removed from the cluster.
+*/
+type ClusterDrainNode struct {
+	Context *middleware.Context
+	Handler ClusterDrainNodeHandler
+}
+
+func (o *ClusterDrainNode) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewClusterDrainNodeParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterDrainNodeOKCode is the HTTP code returned for type ClusterDrainNodeOK
+const ClusterDrainNodeOKCode int = 200
+
+/*
+ClusterDrainNodeOK Node successfully drained
+
+swagger:response clusterDrainNodeOK
+*/
+type ClusterDrainNodeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodeDrainResponse `json:"body,omitempty"`
+}
+
+// NewClusterDrainNodeOK creates ClusterDrainNodeOK with default headers values
+func NewClusterDrainNodeOK() *ClusterDrainNodeOK {
+
+	return &ClusterDrainNodeOK{}
+}
+
+// WithPayload adds the payload to the cluster drain node o k response
+func (o *ClusterDrainNodeOK) WithPayload(payload *models.NodeDrainResponse) *ClusterDrainNodeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster drain node o k response
+func (o *ClusterDrainNodeOK) SetPayload(payload *models.NodeDrainResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterDrainNodeUnauthorizedCode is the HTTP code returned for type ClusterDrainNodeUnauthorized
+const ClusterDrainNodeUnauthorizedCode int = 401
+
+/*
+ClusterDrainNodeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterDrainNodeUnauthorized
+*/
+type ClusterDrainNodeUnauthorized struct {
+}
+
+// NewClusterDrainNodeUnauthorized creates ClusterDrainNodeUnauthorized with default headers values
+func NewClusterDrainNodeUnauthorized() *ClusterDrainNodeUnauthorized {
+
+	return &ClusterDrainNodeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterDrainNodeForbiddenCode is the HTTP code returned for type ClusterDrainNodeForbidden
+const ClusterDrainNodeForbiddenCode int = 403
+
+/*
+ClusterDrainNodeForbidden Forbidden
+
+swagger:response clusterDrainNodeForbidden
+*/
+type ClusterDrainNodeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterDrainNodeForbidden creates ClusterDrainNodeForbidden with default headers values
+func NewClusterDrainNodeForbidden() *ClusterDrainNodeForbidden {
+
+	return &ClusterDrainNodeForbidden{}
+}
+
+// WithPayload adds the payload to the cluster drain node forbidden response
+func (o *ClusterDrainNodeForbidden) WithPayload(payload *models.ErrorResponse) *ClusterDrainNodeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster drain node forbidden response
+func (o *ClusterDrainNodeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterDrainNodeNotFoundCode is the HTTP code returned for type ClusterDrainNodeNotFound
+const ClusterDrainNodeNotFoundCode int = 404
+
+/*
+ClusterDrainNodeNotFound Node not found.
+
+swagger:response clusterDrainNodeNotFound
+*/
+type ClusterDrainNodeNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterDrainNodeNotFound creates ClusterDrainNodeNotFound with default headers values
+func NewClusterDrainNodeNotFound() *ClusterDrainNodeNotFound {
+
+	return &ClusterDrainNodeNotFound{}
+}
+
+// WithPayload adds the payload to the cluster drain node not found response
+func (o *ClusterDrainNodeNotFound) WithPayload(payload *models.ErrorResponse) *ClusterDrainNodeNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster drain node not found response
+func (o *ClusterDrainNodeNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterDrainNodeUnprocessableEntityCode is the HTTP code returned for type ClusterDrainNodeUnprocessableEntity
+const ClusterDrainNodeUnprocessableEntityCode int = 422
+
+/*
+ClusterDrainNodeUnprocessableEntity The node cannot be drained, e.g. because no other node is left to hold its replicas or a collection with replicas on the node does not have async replication enabled.
+
+swagger:response clusterDrainNodeUnprocessableEntity
+*/
+type ClusterDrainNodeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterDrainNodeUnprocessableEntity creates ClusterDrainNodeUnprocessableEntity with default headers values
+func NewClusterDrainNodeUnprocessableEntity() *ClusterDrainNodeUnprocessableEntity {
+
+	return &ClusterDrainNodeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster drain node unprocessable entity response
+func (o *ClusterDrainNodeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterDrainNodeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster drain node unprocessable entity response
+func (o *ClusterDrainNodeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterDrainNodeInternalServerErrorCode is the HTTP code returned for type ClusterDrainNodeInternalServerError
+const ClusterDrainNodeInternalServerErrorCode int = 500
+
+/*
+ClusterDrainNodeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterDrainNodeInternalServerError
+*/
+type ClusterDrainNodeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterDrainNodeInternalServerError creates ClusterDrainNodeInternalServerError with default headers values
+func NewClusterDrainNodeInternalServerError() *ClusterDrainNodeInternalServerError {
+
+	return &ClusterDrainNodeInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster drain node internal server error response
+func (o *ClusterDrainNodeInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterDrainNodeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster drain node internal server error response
+func (o *ClusterDrainNodeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterDrainNodeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterRebalanceHandlerFunc turns a function with the right signature into a cluster rebalance handler
+type ClusterRebalanceHandlerFunc func(ClusterRebalanceParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ClusterRebalanceHandlerFunc) Handle(params ClusterRebalanceParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ClusterRebalanceHandler interface for that can handle valid cluster rebalance params
+type ClusterRebalanceHandler interface {
+	Handle(ClusterRebalanceParams, *models.Principal) middleware.Responder
+}
+
+// NewClusterRebalance creates a new http.Handler for the cluster rebalance operation
+func NewClusterRebalance(ctx *middleware.Context, handler ClusterRebalanceHandler) *ClusterRebalance {
+	return &ClusterRebalance{Context: ctx, Handler: handler}
+}
+
+/*
+	ClusterRebalance swagger:route POST /cluster/rebalance cluster clusterRebalance
+
+Even out the number of objects stored by nodes.
+
+Proposes shard replica moves from the nodes storing the most objects, as reported by the nodes endpoint, to the nodes storing the fewest. Unless a dry run is requested, the moves are executed one after another. Only replicas of collections with async replication enabled are moved.
+*/
+type ClusterRebalance struct {
+	Context *middleware.Context
+	Handler ClusterRebalanceHandler
+}
+
+func (o *ClusterRebalance) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewClusterRebalanceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterRebalanceOKCode is the HTTP code returned for type ClusterRebalanceOK
+const ClusterRebalanceOKCode int = 200
+
+/*
+ClusterRebalanceOK The proposed or executed moves
+
+swagger:response clusterRebalanceOK
+*/
+type ClusterRebalanceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterRebalanceResponse `json:"body,omitempty"`
+}
+
+// NewClusterRebalanceOK creates ClusterRebalanceOK with default headers values
+func NewClusterRebalanceOK() *ClusterRebalanceOK {
+
+	return &ClusterRebalanceOK{}
+}
+
+// WithPayload adds the payload to the cluster rebalance o k response
+func (o *ClusterRebalanceOK) WithPayload(payload *models.ClusterRebalanceResponse) *ClusterRebalanceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster rebalance o k response
+func (o *ClusterRebalanceOK) SetPayload(payload *models.ClusterRebalanceResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterRebalanceUnauthorizedCode is the HTTP code returned for type ClusterRebalanceUnauthorized
+const ClusterRebalanceUnauthorizedCode int = 401
+
+/*
+ClusterRebalanceUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterRebalanceUnauthorized
+*/
+type ClusterRebalanceUnauthorized struct {
+}
+
+// NewClusterRebalanceUnauthorized creates ClusterRebalanceUnauthorized with default headers values
+func NewClusterRebalanceUnauthorized() *ClusterRebalanceUnauthorized {
+
+	return &ClusterRebalanceUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterRebalanceForbiddenCode is the HTTP code returned for type ClusterRebalanceForbidden
+const ClusterRebalanceForbiddenCode int = 403
+
+/*
+ClusterRebalanceForbidden Forbidden
+
+swagger:response clusterRebalanceForbidden
+*/
+type ClusterRebalanceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterRebalanceForbidden creates ClusterRebalanceForbidden with default headers values
+func NewClusterRebalanceForbidden() *ClusterRebalanceForbidden {
+
+	return &ClusterRebalanceForbidden{}
+}
+
+// WithPayload adds the payload to the cluster rebalance forbidden response
+func (o *ClusterRebalanceForbidden) WithPayload(payload *models.ErrorResponse) *ClusterRebalanceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster rebalance forbidden response
+func (o *ClusterRebalanceForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterRebalanceNotFoundCode is the HTTP code returned for type ClusterRebalanceNotFound
+const ClusterRebalanceNotFoundCode int = 404
+
+/*
+ClusterRebalanceNotFound Collection or node not found.
+
+swagger:response clusterRebalanceNotFound
+*/
+type ClusterRebalanceNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterRebalanceNotFound creates ClusterRebalanceNotFound with default headers values
+func NewClusterRebalanceNotFound() *ClusterRebalanceNotFound {
+
+	return &ClusterRebalanceNotFound{}
+}
+
+// WithPayload adds the payload to the cluster rebalance not found response
+func (o *ClusterRebalanceNotFound) WithPayload(payload *models.ErrorResponse) *ClusterRebalanceNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster rebalance not found response
+func (o *ClusterRebalanceNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterRebalanceUnprocessableEntityCode is the HTTP code returned for type ClusterRebalanceUnprocessableEntity
+const ClusterRebalanceUnprocessableEntityCode int = 422
+
+/*
+ClusterRebalanceUnprocessableEntity A move cannot be executed.
+
+swagger:response clusterRebalanceUnprocessableEntity
+*/
+type ClusterRebalanceUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterRebalanceUnprocessableEntity creates ClusterRebalanceUnprocessableEntity with default headers values
+func NewClusterRebalanceUnprocessableEntity() *ClusterRebalanceUnprocessableEntity {
+
+	return &ClusterRebalanceUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster rebalance unprocessable entity response
+func (o *ClusterRebalanceUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterRebalanceUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster rebalance unprocessable entity response
+func (o *ClusterRebalanceUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterRebalanceInternalServerErrorCode is the HTTP code returned for type ClusterRebalanceInternalServerError
+const ClusterRebalanceInternalServerErrorCode int = 500
+
+/*
+ClusterRebalanceInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterRebalanceInternalServerError
+*/
+type ClusterRebalanceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterRebalanceInternalServerError creates ClusterRebalanceInternalServerError with default headers values
+func NewClusterRebalanceInternalServerError() *ClusterRebalanceInternalServerError {
+
+	return &ClusterRebalanceInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster rebalance internal server error response
+func (o *ClusterRebalanceInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterRebalanceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster rebalance internal server error response
+func (o *ClusterRebalanceInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterRebalanceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterReplicasMoveHandlerFunc turns a function with the right signature into a cluster replicas move handler
+type ClusterReplicasMoveHandlerFunc func(ClusterReplicasMoveParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ClusterReplicasMoveHandlerFunc) Handle(params ClusterReplicasMoveParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ClusterReplicasMoveHandler interface for that can handle valid cluster replicas move params
+type ClusterReplicasMoveHandler interface {
+	Handle(ClusterReplicasMoveParams, *models.Principal) middleware.Responder
+}
+
+// NewClusterReplicasMove creates a new http.Handler for the cluster replicas move operation
+func NewClusterReplicasMove(ctx *middleware.Context, handler ClusterReplicasMoveHandler) *ClusterReplicasMove {
+	return &ClusterReplicasMove{Context: ctx, Handler: handler}
+}
+
+/*
+	ClusterReplicasMove swagger:route POST /cluster/replicas/move cluster clusterReplicasMove
+
+Move or copy a shard replica to another node.
+
+Copies the replica of a shard from the source node to the target node and adds the target node to the replicas of the shard. Unless only a copy is requested, the source replica is removed afterwards. Writes made while the replica is copied are propagated by async replication, which must be enabled for the collection to move a replica.
+*/
+type ClusterReplicasMove struct {
+	Context *middleware.Context
+	Handler ClusterReplicasMoveHandler
+}
+
+func (o *ClusterReplicasMove) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewClusterReplicasMoveParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
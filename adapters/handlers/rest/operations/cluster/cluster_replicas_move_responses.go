@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterReplicasMoveOKCode is the HTTP code returned for type ClusterReplicasMoveOK
+const ClusterReplicasMoveOKCode int = 200
+
+/*
+ClusterReplicasMoveOK Replica successfully moved or copied
+
+swagger:response clusterReplicasMoveOK
+*/
+type ClusterReplicasMoveOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicaMoveResponse `json:"body,omitempty"`
+}
+
+// NewClusterReplicasMoveOK creates ClusterReplicasMoveOK with default headers values
+func NewClusterReplicasMoveOK() *ClusterReplicasMoveOK {
+
+	return &ClusterReplicasMoveOK{}
+}
+
+// WithPayload adds the payload to the cluster replicas move o k response
+func (o *ClusterReplicasMoveOK) WithPayload(payload *models.ReplicaMoveResponse) *ClusterReplicasMoveOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster replicas move o k response
+func (o *ClusterReplicasMoveOK) SetPayload(payload *models.ReplicaMoveResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterReplicasMoveUnauthorizedCode is the HTTP code returned for type ClusterReplicasMoveUnauthorized
+const ClusterReplicasMoveUnauthorizedCode int = 401
+
+/*
+ClusterReplicasMoveUnauthorized Unauthorized or invalid credentials.
+
+swagger:response clusterReplicasMoveUnauthorized
+*/
+type ClusterReplicasMoveUnauthorized struct {
+}
+
+// NewClusterReplicasMoveUnauthorized creates ClusterReplicasMoveUnauthorized with default headers values
+func NewClusterReplicasMoveUnauthorized() *ClusterReplicasMoveUnauthorized {
+
+	return &ClusterReplicasMoveUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClusterReplicasMoveForbiddenCode is the HTTP code returned for type ClusterReplicasMoveForbidden
+const ClusterReplicasMoveForbiddenCode int = 403
+
+/*
+ClusterReplicasMoveForbidden Forbidden
+
+swagger:response clusterReplicasMoveForbidden
+*/
+type ClusterReplicasMoveForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterReplicasMoveForbidden creates ClusterReplicasMoveForbidden with default headers values
+func NewClusterReplicasMoveForbidden() *ClusterReplicasMoveForbidden {
+
+	return &ClusterReplicasMoveForbidden{}
+}
+
+// WithPayload adds the payload to the cluster replicas move forbidden response
+func (o *ClusterReplicasMoveForbidden) WithPayload(payload *models.ErrorResponse) *ClusterReplicasMoveForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster replicas move forbidden response
+func (o *ClusterReplicasMoveForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterReplicasMoveNotFoundCode is the HTTP code returned for type ClusterReplicasMoveNotFound
+const ClusterReplicasMoveNotFoundCode int = 404
+
+/*
+ClusterReplicasMoveNotFound Collection or node not found.
+
+swagger:response clusterReplicasMoveNotFound
+*/
+type ClusterReplicasMoveNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterReplicasMoveNotFound creates ClusterReplicasMoveNotFound with default headers values
+func NewClusterReplicasMoveNotFound() *ClusterReplicasMoveNotFound {
+
+	return &ClusterReplicasMoveNotFound{}
+}
+
+// WithPayload adds the payload to the cluster replicas move not found response
+func (o *ClusterReplicasMoveNotFound) WithPayload(payload *models.ErrorResponse) *ClusterReplicasMoveNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster replicas move not found response
+func (o *ClusterReplicasMoveNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterReplicasMoveUnprocessableEntityCode is the HTTP code returned for type ClusterReplicasMoveUnprocessableEntity
+const ClusterReplicasMoveUnprocessableEntityCode int = 422
+
+/*
+ClusterReplicasMoveUnprocessableEntity The replica cannot be moved, e.g. because the target node already holds it.
+
+swagger:response clusterReplicasMoveUnprocessableEntity
+*/
+type ClusterReplicasMoveUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterReplicasMoveUnprocessableEntity creates ClusterReplicasMoveUnprocessableEntity with default headers values
+func NewClusterReplicasMoveUnprocessableEntity() *ClusterReplicasMoveUnprocessableEntity {
+
+	return &ClusterReplicasMoveUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the cluster replicas move unprocessable entity response
+func (o *ClusterReplicasMoveUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ClusterReplicasMoveUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster replicas move unprocessable entity response
+func (o *ClusterReplicasMoveUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClusterReplicasMoveInternalServerErrorCode is the HTTP code returned for type ClusterReplicasMoveInternalServerError
+const ClusterReplicasMoveInternalServerErrorCode int = 500
+
+/*
+ClusterReplicasMoveInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response clusterReplicasMoveInternalServerError
+*/
+type ClusterReplicasMoveInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClusterReplicasMoveInternalServerError creates ClusterReplicasMoveInternalServerError with default headers values
+func NewClusterReplicasMoveInternalServerError() *ClusterReplicasMoveInternalServerError {
+
+	return &ClusterReplicasMoveInternalServerError{}
+}
+
+// WithPayload adds the payload to the cluster replicas move internal server error response
+func (o *ClusterReplicasMoveInternalServerError) WithPayload(payload *models.ErrorResponse) *ClusterReplicasMoveInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster replicas move internal server error response
+func (o *ClusterReplicasMoveInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterReplicasMoveInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
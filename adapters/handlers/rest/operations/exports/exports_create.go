@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsCreateHandlerFunc turns a function with the right signature into a exports create handler
+type ExportsCreateHandlerFunc func(ExportsCreateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportsCreateHandlerFunc) Handle(params ExportsCreateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ExportsCreateHandler interface for that can handle valid exports create params
+type ExportsCreateHandler interface {
+	Handle(ExportsCreateParams, *models.Principal) middleware.Responder
+}
+
+// NewExportsCreate creates a new http.Handler for the exports create operation
+func NewExportsCreate(ctx *middleware.Context, handler ExportsCreateHandler) *ExportsCreate {
+	return &ExportsCreate{Context: ctx, Handler: handler}
+}
+
+/*
+	ExportsCreate swagger:route POST /export/{backend} exports exportsCreate
+
+# Start an export
+
+Export a point-in-time snapshot of a collection, or of a single tenant, to JSONL or Parquet files through a backup backend. The snapshot of all shards is taken when the export starts, writes made afterwards are not included. Shards without a replica on the node receiving the request are exported from one of their replicas on other nodes.
+*/
+type ExportsCreate struct {
+	Context *middleware.Context
+	Handler ExportsCreateHandler
+}
+
+func (o *ExportsCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewExportsCreateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsCreateOKCode is the HTTP code returned for type ExportsCreateOK
+const ExportsCreateOKCode int = 200
+
+/*
+ExportsCreateOK Export successfully started.
+
+swagger:response exportsCreateOK
+*/
+type ExportsCreateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ExportStatusResponse `json:"body,omitempty"`
+}
+
+// NewExportsCreateOK creates ExportsCreateOK with default headers values
+func NewExportsCreateOK() *ExportsCreateOK {
+
+	return &ExportsCreateOK{}
+}
+
+// WithPayload adds the payload to the exports create o k response
+func (o *ExportsCreateOK) WithPayload(payload *models.ExportStatusResponse) *ExportsCreateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports create o k response
+func (o *ExportsCreateOK) SetPayload(payload *models.ExportStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsCreateUnauthorizedCode is the HTTP code returned for type ExportsCreateUnauthorized
+const ExportsCreateUnauthorizedCode int = 401
+
+/*
+ExportsCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response exportsCreateUnauthorized
+*/
+type ExportsCreateUnauthorized struct {
+}
+
+// NewExportsCreateUnauthorized creates ExportsCreateUnauthorized with default headers values
+func NewExportsCreateUnauthorized() *ExportsCreateUnauthorized {
+
+	return &ExportsCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ExportsCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ExportsCreateForbiddenCode is the HTTP code returned for type ExportsCreateForbidden
+const ExportsCreateForbiddenCode int = 403
+
+/*
+ExportsCreateForbidden Forbidden
+
+swagger:response exportsCreateForbidden
+*/
+type ExportsCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsCreateForbidden creates ExportsCreateForbidden with default headers values
+func NewExportsCreateForbidden() *ExportsCreateForbidden {
+
+	return &ExportsCreateForbidden{}
+}
+
+// WithPayload adds the payload to the exports create forbidden response
+func (o *ExportsCreateForbidden) WithPayload(payload *models.ErrorResponse) *ExportsCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports create forbidden response
+func (o *ExportsCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsCreateUnprocessableEntityCode is the HTTP code returned for type ExportsCreateUnprocessableEntity
+const ExportsCreateUnprocessableEntityCode int = 422
+
+/*
+ExportsCreateUnprocessableEntity Invalid export request.
+
+swagger:response exportsCreateUnprocessableEntity
+*/
+type ExportsCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsCreateUnprocessableEntity creates ExportsCreateUnprocessableEntity with default headers values
+func NewExportsCreateUnprocessableEntity() *ExportsCreateUnprocessableEntity {
+
+	return &ExportsCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the exports create unprocessable entity response
+func (o *ExportsCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ExportsCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports create unprocessable entity response
+func (o *ExportsCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsCreateInternalServerErrorCode is the HTTP code returned for type ExportsCreateInternalServerError
+const ExportsCreateInternalServerErrorCode int = 500
+
+/*
+ExportsCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response exportsCreateInternalServerError
+*/
+type ExportsCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsCreateInternalServerError creates ExportsCreateInternalServerError with default headers values
+func NewExportsCreateInternalServerError() *ExportsCreateInternalServerError {
+
+	return &ExportsCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the exports create internal server error response
+func (o *ExportsCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *ExportsCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports create internal server error response
+func (o *ExportsCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsStatusHandlerFunc turns a function with the right signature into a exports status handler
+type ExportsStatusHandlerFunc func(ExportsStatusParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportsStatusHandlerFunc) Handle(params ExportsStatusParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ExportsStatusHandler interface for that can handle valid exports status params
+type ExportsStatusHandler interface {
+	Handle(ExportsStatusParams, *models.Principal) middleware.Responder
+}
+
+// NewExportsStatus creates a new http.Handler for the exports status operation
+func NewExportsStatus(ctx *middleware.Context, handler ExportsStatusHandler) *ExportsStatus {
+	return &ExportsStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	ExportsStatus swagger:route GET /export/{backend}/{id} exports exportsStatus
+
+# Get export status
+
+Returns the status of an export and the data files written so far.
+*/
+type ExportsStatus struct {
+	Context *middleware.Context
+	Handler ExportsStatusHandler
+}
+
+func (o *ExportsStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewExportsStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsStatusOKCode is the HTTP code returned for type ExportsStatusOK
+const ExportsStatusOKCode int = 200
+
+/*
+ExportsStatusOK Export status successfully returned.
+
+swagger:response exportsStatusOK
+*/
+type ExportsStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ExportStatusResponse `json:"body,omitempty"`
+}
+
+// NewExportsStatusOK creates ExportsStatusOK with default headers values
+func NewExportsStatusOK() *ExportsStatusOK {
+
+	return &ExportsStatusOK{}
+}
+
+// WithPayload adds the payload to the exports status o k response
+func (o *ExportsStatusOK) WithPayload(payload *models.ExportStatusResponse) *ExportsStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports status o k response
+func (o *ExportsStatusOK) SetPayload(payload *models.ExportStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsStatusUnauthorizedCode is the HTTP code returned for type ExportsStatusUnauthorized
+const ExportsStatusUnauthorizedCode int = 401
+
+/*
+ExportsStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response exportsStatusUnauthorized
+*/
+type ExportsStatusUnauthorized struct {
+}
+
+// NewExportsStatusUnauthorized creates ExportsStatusUnauthorized with default headers values
+func NewExportsStatusUnauthorized() *ExportsStatusUnauthorized {
+
+	return &ExportsStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ExportsStatusForbiddenCode is the HTTP code returned for type ExportsStatusForbidden
+const ExportsStatusForbiddenCode int = 403
+
+/*
+ExportsStatusForbidden Forbidden
+
+swagger:response exportsStatusForbidden
+*/
+type ExportsStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsStatusForbidden creates ExportsStatusForbidden with default headers values
+func NewExportsStatusForbidden() *ExportsStatusForbidden {
+
+	return &ExportsStatusForbidden{}
+}
+
+// WithPayload adds the payload to the exports status forbidden response
+func (o *ExportsStatusForbidden) WithPayload(payload *models.ErrorResponse) *ExportsStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports status forbidden response
+func (o *ExportsStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsStatusNotFoundCode is the HTTP code returned for type ExportsStatusNotFound
+const ExportsStatusNotFoundCode int = 404
+
+/*
+ExportsStatusNotFound Not Found - Export does not exist
+
+swagger:response exportsStatusNotFound
+*/
+type ExportsStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsStatusNotFound creates ExportsStatusNotFound with default headers values
+func NewExportsStatusNotFound() *ExportsStatusNotFound {
+
+	return &ExportsStatusNotFound{}
+}
+
+// WithPayload adds the payload to the exports status not found response
+func (o *ExportsStatusNotFound) WithPayload(payload *models.ErrorResponse) *ExportsStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports status not found response
+func (o *ExportsStatusNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsStatusUnprocessableEntityCode is the HTTP code returned for type ExportsStatusUnprocessableEntity
+const ExportsStatusUnprocessableEntityCode int = 422
+
+/*
+ExportsStatusUnprocessableEntity Invalid export status request.
+
+swagger:response exportsStatusUnprocessableEntity
+*/
+type ExportsStatusUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsStatusUnprocessableEntity creates ExportsStatusUnprocessableEntity with default headers values
+func NewExportsStatusUnprocessableEntity() *ExportsStatusUnprocessableEntity {
+
+	return &ExportsStatusUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the exports status unprocessable entity response
+func (o *ExportsStatusUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ExportsStatusUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports status unprocessable entity response
+func (o *ExportsStatusUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportsStatusInternalServerErrorCode is the HTTP code returned for type ExportsStatusInternalServerError
+const ExportsStatusInternalServerErrorCode int = 500
+
+/*
+ExportsStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response exportsStatusInternalServerError
+*/
+type ExportsStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExportsStatusInternalServerError creates ExportsStatusInternalServerError with default headers values
+func NewExportsStatusInternalServerError() *ExportsStatusInternalServerError {
+
+	return &ExportsStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the exports status internal server error response
+func (o *ExportsStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *ExportsStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exports status internal server error response
+func (o *ExportsStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportsStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
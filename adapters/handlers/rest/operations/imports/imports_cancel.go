@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCancelHandlerFunc turns a function with the right signature into a imports cancel handler
+type ImportsCancelHandlerFunc func(ImportsCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportsCancelHandlerFunc) Handle(params ImportsCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ImportsCancelHandler interface for that can handle valid imports cancel params
+type ImportsCancelHandler interface {
+	Handle(ImportsCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewImportsCancel creates a new http.Handler for the imports cancel operation
+func NewImportsCancel(ctx *middleware.Context, handler ImportsCancelHandler) *ImportsCancel {
+	return &ImportsCancel{Context: ctx, Handler: handler}
+}
+
+/*
+	ImportsCancel swagger:route DELETE /import/{backend}/{id} imports importsCancel
+
+# Cancel an import
+
+Cancels a running import. It can be resumed by starting it again.
+*/
+type ImportsCancel struct {
+	Context *middleware.Context
+	Handler ImportsCancelHandler
+}
+
+func (o *ImportsCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewImportsCancelParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCancelNoContentCode is the HTTP code returned for type ImportsCancelNoContent
+const ImportsCancelNoContentCode int = 204
+
+/*
+ImportsCancelNoContent Successfully canceled.
+
+swagger:response importsCancelNoContent
+*/
+type ImportsCancelNoContent struct {
+}
+
+// NewImportsCancelNoContent creates ImportsCancelNoContent with default headers values
+func NewImportsCancelNoContent() *ImportsCancelNoContent {
+
+	return &ImportsCancelNoContent{}
+}
+
+// WriteResponse to the client
+func (o *ImportsCancelNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// ImportsCancelUnauthorizedCode is the HTTP code returned for type ImportsCancelUnauthorized
+const ImportsCancelUnauthorizedCode int = 401
+
+/*
+ImportsCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response importsCancelUnauthorized
+*/
+type ImportsCancelUnauthorized struct {
+}
+
+// NewImportsCancelUnauthorized creates ImportsCancelUnauthorized with default headers values
+func NewImportsCancelUnauthorized() *ImportsCancelUnauthorized {
+
+	return &ImportsCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ImportsCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ImportsCancelForbiddenCode is the HTTP code returned for type ImportsCancelForbidden
+const ImportsCancelForbiddenCode int = 403
+
+/*
+ImportsCancelForbidden Forbidden
+
+swagger:response importsCancelForbidden
+*/
+type ImportsCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCancelForbidden creates ImportsCancelForbidden with default headers values
+func NewImportsCancelForbidden() *ImportsCancelForbidden {
+
+	return &ImportsCancelForbidden{}
+}
+
+// WithPayload adds the payload to the imports cancel forbidden response
+func (o *ImportsCancelForbidden) WithPayload(payload *models.ErrorResponse) *ImportsCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports cancel forbidden response
+func (o *ImportsCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsCancelNotFoundCode is the HTTP code returned for type ImportsCancelNotFound
+const ImportsCancelNotFoundCode int = 404
+
+/*
+ImportsCancelNotFound Not Found - Import is not running
+
+swagger:response importsCancelNotFound
+*/
+type ImportsCancelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCancelNotFound creates ImportsCancelNotFound with default headers values
+func NewImportsCancelNotFound() *ImportsCancelNotFound {
+
+	return &ImportsCancelNotFound{}
+}
+
+// WithPayload adds the payload to the imports cancel not found response
+func (o *ImportsCancelNotFound) WithPayload(payload *models.ErrorResponse) *ImportsCancelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports cancel not found response
+func (o *ImportsCancelNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsCancelInternalServerErrorCode is the HTTP code returned for type ImportsCancelInternalServerError
+const ImportsCancelInternalServerErrorCode int = 500
+
+/*
+ImportsCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response importsCancelInternalServerError
+*/
+type ImportsCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCancelInternalServerError creates ImportsCancelInternalServerError with default headers values
+func NewImportsCancelInternalServerError() *ImportsCancelInternalServerError {
+
+	return &ImportsCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the imports cancel internal server error response
+func (o *ImportsCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *ImportsCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports cancel internal server error response
+func (o *ImportsCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
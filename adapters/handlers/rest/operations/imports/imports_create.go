@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCreateHandlerFunc turns a function with the right signature into a imports create handler
+type ImportsCreateHandlerFunc func(ImportsCreateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportsCreateHandlerFunc) Handle(params ImportsCreateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ImportsCreateHandler interface for that can handle valid imports create params
+type ImportsCreateHandler interface {
+	Handle(ImportsCreateParams, *models.Principal) middleware.Responder
+}
+
+// NewImportsCreate creates a new http.Handler for the imports create operation
+func NewImportsCreate(ctx *middleware.Context, handler ImportsCreateHandler) *ImportsCreate {
+	return &ImportsCreate{Context: ctx, Handler: handler}
+}
+
+/*
+	ImportsCreate swagger:route POST /import/{backend} imports importsCreate
+
+# Start or resume an import
+
+Import objects from JSONL or Parquet files on a backup backend, e.g. the files written by an export. The import runs in the background and ingests the objects in batches. Rows which cannot be imported are reported in the status of the import. Starting an import with the ID of a failed or canceled import resumes it after the last processed rows.
+*/
+type ImportsCreate struct {
+	Context *middleware.Context
+	Handler ImportsCreateHandler
+}
+
+func (o *ImportsCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewImportsCreateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
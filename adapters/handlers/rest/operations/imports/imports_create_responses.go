@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCreateOKCode is the HTTP code returned for type ImportsCreateOK
+const ImportsCreateOKCode int = 200
+
+/*
+ImportsCreateOK Import successfully started.
+
+swagger:response importsCreateOK
+*/
+type ImportsCreateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ImportStatusResponse `json:"body,omitempty"`
+}
+
+// NewImportsCreateOK creates ImportsCreateOK with default headers values
+func NewImportsCreateOK() *ImportsCreateOK {
+
+	return &ImportsCreateOK{}
+}
+
+// WithPayload adds the payload to the imports create o k response
+func (o *ImportsCreateOK) WithPayload(payload *models.ImportStatusResponse) *ImportsCreateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports create o k response
+func (o *ImportsCreateOK) SetPayload(payload *models.ImportStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsCreateUnauthorizedCode is the HTTP code returned for type ImportsCreateUnauthorized
+const ImportsCreateUnauthorizedCode int = 401
+
+/*
+ImportsCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response importsCreateUnauthorized
+*/
+type ImportsCreateUnauthorized struct {
+}
+
+// NewImportsCreateUnauthorized creates ImportsCreateUnauthorized with default headers values
+func NewImportsCreateUnauthorized() *ImportsCreateUnauthorized {
+
+	return &ImportsCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ImportsCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ImportsCreateForbiddenCode is the HTTP code returned for type ImportsCreateForbidden
+const ImportsCreateForbiddenCode int = 403
+
+/*
+ImportsCreateForbidden Forbidden
+
+swagger:response importsCreateForbidden
+*/
+type ImportsCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCreateForbidden creates ImportsCreateForbidden with default headers values
+func NewImportsCreateForbidden() *ImportsCreateForbidden {
+
+	return &ImportsCreateForbidden{}
+}
+
+// WithPayload adds the payload to the imports create forbidden response
+func (o *ImportsCreateForbidden) WithPayload(payload *models.ErrorResponse) *ImportsCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports create forbidden response
+func (o *ImportsCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsCreateUnprocessableEntityCode is the HTTP code returned for type ImportsCreateUnprocessableEntity
+const ImportsCreateUnprocessableEntityCode int = 422
+
+/*
+ImportsCreateUnprocessableEntity Invalid import request.
+
+swagger:response importsCreateUnprocessableEntity
+*/
+type ImportsCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCreateUnprocessableEntity creates ImportsCreateUnprocessableEntity with default headers values
+func NewImportsCreateUnprocessableEntity() *ImportsCreateUnprocessableEntity {
+
+	return &ImportsCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the imports create unprocessable entity response
+func (o *ImportsCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ImportsCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports create unprocessable entity response
+func (o *ImportsCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsCreateInternalServerErrorCode is the HTTP code returned for type ImportsCreateInternalServerError
+const ImportsCreateInternalServerErrorCode int = 500
+
+/*
+ImportsCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response importsCreateInternalServerError
+*/
+type ImportsCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsCreateInternalServerError creates ImportsCreateInternalServerError with default headers values
+func NewImportsCreateInternalServerError() *ImportsCreateInternalServerError {
+
+	return &ImportsCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the imports create internal server error response
+func (o *ImportsCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *ImportsCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports create internal server error response
+func (o *ImportsCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsStatusHandlerFunc turns a function with the right signature into a imports status handler
+type ImportsStatusHandlerFunc func(ImportsStatusParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportsStatusHandlerFunc) Handle(params ImportsStatusParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ImportsStatusHandler interface for that can handle valid imports status params
+type ImportsStatusHandler interface {
+	Handle(ImportsStatusParams, *models.Principal) middleware.Responder
+}
+
+// NewImportsStatus creates a new http.Handler for the imports status operation
+func NewImportsStatus(ctx *middleware.Context, handler ImportsStatusHandler) *ImportsStatus {
+	return &ImportsStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	ImportsStatus swagger:route GET /import/{backend}/{id} imports importsStatus
+
+# Get import status
+
+Returns the progress of an import and the errors of rows which could not be imported.
+*/
+type ImportsStatus struct {
+	Context *middleware.Context
+	Handler ImportsStatusHandler
+}
+
+func (o *ImportsStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewImportsStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsStatusOKCode is the HTTP code returned for type ImportsStatusOK
+const ImportsStatusOKCode int = 200
+
+/*
+ImportsStatusOK Import status successfully returned.
+
+swagger:response importsStatusOK
+*/
+type ImportsStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ImportStatusResponse `json:"body,omitempty"`
+}
+
+// NewImportsStatusOK creates ImportsStatusOK with default headers values
+func NewImportsStatusOK() *ImportsStatusOK {
+
+	return &ImportsStatusOK{}
+}
+
+// WithPayload adds the payload to the imports status o k response
+func (o *ImportsStatusOK) WithPayload(payload *models.ImportStatusResponse) *ImportsStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports status o k response
+func (o *ImportsStatusOK) SetPayload(payload *models.ImportStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsStatusUnauthorizedCode is the HTTP code returned for type ImportsStatusUnauthorized
+const ImportsStatusUnauthorizedCode int = 401
+
+/*
+ImportsStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response importsStatusUnauthorized
+*/
+type ImportsStatusUnauthorized struct {
+}
+
+// NewImportsStatusUnauthorized creates ImportsStatusUnauthorized with default headers values
+func NewImportsStatusUnauthorized() *ImportsStatusUnauthorized {
+
+	return &ImportsStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ImportsStatusForbiddenCode is the HTTP code returned for type ImportsStatusForbidden
+const ImportsStatusForbiddenCode int = 403
+
+/*
+ImportsStatusForbidden Forbidden
+
+swagger:response importsStatusForbidden
+*/
+type ImportsStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsStatusForbidden creates ImportsStatusForbidden with default headers values
+func NewImportsStatusForbidden() *ImportsStatusForbidden {
+
+	return &ImportsStatusForbidden{}
+}
+
+// WithPayload adds the payload to the imports status forbidden response
+func (o *ImportsStatusForbidden) WithPayload(payload *models.ErrorResponse) *ImportsStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports status forbidden response
+func (o *ImportsStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsStatusNotFoundCode is the HTTP code returned for type ImportsStatusNotFound
+const ImportsStatusNotFoundCode int = 404
+
+/*
+ImportsStatusNotFound Not Found - Import does not exist
+
+swagger:response importsStatusNotFound
+*/
+type ImportsStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsStatusNotFound creates ImportsStatusNotFound with default headers values
+func NewImportsStatusNotFound() *ImportsStatusNotFound {
+
+	return &ImportsStatusNotFound{}
+}
+
+// WithPayload adds the payload to the imports status not found response
+func (o *ImportsStatusNotFound) WithPayload(payload *models.ErrorResponse) *ImportsStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports status not found response
+func (o *ImportsStatusNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsStatusUnprocessableEntityCode is the HTTP code returned for type ImportsStatusUnprocessableEntity
+const ImportsStatusUnprocessableEntityCode int = 422
+
+/*
+ImportsStatusUnprocessableEntity Invalid import status request.
+
+swagger:response importsStatusUnprocessableEntity
+*/
+type ImportsStatusUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsStatusUnprocessableEntity creates ImportsStatusUnprocessableEntity with default headers values
+func NewImportsStatusUnprocessableEntity() *ImportsStatusUnprocessableEntity {
+
+	return &ImportsStatusUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the imports status unprocessable entity response
+func (o *ImportsStatusUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ImportsStatusUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports status unprocessable entity response
+func (o *ImportsStatusUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportsStatusInternalServerErrorCode is the HTTP code returned for type ImportsStatusInternalServerError
+const ImportsStatusInternalServerErrorCode int = 500
+
+/*
+ImportsStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response importsStatusInternalServerError
+*/
+type ImportsStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewImportsStatusInternalServerError creates ImportsStatusInternalServerError with default headers values
+func NewImportsStatusInternalServerError() *ImportsStatusInternalServerError {
+
+	return &ImportsStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the imports status internal server error response
+func (o *ImportsStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *ImportsStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the imports status internal server error response
+func (o *ImportsStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportsStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsReshardHandlerFunc turns a function with the right signature into a schema objects reshard handler
+type SchemaObjectsReshardHandlerFunc func(SchemaObjectsReshardParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsReshardHandlerFunc) Handle(params SchemaObjectsReshardParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsReshardHandler interface for that can handle valid schema objects reshard params
+type SchemaObjectsReshardHandler interface {
+	Handle(SchemaObjectsReshardParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsReshard creates a new http.Handler for the schema objects reshard operation
+func NewSchemaObjectsReshard(ctx *middleware.Context, handler SchemaObjectsReshardHandler) *SchemaObjectsReshard {
+	return &SchemaObjectsReshard{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsReshard swagger:route POST /schema/{className}/reshard schema schemaObjectsReshard
+
+# Change the number of shards of a collection
+
+Split the shards of a collection without multi-tenancy into the requested number of shards while it keeps serving reads and writes. The objects which move to the new shards are copied in the background, all changes made to them meanwhile are mirrored. Once the copy has caught up, the new sharding state is committed and the moved objects are deleted from their previous shards. The number of shards can only be increased, up to the number of virtual shards of the collection.
+*/
+type SchemaObjectsReshard struct {
+	Context *middleware.Context
+	Handler SchemaObjectsReshardHandler
+}
+
+func (o *SchemaObjectsReshard) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsReshardParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsReshardOKCode is the HTTP code returned for type SchemaObjectsReshardOK
+const SchemaObjectsReshardOKCode int = 200
+
+/*
+SchemaObjectsReshardOK The collection was resharded
+
+swagger:response schemaObjectsReshardOK
+*/
+type SchemaObjectsReshardOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReshardResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsReshardOK creates SchemaObjectsReshardOK with default headers values
+func NewSchemaObjectsReshardOK() *SchemaObjectsReshardOK {
+
+	return &SchemaObjectsReshardOK{}
+}
+
+// WithPayload adds the payload to the schema objects reshard o k response
+func (o *SchemaObjectsReshardOK) WithPayload(payload *models.ReshardResponse) *SchemaObjectsReshardOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects reshard o k response
+func (o *SchemaObjectsReshardOK) SetPayload(payload *models.ReshardResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsReshardUnauthorizedCode is the HTTP code returned for type SchemaObjectsReshardUnauthorized
+const SchemaObjectsReshardUnauthorizedCode int = 401
+
+/*
+SchemaObjectsReshardUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsReshardUnauthorized
+*/
+type SchemaObjectsReshardUnauthorized struct {
+}
+
+// NewSchemaObjectsReshardUnauthorized creates SchemaObjectsReshardUnauthorized with default headers values
+func NewSchemaObjectsReshardUnauthorized() *SchemaObjectsReshardUnauthorized {
+
+	return &SchemaObjectsReshardUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsReshardForbiddenCode is the HTTP code returned for type SchemaObjectsReshardForbidden
+const SchemaObjectsReshardForbiddenCode int = 403
+
+/*
+SchemaObjectsReshardForbidden Forbidden
+
+swagger:response schemaObjectsReshardForbidden
+*/
+type SchemaObjectsReshardForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsReshardForbidden creates SchemaObjectsReshardForbidden with default headers values
+func NewSchemaObjectsReshardForbidden() *SchemaObjectsReshardForbidden {
+
+	return &SchemaObjectsReshardForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects reshard forbidden response
+func (o *SchemaObjectsReshardForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsReshardForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects reshard forbidden response
+func (o *SchemaObjectsReshardForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsReshardNotFoundCode is the HTTP code returned for type SchemaObjectsReshardNotFound
+const SchemaObjectsReshardNotFoundCode int = 404
+
+/*
+SchemaObjectsReshardNotFound Class to be resharded does not exist
+
+swagger:response schemaObjectsReshardNotFound
+*/
+type SchemaObjectsReshardNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsReshardNotFound creates SchemaObjectsReshardNotFound with default headers values
+func NewSchemaObjectsReshardNotFound() *SchemaObjectsReshardNotFound {
+
+	return &SchemaObjectsReshardNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects reshard not found response
+func (o *SchemaObjectsReshardNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsReshardNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects reshard not found response
+func (o *SchemaObjectsReshardNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsReshardUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsReshardUnprocessableEntity
+const SchemaObjectsReshardUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsReshardUnprocessableEntity Invalid reshard attempt
+
+swagger:response schemaObjectsReshardUnprocessableEntity
+*/
+type SchemaObjectsReshardUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsReshardUnprocessableEntity creates SchemaObjectsReshardUnprocessableEntity with default headers values
+func NewSchemaObjectsReshardUnprocessableEntity() *SchemaObjectsReshardUnprocessableEntity {
+
+	return &SchemaObjectsReshardUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects reshard unprocessable entity response
+func (o *SchemaObjectsReshardUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsReshardUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects reshard unprocessable entity response
+func (o *SchemaObjectsReshardUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsReshardInternalServerErrorCode is the HTTP code returned for type SchemaObjectsReshardInternalServerError
+const SchemaObjectsReshardInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsReshardInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsReshardInternalServerError
+*/
+type SchemaObjectsReshardInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsReshardInternalServerError creates SchemaObjectsReshardInternalServerError with default headers values
+func NewSchemaObjectsReshardInternalServerError() *SchemaObjectsReshardInternalServerError {
+
+	return &SchemaObjectsReshardInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects reshard internal server error response
+func (o *SchemaObjectsReshardInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsReshardInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects reshard internal server error response
+func (o *SchemaObjectsReshardInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsReshardInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorsReindexHandlerFunc turns a function with the right signature into a schema objects vectors reindex handler
+type SchemaObjectsVectorsReindexHandlerFunc func(SchemaObjectsVectorsReindexParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorsReindexHandlerFunc) Handle(params SchemaObjectsVectorsReindexParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorsReindexHandler interface for that can handle valid schema objects vectors reindex params
+type SchemaObjectsVectorsReindexHandler interface {
+	Handle(SchemaObjectsVectorsReindexParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorsReindex creates a new http.Handler for the schema objects vectors reindex operation
+func NewSchemaObjectsVectorsReindex(ctx *middleware.Context, handler SchemaObjectsVectorsReindexHandler) *SchemaObjectsVectorsReindex {
+	return &SchemaObjectsVectorsReindex{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorsReindex swagger:route POST /schema/{className}/vectors/reindex schema schemaObjectsVectorsReindex
+
+# Rebuild the vector index of a collection with a new configuration
+
+Rebuild the vector index of a collection, or of one of its named vectors, with a new configuration, including settings that cannot be updated in place and the index type. A new index is built from the stored objects in the background of every shard while the current index keeps serving queries and writes. Once a shard has caught up, the new index atomically replaces the old one. The progress is reported by the nodes endpoint.
+*/
+type SchemaObjectsVectorsReindex struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorsReindexHandler
+}
+
+func (o *SchemaObjectsVectorsReindex) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorsReindexParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
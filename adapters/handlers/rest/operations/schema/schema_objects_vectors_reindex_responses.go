@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorsReindexOKCode is the HTTP code returned for type SchemaObjectsVectorsReindexOK
+const SchemaObjectsVectorsReindexOKCode int = 200
+
+/*
+SchemaObjectsVectorsReindexOK The rebuild was started, the class has the new vector index configuration
+
+swagger:response schemaObjectsVectorsReindexOK
+*/
+type SchemaObjectsVectorsReindexOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Class `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorsReindexOK creates SchemaObjectsVectorsReindexOK with default headers values
+func NewSchemaObjectsVectorsReindexOK() *SchemaObjectsVectorsReindexOK {
+
+	return &SchemaObjectsVectorsReindexOK{}
+}
+
+// WithPayload adds the payload to the schema objects vectors reindex o k response
+func (o *SchemaObjectsVectorsReindexOK) WithPayload(payload *models.Class) *SchemaObjectsVectorsReindexOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vectors reindex o k response
+func (o *SchemaObjectsVectorsReindexOK) SetPayload(payload *models.Class) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorsReindexUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorsReindexUnauthorized
+const SchemaObjectsVectorsReindexUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorsReindexUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorsReindexUnauthorized
+*/
+type SchemaObjectsVectorsReindexUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorsReindexUnauthorized creates SchemaObjectsVectorsReindexUnauthorized with default headers values
+func NewSchemaObjectsVectorsReindexUnauthorized() *SchemaObjectsVectorsReindexUnauthorized {
+
+	return &SchemaObjectsVectorsReindexUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorsReindexForbiddenCode is the HTTP code returned for type SchemaObjectsVectorsReindexForbidden
+const SchemaObjectsVectorsReindexForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorsReindexForbidden Forbidden
+
+swagger:response schemaObjectsVectorsReindexForbidden
+*/
+type SchemaObjectsVectorsReindexForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorsReindexForbidden creates SchemaObjectsVectorsReindexForbidden with default headers values
+func NewSchemaObjectsVectorsReindexForbidden() *SchemaObjectsVectorsReindexForbidden {
+
+	return &SchemaObjectsVectorsReindexForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vectors reindex forbidden response
+func (o *SchemaObjectsVectorsReindexForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorsReindexForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vectors reindex forbidden response
+func (o *SchemaObjectsVectorsReindexForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorsReindexNotFoundCode is the HTTP code returned for type SchemaObjectsVectorsReindexNotFound
+const SchemaObjectsVectorsReindexNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorsReindexNotFound Class to be reindexed does not exist
+
+swagger:response schemaObjectsVectorsReindexNotFound
+*/
+type SchemaObjectsVectorsReindexNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorsReindexNotFound creates SchemaObjectsVectorsReindexNotFound with default headers values
+func NewSchemaObjectsVectorsReindexNotFound() *SchemaObjectsVectorsReindexNotFound {
+
+	return &SchemaObjectsVectorsReindexNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vectors reindex not found response
+func (o *SchemaObjectsVectorsReindexNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorsReindexNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vectors reindex not found response
+func (o *SchemaObjectsVectorsReindexNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorsReindexUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorsReindexUnprocessableEntity
+const SchemaObjectsVectorsReindexUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorsReindexUnprocessableEntity Invalid reindex attempt
+
+swagger:response schemaObjectsVectorsReindexUnprocessableEntity
+*/
+type SchemaObjectsVectorsReindexUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorsReindexUnprocessableEntity creates SchemaObjectsVectorsReindexUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorsReindexUnprocessableEntity() *SchemaObjectsVectorsReindexUnprocessableEntity {
+
+	return &SchemaObjectsVectorsReindexUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vectors reindex unprocessable entity response
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorsReindexUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vectors reindex unprocessable entity response
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorsReindexInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorsReindexInternalServerError
+const SchemaObjectsVectorsReindexInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorsReindexInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorsReindexInternalServerError
+*/
+type SchemaObjectsVectorsReindexInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorsReindexInternalServerError creates SchemaObjectsVectorsReindexInternalServerError with default headers values
+func NewSchemaObjectsVectorsReindexInternalServerError() *SchemaObjectsVectorsReindexInternalServerError {
+
+	return &SchemaObjectsVectorsReindexInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vectors reindex internal server error response
+func (o *SchemaObjectsVectorsReindexInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorsReindexInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vectors reindex internal server error response
+func (o *SchemaObjectsVectorsReindexInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorsReindexInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -36,7 +36,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/exports"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/imports"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
@@ -110,15 +112,39 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterAsyncReplicationPauseHandler: cluster.ClusterAsyncReplicationPauseHandlerFunc(func(params cluster.ClusterAsyncReplicationPauseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterAsyncReplicationPause has not yet been implemented")
+		}),
+		ClusterClusterAsyncReplicationRepairHandler: cluster.ClusterAsyncReplicationRepairHandlerFunc(func(params cluster.ClusterAsyncReplicationRepairParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterAsyncReplicationRepair has not yet been implemented")
+		}),
+		ClusterClusterAsyncReplicationResumeHandler: cluster.ClusterAsyncReplicationResumeHandlerFunc(func(params cluster.ClusterAsyncReplicationResumeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterAsyncReplicationResume has not yet been implemented")
+		}),
+		ClusterClusterDrainNodeHandler: cluster.ClusterDrainNodeHandlerFunc(func(params cluster.ClusterDrainNodeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterDrainNode has not yet been implemented")
+		}),
 		ClusterClusterGetStatisticsHandler: cluster.ClusterGetStatisticsHandlerFunc(func(params cluster.ClusterGetStatisticsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterGetStatistics has not yet been implemented")
 		}),
+		ClusterClusterRebalanceHandler: cluster.ClusterRebalanceHandlerFunc(func(params cluster.ClusterRebalanceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterRebalance has not yet been implemented")
+		}),
+		ClusterClusterReplicasMoveHandler: cluster.ClusterReplicasMoveHandlerFunc(func(params cluster.ClusterReplicasMoveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterReplicasMove has not yet been implemented")
+		}),
 		AuthzCreateRoleHandler: authz.CreateRoleHandlerFunc(func(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.CreateRole has not yet been implemented")
 		}),
 		AuthzDeleteRoleHandler: authz.DeleteRoleHandlerFunc(func(params authz.DeleteRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.DeleteRole has not yet been implemented")
 		}),
+		ExportsExportsCreateHandler: exports.ExportsCreateHandlerFunc(func(params exports.ExportsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation exports.ExportsCreate has not yet been implemented")
+		}),
+		ExportsExportsStatusHandler: exports.ExportsStatusHandlerFunc(func(params exports.ExportsStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation exports.ExportsStatus has not yet been implemented")
+		}),
 		AuthzGetRoleHandler: authz.GetRoleHandlerFunc(func(params authz.GetRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.GetRole has not yet been implemented")
 		}),
@@ -137,6 +163,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		ImportsImportsCancelHandler: imports.ImportsCancelHandlerFunc(func(params imports.ImportsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation imports.ImportsCancel has not yet been implemented")
+		}),
+		ImportsImportsCreateHandler: imports.ImportsCreateHandlerFunc(func(params imports.ImportsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation imports.ImportsCreate has not yet been implemented")
+		}),
+		ImportsImportsStatusHandler: imports.ImportsStatusHandlerFunc(func(params imports.ImportsStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation imports.ImportsStatus has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
@@ -224,6 +259,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsReshardHandler: schema.SchemaObjectsReshardHandlerFunc(func(params schema.SchemaObjectsReshardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReshard has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -233,6 +271,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsVectorsReindexHandler: schema.SchemaObjectsVectorsReindexHandlerFunc(func(params schema.SchemaObjectsVectorsReindexParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsReindex has not yet been implemented")
+		}),
 		SchemaTenantExistsHandler: schema.TenantExistsHandlerFunc(func(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantExists has not yet been implemented")
 		}),
@@ -355,12 +396,28 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterAsyncReplicationPauseHandler sets the operation handler for the cluster async replication pause operation
+	ClusterClusterAsyncReplicationPauseHandler cluster.ClusterAsyncReplicationPauseHandler
+	// ClusterClusterAsyncReplicationRepairHandler sets the operation handler for the cluster async replication repair operation
+	ClusterClusterAsyncReplicationRepairHandler cluster.ClusterAsyncReplicationRepairHandler
+	// ClusterClusterAsyncReplicationResumeHandler sets the operation handler for the cluster async replication resume operation
+	ClusterClusterAsyncReplicationResumeHandler cluster.ClusterAsyncReplicationResumeHandler
+	// ClusterClusterDrainNodeHandler sets the operation handler for the cluster drain node operation
+	ClusterClusterDrainNodeHandler cluster.ClusterDrainNodeHandler
 	// ClusterClusterGetStatisticsHandler sets the operation handler for the cluster get statistics operation
 	ClusterClusterGetStatisticsHandler cluster.ClusterGetStatisticsHandler
+	// ClusterClusterRebalanceHandler sets the operation handler for the cluster rebalance operation
+	ClusterClusterRebalanceHandler cluster.ClusterRebalanceHandler
+	// ClusterClusterReplicasMoveHandler sets the operation handler for the cluster replicas move operation
+	ClusterClusterReplicasMoveHandler cluster.ClusterReplicasMoveHandler
 	// AuthzCreateRoleHandler sets the operation handler for the create role operation
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// AuthzDeleteRoleHandler sets the operation handler for the delete role operation
 	AuthzDeleteRoleHandler authz.DeleteRoleHandler
+	// ExportsExportsCreateHandler sets the operation handler for the exports create operation
+	ExportsExportsCreateHandler exports.ExportsCreateHandler
+	// ExportsExportsStatusHandler sets the operation handler for the exports status operation
+	ExportsExportsStatusHandler exports.ExportsStatusHandler
 	// AuthzGetRoleHandler sets the operation handler for the get role operation
 	AuthzGetRoleHandler authz.GetRoleHandler
 	// AuthzGetRolesHandler sets the operation handler for the get roles operation
@@ -373,6 +430,12 @@ type WeaviateAPI struct {
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// ImportsImportsCancelHandler sets the operation handler for the imports cancel operation
+	ImportsImportsCancelHandler imports.ImportsCancelHandler
+	// ImportsImportsCreateHandler sets the operation handler for the imports create operation
+	ImportsImportsCreateHandler imports.ImportsCreateHandler
+	// ImportsImportsStatusHandler sets the operation handler for the imports status operation
+	ImportsImportsStatusHandler imports.ImportsStatusHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
@@ -431,12 +494,16 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsReshardHandler sets the operation handler for the schema objects reshard operation
+	SchemaSchemaObjectsReshardHandler schema.SchemaObjectsReshardHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaObjectsVectorsReindexHandler sets the operation handler for the schema objects vectors reindex operation
+	SchemaSchemaObjectsVectorsReindexHandler schema.SchemaObjectsVectorsReindexHandler
 	// SchemaTenantExistsHandler sets the operation handler for the tenant exists operation
 	SchemaTenantExistsHandler schema.TenantExistsHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -581,15 +648,39 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterAsyncReplicationPauseHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterAsyncReplicationPauseHandler")
+	}
+	if o.ClusterClusterAsyncReplicationRepairHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterAsyncReplicationRepairHandler")
+	}
+	if o.ClusterClusterAsyncReplicationResumeHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterAsyncReplicationResumeHandler")
+	}
+	if o.ClusterClusterDrainNodeHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterDrainNodeHandler")
+	}
 	if o.ClusterClusterGetStatisticsHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterGetStatisticsHandler")
 	}
+	if o.ClusterClusterRebalanceHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterRebalanceHandler")
+	}
+	if o.ClusterClusterReplicasMoveHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterReplicasMoveHandler")
+	}
 	if o.AuthzCreateRoleHandler == nil {
 		unregistered = append(unregistered, "authz.CreateRoleHandler")
 	}
 	if o.AuthzDeleteRoleHandler == nil {
 		unregistered = append(unregistered, "authz.DeleteRoleHandler")
 	}
+	if o.ExportsExportsCreateHandler == nil {
+		unregistered = append(unregistered, "exports.ExportsCreateHandler")
+	}
+	if o.ExportsExportsStatusHandler == nil {
+		unregistered = append(unregistered, "exports.ExportsStatusHandler")
+	}
 	if o.AuthzGetRoleHandler == nil {
 		unregistered = append(unregistered, "authz.GetRoleHandler")
 	}
@@ -608,6 +699,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.ImportsImportsCancelHandler == nil {
+		unregistered = append(unregistered, "imports.ImportsCancelHandler")
+	}
+	if o.ImportsImportsCreateHandler == nil {
+		unregistered = append(unregistered, "imports.ImportsCreateHandler")
+	}
+	if o.ImportsImportsStatusHandler == nil {
+		unregistered = append(unregistered, "imports.ImportsStatusHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
@@ -695,6 +795,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsReshardHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReshardHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -704,6 +807,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsVectorsReindexHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsReindexHandler")
+	}
 	if o.SchemaTenantExistsHandler == nil {
 		unregistered = append(unregistered, "schema.TenantExistsHandler")
 	}
@@ -887,6 +993,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/classifications"] = classifications.NewClassificationsPost(o.context, o.ClassificationsClassificationsPostHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cluster/async-replication/{className}/pause"] = cluster.NewClusterAsyncReplicationPause(o.context, o.ClusterClusterAsyncReplicationPauseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/async-replication/{className}/repair"] = cluster.NewClusterAsyncReplicationRepair(o.context, o.ClusterClusterAsyncReplicationRepairHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cluster/async-replication/{className}/resume"] = cluster.NewClusterAsyncReplicationResume(o.context, o.ClusterClusterAsyncReplicationResumeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterDrainNode(o.context, o.ClusterClusterDrainNodeHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -894,11 +1016,27 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/rebalance"] = cluster.NewClusterRebalance(o.context, o.ClusterClusterRebalanceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/replicas/move"] = cluster.NewClusterReplicasMove(o.context, o.ClusterClusterReplicasMoveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/roles"] = authz.NewCreateRole(o.context, o.AuthzCreateRoleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/authz/roles/{id}"] = authz.NewDeleteRole(o.context, o.AuthzDeleteRoleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/export/{backend}"] = exports.NewExportsCreate(o.context, o.ExportsExportsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/export/{backend}/{id}"] = exports.NewExportsStatus(o.context, o.ExportsExportsStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -923,6 +1061,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/import/{backend}/{id}"] = imports.NewImportsCancel(o.context, o.ImportsImportsCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/import/{backend}"] = imports.NewImportsCreate(o.context, o.ImportsImportsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/import/{backend}/{id}"] = imports.NewImportsStatus(o.context, o.ImportsImportsStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1039,6 +1189,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/reshard"] = schema.NewSchemaObjectsReshard(o.context, o.SchemaSchemaObjectsReshardHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1051,6 +1205,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vectors/reindex"] = schema.NewSchemaObjectsVectorsReindex(o.context, o.SchemaSchemaObjectsVectorsReindexHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/export"
 	"github.com/weaviate/weaviate/usecases/locks"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
@@ -68,6 +69,7 @@ type State struct {
 	Metrics            *monitoring.PrometheusMetrics
 	ServerMetrics      *monitoring.ServerMetrics
 	BackupManager      *backup.Handler
+	Exporter           *export.Exporter
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
 	ClusterHttpClient  *http.Client
@@ -47,6 +47,7 @@ type Aggregator struct {
 	isFallbackToSearchable inverted.IsFallbackToSearchable
 	tenant                 string
 	nestedCrossRefLimit    int64
+	termExpansionScanLimit int
 	bitmapFactory          *roaringset.BitmapFactory
 	modules                *modules.Provider
 }
@@ -57,7 +58,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 	vectorIndex vectorIndex, logger logrus.FieldLogger,
 	propLenTracker *inverted.JsonShardMetaData,
 	isFallbackToSearchable inverted.IsFallbackToSearchable,
-	tenant string, nestedCrossRefLimit int64,
+	tenant string, nestedCrossRefLimit int64, termExpansionScanLimit int,
 	bitmapFactory *roaringset.BitmapFactory,
 	modules *modules.Provider,
 ) *Aggregator {
@@ -74,6 +75,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 		isFallbackToSearchable: isFallbackToSearchable,
 		tenant:                 tenant,
 		nestedCrossRefLimit:    nestedCrossRefLimit,
+		termExpansionScanLimit: termExpansionScanLimit,
 		bitmapFactory:          bitmapFactory,
 		modules:                modules,
 	}
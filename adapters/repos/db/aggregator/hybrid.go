@@ -25,8 +25,11 @@ import (
 
 func (a *Aggregator) buildHybridKeywordRanking() (*searchparams.KeywordRanking, error) {
 	kw := &searchparams.KeywordRanking{
-		Type:  "bm25",
-		Query: a.params.Hybrid.Query,
+		Type:              "bm25",
+		Query:             a.params.Hybrid.Query,
+		Fuzziness:         a.params.Hybrid.Fuzziness,
+		FuzzyPrefixLength: a.params.Hybrid.FuzzyPrefixLength,
+		Prefix:            a.params.Hybrid.Prefix,
 	}
 
 	cl := a.getSchema.ReadOnlyClass(a.params.ClassName.String())
@@ -54,7 +57,7 @@ func (a *Aggregator) bm25Objects(ctx context.Context, kw *searchparams.KeywordRa
 
 	objs, dists, err := inverted.NewBM25Searcher(cfg.BM25, a.store, a.getSchema.ReadOnlyClass,
 		propertyspecific.Indices{}, a.classSearcher,
-		a.GetPropertyLengthTracker(), a.logger, a.shardVersion,
+		a.GetPropertyLengthTracker(), a.logger, a.shardVersion, a.termExpansionScanLimit,
 	).BM25F(ctx, nil, a.params.ClassName, *a.params.ObjectLimit, *kw, additional.Properties{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("bm25 objects: %w", err)
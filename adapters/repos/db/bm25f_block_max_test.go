@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestBM25FBlockMax(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "BlockMaxDocument"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "body",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	idOf := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+
+	// a few common words and some rare ones, so that the common words have
+	// posting lists spanning many blocks
+	r := rand.New(rand.NewSource(3))
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"}
+	text := func(length int) string {
+		tokens := make([]string, length)
+		for i := range tokens {
+			// skewed distribution, the first words are the most common
+			tokens[i] = words[int(float64(len(words))*r.Float64()*r.Float64())]
+		}
+		return strings.Join(tokens, " ")
+	}
+	for i := 0; i < 1000; i++ {
+		obj := &models.Object{Class: className, ID: idOf(i), Properties: map[string]interface{}{
+			"title": text(1 + r.Intn(3)),
+			"body":  text(5 + r.Intn(40)),
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	type result struct {
+		ids    []strfmt.UUID
+		scores []float32
+	}
+	search := func(t *testing.T, properties []string, query string, limit int) result {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, scores, err := idx.objectSearch(context.TODO(), limit, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, []string{"title", "body"})
+		require.Nil(t, err)
+		out := result{ids: make([]strfmt.UUID, len(res)), scores: scores}
+		for i := range res {
+			out.ids[i] = res[i].ID()
+		}
+		return out
+	}
+
+	queries := []string{"alpha", "alpha theta", "beta gamma eta", "theta", "alpha beta gamma delta"}
+	limits := []int{1, 10, 50}
+
+	inMemory := map[string]result{}
+	for _, query := range queries {
+		for _, limit := range limits {
+			inMemory[fmt.Sprintf("%s/%d", query, limit)] = search(t, []string{"body"}, query, limit)
+		}
+	}
+
+	require.Nil(t, idx.ForEachShard(func(name string, shard ShardLike) error {
+		return shard.Store().FlushMemtables(context.Background())
+	}))
+
+	t.Run("block max index is persisted", func(t *testing.T) {
+		var found []string
+		require.Nil(t, filepath.Walk(dirName, func(path string, info os.FileInfo, err error) error {
+			if err == nil && strings.HasSuffix(path, ".blockmax") &&
+				strings.Contains(path, "property_body_searchable") {
+				found = append(found, path)
+			}
+			return err
+		}))
+		assert.NotEmpty(t, found)
+	})
+
+	t.Run("results are identical to the in-memory lists", func(t *testing.T) {
+		for _, query := range queries {
+			for _, limit := range limits {
+				key := fmt.Sprintf("%s/%d", query, limit)
+				actual := search(t, []string{"body"}, query, limit)
+				assert.Equal(t, inMemory[key].ids, actual.ids, key)
+				assert.InDeltaSlice(t, inMemory[key].scores, actual.scores, 1e-6, key)
+			}
+		}
+	})
+
+	t.Run("multiple properties", func(t *testing.T) {
+		// the document frequency across properties is estimated once lists
+		// are on disk, so only the shape of the results is compared
+		for _, query := range queries {
+			for _, limit := range limits {
+				actual := search(t, []string{"title", "body"}, query, limit)
+				assert.Len(t, actual.ids, limit, query)
+				assert.IsNonIncreasing(t, actual.scores, query)
+			}
+		}
+	})
+
+	t.Run("updates and deletes of flushed documents", func(t *testing.T) {
+		top := search(t, []string{"body"}, "theta", 5)
+		require.Len(t, top.ids, 5)
+
+		require.Nil(t, repo.DeleteObject(context.Background(), className, top.ids[0], time.Now(), nil, "", 0))
+		obj := &models.Object{Class: className, ID: top.ids[1], Properties: map[string]interface{}{
+			"title": "alpha", "body": "alpha",
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+
+		after := search(t, []string{"body"}, "theta", 5)
+		assert.NotContains(t, after.ids, top.ids[0])
+		assert.NotContains(t, after.ids, top.ids[1])
+		assert.Equal(t, top.ids[2:], after.ids[:3])
+	})
+}
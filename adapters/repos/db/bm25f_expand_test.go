@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestBM25FFuzzyAndPrefix(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "Product"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "text",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	idOf := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	texts := []string{
+		"the weather is nice today",
+		"leather jacket for sale",
+		"weatherproof boots",
+		"whether or not",
+	}
+	for i, text := range texts {
+		obj := &models.Object{Class: className, ID: idOf(i), Properties: map[string]interface{}{"text": text}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, kwr *searchparams.KeywordRanking) ([]strfmt.UUID, error) {
+		kwr.Type = "bm25"
+		kwr.Properties = []string{"text"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, kwr.Properties)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids, err
+	}
+
+	t.Run("no typo tolerance by default", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{Query: "wetaher"})
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("fuzzy", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{Query: "wetaher", Fuzziness: 1})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{idOf(0)}, ids)
+
+		ids, err = search(t, &searchparams.KeywordRanking{Query: "wether", Fuzziness: 1})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{idOf(0), idOf(3)}, ids)
+	})
+
+	t.Run("fuzzy with a typo in the first character", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{Query: "qeather", Fuzziness: 1})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{idOf(0), idOf(1)}, ids)
+	})
+
+	t.Run("fuzzy prefix length", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{
+			Query: "qeather", Fuzziness: 1, FuzzyPrefixLength: 1,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+
+		ids, err = search(t, &searchparams.KeywordRanking{
+			Query: "wetaher", Fuzziness: 1, FuzzyPrefixLength: 2,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{idOf(0)}, ids)
+	})
+
+	t.Run("truncated expansions are reported", func(t *testing.T) {
+		scanLimit := idx.Config.QueryTermExpansionScanLimit
+		idx.Config.QueryTermExpansionScanLimit = 2
+		defer func() { idx.Config.QueryTermExpansionScanLimit = scanLimit }()
+
+		kwr := &searchparams.KeywordRanking{
+			Type:                   "bm25",
+			Properties:             []string{"text"},
+			Query:                  "nice wether",
+			Fuzziness:              1,
+			AdditionalExplanations: true,
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, kwr.Properties)
+		require.Nil(t, err)
+		// the scan stops before it reaches "whether"
+		require.Len(t, res, 1)
+		assert.Equal(t, idOf(0), res[0].ID())
+		assert.Contains(t, res[0].Object.Additional["explainScore"], "BM25F_wether_expansionTruncated:true")
+	})
+
+	t.Run("short terms are matched exactly", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{Query: "ot", Fuzziness: 2})
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		ids, err := search(t, &searchparams.KeywordRanking{Query: "weather", Prefix: true})
+		require.Nil(t, err)
+		// the exact match ranks higher
+		assert.Equal(t, []strfmt.UUID{idOf(0), idOf(2)}, ids)
+
+		ids, err = search(t, &searchparams.KeywordRanking{Query: "jack", Prefix: true})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{idOf(1)}, ids)
+	})
+
+	t.Run("invalid fuzziness", func(t *testing.T) {
+		_, err := search(t, &searchparams.KeywordRanking{Query: "weather", Fuzziness: 3})
+		require.ErrorContains(t, err, "fuzziness must be between 0 and 2")
+
+		_, err = search(t, &searchparams.KeywordRanking{Query: "weather", Fuzziness: 1, FuzzyPrefixLength: -1})
+		require.ErrorContains(t, err, "fuzzy prefix length must not be negative")
+	})
+}
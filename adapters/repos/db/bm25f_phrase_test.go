@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestBM25FPhraseAndProximity(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vTrue := true
+	className := "LegalDocument"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:           "text",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "clauses",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "plain",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	idOf := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	put := func(i int, props map[string]interface{}) {
+		obj := &models.Object{Class: className, ID: idOf(i), Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	texts := []string{
+		"the defendant committed a breach of contract and owes damages",
+		"the contract was signed, no breach was found",
+		"breach of contract claims: a breach of contract requires damages",
+		"damages were awarded",
+	}
+	for i, text := range texts {
+		put(i, map[string]interface{}{"text": text, "plain": text})
+	}
+	put(4, map[string]interface{}{"clauses": []interface{}{"the breach", "of contract"}})
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, properties []string, query string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, scores, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, properties)
+		require.Nil(t, err)
+		require.Len(t, scores, len(res))
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids
+	}
+
+	t.Run("phrase", func(t *testing.T) {
+		ids := search(t, []string{"text"}, `"breach of contract"`)
+		// more occurrences of the phrase rank higher
+		assert.Equal(t, []strfmt.UUID{idOf(2), idOf(0)}, ids)
+	})
+
+	t.Run("phrase and term", func(t *testing.T) {
+		ids := search(t, []string{"text"}, `"breach of contract" damages`)
+		assert.ElementsMatch(t, []strfmt.UUID{idOf(0), idOf(2)}, ids)
+	})
+
+	t.Run("phrase does not match across array values", func(t *testing.T) {
+		assert.Empty(t, search(t, []string{"clauses"}, `"breach of contract"`))
+		assert.Equal(t, []strfmt.UUID{idOf(4)}, search(t, []string{"clauses"}, `"of contract"`))
+	})
+
+	t.Run("proximity", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{idOf(0), idOf(2)}, search(t, []string{"text"}, "contract NEAR/2 breach"))
+		assert.Equal(t, []strfmt.UUID{idOf(1)}, search(t, []string{"text"}, "breach NEAR/2 found"))
+		assert.Empty(t, search(t, []string{"text"}, "breach NEAR/1 found"))
+	})
+
+	t.Run("invalid proximity distance", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"text"}, Query: "breach NEAR/0 found"}
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, []string{"text"})
+		require.ErrorContains(t, err, "invalid proximity operator")
+	})
+
+	t.Run("quotes are ignored without positions", func(t *testing.T) {
+		ids := search(t, []string{"plain"}, `"breach of contract"`)
+		assert.ElementsMatch(t, []strfmt.UUID{idOf(0), idOf(1), idOf(2)}, ids)
+	})
+
+	t.Run("positions are updated and deleted", func(t *testing.T) {
+		put(0, map[string]interface{}{"text": "the contract was fulfilled"})
+		require.Nil(t, repo.DeleteObject(context.Background(), className, idOf(2), time.Now(), nil, "", 0))
+
+		assert.Empty(t, search(t, []string{"text"}, `"breach of contract"`))
+
+		put(1, map[string]interface{}{"text": "a breach of contract was found"})
+		assert.Equal(t, []strfmt.UUID{idOf(1)}, search(t, []string{"text"}, `"breach of contract"`))
+	})
+}
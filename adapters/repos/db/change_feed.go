@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	changeFeedBatchSize    = 100
+	changeFeedPollInterval = 250 * time.Millisecond
+)
+
+// ChangeFeed follows the change feeds of all shards of a class, or of the
+// shard of the given tenant, until the context is cancelled or fn fails.
+// Changes are read after the sequences in the given position, which is
+// advanced before fn is called for a change, so that the position passed to
+// fn always includes the change.
+//
+// Each replica of a shard assigns its own sequences, so every shard is
+// followed on a single replica, preferably the local one. The replica is
+// recorded in the position, and a position can only be resumed as long as
+// its replica still holds the shard.
+func (db *DB) ChangeFeed(ctx context.Context, class, tenant string, position entchangefeed.Position,
+	withObjects bool, fn func(shard string, change entchangefeed.Change) error,
+) error {
+	if !db.config.ChangeFeedEnabled {
+		return errChangeFeedDisabled
+	}
+
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("collection %q not found", class)
+	}
+	if err := index.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(changeFeedPollInterval)
+	defer ticker.Stop()
+
+	for {
+		shards := []string{tenant}
+		if tenant == "" {
+			shards = index.shardState().AllPhysicalShards()
+			sort.Strings(shards)
+		}
+
+		for _, name := range shards {
+			pos, err := index.changeFeedReplica(name, position[name])
+			if err != nil {
+				return err
+			}
+
+			for {
+				changes, err := index.readShardChanges(ctx, name, pos.Node, pos.Sequence, withObjects)
+				if err != nil {
+					return err
+				}
+
+				for _, change := range changes {
+					pos.Sequence = change.Sequence
+					position[name] = pos
+					if err := fn(name, change); err != nil {
+						return err
+					}
+				}
+
+				if len(changes) < changeFeedBatchSize {
+					break
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// changeFeedReplica returns the position to follow the feed of the shard
+// from. A shard that was not followed yet is followed on the local replica
+// if there is one, otherwise on the first replica.
+func (i *Index) changeFeedReplica(shard string, pos entchangefeed.ShardPosition) (entchangefeed.ShardPosition, error) {
+	replicas, err := i.getSchema.ShardReplicas(i.Config.ClassName.String(), shard)
+	if err != nil {
+		return pos, fmt.Errorf("replicas of shard %q: %w", shard, err)
+	}
+
+	if pos.Node != "" {
+		if !slices.Contains(replicas, pos.Node) {
+			return pos, fmt.Errorf("shard %q was moved off node %q: %w",
+				shard, pos.Node, entchangefeed.ErrTruncated)
+		}
+		return pos, nil
+	}
+
+	if len(replicas) == 0 {
+		return pos, fmt.Errorf("shard %q has no replicas", shard)
+	}
+	pos.Node = replicas[0]
+	if slices.Contains(replicas, i.getSchema.NodeName()) {
+		pos.Node = i.getSchema.NodeName()
+	}
+	pos.Sequence = 0
+	return pos, nil
+}
+
+// readShardChanges reads the next batch of changes of the shard replica on
+// the given node. Local changes are buffered so that the shard is not kept
+// from shutting down while they are consumed.
+func (i *Index) readShardChanges(ctx context.Context, name, node string, after uint64,
+	withObjects bool,
+) ([]entchangefeed.Change, error) {
+	if node != i.getSchema.NodeName() {
+		changes, err := i.remote.ReadChangesOnNode(ctx, node, name, after, changeFeedBatchSize, withObjects)
+		if err != nil {
+			return nil, fmt.Errorf("read changes of shard %q on node %q: %w", name, node, err)
+		}
+		return changes, nil
+	}
+
+	return i.IncomingReadChanges(ctx, name, after, changeFeedBatchSize, withObjects)
+}
+
+// IncomingReadChanges reads up to limit changes of a local shard after the
+// given sequence
+func (i *Index) IncomingReadChanges(ctx context.Context, name string, after uint64, limit int,
+	withObjects bool,
+) ([]entchangefeed.Change, error) {
+	shard, release, err := i.GetShard(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if shard == nil {
+		// the shard has not received any writes yet
+		return nil, nil
+	}
+	defer release()
+
+	changes := make([]entchangefeed.Change, 0, limit)
+	err = shard.ReadChanges(ctx, after, limit, withObjects, func(change entchangefeed.Change) error {
+		changes = append(changes, change)
+		return nil
+	})
+	return changes, err
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestDBChangeFeedAcrossNodes(t *testing.T) {
+	ctx := context.Background()
+	className := "Article"
+
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{
+		"desiredCount": json.Number("2"),
+	}, 2)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState("change-feed-test-index", cfg, "node1", []string{"node1", "node2"}, 1, false)
+	require.Nil(t, err)
+
+	var localShard, remoteShard string
+	for name, shard := range shardState.Physical {
+		if shard.BelongsToNodes[0] == "node1" {
+			localShard = name
+		} else {
+			remoteShard = name
+		}
+	}
+	require.NotEmpty(t, localShard)
+	require.NotEmpty(t, remoteShard)
+
+	remoteID := strfmt.UUID(uuid.NewString())
+	remoteClient := &fakeRemoteClient{changes: map[string][]entchangefeed.Change{
+		remoteShard: {
+			{Sequence: 1, Operation: entchangefeed.OperationInsert, UUID: remoteID, Timestamp: 1},
+			{Sequence: 2, Operation: entchangefeed.OperationDelete, UUID: remoteID, Timestamp: 2},
+		},
+	}}
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		ChangeFeedEnabled:         true,
+	}, remoteClient, &fakeNodeResolver{hosts: map[string]string{"node2": "node2:8300"}},
+		&fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		ShardingConfig:      cfg,
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, shardState))
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	localID := strfmt.UUID(uuid.NewString())
+	shard, release, err := idx.getOrInitShard(ctx, localShard)
+	require.Nil(t, err)
+	require.Nil(t, shard.PutObject(ctx, storobj.FromObject(&models.Object{
+		Class: className,
+		ID:    localID,
+	}, []float32{1, 2, 3}, nil)))
+	release()
+
+	errDone := errors.New("done")
+	follow := func(position entchangefeed.Position, n int) ([]strfmt.UUID, error) {
+		var ids []strfmt.UUID
+		err := repo.ChangeFeed(ctx, className, "", position, false,
+			func(shard string, change entchangefeed.Change) error {
+				ids = append(ids, change.UUID)
+				if len(ids) == n {
+					return errDone
+				}
+				return nil
+			})
+		return ids, err
+	}
+
+	t.Run("all shards are followed on their replica", func(t *testing.T) {
+		position := entchangefeed.Position{}
+		ids, err := follow(position, 3)
+		require.ErrorIs(t, err, errDone)
+		assert.ElementsMatch(t, []strfmt.UUID{localID, remoteID, remoteID}, ids)
+		assert.Equal(t, entchangefeed.Position{
+			localShard:  {Node: "node1", Sequence: 1},
+			remoteShard: {Node: "node2", Sequence: 2},
+		}, position)
+	})
+
+	t.Run("positions of replicas that no longer hold the shard can't be resumed", func(t *testing.T) {
+		_, err := follow(entchangefeed.Position{
+			remoteShard: {Node: "node3", Sequence: 2},
+		}, 10)
+		assert.ErrorIs(t, err, entchangefeed.ErrTruncated)
+	})
+}
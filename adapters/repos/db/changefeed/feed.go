@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changefeed
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// The feed of a shard is stored in its own replace bucket. Changes are keyed
+// by their big endian sequence so that a cursor returns them in order. The
+// next sequence is persisted in a separate meta key, as the cursor can not
+// seek to the last key of a bucket.
+//
+// An entry is encoded as
+//
+//	operation (1) | appended at (8) | timestamp (8) | uuid (16) | object (n)
+//
+// where the appended at time is only used to enforce the retention and the
+// object is omitted for deletes.
+
+const (
+	entryKeyPrefix  = byte(0x01)
+	entryHeaderSize = 1 + 8 + 8 + 16
+
+	// trimInterval is the minimum time between two retention runs
+	trimInterval = time.Minute
+	// trimBatchSize limits the number of entries removed per retention run to
+	// keep the latency of the triggering write low
+	trimBatchSize = 10_000
+)
+
+var metaKey = []byte{0x00}
+
+type Feed struct {
+	bucket    *lsmkv.Bucket
+	retention time.Duration
+
+	mu       sync.Mutex
+	next     uint64
+	lastTrim time.Time
+	now      func() time.Time
+}
+
+// New creates or loads the change feed bucket in the given store. Changes
+// older than the retention are removed as new changes are appended, a zero
+// retention keeps all changes.
+func New(ctx context.Context, store *lsmkv.Store, retention time.Duration,
+	opts ...lsmkv.BucketOption,
+) (*Feed, error) {
+	opts = append(opts, lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	if err := store.CreateOrLoadBucket(ctx, helpers.ChangeFeedBucketLSM, opts...); err != nil {
+		return nil, errors.Wrap(err, "create change feed bucket")
+	}
+
+	f := &Feed{
+		bucket:    store.Bucket(helpers.ChangeFeedBucketLSM),
+		retention: retention,
+		next:      1,
+		now:       time.Now,
+	}
+
+	meta, err := f.bucket.Get(metaKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "read change feed meta")
+	}
+	if len(meta) == 8 {
+		f.next = binary.BigEndian.Uint64(meta)
+	}
+	f.lastTrim = f.now()
+
+	return f, nil
+}
+
+// Record applies a change with write and records it. The object is the
+// binary encoded object as written, it is ignored for deletes.
+//
+// The change is persisted before write is called, but only becomes visible
+// to readers once write succeeded. If write fails, the change is removed
+// again, so that the feed only ever contains applied changes. As the feed is
+// locked while write runs, changes of an object are recorded in the order
+// they were applied.
+func (f *Feed) Record(op changefeed.Operation, id []byte, timestamp int64,
+	object []byte, write func() error,
+) (uint64, error) {
+	if len(id) != 16 {
+		return 0, fmt.Errorf("invalid object uuid")
+	}
+	if op == changefeed.OperationDelete {
+		object = nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+
+	// trim before anything is written, so that a failure doesn't leave the
+	// change applied but unrecorded
+	if f.retention > 0 && now.Sub(f.lastTrim) >= trimInterval {
+		f.lastTrim = now
+		if err := f.trim(now.Add(-f.retention)); err != nil {
+			return 0, errors.Wrap(err, "trim change feed")
+		}
+	}
+
+	value := make([]byte, entryHeaderSize+len(object))
+	value[0] = byte(op)
+	binary.BigEndian.PutUint64(value[1:9], uint64(now.UnixMilli()))
+	binary.BigEndian.PutUint64(value[9:17], uint64(timestamp))
+	copy(value[17:33], id)
+	copy(value[33:], object)
+
+	seq := f.next
+	if err := f.bucket.Put(entryKey(seq), value); err != nil {
+		return 0, errors.Wrap(err, "put change")
+	}
+	if err := f.putNext(seq + 1); err != nil {
+		f.bucket.Delete(entryKey(seq))
+		return 0, err
+	}
+
+	if err := write(); err != nil {
+		// the sequence is reused by the next change, the rollback only makes
+		// sure that the change doesn't resurface after a restart
+		f.bucket.Delete(entryKey(seq))
+		f.putNext(seq)
+		return 0, err
+	}
+	f.next = seq + 1
+
+	return seq, nil
+}
+
+func (f *Feed) putNext(next uint64) error {
+	var meta [8]byte
+	binary.BigEndian.PutUint64(meta[:], next)
+	if err := f.bucket.Put(metaKey, meta[:]); err != nil {
+		return errors.Wrap(err, "put change feed meta")
+	}
+	return nil
+}
+
+// trim removes the oldest changes appended before the given time, it must be
+// called with the lock held
+func (f *Feed) trim(before time.Time) error {
+	var expired [][]byte
+
+	c := f.bucket.Cursor()
+	for k, v := c.Seek([]byte{entryKeyPrefix}); k != nil && len(expired) < trimBatchSize; k, v = c.Next() {
+		if len(v) < entryHeaderSize {
+			continue
+		}
+		if int64(binary.BigEndian.Uint64(v[1:9])) >= before.UnixMilli() {
+			break
+		}
+		// the cursor reuses its buffers
+		expired = append(expired, append([]byte{}, k...))
+	}
+	c.Close()
+
+	for _, k := range expired {
+		if err := f.bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Last returns the sequence of the most recent change, or 0 if no change was
+// ever appended
+func (f *Feed) Last() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.next - 1
+}
+
+// Read calls fn for up to limit changes with a sequence greater than after,
+// in order. Objects are only decoded if withObjects is set. A non-zero after
+// that is no longer followed by a retained change results in changefeed.ErrTruncated,
+// while after being 0 starts at the oldest retained change.
+func (f *Feed) Read(after uint64, limit int, withObjects bool,
+	fn func(changefeed.Change) error,
+) error {
+	last := f.Last()
+	if limit <= 0 {
+		return nil
+	}
+	if after > last {
+		return fmt.Errorf("change feed position %d is ahead of the feed", after)
+	}
+	if after == last {
+		return nil
+	}
+
+	c := f.bucket.Cursor()
+	defer c.Close()
+
+	read := 0
+	for k, v := c.Seek(entryKey(after + 1)); k != nil && read < limit; k, v = c.Next() {
+		if len(k) != 9 || k[0] != entryKeyPrefix {
+			break
+		}
+
+		seq := binary.BigEndian.Uint64(k[1:])
+		if seq > last {
+			break
+		}
+		if read == 0 && after > 0 && seq != after+1 {
+			return changefeed.ErrTruncated
+		}
+
+		change, err := decodeEntry(seq, v, withObjects)
+		if err != nil {
+			return errors.Wrapf(err, "decode change %d", seq)
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+		read++
+	}
+
+	if read == 0 && after > 0 {
+		// all changes up to last were removed
+		return changefeed.ErrTruncated
+	}
+
+	return nil
+}
+
+func decodeEntry(seq uint64, v []byte, withObject bool) (changefeed.Change, error) {
+	if len(v) < entryHeaderSize {
+		return changefeed.Change{}, fmt.Errorf("invalid entry length %d", len(v))
+	}
+
+	id, err := uuid.FromBytes(v[17:33])
+	if err != nil {
+		return changefeed.Change{}, err
+	}
+
+	change := changefeed.Change{
+		Sequence:  seq,
+		Operation: changefeed.Operation(v[0]),
+		Timestamp: int64(binary.BigEndian.Uint64(v[9:17])),
+	}
+	change.UUID = strfmt.UUID(id.String())
+
+	if withObject && len(v) > entryHeaderSize {
+		// the cursor reuses its buffers, so the object must not reference them
+		obj, err := storobj.FromBinary(append([]byte{}, v[entryHeaderSize:]...))
+		if err != nil {
+			return changefeed.Change{}, errors.Wrap(err, "unmarshal object")
+		}
+		change.Object = obj
+	}
+
+	return change, nil
+}
+
+func entryKey(seq uint64) []byte {
+	k := make([]byte, 9)
+	k[0] = entryKeyPrefix
+	binary.BigEndian.PutUint64(k[1:], seq)
+	return k
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changefeed"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func newStore(t *testing.T, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func readAll(t *testing.T, f *Feed, after uint64, withObjects bool) []changefeed.Change {
+	var changes []changefeed.Change
+	err := f.Read(after, 100, withObjects, func(c changefeed.Change) error {
+		changes = append(changes, c)
+		return nil
+	})
+	require.Nil(t, err)
+	return changes
+}
+
+func noop() error { return nil }
+
+func TestFeed(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := newStore(t, dir)
+
+	f, err := New(ctx, store, 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), f.Last())
+
+	id := uuid.New()
+	idBytes, _ := id.MarshalBinary()
+	obj := storobj.FromObject(&models.Object{
+		Class:              "Test",
+		ID:                 strfmt.UUID(id.String()),
+		LastUpdateTimeUnix: 10,
+		Properties:         map[string]interface{}{"name": "first"},
+	}, nil, nil)
+	objBytes, err := obj.MarshalBinary()
+	require.Nil(t, err)
+
+	for _, op := range []changefeed.Operation{
+		changefeed.OperationInsert, changefeed.OperationUpdate, changefeed.OperationDelete,
+	} {
+		_, err := f.Record(op, idBytes, 10, objBytes, noop)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, uint64(3), f.Last())
+
+	t.Run("read from the start", func(t *testing.T) {
+		changes := readAll(t, f, 0, false)
+		require.Len(t, changes, 3)
+		for i, c := range changes {
+			assert.Equal(t, uint64(i+1), c.Sequence)
+			assert.Equal(t, strfmt.UUID(id.String()), c.UUID)
+			assert.Equal(t, int64(10), c.Timestamp)
+			assert.Nil(t, c.Object)
+		}
+		assert.Equal(t, changefeed.OperationInsert, changes[0].Operation)
+		assert.Equal(t, changefeed.OperationUpdate, changes[1].Operation)
+		assert.Equal(t, changefeed.OperationDelete, changes[2].Operation)
+	})
+
+	t.Run("read with objects", func(t *testing.T) {
+		changes := readAll(t, f, 1, true)
+		require.Len(t, changes, 2)
+		require.NotNil(t, changes[0].Object)
+		assert.Equal(t, "first", changes[0].Object.Properties().(map[string]interface{})["name"])
+		assert.Nil(t, changes[1].Object, "deletes carry no object")
+	})
+
+	t.Run("read with limit", func(t *testing.T) {
+		var seqs []uint64
+		err := f.Read(0, 2, false, func(c changefeed.Change) error {
+			seqs = append(seqs, c.Sequence)
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2}, seqs)
+	})
+
+	t.Run("read at the end", func(t *testing.T) {
+		assert.Empty(t, readAll(t, f, 3, false))
+	})
+
+	t.Run("read ahead of the feed", func(t *testing.T) {
+		err := f.Read(4, 10, false, func(changefeed.Change) error { return nil })
+		require.NotNil(t, err)
+	})
+
+	t.Run("failed writes are not recorded", func(t *testing.T) {
+		_, err := f.Record(changefeed.OperationUpdate, idBytes, 12, objBytes, func() error {
+			return errors.New("write failed")
+		})
+		assert.ErrorContains(t, err, "write failed")
+		assert.Equal(t, uint64(3), f.Last())
+		assert.Len(t, readAll(t, f, 0, false), 3)
+	})
+
+	t.Run("sequence survives restarts", func(t *testing.T) {
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newStore(t, dir)
+		f, err = New(ctx, store, 0)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), f.Last())
+
+		seq, err := f.Record(changefeed.OperationInsert, idBytes, 11, objBytes, noop)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(4), seq)
+		assert.Len(t, readAll(t, f, 0, false), 4)
+	})
+
+	require.Nil(t, store.Shutdown(ctx))
+}
+
+func TestFeedRetention(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t, t.TempDir())
+	defer store.Shutdown(ctx)
+
+	f, err := New(ctx, store, time.Hour)
+	require.Nil(t, err)
+
+	now := time.Now()
+	f.now = func() time.Time { return now }
+	f.lastTrim = now
+
+	idBytes, _ := uuid.New().MarshalBinary()
+	for i := 0; i < 3; i++ {
+		_, err := f.Record(changefeed.OperationDelete, idBytes, 1, nil, noop)
+		require.Nil(t, err)
+	}
+
+	// the retention run is triggered by the first append after the interval
+	now = now.Add(2 * time.Hour)
+	_, err = f.Record(changefeed.OperationDelete, idBytes, 1, nil, noop)
+	require.Nil(t, err)
+
+	changes := readAll(t, f, 0, false)
+	require.Len(t, changes, 1)
+	assert.Equal(t, uint64(4), changes[0].Sequence)
+
+	t.Run("resuming from a removed position", func(t *testing.T) {
+		err := f.Read(1, 10, false, func(changefeed.Change) error { return nil })
+		assert.ErrorIs(t, err, changefeed.ErrTruncated)
+	})
+
+	t.Run("resuming right before the oldest change", func(t *testing.T) {
+		assert.Len(t, readAll(t, f, 3, false), 1)
+	})
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestDiskANNVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+
+	vectorIndexConfig := diskannent.NewDefaultUserConfig()
+	vectorIndexConfig.MaxDegree = 16
+	vectorIndexConfig.BuildSearchListSize = 32
+	vectorIndexConfig.FlatSearchCutoff = 0
+	class := &models.Class{
+		Class:               "DiskANNClass",
+		InvertedIndexConfig: invertedConfig(),
+		VectorIndexType:     "diskann",
+		VectorIndexConfig:   vectorIndexConfig,
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: schema.DataTypeText.PropString(),
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: singleShardState(),
+	}
+	repo.SetSchemaGetter(schemaGetter)
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+
+	r := rand.New(rand.NewSource(7))
+	ids := make([]strfmt.UUID, 200)
+	vectors := make([][]float32, len(ids))
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+		vectors[i] = make([]float32, 8)
+		for j := range vectors[i] {
+			vectors[i][j] = r.Float32()
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: ids[i], Class: class.Class},
+			vectors[i], nil, nil, 0))
+	}
+
+	searchFirst := func(t *testing.T, vector []float32) strfmt.UUID {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 1},
+		}, []string{""}, [][]float32{vector})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		return res[0].ID
+	}
+
+	t.Run("nearest neighbor of an indexed vector is the object itself", func(t *testing.T) {
+		for _, i := range []int{0, 17, 123, 199} {
+			assert.Equal(t, ids[i], searchFirst(t, vectors[i]))
+		}
+	})
+
+	t.Run("deleted objects are not returned", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[17], time.Now(), nil, "", 0))
+		assert.NotEqual(t, ids[17], searchFirst(t, vectors[17]))
+	})
+
+	t.Run("index files are part of the backup", func(t *testing.T) {
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		var files []string
+		index.ForEachShard(func(_ string, shard ShardLike) error {
+			if err = shard.HaltForTransfer(ctx); err != nil {
+				return err
+			}
+			defer shard.resumeMaintenanceCycles(ctx)
+
+			desc := &backup.ShardDescriptor{}
+			err = shard.ListBackupFiles(ctx, desc)
+			files = desc.Files
+			return err
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, files)
+
+		names := make([]string, len(files))
+		for i, file := range files {
+			names[i] = filepath.Base(file)
+		}
+		assert.Contains(t, names, "graph")
+		assert.Contains(t, names, "meta.db")
+	})
+}
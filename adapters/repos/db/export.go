@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ExportObjects passes the objects of a class to fn, shard by shard. If
+// tenant is set only the objects of that tenant are visited, otherwise all
+// shards, respectively all active tenants, of the class. Every shard is
+// exported from a single replica, this node if it holds one.
+//
+// The snapshots of all shards are taken before the first object is passed to
+// fn: compactions of every shard are paused and a snapshot cursor is opened
+// on its objects bucket, so that writes made after the export started are
+// not visible in any of them. The snapshots are released once the export is
+// finished.
+//
+// An export excludes backups of the class while it is running, exportID is
+// used to report it to a conflicting backup.
+func (db *DB) ExportObjects(ctx context.Context, exportID, class, tenant string,
+	fn func(shard string, obj *storobj.Object) error,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("collection %q not found", class)
+	}
+	return index.exportObjects(ctx, exportID, tenant, fn)
+}
+
+func (i *Index) exportObjects(ctx context.Context, exportID, tenant string,
+	fn func(shard string, obj *storobj.Object) error,
+) error {
+	if tenant != "" {
+		if err := i.validateMultiTenancy(tenant); err != nil {
+			return err
+		}
+	}
+
+	shards, err := i.exportShards(tenant)
+	if err != nil {
+		return err
+	}
+
+	if err := i.initExport(exportID); err != nil {
+		return err
+	}
+	defer i.releaseExport()
+
+	nodeName := i.getSchema.NodeName()
+	streams := make([]sharding.ObjectStream, 0, len(shards))
+	defer func() {
+		for n, stream := range streams {
+			if err := stream.Close(); err != nil {
+				i.logger.WithField("action", "export").WithField("shard", shards[n].name).Error(err)
+			}
+		}
+	}()
+	for _, s := range shards {
+		var stream sharding.ObjectStream
+		if s.node == nodeName {
+			stream, err = i.openShardSnapshot(ctx, s.name)
+		} else {
+			stream, err = i.remote.OpenExportObjectsOnNode(ctx, s.node, s.name, exportID)
+		}
+		if err != nil {
+			return fmt.Errorf("shard %q on node %q: %w", s.name, s.node, err)
+		}
+		streams = append(streams, stream)
+	}
+
+	for n, s := range shards {
+		err := streams[n].Read(func(obj *storobj.Object) error { return fn(s.name, obj) })
+		if err != nil {
+			return fmt.Errorf("shard %q on node %q: %w", s.name, s.node, err)
+		}
+	}
+
+	return nil
+}
+
+// IncomingExportObjects exports a shard of this node for an export
+// coordinated by another node. onSnapshot is called once the snapshot of the
+// shard is taken, before its objects are passed to fn. Backups of the class
+// are excluded until the shard is exported.
+func (i *Index) IncomingExportObjects(ctx context.Context, exportID, shardName string,
+	onSnapshot func() error, fn func(*storobj.Object) error,
+) error {
+	if err := i.initExport(exportID); err != nil {
+		return err
+	}
+	defer i.releaseExport()
+
+	snapshot, err := i.openShardSnapshot(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
+
+	if err := onSnapshot(); err != nil {
+		return err
+	}
+	return snapshot.Read(fn)
+}
+
+// initExport reserves the backup state of the index for exportID. Shards of
+// the index may be exported concurrently for the same export, the backup
+// state is reset when the last of them is released.
+func (i *Index) initExport(exportID string) error {
+	i.exports.Lock()
+	defer i.exports.Unlock()
+
+	if i.exports.refs > 0 && i.exports.id == exportID {
+		i.exports.refs++
+		return nil
+	}
+	if err := i.initBackup(exportID); err != nil {
+		return err
+	}
+	i.exports.id = exportID
+	i.exports.refs = 1
+	return nil
+}
+
+func (i *Index) releaseExport() {
+	i.exports.Lock()
+	defer i.exports.Unlock()
+
+	i.exports.refs--
+	if i.exports.refs == 0 {
+		i.resetBackupState()
+	}
+}
+
+// shardSnapshot is a point-in-time view of the objects of a local shard.
+// Compactions of the shard are paused until it is closed.
+type shardSnapshot struct {
+	ctx     context.Context
+	name    string
+	index   *Index
+	store   *lsmkv.Store
+	cursor  *lsmkv.CursorReplace
+	release func()
+}
+
+func (i *Index) openShardSnapshot(ctx context.Context, name string) (*shardSnapshot, error) {
+	shard, release, err := i.getOrInitShard(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	store := shard.Store()
+	if err := store.PauseCompaction(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return &shardSnapshot{
+		ctx:     ctx,
+		name:    name,
+		index:   i,
+		store:   store,
+		cursor:  store.Bucket(helpers.ObjectsBucketLSM).SnapshotCursor(),
+		release: release,
+	}, nil
+}
+
+func (s *shardSnapshot) Read(fn func(*storobj.Object) error) error {
+	for k, v := s.cursor.First(); k != nil; k, v = s.cursor.Next() {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return fmt.Errorf("unmarshal object: %w", err)
+		}
+		if s.index.partitioningEnabled {
+			// the tenant is not part of the binary object
+			obj.Object.Tenant = s.name
+		}
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *shardSnapshot) Close() error {
+	defer s.release()
+
+	s.cursor.Close()
+	return s.store.ResumeCompaction(context.Background())
+}
+
+// exportedShard is a shard to export and the node to export it from
+type exportedShard struct {
+	name string
+	node string
+}
+
+// exportShards returns the shards to export, sorted by name. A shard is
+// exported from this node if it holds a replica, otherwise from its first
+// replica. Inactive tenants are skipped unless the tenant was requested
+// explicitly.
+func (i *Index) exportShards(tenant string) ([]exportedShard, error) {
+	nodeName := i.getSchema.NodeName()
+	state := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	if state == nil {
+		return nil, fmt.Errorf("no sharding state for collection %q", i.Config.ClassName)
+	}
+
+	var shards []exportedShard
+	for name, physical := range state.Physical {
+		if tenant != "" && name != tenant {
+			continue
+		}
+		if tenant == "" && i.partitioningEnabled &&
+			physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			return nil, fmt.Errorf("tenant %q is not active", name)
+		}
+		if len(physical.BelongsToNodes) == 0 {
+			return nil, fmt.Errorf("shard %q has no replicas", name)
+		}
+		node := physical.BelongsToNodes[0]
+		if slices.Contains(physical.BelongsToNodes, nodeName) {
+			node = nodeName
+		}
+		shards = append(shards, exportedShard{name: name, node: node})
+	}
+	if tenant != "" && len(shards) == 0 {
+		return nil, fmt.Errorf("tenant %q not found", tenant)
+	}
+
+	sort.Slice(shards, func(a, b int) bool { return shards[a].name < shards[b].name })
+	return shards, nil
+}
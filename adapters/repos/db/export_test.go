@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestIndex_ExportObjects(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	defer idx.drop()
+
+	before := []*storobj.Object{testObject(className), testObject(className)}
+	for _, obj := range before {
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	var exported []*storobj.Object
+	err := idx.exportObjects(ctx, "export-1", "", func(shard string, obj *storobj.Object) error {
+		assert.Equal(t, shd.Name(), shard)
+
+		if len(exported) == 0 {
+			// writes during the export are not part of the snapshot
+			require.Nil(t, shd.PutObject(ctx, testObject(className)))
+			require.Nil(t, shd.Store().FlushMemtables(ctx))
+
+			// neither can a backup start while the export is running
+			assert.Error(t, idx.initBackup("backup-1"))
+		}
+		exported = append(exported, obj)
+		return nil
+	})
+	require.Nil(t, err)
+
+	require.Len(t, exported, len(before))
+	ids := []string{exported[0].ID().String(), exported[1].ID().String()}
+	assert.ElementsMatch(t, []string{before[0].ID().String(), before[1].ID().String()}, ids)
+	assert.Equal(t, before[0].Vector, exported[0].Vector)
+
+	t.Run("backup state is released", func(t *testing.T) {
+		require.Nil(t, idx.initBackup("backup-1"))
+		idx.resetBackupState()
+	})
+
+	t.Run("shards are exported concurrently for the same export", func(t *testing.T) {
+		n := 0
+		err := idx.IncomingExportObjects(ctx, "export-2", shd.Name(), func() error {
+			assert.Error(t, idx.IncomingExportObjects(ctx, "export-3", shd.Name(),
+				func() error { return nil }, func(*storobj.Object) error { return nil }))
+			return idx.IncomingExportObjects(ctx, "export-2", shd.Name(),
+				func() error { return nil }, func(*storobj.Object) error { n++; return nil })
+		}, func(*storobj.Object) error { n++; return nil })
+		require.Nil(t, err)
+		assert.Equal(t, 2*(len(before)+1), n)
+
+		require.Nil(t, idx.initBackup("backup-1"))
+		idx.resetBackupState()
+	})
+
+	t.Run("tenant on collection without multi-tenancy", func(t *testing.T) {
+		err := idx.exportObjects(ctx, "export-2", "tenant1", func(string, *storobj.Object) error { return nil })
+		assert.Error(t, err)
+	})
+}
+
+func TestDBExportObjectsAcrossNodes(t *testing.T) {
+	ctx := context.Background()
+	className := "Article"
+
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{
+		"desiredCount": json.Number("2"),
+	}, 2)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState("export-test-index", cfg, "node1", []string{"node1", "node2"}, 1, false)
+	require.Nil(t, err)
+
+	var localShard, remoteShard string
+	for name, shard := range shardState.Physical {
+		if shard.BelongsToNodes[0] == "node1" {
+			localShard = name
+		} else {
+			remoteShard = name
+		}
+	}
+	require.NotEmpty(t, localShard)
+	require.NotEmpty(t, remoteShard)
+
+	remoteObj := storobj.FromObject(&models.Object{
+		Class: className,
+		ID:    strfmt.UUID(uuid.NewString()),
+	}, []float32{4, 5, 6}, nil)
+	remoteClient := &fakeRemoteClient{objects: map[string][]*storobj.Object{
+		remoteShard: {remoteObj},
+	}}
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, remoteClient, &fakeNodeResolver{hosts: map[string]string{"node2": "node2:8300"}},
+		&fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		ShardingConfig:      cfg,
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, shardState))
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	localObj := storobj.FromObject(&models.Object{
+		Class: className,
+		ID:    strfmt.UUID(uuid.NewString()),
+	}, []float32{1, 2, 3}, nil)
+	shard, release, err := idx.getOrInitShard(ctx, localShard)
+	require.Nil(t, err)
+	require.Nil(t, shard.PutObject(ctx, localObj))
+	release()
+
+	exported := map[string]strfmt.UUID{}
+	err = repo.ExportObjects(ctx, "export-1", className, "", func(shard string, obj *storobj.Object) error {
+		if len(exported) == 0 {
+			// the snapshots of all shards are taken before the first object
+			// is exported, writes to the shards exported later are not visible
+			later := storobj.FromObject(&models.Object{
+				Class: className,
+				ID:    strfmt.UUID(uuid.NewString()),
+			}, []float32{7, 8, 9}, nil)
+			if shard == localShard {
+				remoteClient.objects[remoteShard] = append(remoteClient.objects[remoteShard], later)
+			} else {
+				shard, release, err := idx.getOrInitShard(ctx, localShard)
+				require.Nil(t, err)
+				require.Nil(t, shard.PutObject(ctx, later))
+				release()
+			}
+		}
+		exported[shard] = obj.ID()
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]strfmt.UUID{
+		localShard:  localObj.ID(),
+		remoteShard: remoteObj.ID(),
+	}, exported)
+}
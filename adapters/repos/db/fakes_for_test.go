@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/dto"
@@ -26,6 +27,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -165,7 +167,12 @@ func fixedMultiShardState() *sharding.State {
 	return s
 }
 
-type fakeRemoteClient struct{}
+type fakeRemoteClient struct {
+	// changes of the shards on other nodes, by shard
+	changes map[string][]changefeed.Change
+	// objects of the shards on other nodes, by shard
+	objects map[string][]*storobj.Object
+}
 
 func (f *fakeRemoteClient) BatchPutObjects(ctx context.Context, hostName, indexName, shardName string, objs []*storobj.Object, repl *additional.ReplicationProperties, schemaVersion uint64) []error {
 	return nil
@@ -259,20 +266,59 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) ReadChanges(ctx context.Context, hostName, indexName, shardName string,
+	after uint64, limit int, withObjects bool,
+) ([]changefeed.Change, error) {
+	var out []changefeed.Change
+	for _, change := range f.changes[shardName] {
+		if change.Sequence > after && len(out) < limit {
+			out = append(out, change)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRemoteClient) OpenExportObjects(ctx context.Context, hostName, indexName, shardName,
+	exportID string,
+) (sharding.ObjectStream, error) {
+	return &fakeObjectStream{objects: slices.Clone(f.objects[shardName])}, nil
+}
+
+// fakeObjectStream is the snapshot of the objects of a remote shard
+type fakeObjectStream struct {
+	objects []*storobj.Object
+}
+
+func (s *fakeObjectStream) Read(fn func(*storobj.Object) error) error {
+	for _, obj := range s.objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeObjectStream) Close() error {
+	return nil
+}
+
 func (f *fakeRemoteClient) PutFile(ctx context.Context, hostName, indexName, shardName,
 	fileName string, payload io.ReadSeekCloser,
 ) error {
 	return nil
 }
 
-type fakeNodeResolver struct{}
+type fakeNodeResolver struct {
+	hosts map[string]string
+}
 
 func (f *fakeNodeResolver) AllHostnames() []string {
 	return nil
 }
 
-func (f *fakeNodeResolver) NodeHostname(string) (string, bool) {
-	return "", false
+func (f *fakeNodeResolver) NodeHostname(name string) (string, bool) {
+	host, ok := f.hosts[name]
+	return host, ok
 }
 
 type fakeRemoteNodeClient struct{}
@@ -23,6 +23,13 @@ var (
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
+	// MultivectorMappingBucketLSM is suffixed with the id of the vector index
+	// and maps doc ids to the node ids of their vectors in multivector indexes
+	MultivectorMappingBucketLSM = "multivector_mapping"
+	ChangeFeedBucketLSM         = "change_feed"
+	// SparseVectorsBucketLSM is suffixed with the name of the target vector
+	// and holds the posting lists of sparse target vectors
+	SparseVectorsBucketLSM = "sparse_vectors"
 )
 
 const (
@@ -74,3 +81,11 @@ func BucketSearchableFromPropNameLSM(propName string) string {
 func BucketRangeableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_rangeable")
 }
+
+func BucketPositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_positions")
+}
+
+func BucketSparseVectorsLSM(targetVector string) string {
+	return fmt.Sprintf("%s_%s", SparseVectorsBucketLSM, targetVector)
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+type (
+	vectorSearchExplainKey struct{}
+	filterStrategyKey      struct{}
+)
+
+// VectorSearchExplain collects how the vector indexes of a shard executed a
+// search. All methods can be called on a nil receiver, so the indexes can
+// record unconditionally and only pay for it if an explanation was requested.
+type VectorSearchExplain struct {
+	allowListSize        atomic.Int64
+	nodesVisited         atomic.Int64
+	distanceComputations atomic.Int64
+	rescoredCandidates   atomic.Int64
+
+	sync.Mutex
+	strategies []string
+}
+
+// InitVectorSearchExplain attaches a new VectorSearchExplain to the context.
+func InitVectorSearchExplain(ctx context.Context) (context.Context, *VectorSearchExplain) {
+	e := &VectorSearchExplain{}
+	e.allowListSize.Store(-1)
+	return context.WithValue(ctx, vectorSearchExplainKey{}, e), e
+}
+
+// VectorSearchExplainFromContext returns the VectorSearchExplain of the
+// context or nil if no explanation was requested.
+func VectorSearchExplainFromContext(ctx context.Context) *VectorSearchExplain {
+	e, _ := ctx.Value(vectorSearchExplainKey{}).(*VectorSearchExplain)
+	return e
+}
+
+// WithFilterStrategy overrides the filter strategy of the vector index for
+// all searches using the returned context.
+func WithFilterStrategy(ctx context.Context, strategy string) context.Context {
+	if strategy == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, filterStrategyKey{}, strategy)
+}
+
+// FilterStrategyFromContext returns the filter strategy override or an empty
+// string if the vector index should decide.
+func FilterStrategyFromContext(ctx context.Context) string {
+	strategy, _ := ctx.Value(filterStrategyKey{}).(string)
+	return strategy
+}
+
+func (e *VectorSearchExplain) SetAllowListSize(size int) {
+	if e == nil {
+		return
+	}
+	e.allowListSize.Store(int64(size))
+}
+
+// AddFilterStrategy records the strategy used to apply the filter. Several
+// target vectors may be searched with different strategies.
+func (e *VectorSearchExplain) AddFilterStrategy(strategy string) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	if !slices.Contains(e.strategies, strategy) {
+		e.strategies = append(e.strategies, strategy)
+	}
+}
+
+func (e *VectorSearchExplain) AddNodesVisited(n int) {
+	if e == nil {
+		return
+	}
+	e.nodesVisited.Add(int64(n))
+}
+
+func (e *VectorSearchExplain) AddDistanceComputations(n int) {
+	if e == nil {
+		return
+	}
+	e.distanceComputations.Add(int64(n))
+}
+
+func (e *VectorSearchExplain) AddRescoredCandidates(n int) {
+	if e == nil {
+		return
+	}
+	e.rescoredCandidates.Add(int64(n))
+}
+
+// Result returns a snapshot of the collected values.
+func (e *VectorSearchExplain) Result(shard string) *additional.VectorSearchExplain {
+	if e == nil {
+		return nil
+	}
+	e.Lock()
+	strategies := strings.Join(e.strategies, ",")
+	e.Unlock()
+
+	return &additional.VectorSearchExplain{
+		Shard:                shard,
+		AllowListSize:        int(e.allowListSize.Load()),
+		FilterStrategy:       strategies,
+		NodesVisited:         e.nodesVisited.Load(),
+		DistanceComputations: e.distanceComputations.Load(),
+		RescoredCandidates:   e.rescoredCandidates.Load(),
+	}
+}
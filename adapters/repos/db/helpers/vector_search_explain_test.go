@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSearchExplain(t *testing.T) {
+	t.Run("nothing is recorded without an explanation in the context", func(t *testing.T) {
+		explain := VectorSearchExplainFromContext(context.Background())
+		require.Nil(t, explain)
+
+		// must not panic
+		explain.SetAllowListSize(3)
+		explain.AddFilterStrategy("acorn")
+		explain.AddNodesVisited(1)
+		explain.AddDistanceComputations(1)
+		explain.AddRescoredCandidates(1)
+		assert.Nil(t, explain.Result("shard"))
+	})
+
+	t.Run("values are accumulated", func(t *testing.T) {
+		ctx, explain := InitVectorSearchExplain(context.Background())
+		require.Same(t, explain, VectorSearchExplainFromContext(ctx))
+
+		explain.SetAllowListSize(300)
+		explain.AddFilterStrategy("acorn")
+		explain.AddFilterStrategy("flat")
+		explain.AddFilterStrategy("acorn")
+		explain.AddNodesVisited(10)
+		explain.AddNodesVisited(5)
+		explain.AddDistanceComputations(100)
+		explain.AddRescoredCandidates(20)
+
+		res := explain.Result("shard")
+		assert.Equal(t, "shard", res.Shard)
+		assert.Equal(t, 300, res.AllowListSize)
+		assert.Equal(t, "acorn,flat", res.FilterStrategy)
+		assert.Equal(t, int64(15), res.NodesVisited)
+		assert.Equal(t, int64(100), res.DistanceComputations)
+		assert.Equal(t, int64(20), res.RescoredCandidates)
+	})
+
+	t.Run("allow list size of unfiltered searches", func(t *testing.T) {
+		_, explain := InitVectorSearchExplain(context.Background())
+		assert.Equal(t, -1, explain.Result("shard").AllowListSize)
+	})
+}
+
+func TestFilterStrategyFromContext(t *testing.T) {
+	assert.Empty(t, FilterStrategyFromContext(context.Background()))
+	assert.Empty(t, FilterStrategyFromContext(WithFilterStrategy(context.Background(), "")))
+	assert.Equal(t, "acorn", FilterStrategyFromContext(WithFilterStrategy(context.Background(), "acorn")))
+}
@@ -172,6 +172,11 @@ type Index struct {
 
 	partitioningEnabled bool
 
+	// partitionKey is the property objects are sharded by, empty if they are
+	// sharded by their id. It is immutable, see validateShardingConfig.
+	partitionKey     string
+	partitionKeyType schema.DataType
+
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
@@ -197,6 +202,13 @@ type Index struct {
 
 	shardTransferMutex shardTransfer
 	lastBackup         atomic.Pointer[BackupState]
+	// exports count the shards exported for the running export, see
+	// initExport
+	exports struct {
+		sync.Mutex
+		id   string
+		refs int
+	}
 
 	// canceled when either Shutdown or Drop called
 	closingCtx    context.Context
@@ -210,6 +222,11 @@ type Index struct {
 
 	asyncReplicationLock sync.RWMutex
 
+	// forwarders of the local shards whose objects move to other shards while
+	// the class is resharded, by shard name
+	reshards     map[string]*reshardForwarder
+	reshardsLock sync.RWMutex
+
 	closeLock sync.RWMutex
 	closed    bool
 }
@@ -252,6 +269,9 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	if cfg.QueryNestedRefLimit == 0 {
 		cfg.QueryNestedRefLimit = config.DefaultQueryNestedCrossReferenceLimit
 	}
+	if cfg.QueryTermExpansionScanLimit == 0 {
+		cfg.QueryTermExpansionScanLimit = config.DefaultQueryTermExpansionScanLimit
+	}
 
 	index := &Index{
 		Config:                 cfg,
@@ -264,6 +284,8 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		stopwords:              sd,
 		replicator:             repl,
 		partitioningEnabled:    shardState.PartitioningEnabled,
+		partitionKey:           partitionKeyOf(shardState),
+		partitionKeyType:       partitionKeyTypeOf(shardState, class),
 		remote:                 sharding.NewRemoteIndex(cfg.ClassName.String(), sg, nodeResolver, remoteClient),
 		metrics:                NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
 		centralJobQueue:        jobQueueCh,
@@ -291,6 +313,8 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.compactionAuxCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
+	index.cycleCallbacks.objectTTLCycle.Start()
+	index.cycleCallbacks.vectorRecallCycle.Start()
 
 	return index, nil
 }
@@ -540,6 +564,59 @@ func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	return nil
 }
 
+// reindexVectorIndex rebuilds the vector index of the target vector in every
+// shard with the updated config, see [Shard.ReindexVectorIndex]. The config
+// the existing indexes were built with is recorded in every shard on disk
+// first, including the shards that are not loaded, so that they are rebuilt
+// once loaded.
+func (i *Index) reindexVectorIndex(ctx context.Context, targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) error {
+	current := i.vectorIndexConfig(targetVector)
+	if current == nil {
+		return fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+
+	entries, err := os.ReadDir(i.path())
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("list shards: %w", err)
+	}
+	for _, entry := range entries {
+		shardPath := path.Join(i.path(), entry.Name())
+		if _, err := os.Stat(path.Join(shardPath, "lsm")); err != nil {
+			continue
+		}
+		if err := writeReindexMarker(shardPath, targetVector, current); err != nil {
+			return fmt.Errorf("shard %q: %w", entry.Name(), err)
+		}
+	}
+
+	i.vectorIndexUserConfigLock.Lock()
+	if targetVector == "" {
+		i.vectorIndexUserConfig = updated
+	} else {
+		i.vectorIndexUserConfigs[targetVector] = updated
+	}
+	i.vectorIndexUserConfigLock.Unlock()
+
+	return i.ForEachShard(func(name string, shard ShardLike) error {
+		if err := shard.ReindexVectorIndex(ctx, targetVector); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+func (i *Index) vectorIndexConfig(targetVector string) schemaConfig.VectorIndexConfig {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	if targetVector == "" {
+		return i.vectorIndexUserConfig
+	}
+	return i.vectorIndexUserConfigs[targetVector]
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -558,14 +635,15 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	return nil
 }
 
-func (i *Index) updateAsyncReplication(ctx context.Context, enabled bool) error {
+func (i *Index) updateAsyncReplication(ctx context.Context, enabled, paused bool) error {
 	i.asyncReplicationLock.Lock()
 	defer i.asyncReplicationLock.Unlock()
 
 	i.Config.AsyncReplicationEnabled = enabled
+	i.Config.AsyncReplicationPaused = paused
 
 	err := i.ForEachLoadedShard(func(name string, shard ShardLike) error {
-		if err := shard.UpdateAsyncReplication(ctx, enabled); err != nil {
+		if err := shard.UpdateAsyncReplication(ctx, enabled, paused); err != nil {
 			return fmt.Errorf("updating async replication on shard %q: %w", name, err)
 		}
 		return nil
@@ -582,6 +660,7 @@ type IndexConfig struct {
 	ClassName                      schema.ClassName
 	QueryMaximumResults            int64
 	QueryNestedRefLimit            int64
+	QueryTermExpansionScanLimit    int
 	ResourceUsage                  config.ResourceUsage
 	MemtablesFlushDirtyAfter       int
 	MemtablesInitialSizeMB         int
@@ -592,12 +671,17 @@ type IndexConfig struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	ChangeFeedEnabled              bool
+	ChangeFeedRetention            time.Duration
+	ObjectsTTLDeleteInterval       time.Duration
+	VectorRecallMonitoring         config.VectorRecallMonitoring
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
 	ReplicationFactor              *atomic.Int64
 	DeletionStrategy               string
 	AsyncReplicationEnabled        bool
+	AsyncReplicationPaused         bool
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
@@ -661,7 +745,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 			object.Class(), i.Config.ClassName)
 	}
 
-	shardName, err := i.determineObjectShard(ctx, object.ID(), object.Object.Tenant)
+	shardName, err := i.determineShardOfObject(ctx, nil, object, nil)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
@@ -740,6 +824,15 @@ func (i *Index) asyncReplicationEnabled() bool {
 	return i.replicationEnabled() && i.Config.AsyncReplicationEnabled
 }
 
+// asyncReplicationPaused returns true if the hashtrees of the shards are kept
+// up to date, but the replicas are not compared in the background.
+func (i *Index) asyncReplicationPaused() bool {
+	i.asyncReplicationLock.RLock()
+	defer i.asyncReplicationLock.RUnlock()
+
+	return i.Config.AsyncReplicationPaused
+}
+
 // parseDateFieldsInProps checks the schema for the current class for which
 // fields are date fields, then - if they are set - parses them accordingly.
 // Works for both date and date[].
@@ -854,17 +947,25 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 		}
 	}
 
+	var state *sharding.State
+	if i.partitionKey != "" {
+		state = i.shardState()
+	}
+
+	shardNames := make([]string, len(objects))
 	for pos, obj := range objects {
 		if err := i.validateMultiTenancy(obj.Object.Tenant); err != nil {
 			out[pos] = err
 			continue
 		}
-		shardName, err := i.determineObjectShardByStatus(ctx, obj.ID(), obj.Object.Tenant, tenantsStatus)
-		if err != nil {
-			out[pos] = err
+		shardNames[pos], out[pos] = i.determineShardOfObject(ctx, state, obj, tenantsStatus)
+	}
+
+	for pos, obj := range objects {
+		if out[pos] != nil {
 			continue
 		}
-
+		shardName := shardNames[pos]
 		group := byShard[shardName]
 		group.objects = append(group.objects, obj)
 		group.pos = append(group.pos, pos)
@@ -979,11 +1080,15 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 			out[pos] = err
 			continue
 		}
-		shardName, err := i.determineObjectShard(ctx, ref.From.TargetID, ref.Tenant)
+		shardName, err := i.determineObjectShardByID(ctx, ref.From.TargetID, ref.Tenant, replProps)
 		if err != nil {
 			out[pos] = err
 			continue
 		}
+		if shardName == "" {
+			out[pos] = fmt.Errorf("source object %s not found", ref.From.TargetID)
+			continue
+		}
 
 		group := byShard[shardName]
 		group.refs = append(group.refs, ref)
@@ -1042,7 +1147,7 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, err
 	}
 
-	shardName, err := i.determineObjectShard(ctx, id, tenant)
+	shardName, err := i.determineObjectShardByID(ctx, id, tenant, replProps)
 	if err != nil {
 		switch err.(type) {
 		case objects.ErrMultiTenancy:
@@ -1051,6 +1156,9 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
 		}
 	}
+	if shardName == "" {
+		return nil, nil
+	}
 
 	var obj *storobj.Object
 
@@ -1133,10 +1241,13 @@ func (i *Index) multiObjectByID(ctx context.Context,
 
 	byShard := map[string]idsAndPos{}
 	for pos, id := range query {
-		shardName, err := i.determineObjectShard(ctx, strfmt.UUID(id.ID), tenant)
+		shardName, err := i.determineObjectShardByID(ctx, strfmt.UUID(id.ID), tenant, nil)
 		if err != nil {
 			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
 		}
+		if shardName == "" {
+			continue
+		}
 
 		group := byShard[shardName]
 		group.ids = append(group.ids, id)
@@ -1202,6 +1313,11 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		return false, err
 	}
 
+	if tenant == "" && i.partitionKey != "" {
+		shardName, err := i.locateObjectShard(ctx, id, replProps)
+		return shardName != "", err
+	}
+
 	shardName, err := i.determineObjectShard(ctx, id, tenant)
 	if err != nil {
 		switch err.(type) {
@@ -1212,6 +1328,12 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
+	return i.existsInShard(ctx, shardName, id, replProps)
+}
+
+func (i *Index) existsInShard(ctx context.Context, shardName string, id strfmt.UUID,
+	replProps *additional.ReplicationProperties,
+) (bool, error) {
 	var exists bool
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -1268,7 +1390,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return nil, nil, err
 	}
 
-	shardNames, err := i.targetShardNames(ctx, tenant)
+	shardNames, err := i.targetShardNamesForFilter(ctx, tenant, filters)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
@@ -1563,7 +1685,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
-	shardNames, err := i.targetShardNames(ctx, tenant)
+	shardNames, err := i.targetShardNamesForFilter(ctx, tenant, filters)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
@@ -1769,10 +1891,14 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		return err
 	}
 
-	shardName, err := i.determineObjectShard(ctx, id, tenant)
+	shardName, err := i.determineObjectShardByID(ctx, id, tenant, replProps)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
+	if shardName == "" {
+		// no shard holds the object, nothing to delete
+		return nil
+	}
 
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -1939,10 +2065,16 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		return err
 	}
 
-	shardName, err := i.determineObjectShard(ctx, merge.ID, tenant)
+	shardName, err := i.determineObjectShardByID(ctx, merge.ID, tenant, replProps)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
+	if shardName == "" {
+		return objects.NewErrInvalidUserInput("merge: object %s not found", merge.ID)
+	}
+	if err := i.validateMergePartitionKey(shardName, merge); err != nil {
+		return objects.NewErrInvalidUserInput("merge: %v", err)
+	}
 
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -2001,7 +2133,7 @@ func (i *Index) aggregate(ctx context.Context,
 		return nil, err
 	}
 
-	shardNames, err := i.targetShardNames(ctx, params.Tenant)
+	shardNames, err := i.targetShardNamesForFilter(ctx, params.Tenant, params.Filters)
 	if err != nil || len(shardNames) == 0 {
 		return nil, err
 	}
@@ -2233,6 +2365,12 @@ func (i *Index) stopCycleManagers(ctx context.Context, usecase string) error {
 	if err := i.cycleCallbacks.geoPropsTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return fmt.Errorf("%s: stop geo props tombstone cleanup cycle: %w", usecase, err)
 	}
+	if err := i.cycleCallbacks.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop object ttl cycle: %w", usecase, err)
+	}
+	if err := i.cycleCallbacks.vectorRecallCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop vector recall cycle: %w", usecase, err)
+	}
 	return nil
 }
 
@@ -2388,7 +2526,7 @@ func (i *Index) findUUIDs(ctx context.Context,
 
 	className := i.Config.ClassName.String()
 
-	shardNames, err := i.targetShardNames(ctx, tenant)
+	shardNames, err := i.targetShardNamesForFilter(ctx, tenant, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 type indexCycleCallbacks struct {
@@ -38,6 +39,12 @@ type indexCycleCallbacks struct {
 	geoPropsCommitLoggerCycle         cyclemanager.CycleManager
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCycle     cyclemanager.CycleManager
+
+	objectTTLCallbacks cyclemanager.CycleCallbackGroup
+	objectTTLCycle     cyclemanager.CycleManager
+
+	vectorRecallCallbacks cyclemanager.CycleCallbackGroup
+	vectorRecallCycle     cyclemanager.CycleManager
 }
 
 func (index *Index) initCycleCallbacks() {
@@ -114,6 +121,29 @@ func (index *Index) initCycleCallbacks() {
 		cyclemanager.NewFixedTicker(enthnsw.DefaultCleanupIntervalSeconds*time.Second),
 		geoPropsTombstoneCleanupCallbacks.CycleCallback, index.logger)
 
+	objectTTLDeleteInterval := index.Config.ObjectsTTLDeleteInterval
+	if objectTTLDeleteInterval <= 0 {
+		objectTTLDeleteInterval = config.DefaultObjectsTTLDeleteIntervalSeconds * time.Second
+	}
+	objectTTLCallbacks := cyclemanager.NewCallbackGroup(id("object_ttl"), index.logger, _NUMCPU)
+	objectTTLCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(objectTTLDeleteInterval),
+		objectTTLCallbacks.CycleCallback, index.logger)
+
+	// recall monitoring is opt-in, as every cycle scans all vectors of a shard
+	vectorRecallCallbacks := cyclemanager.NewCallbackGroupNoop()
+	vectorRecallCycle := cyclemanager.NewManagerNoop()
+	if recallCfg := index.Config.VectorRecallMonitoring; recallCfg.Enabled {
+		interval := time.Duration(recallCfg.IntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = config.DefaultVectorRecallMonitoringIntervalSeconds * time.Second
+		}
+		vectorRecallCallbacks = cyclemanager.NewCallbackGroup(id("vector", "recall"), index.logger, 1)
+		vectorRecallCycle = cyclemanager.NewManager(
+			cyclemanager.NewFixedTicker(interval),
+			vectorRecallCallbacks.CycleCallback, index.logger)
+	}
+
 	index.cycleCallbacks = &indexCycleCallbacks{
 		compactionCallbacks:    compactionCallbacks,
 		compactionCycle:        compactionCycle,
@@ -131,6 +161,12 @@ func (index *Index) initCycleCallbacks() {
 		geoPropsCommitLoggerCycle:         geoPropsCommitLoggerCycle,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsTombstoneCleanupCycle:     geoPropsTombstoneCleanupCycle,
+
+		objectTTLCallbacks: objectTTLCallbacks,
+		objectTTLCycle:     objectTTLCycle,
+
+		vectorRecallCallbacks: vectorRecallCallbacks,
+		vectorRecallCycle:     vectorRecallCycle,
 	}
 }
 
@@ -152,5 +188,11 @@ func (index *Index) initCycleCallbacksNoop() {
 		geoPropsCommitLoggerCycle:         cyclemanager.NewManagerNoop(),
 		geoPropsTombstoneCleanupCallbacks: cyclemanager.NewCallbackGroupNoop(),
 		geoPropsTombstoneCleanupCycle:     cyclemanager.NewManagerNoop(),
+
+		objectTTLCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		objectTTLCycle:     cyclemanager.NewManagerNoop(),
+
+		vectorRecallCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		vectorRecallCycle:     cyclemanager.NewManagerNoop(),
 	}
 }
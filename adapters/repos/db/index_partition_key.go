@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Objects of classes sharded by a property are placed in the shard their
+// partition key value hashes to. Writes carry the value and are routed
+// directly. Lookups by id don't, so they ask all shards for the object.
+// Searches whose filter pins the partition key only need to ask its shard.
+
+func partitionKeyOf(state *sharding.State) string {
+	if state == nil || !state.UsesPartitionKey() {
+		return ""
+	}
+	return state.Config.Key
+}
+
+func partitionKeyTypeOf(state *sharding.State, class *models.Class) schema.DataType {
+	key := partitionKeyOf(state)
+	if key == "" || class == nil {
+		return ""
+	}
+	for _, prop := range class.Properties {
+		if prop.Name == key && len(prop.DataType) == 1 {
+			return schema.DataType(prop.DataType[0])
+		}
+	}
+	return ""
+}
+
+// partitionKeyValue returns the canonical partition key value of the given
+// properties. ok is false if the partition key is not set.
+func (i *Index) partitionKeyValue(props interface{}) (value string, ok bool, err error) {
+	asMap, _ := props.(map[string]interface{})
+	raw, ok := asMap[i.partitionKey]
+	if !ok || raw == nil {
+		return "", false, nil
+	}
+	value, err = schema.PartitionKeyValue(i.partitionKeyType, raw)
+	if err != nil {
+		return "", false, fmt.Errorf("property %q: %w", i.partitionKey, err)
+	}
+	return value, true, nil
+}
+
+// determineShardOfObject returns the shard the object has to be written to.
+// state is only used for classes sharded by a property and is fetched if nil.
+func (i *Index) determineShardOfObject(ctx context.Context, state *sharding.State,
+	obj *storobj.Object, tenantsStatus map[string]string,
+) (string, error) {
+	if obj.Object.Tenant != "" || i.partitionKey == "" {
+		return i.determineObjectShardByStatus(ctx, obj.ID(), obj.Object.Tenant, tenantsStatus)
+	}
+
+	value, ok, err := i.partitionKeyValue(obj.Properties())
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("object %s is missing partition key property %q", obj.ID(), i.partitionKey)
+	}
+	if state == nil {
+		state = i.shardState()
+	}
+	return state.Shard(value, ""), nil
+}
+
+// determineObjectShardByID returns the shard holding the object with the
+// given id. For classes sharded by a property the object has to be found
+// first, an empty shard name means that no shard holds it.
+func (i *Index) determineObjectShardByID(ctx context.Context, id strfmt.UUID,
+	tenant string, replProps *additional.ReplicationProperties,
+) (string, error) {
+	if tenant == "" && i.partitionKey != "" {
+		return i.locateObjectShard(ctx, id, replProps)
+	}
+	return i.determineObjectShard(ctx, id, tenant)
+}
+
+// locateObjectShard asks all shards concurrently whether they hold the
+// object. It returns an empty shard name if none does.
+func (i *Index) locateObjectShard(ctx context.Context, id strfmt.UUID,
+	replProps *additional.ReplicationProperties,
+) (string, error) {
+	shardNames := i.shardState().AllPhysicalShards()
+	sort.Strings(shardNames)
+
+	var (
+		mu    sync.Mutex
+		found string
+	)
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	for _, shardName := range shardNames {
+		shardName := shardName
+		eg.Go(func() error {
+			ok, err := i.existsInShard(ctx, shardName, id, replProps)
+			if err != nil {
+				return fmt.Errorf("locate object %s: %w", id, err)
+			}
+			if ok {
+				mu.Lock()
+				found = shardName
+				mu.Unlock()
+			}
+			return nil
+		}, shardName)
+	}
+	if err := eg.Wait(); err != nil {
+		return "", err
+	}
+	return found, nil
+}
+
+// validateMergePartitionKey makes sure a merge doesn't change the partition
+// key of an object, as this would require moving it to another shard.
+func (i *Index) validateMergePartitionKey(shardName string, merge objects.MergeDocument) error {
+	if i.partitionKey == "" || merge.PrimitiveSchema == nil {
+		return nil
+	}
+	value, ok, err := i.partitionKeyValue(merge.PrimitiveSchema)
+	if err != nil || !ok {
+		return err
+	}
+	if target := i.shardState().Shard(value, ""); target != shardName {
+		return fmt.Errorf("partition key property %q of object %s can't be changed",
+			i.partitionKey, merge.ID)
+	}
+	return nil
+}
+
+// validatePartitionKeyUnchanged makes sure a write doesn't change the
+// partition key of an object stored in a shard. It is called by the shard
+// under the lock of the object id, so the stored object can't be changed
+// concurrently. A write which moves the object to another shard isn't
+// detected, as the new shard doesn't hold it.
+func (i *Index) validatePartitionKeyUnchanged(prev, next *storobj.Object) error {
+	if i.partitionKey == "" || prev == nil || next.Object.Tenant != "" {
+		return nil
+	}
+	prevValue, _, err := i.partitionKeyValue(prev.Properties())
+	if err != nil {
+		return err
+	}
+	nextValue, _, err := i.partitionKeyValue(next.Properties())
+	if err != nil {
+		return err
+	}
+	if prevValue != nextValue {
+		return fmt.Errorf("partition key property %q of object %s can't be changed",
+			i.partitionKey, next.ID())
+	}
+	return nil
+}
+
+// targetShardNamesForFilter narrows the shards to search down to a single
+// one if the filter requires the partition key to equal a value.
+//
+// to be called after validating multi-tenancy
+func (i *Index) targetShardNamesForFilter(ctx context.Context, tenant string,
+	filter *filters.LocalFilter,
+) ([]string, error) {
+	if tenant == "" && i.partitionKey != "" && filter != nil {
+		if value, ok := i.partitionKeyFromClause(filter.Root); ok {
+			return []string{i.shardState().Shard(value, "")}, nil
+		}
+	}
+	return i.targetShardNames(ctx, tenant)
+}
+
+// partitionKeyFromClause returns the partition key value the clause pins,
+// either directly or through one of the operands of a conjunction.
+func (i *Index) partitionKeyFromClause(clause *filters.Clause) (string, bool) {
+	if clause == nil {
+		return "", false
+	}
+
+	switch clause.Operator {
+	case filters.OperatorEqual:
+		if clause.On == nil || clause.On.Child != nil || clause.Value == nil ||
+			string(clause.On.Property) != i.partitionKey {
+			return "", false
+		}
+		value, err := schema.PartitionKeyValue(i.partitionKeyType, clause.Value.Value)
+		if err != nil {
+			return "", false
+		}
+		return value, true
+	case filters.OperatorAnd:
+		for j := range clause.Operands {
+			if value, ok := i.partitionKeyFromClause(&clause.Operands[j]); ok {
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,276 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestPartitionKeySharding(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{
+		"desiredCount": json.Number("4"),
+		"key":          "customerId",
+	}, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState("partition-key-test-index", cfg, "node1", []string{"node1"}, 1, false)
+	require.Nil(t, err)
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(context.Background())
+
+	className := "Order"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		ShardingConfig:      cfg,
+		Properties: []*models.Property{
+			{
+				Name:         "customerId",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+	require.Equal(t, "customerId", idx.partitionKey)
+
+	customerFilter := func(customer string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "customerId"},
+			Value:    &filters.Value{Value: customer, Type: schema.DataTypeText},
+		}}
+	}
+
+	ids := map[string][]strfmt.UUID{}
+	var batch objects.BatchObjects
+	for i := 0; i < 40; i++ {
+		customer := fmt.Sprintf("customer-%d", i%8)
+		id := strfmt.UUID(uuid.NewString())
+		ids[customer] = append(ids[customer], id)
+		batch = append(batch, objects.BatchObject{
+			OriginalIndex: i,
+			UUID:          id,
+			Object: &models.Object{
+				Class:      className,
+				ID:         id,
+				Properties: map[string]interface{}{"customerId": customer, "name": "order"},
+				Vector:     []float32{1, 2, 3},
+			},
+		})
+	}
+	res, err := repo.BatchPutObjects(ctx, batch, nil, 0)
+	require.Nil(t, err)
+	for _, r := range res {
+		require.Nil(t, r.Err)
+	}
+
+	t.Run("objects of a customer share a shard", func(t *testing.T) {
+		for customer, customerIDs := range ids {
+			shard := shardState.Shard(customer, "")
+			local := idx.shards.Load(shard)
+			require.NotNil(t, local)
+			for _, id := range customerIDs {
+				ok, err := local.Exists(ctx, id)
+				require.Nil(t, err)
+				assert.True(t, ok, "object %s of %s should be in shard %s", id, customer, shard)
+			}
+		}
+	})
+
+	t.Run("objects without partition key are rejected", func(t *testing.T) {
+		err := repo.PutObject(ctx, &models.Object{
+			Class:      className,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Properties: map[string]interface{}{"name": "order"},
+		}, []float32{1, 2, 3}, nil, nil, 0)
+		assert.ErrorContains(t, err, "missing partition key property")
+	})
+
+	t.Run("objects are found by id", func(t *testing.T) {
+		id := ids["customer-3"][0]
+		ok, err := idx.exists(ctx, id, nil, "")
+		require.Nil(t, err)
+		assert.True(t, ok)
+
+		obj, err := idx.objectByID(ctx, id, nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, id, obj.ID())
+
+		ok, err = idx.exists(ctx, strfmt.UUID(uuid.NewString()), nil, "")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("filtered searches only ask the customer's shard", func(t *testing.T) {
+		shards, err := idx.targetShardNamesForFilter(ctx, "", customerFilter("customer-5"))
+		require.Nil(t, err)
+		assert.Equal(t, []string{shardState.Shard("customer-5", "")}, shards)
+
+		and := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				*customerFilter("customer-5").Root,
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: schema.ClassName(className), Property: "name"},
+					Value:    &filters.Value{Value: "order", Type: schema.DataTypeText},
+				},
+			},
+		}}
+		shards, err = idx.targetShardNamesForFilter(ctx, "", and)
+		require.Nil(t, err)
+		assert.Len(t, shards, 1)
+
+		or := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: []filters.Clause{*customerFilter("customer-5").Root, *customerFilter("customer-6").Root},
+		}}
+		shards, err = idx.targetShardNamesForFilter(ctx, "", or)
+		require.Nil(t, err)
+		assert.Len(t, shards, 4)
+
+		found, _, err := idx.objectSearch(ctx, 100, customerFilter("customer-5"), nil, nil, nil,
+			additional.Properties{}, nil, "", 0, nil)
+		require.Nil(t, err)
+		foundIDs := make([]strfmt.UUID, len(found))
+		for i, obj := range found {
+			foundIDs[i] = obj.ID()
+		}
+		assert.ElementsMatch(t, ids["customer-5"], foundIDs)
+	})
+
+	t.Run("partition key can't be changed by a merge", func(t *testing.T) {
+		id := ids["customer-1"][0]
+		err := idx.mergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"customerId": "customer-1", "name": "updated"},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, "", 0)
+		require.Nil(t, err)
+
+		var other string
+		for customer := range ids {
+			if shardState.Shard(customer, "") != shardState.Shard("customer-1", "") {
+				other = customer
+				break
+			}
+		}
+		require.NotEmpty(t, other)
+		err = idx.mergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"customerId": other},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, "", 0)
+		assert.ErrorContains(t, err, "can't be changed")
+	})
+
+	t.Run("partition key can't be changed by a re-import", func(t *testing.T) {
+		id := ids["customer-4"][0]
+		shard := shardState.Shard("customer-4", "")
+		// a value of the same shard, the shard holding the object rejects it
+		var other string
+		for i := 0; other == ""; i++ {
+			if value := fmt.Sprintf("other-%d", i); shardState.Shard(value, "") == shard {
+				other = value
+			}
+		}
+
+		reimport := func(customer string) objects.BatchObjects {
+			return objects.BatchObjects{{
+				UUID: id,
+				Object: &models.Object{
+					Class:      className,
+					ID:         id,
+					Properties: map[string]interface{}{"customerId": customer, "name": "reimported"},
+					Vector:     []float32{1, 2, 3},
+				},
+			}}
+		}
+
+		res, err := repo.BatchPutObjects(ctx, reimport(other), nil, 0)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.ErrorContains(t, res[0].Err, "can't be changed")
+
+		err = repo.PutObject(ctx, reimport(other)[0].Object, []float32{1, 2, 3}, nil, nil, 0)
+		assert.ErrorContains(t, err, "can't be changed")
+
+		obj, err := idx.shards.Load(shard).ObjectByID(ctx, id, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, "customer-4", obj.Properties().(map[string]interface{})["customerId"])
+
+		// re-importing with the same partition key is an update
+		res, err = repo.BatchPutObjects(ctx, reimport("customer-4"), nil, 0)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		require.Nil(t, res[0].Err)
+	})
+
+	t.Run("objects are deleted by id", func(t *testing.T) {
+		id := ids["customer-2"][0]
+		require.Nil(t, idx.deleteObject(ctx, id, time.Now(), nil, "", 0))
+		ok, err := idx.exists(ctx, id, nil, "")
+		require.Nil(t, err)
+		assert.False(t, ok)
+
+		// deleting a missing object is a no-op
+		require.Nil(t, idx.deleteObject(ctx, id, time.Now(), nil, "", 0))
+	})
+}
@@ -133,6 +133,18 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+type downgradableIndexer interface {
+	ShouldDowngrade() bool
+	Downgrade(callback func()) error
+}
+
+// shadowedIndexer is implemented by indexes that are rebuilt in the
+// background and need to see the deletion of vectors that are still
+// in the queue.
+type shadowedIndexer interface {
+	DeleteFromShadow(ids ...uint64)
+}
+
 type shardStatusUpdater interface {
 	compareAndSwapStatusIndexingAndReady(old, new string) (storagestate.Status, error)
 	Name() string
@@ -276,6 +288,18 @@ func (q *IndexQueue) ResetWith(v batchIndexer) error {
 	return nil
 }
 
+// SwapIndex replaces the index the queue is feeding without touching
+// the queued vectors or the checkpoint. It waits for the jobs
+// already sent to the workers to finish, so that no vector
+// is added to the previous index after the swap.
+func (q *IndexQueue) SwapIndex(v batchIndexer) {
+	q.indexLock.Lock()
+	defer q.indexLock.Unlock()
+
+	q.processingJobs.Wait()
+	q.index = v
+}
+
 // Push adds a list of vectors to the queue.
 func (q *IndexQueue) Push(ctx context.Context, vectors ...vectorDescriptor) error {
 	if ctx.Err() != nil {
@@ -368,6 +392,9 @@ func (q *IndexQueue) Delete(ids ...uint64) error {
 			}
 		} else {
 			q.queue.Delete(ids[i])
+			if si, ok := q.index.(shadowedIndexer); ok {
+				si.DeleteFromShadow(ids[i])
+			}
 		}
 	}
 
@@ -621,6 +648,16 @@ func (q *IndexQueue) search(ctx context.Context, vector []float32, dist float32,
 }
 
 func (q *IndexQueue) checkCompressionSettings() bool {
+	if di, ok := q.index.(downgradableIndexer); ok && di.ShouldDowngrade() {
+		q.PauseIndexing()
+		err := di.Downgrade(q.ResumeIndexing)
+		if err != nil {
+			q.Logger.WithError(err).Error("failed to downgrade")
+		}
+
+		return true
+	}
+
 	ci, ok := q.index.(upgradableIndexer)
 	if !ok {
 		return false
@@ -0,0 +1,543 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	// number of objects mirrored to the target shards at once
+	reshardBatchSize = 100
+	// number of ids read from a shard at once while copying or pruning, the
+	// cursor blocks flushing of the objects bucket while open
+	reshardPageSize = 1000
+	// the copy waits for the forwarder to catch up above this queue length
+	reshardMaxQueued = 10 * reshardBatchSize
+	// pause before mirroring a batch again which failed
+	reshardRetryInterval = time.Second
+	// interval to check whether the forwarder caught up
+	reshardPollInterval = 50 * time.Millisecond
+)
+
+// reshardForwarder mirrors the objects of a local shard which move to another
+// shard while the class is resharded.
+//
+// The ids of changed objects are queued by the write paths of the shard as
+// well as by the initial copy. A single worker reads the current version of
+// each queued object from the shard and writes it to all replicas of its
+// target shard, or deletes it there if the object is gone. As the ids are
+// processed one batch after another and always read anew, the target shards
+// converge to the source no matter how writes and the copy interleave.
+type reshardForwarder struct {
+	index   *Index
+	shard   ShardLike
+	release func()
+	state   *sharding.State
+	logger  logrus.FieldLogger
+
+	sync.Mutex
+	cond      *sync.Cond
+	queue     [][]byte
+	enqueued  uint64
+	processed uint64
+	lastErr   error
+	stopped   bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newReshardForwarder(index *Index, shard ShardLike, release func(),
+	state *sharding.State,
+) *reshardForwarder {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &reshardForwarder{
+		index:   index,
+		shard:   shard,
+		release: release,
+		state:   state,
+		logger: index.logger.WithField("action", "reshard").
+			WithField("shard", shard.Name()),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	f.cond = sync.NewCond(&f.Mutex)
+	return f
+}
+
+// moves returns whether the object with the given id belongs to another
+// shard after resharding
+func (f *reshardForwarder) moves(id []byte) bool {
+	return f.state.PhysicalShard(id) != f.shard.Name()
+}
+
+// enqueue queues the ids of objects which move to another shard
+func (f *reshardForwarder) enqueue(ids ...[]byte) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.stopped {
+		return
+	}
+	for _, id := range ids {
+		if !f.moves(id) {
+			continue
+		}
+		f.queue = append(f.queue, bytes.Clone(id))
+		f.enqueued++
+	}
+	f.cond.Broadcast()
+}
+
+func (f *reshardForwarder) run() {
+	defer close(f.done)
+
+	for {
+		f.Lock()
+		for len(f.queue) == 0 && !f.stopped {
+			f.cond.Wait()
+		}
+		if len(f.queue) == 0 {
+			f.Unlock()
+			return
+		}
+		n := min(len(f.queue), reshardBatchSize)
+		batch := f.queue[:n]
+		f.queue = f.queue[n:]
+		f.Unlock()
+
+		err := f.mirror(f.ctx, batch)
+
+		f.Lock()
+		if err != nil {
+			f.lastErr = err
+			// keep the order, later writes of the same objects must win
+			f.queue = append(batch, f.queue...)
+			f.Unlock()
+			f.logger.WithError(err).Warn("mirror objects to target shards, retrying")
+			select {
+			case <-f.ctx.Done():
+				return
+			case <-time.After(reshardRetryInterval):
+			}
+			continue
+		}
+		f.lastErr = nil
+		f.processed += uint64(n)
+		f.Unlock()
+	}
+}
+
+// mirror writes the current version of the objects to the replicas of their
+// target shards
+func (f *reshardForwarder) mirror(ctx context.Context, ids [][]byte) error {
+	bucket := f.shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	puts := map[string][]*storobj.Object{}
+	deletes := map[string][]strfmt.UUID{}
+	for _, id := range ids {
+		uid, err := uuid.FromBytes(id)
+		if err != nil {
+			return err
+		}
+		target := f.state.PhysicalShard(id)
+		raw, err := bucket.Get(id)
+		if err != nil {
+			return fmt.Errorf("get object %s: %w", uid, err)
+		}
+		if raw == nil {
+			deletes[target] = append(deletes[target], strfmt.UUID(uid.String()))
+			continue
+		}
+		obj, err := storobj.FromBinary(raw)
+		if err != nil {
+			return fmt.Errorf("unmarshal object %s: %w", uid, err)
+		}
+		puts[target] = append(puts[target], obj)
+	}
+
+	for target, objs := range puts {
+		for _, node := range f.state.Physical[target].BelongsToNodes {
+			if err := f.index.reshardPutObjects(ctx, node, target, objs); err != nil {
+				return err
+			}
+		}
+	}
+	for target, uuids := range deletes {
+		for _, node := range f.state.Physical[target].BelongsToNodes {
+			if err := f.index.reshardDeleteObjects(ctx, node, target, uuids); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// copy queues all objects of the shard which move to another shard
+func (f *reshardForwarder) copy(ctx context.Context) error {
+	return forEachObjectIDPage(f.shard, func(ids [][]byte) error {
+		f.enqueue(ids...)
+		return f.waitFor(ctx, func() bool { return len(f.queue) <= reshardMaxQueued })
+	})
+}
+
+// waitCaughtUp waits until all ids queued so far are mirrored
+func (f *reshardForwarder) waitCaughtUp(ctx context.Context) error {
+	f.Lock()
+	target := f.enqueued
+	f.Unlock()
+	return f.waitFor(ctx, func() bool { return f.processed >= target })
+}
+
+// waitFor polls until cond, which is called with the lock held, is true
+func (f *reshardForwarder) waitFor(ctx context.Context, cond func() bool) error {
+	t := time.NewTicker(reshardPollInterval)
+	defer t.Stop()
+	for {
+		f.Lock()
+		ok, err := cond(), f.lastErr
+		f.Unlock()
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w: %w", ctx.Err(), err)
+			}
+			return ctx.Err()
+		case <-f.done:
+			return fmt.Errorf("forwarder of shard %q stopped", f.shard.Name())
+		case <-t.C:
+		}
+	}
+}
+
+// stop stops the forwarder. If drain is set, all queued objects are mirrored
+// first.
+func (f *reshardForwarder) stop(ctx context.Context, drain bool) error {
+	f.Lock()
+	f.stopped = true
+	if !drain {
+		f.queue = nil
+	}
+	f.cond.Broadcast()
+	f.Unlock()
+
+	defer f.release()
+	select {
+	case <-f.done:
+		return nil
+	case <-ctx.Done():
+		f.cancel()
+		<-f.done
+		return fmt.Errorf("drain forwarder of shard %q: %w", f.shard.Name(), ctx.Err())
+	}
+}
+
+// forEachObjectIDPage calls fn with the ids of all objects of the shard, page
+// by page. The cursor is not kept open while fn runs.
+func forEachObjectIDPage(shard ShardLike, fn func(ids [][]byte) error) error {
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	var last []byte
+	for {
+		ids := make([][]byte, 0, reshardPageSize)
+		cursor := bucket.Cursor()
+		var k []byte
+		if last == nil {
+			k, _ = cursor.First()
+		} else {
+			k, _ = cursor.Seek(last)
+			if bytes.Equal(k, last) {
+				k, _ = cursor.Next()
+			}
+		}
+		for ; k != nil && len(ids) < reshardPageSize; k, _ = cursor.Next() {
+			ids = append(ids, bytes.Clone(k))
+		}
+		cursor.Close()
+
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := fn(ids); err != nil {
+			return err
+		}
+		if len(ids) < reshardPageSize {
+			return nil
+		}
+		last = ids[len(ids)-1]
+	}
+}
+
+// reshardObjectChanged is called by the write paths of a shard after an
+// object was stored or deleted
+func (i *Index) reshardObjectChanged(shardName string, id []byte) {
+	i.reshardsLock.RLock()
+	f := i.reshards[shardName]
+	i.reshardsLock.RUnlock()
+
+	if f != nil {
+		f.enqueue(id)
+	}
+}
+
+func (i *Index) reshardPutObjects(ctx context.Context, node, shardName string,
+	objs []*storobj.Object,
+) error {
+	var errs []error
+	if node == i.getSchema.NodeName() {
+		errs = i.IncomingBatchPutObjects(ctx, shardName, objs, 0)
+	} else {
+		errs = i.remote.BatchPutObjectsOnNode(ctx, node, shardName, objs, 0)
+	}
+	for _, err := range errs {
+		if err != nil {
+			return fmt.Errorf("put objects to shard %q on node %q: %w", shardName, node, err)
+		}
+	}
+	return nil
+}
+
+func (i *Index) reshardDeleteObjects(ctx context.Context, node, shardName string,
+	uuids []strfmt.UUID,
+) error {
+	var res []objects.BatchSimpleObject
+	if node == i.getSchema.NodeName() {
+		res = i.IncomingDeleteObjectBatch(ctx, shardName, uuids, time.Time{}, false, 0)
+	} else {
+		res = i.remote.DeleteObjectBatchOnNode(ctx, node, shardName, uuids, time.Time{}, 0)
+	}
+	for _, r := range res {
+		if r.Err != nil {
+			return fmt.Errorf("delete objects from shard %q on node %q: %w", shardName, node, r.Err)
+		}
+	}
+	return nil
+}
+
+// startReshard starts mirroring the objects of the local shard which move
+// to another shard according to state, and copies them if copyObjects is set.
+// It returns once all objects were copied, mirroring goes on until
+// finishReshard or abortReshard.
+func (i *Index) startReshard(ctx context.Context, shardName string,
+	state *sharding.State, copyObjects bool,
+) error {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return fmt.Errorf("get shard %q: %w", shardName, err)
+	}
+
+	i.reshardsLock.Lock()
+	if i.reshards == nil {
+		i.reshards = map[string]*reshardForwarder{}
+	}
+	f, ok := i.reshards[shardName]
+	if ok {
+		// a retried request, copying again is harmless
+		release()
+	} else {
+		f = newReshardForwarder(i, shard, release, state)
+		i.reshards[shardName] = f
+		enterrors.GoWrapper(f.run, f.logger)
+	}
+	i.reshardsLock.Unlock()
+	if !copyObjects {
+		return nil
+	}
+
+	start := time.Now()
+	if err := f.copy(ctx); err != nil {
+		return fmt.Errorf("copy objects of shard %q: %w", shardName, err)
+	}
+	if err := f.waitCaughtUp(ctx); err != nil {
+		return fmt.Errorf("copy objects of shard %q: %w", shardName, err)
+	}
+	f.logger.WithField("took", time.Since(start)).Info("copied objects to new shards")
+	return nil
+}
+
+// stopReshards stops the forwarders of all local shards
+func (i *Index) stopReshards(ctx context.Context, drain bool) error {
+	i.reshardsLock.Lock()
+	forwarders := i.reshards
+	i.reshards = nil
+	i.reshardsLock.Unlock()
+
+	var firstErr error
+	for _, f := range forwarders {
+		if err := f.stop(ctx, drain); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// finishReshard is called once the resharded state was committed. It stops
+// mirroring and deletes the objects which the local shards don't own
+// anymore.
+func (i *Index) finishReshard(ctx context.Context, state *sharding.State) error {
+	if err := i.stopReshards(ctx, true); err != nil {
+		return err
+	}
+
+	for _, name := range state.AllLocalPhysicalShards() {
+		if err := i.pruneShard(ctx, name, state); err != nil {
+			return fmt.Errorf("prune shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// pruneShard deletes the objects of a local shard which belong to another
+// shard according to state
+func (i *Index) pruneShard(ctx context.Context, shardName string, state *sharding.State) error {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	deleted := 0
+	err = forEachObjectIDPage(shard, func(ids [][]byte) error {
+		var uuids []strfmt.UUID
+		for _, id := range ids {
+			uid, err := uuid.FromBytes(id)
+			if err != nil {
+				return err
+			}
+			if state.PhysicalShard(id) != shardName {
+				uuids = append(uuids, strfmt.UUID(uid.String()))
+			}
+		}
+		if len(uuids) == 0 {
+			return nil
+		}
+		for _, r := range shard.DeleteObjectBatch(ctx, uuids, time.Time{}, false) {
+			if r.Err != nil {
+				return fmt.Errorf("delete object %s: %w", r.UUID, r.Err)
+			}
+		}
+		deleted += len(uuids)
+		return ctx.Err()
+	})
+	if deleted > 0 {
+		i.logger.WithField("action", "reshard").WithField("shard", shardName).
+			WithField("deleted", deleted).Info("deleted objects moved to other shards")
+	}
+	return err
+}
+
+// abortReshard stops mirroring and drops the local shards which were created
+// for state but are not part of the committed sharding state
+func (i *Index) abortReshard(ctx context.Context, state *sharding.State) error {
+	stopErr := i.stopReshards(ctx, false)
+
+	committed := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	var toDrop []string
+	i.ForEachShard(func(name string, _ ShardLike) error {
+		if _, ok := state.Physical[name]; !ok {
+			return nil
+		}
+		if committed != nil {
+			if _, ok := committed.Physical[name]; ok {
+				return nil
+			}
+		}
+		toDrop = append(toDrop, name)
+		return nil
+	})
+	if len(toDrop) > 0 {
+		if err := i.dropShards(toDrop); err != nil {
+			return fmt.Errorf("drop shards %v: %w", toDrop, err)
+		}
+	}
+	return stopErr
+}
+
+// StartReshard mirrors the objects of the given local shards of a class
+// which move to another shard according to state. The objects of copyShards
+// are copied as well, it returns once they were copied. Mirroring goes
+// on until FinishReshard or AbortReshard.
+func (db *DB) StartReshard(ctx context.Context, className string, shards, copyShards []string,
+	state *sharding.State,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(db.logger, ctx)
+	for _, name := range shards {
+		name := name
+		eg.Go(func() error {
+			return idx.startReshard(ctx, name, state, slices.Contains(copyShards, name))
+		})
+	}
+	return eg.Wait()
+}
+
+// FinishReshard stops mirroring the objects of the local shards of a class and
+// deletes the objects which they don't own anymore according to the
+// committed state.
+func (db *DB) FinishReshard(ctx context.Context, className string, state *sharding.State) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+	state.SetLocalName(db.schemaGetter.NodeName())
+	return idx.finishReshard(ctx, state)
+}
+
+// AbortReshard stops mirroring the objects of the local shards of a class
+// and drops the local shards which were created for state.
+func (db *DB) AbortReshard(ctx context.Context, className string, state *sharding.State) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+	return idx.abortReshard(ctx, state)
+}
+
+// mayForwardReshard queues a changed object for mirroring to its new shard if
+// the shard is being resharded
+func (s *Shard) mayForwardReshard(id []byte) {
+	if s.index == nil {
+		return
+	}
+	s.index.reshardObjectChanged(s.name, id)
+}
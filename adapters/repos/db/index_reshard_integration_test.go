@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestReshard(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	logger := logrus.New()
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(ctx))
+	defer repo.Shutdown(context.Background())
+
+	className := "Reshard"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	putObject := func(id strfmt.UUID, name string) {
+		obj := &models.Object{
+			Class:      className,
+			ID:         id,
+			Properties: map[string]interface{}{"name": name},
+		}
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+	// shardObjects returns the ids of the objects stored in each local shard
+	shardObjects := func() map[string][]strfmt.UUID {
+		res := map[string][]strfmt.UUID{}
+		idx.ForEachShard(func(name string, shard ShardLike) error {
+			return forEachObjectIDPage(shard, func(ids [][]byte) error {
+				for _, id := range ids {
+					res[name] = append(res[name], strfmt.UUID(uuid.Must(uuid.FromBytes(id)).String()))
+				}
+				return nil
+			})
+		})
+		return res
+	}
+
+	// objects are routed by the bytes of their uuid
+	shardOf := func(state *sharding.State, id strfmt.UUID) string {
+		b, err := uuid.MustParse(id.String()).MarshalBinary()
+		require.Nil(t, err)
+		return state.PhysicalShard(b)
+	}
+
+	ids := make([]strfmt.UUID, 50)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		putObject(ids[i], "initial")
+	}
+	source := shardState.AllPhysicalShards()[0]
+
+	after, err := shardState.Reshard(3, []string{"node1"}, 1)
+	require.Nil(t, err)
+	after.SetLocalName("node1")
+
+	require.Nil(t, repo.StartReshard(ctx, className, []string{source}, []string{source}, &after))
+
+	t.Run("objects are copied to their new shards", func(t *testing.T) {
+		objs := shardObjects()
+		assert.Len(t, objs, 3)
+		assert.ElementsMatch(t, ids, objs[source])
+		for name, shardIDs := range objs {
+			if name == source {
+				continue
+			}
+			for _, id := range shardIDs {
+				assert.Equal(t, name, shardOf(&after, id))
+			}
+		}
+	})
+
+	// writes keep going to the source shard until the new state is committed
+	var moved, deleted, kept strfmt.UUID
+	for _, id := range ids {
+		switch {
+		case shardOf(&after, id) == source:
+			kept = id
+		case moved == "":
+			moved = id
+		default:
+			deleted = id
+		}
+	}
+	require.NotEmpty(t, moved)
+	require.NotEmpty(t, deleted)
+	added := strfmt.UUID(uuid.NewString())
+	putObject(added, "added")
+	putObject(moved, "updated")
+	require.Nil(t, repo.DeleteObject(ctx, className, deleted, time.Now(), nil, "", 0))
+
+	// commit the new state
+	schemaGetter.shardState = &after
+	require.Nil(t, migrator.UpdateIndex(ctx, class, &after))
+	require.Nil(t, repo.FinishReshard(ctx, className, &after))
+
+	t.Run("objects are only stored in their new shard", func(t *testing.T) {
+		objs := shardObjects()
+		total := 0
+		for name, shardIDs := range objs {
+			for _, id := range shardIDs {
+				assert.Equal(t, name, shardOf(&after, id))
+			}
+			total += len(shardIDs)
+		}
+		assert.Equal(t, len(ids), total)
+	})
+
+	t.Run("changes made while copying are moved", func(t *testing.T) {
+		for _, id := range []strfmt.UUID{added, moved, kept} {
+			res, err := repo.ObjectByID(ctx, id, nil, additional.Properties{}, "")
+			require.Nil(t, err)
+			require.NotNil(t, res, id)
+		}
+		res, err := repo.ObjectByID(ctx, moved, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Equal(t, "updated", res.Object().Properties.(map[string]interface{})["name"])
+
+		res, err = repo.ObjectByID(ctx, deleted, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+}
@@ -85,6 +85,7 @@ func (db *DB) init(ctx context.Context) error {
 				ResourceUsage:                  db.config.ResourceUsage,
 				QueryMaximumResults:            db.config.QueryMaximumResults,
 				QueryNestedRefLimit:            db.config.QueryNestedRefLimit,
+				QueryTermExpansionScanLimit:    db.config.QueryTermExpansionScanLimit,
 				MemtablesFlushDirtyAfter:       db.config.MemtablesFlushDirtyAfter,
 				MemtablesInitialSizeMB:         db.config.MemtablesInitialSizeMB,
 				MemtablesMaxSizeMB:             db.config.MemtablesMaxSizeMB,
@@ -94,6 +95,10 @@ func (db *DB) init(ctx context.Context) error {
 				SeparateObjectsCompactions:     db.config.SeparateObjectsCompactions,
 				MaxSegmentSize:                 db.config.MaxSegmentSize,
 				HNSWMaxLogSize:                 db.config.HNSWMaxLogSize,
+				ChangeFeedEnabled:              db.config.ChangeFeedEnabled,
+				ChangeFeedRetention:            db.config.ChangeFeedRetention,
+				ObjectsTTLDeleteInterval:       db.config.ObjectsTTLDeleteInterval,
+				VectorRecallMonitoring:         db.config.VectorRecallMonitoring,
 				HNSWWaitForCachePrefill:        db.config.HNSWWaitForCachePrefill,
 				HNSWFlatSearchConcurrency:      db.config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize:         db.config.VisitedListPoolMaxSize,
@@ -103,6 +108,7 @@ func (db *DB) init(ctx context.Context) error {
 				ForceFullReplicasSearch:        db.config.ForceFullReplicasSearch,
 				ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 				AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
+				AsyncReplicationPaused:         class.ReplicationConfig.AsyncPaused,
 				DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// wandBlockMax evaluates a top-k query with block-max WAND. Posting lists are
+// not read upfront, instead blocks are decoded as the evaluation reaches
+// them, and blocks whose maximum impact can't lift any document into the
+// top-k are skipped entirely.
+func (b *BM25Searcher) wandBlockMax(ctx context.Context, N float64, filterDocIds helpers.AllowList,
+	requests []termListRequest, propertyBoosts map[string]float32, limit int,
+	averagePropLength float64, additionalExplanations bool, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	if filterDocIds != nil && filterDocIds.IsEmpty() {
+		return b.getTopKObjects(priorityqueue.NewMin[[]*terms.DocPointerWithScore](limit),
+			additionalExplanations, requests, additional)
+	}
+
+	// Each bucket is accessed exactly once for all terms and in a stable
+	// order, as the layers block flushes and compactions until released.
+	propNames := make([]string, 0, len(propertyBoosts))
+	for _, request := range requests {
+		for _, propName := range request.propertyNames {
+			if !slices.Contains(propNames, propName) {
+				propNames = append(propNames, propName)
+			}
+		}
+	}
+	sort.Strings(propNames)
+
+	var releases []func()
+	releaseAll := func() {
+		for _, release := range releases {
+			release()
+		}
+		releases = nil
+	}
+	defer releaseAll()
+
+	layers := make([][][]terms.PostingLayer, len(requests))
+	for _, propName := range propNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return nil, nil, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+
+		keys := make([][]byte, 0, len(requests))
+		positions := make([]int, 0, len(requests))
+		for i, request := range requests {
+			if slices.Contains(request.propertyNames, propName) {
+				keys = append(keys, []byte(request.term))
+				positions = append(positions, i)
+			}
+		}
+
+		propLayers, release, err := bucket.DocPointerWithScoreLayers(ctx, keys, propertyBoosts[propName])
+		if err != nil {
+			return nil, nil, err
+		}
+		releases = append(releases, release)
+		for i, pos := range positions {
+			layers[pos] = append(layers[pos], propLayers[i])
+		}
+	}
+
+	blockMaxTerms := make([]*terms.BlockMaxTerm, 0, len(requests))
+	for i, request := range requests {
+		term, err := terms.NewBlockMaxTerm(layers[i], request.term, request.termId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("term %q: %w", request.term, err)
+		}
+		if term.Exhausted() {
+			continue
+		}
+
+		n, exact, err := terms.DocumentFrequency(ctx, layers[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("term %q: %w", request.term, err)
+		}
+		if !exact && n > N {
+			n = N
+		}
+		term.Idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(request.duplicateTextBoost) * request.weight
+		term.SetMaxImpact(averagePropLength, b.config)
+		blockMaxTerms = append(blockMaxTerms, term)
+	}
+
+	topKHeap, err := b.getTopKHeapBlockMax(ctx, limit, blockMaxTerms, averagePropLength,
+		filterDocIds, additionalExplanations, len(requests))
+	if err != nil {
+		return nil, nil, err
+	}
+	releaseAll()
+
+	return b.getTopKObjects(topKHeap, additionalExplanations, requests, additional)
+}
+
+func (b *BM25Searcher) getTopKHeapBlockMax(ctx context.Context, limit int, active []*terms.BlockMaxTerm,
+	averagePropLength float64, filterDocIds helpers.AllowList, additionalExplanations bool, count int,
+) (*priorityqueue.Queue[[]*terms.DocPointerWithScore], error) {
+	topKHeap := priorityqueue.NewMin[[]*terms.DocPointerWithScore](limit)
+	threshold := math.Inf(-1)
+
+	for i := 0; ; i++ {
+		if i%1000 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		n := 0
+		for _, term := range active {
+			if !term.Exhausted() {
+				active[n] = term
+				n++
+			}
+		}
+		active = active[:n]
+		if len(active) == 0 {
+			return topKHeap, nil
+		}
+		sort.Slice(active, func(a, b int) bool {
+			if active[a].Id() != active[b].Id() {
+				return active[a].Id() < active[b].Id()
+			}
+			return active[a].QueryTermIndex < active[b].QueryTermIndex
+		})
+
+		// the pivot is the first document that could make it into the results
+		// if it was contained in all preceding terms
+		full := topKHeap.Len() >= limit
+		pivot := -1
+		cumImpact := 0.
+		for j, term := range active {
+			cumImpact += term.MaxImpact()
+			if !full || cumImpact > threshold {
+				pivot = j
+				break
+			}
+		}
+		if pivot == -1 {
+			return topKHeap, nil
+		}
+		pivotID := active[pivot].Id()
+		for pivot+1 < len(active) && active[pivot+1].Id() == pivotID {
+			pivot++
+		}
+
+		if full {
+			blockImpact := 0.
+			boundary := uint64(math.MaxUint64)
+			for _, term := range active[:pivot+1] {
+				impact, termBoundary := term.BlockMaxImpact(pivotID, averagePropLength, b.config)
+				blockImpact += impact
+				if termBoundary < boundary {
+					boundary = termBoundary
+				}
+			}
+
+			if blockImpact <= threshold {
+				// none of the documents up to the end of the shortest block can
+				// make it into the results, skip all of them
+				next := boundary
+				if next < math.MaxUint64 {
+					next++
+				}
+				if pivot+1 < len(active) && active[pivot+1].Id() < next {
+					next = active[pivot+1].Id()
+				}
+				for _, term := range active[:pivot+1] {
+					if err := term.AdvanceTo(next); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+		}
+
+		if active[0].Id() != pivotID {
+			for _, term := range active[:pivot] {
+				if err := term.AdvanceTo(pivotID); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if filterDocIds == nil || filterDocIds.Contains(pivotID) {
+			var docInfos []*terms.DocPointerWithScore
+			if additionalExplanations {
+				docInfos = make([]*terms.DocPointerWithScore, count)
+			}
+			score := 0.
+			for _, term := range active[:pivot+1] {
+				termScore, docInfo := term.Score(averagePropLength, b.config)
+				score += termScore
+				if additionalExplanations {
+					docInfos[term.QueryTermIndex] = &docInfo
+				}
+			}
+
+			if topKHeap.Len() < limit || topKHeap.Top().Dist < float32(score) {
+				topKHeap.InsertWithValue(pivotID, float32(score), docInfos)
+				for topKHeap.Len() > limit {
+					topKHeap.Pop()
+				}
+				if topKHeap.Len() >= limit {
+					threshold = float64(topKHeap.Top().Dist)
+				}
+			}
+		}
+
+		for _, term := range active[:pivot+1] {
+			if err := term.Next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// blockedLayer is a posting layer split into fixed size blocks, like a
+// segment with a block max index
+type blockedLayer struct {
+	data    []terms.DocPointerWithScore
+	size    int
+	decoded int
+}
+
+func (l *blockedLayer) Blocks() []terms.BlockMax {
+	var out []terms.BlockMax
+	for start := 0; start < len(l.data); start += l.size {
+		end := min(start+l.size, len(l.data))
+		block := terms.NewDecodedPostingLayer(l.data[start:end]).Blocks()[0]
+		out = append(out, block)
+	}
+	return out
+}
+
+func (l *blockedLayer) Block(pos int) ([]terms.DocPointerWithScore, error) {
+	l.decoded++
+	start := pos * l.size
+	return l.data[start:min(start+l.size, len(l.data))], nil
+}
+
+func (l *blockedLayer) Additions() int {
+	return terms.NewDecodedPostingLayer(l.data).Additions()
+}
+
+func (l *blockedLayer) Tombstones() int {
+	return terms.NewDecodedPostingLayer(l.data).Tombstones()
+}
+
+func (l *blockedLayer) Decoded() ([]terms.DocPointerWithScore, bool) {
+	return nil, false
+}
+
+func randomPostings(r *rand.Rand, maxID int, density float64, maxFrequency int) []terms.DocPointerWithScore {
+	var out []terms.DocPointerWithScore
+	for id := 0; id < maxID; id++ {
+		if r.Float64() >= density {
+			continue
+		}
+		out = append(out, terms.DocPointerWithScore{
+			Id:         uint64(id),
+			Frequency:  float32(1 + r.Intn(maxFrequency)),
+			PropLength: float32(5 + r.Intn(50)),
+		})
+	}
+	return out
+}
+
+// exhaustiveTerm merges the layers of all props the same way createTerm does
+func exhaustiveTerm(t *testing.T, propLayers [][][]terms.DocPointerWithScore, idf float64, index int) *terms.Term {
+	summed := map[uint64]terms.DocPointerWithScore{}
+	for _, layers := range propLayers {
+		merged, err := terms.NewSortedDocPointerWithScoreMerger().Do(context.Background(), layers)
+		require.Nil(t, err)
+		for _, d := range merged {
+			s := summed[d.Id]
+			s.Id = d.Id
+			s.Frequency += d.Frequency
+			s.PropLength += d.PropLength
+			summed[d.Id] = s
+		}
+	}
+	data := make([]terms.DocPointerWithScore, 0, len(summed))
+	for _, d := range summed {
+		data = append(data, d)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	sort.Slice(data, func(a, b int) bool { return data[a].Id < data[b].Id })
+	return &terms.Term{Idf: idf, Data: data, IdPointer: data[0].Id, QueryTermIndex: index}
+}
+
+func popAll(heap *priorityqueue.Queue[[]*terms.DocPointerWithScore]) ([]uint64, []float32) {
+	var ids []uint64
+	var scores []float32
+	for heap.Len() > 0 {
+		item := heap.Pop()
+		ids = append(ids, item.ID)
+		scores = append(scores, item.Dist)
+	}
+	return ids, scores
+}
+
+func TestBlockMaxWand(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	b := &BM25Searcher{config: schema.BM25Config{K1: 1.2, B: 0.75}}
+	averagePropLength := 25.
+
+	type queryTerm struct {
+		idf    float64
+		layers [][][]terms.DocPointerWithScore // per prop, per layer
+	}
+
+	// a common low impact term, a rare high impact term and a term spread
+	// over multiple props and layers, with the newer layer deleting and
+	// updating documents of the older one
+	common := queryTerm{idf: 0.2, layers: [][][]terms.DocPointerWithScore{
+		{randomPostings(r, 5000, 0.8, 3)},
+	}}
+	rare := queryTerm{idf: 4, layers: [][][]terms.DocPointerWithScore{
+		{randomPostings(r, 5000, 0.01, 10)},
+	}}
+	older := randomPostings(r, 5000, 0.2, 5)
+	newer := []terms.DocPointerWithScore{}
+	for i, d := range older {
+		switch i % 7 {
+		case 0:
+			newer = append(newer, terms.DocPointerWithScore{Id: d.Id}) // tombstone
+		case 1:
+			d.Frequency += 3
+			newer = append(newer, d)
+		}
+	}
+	multi := queryTerm{idf: 1.5, layers: [][][]terms.DocPointerWithScore{
+		{older, newer},
+		{randomPostings(r, 5000, 0.05, 4)},
+	}}
+	queryTerms := []queryTerm{common, rare, multi}
+
+	build := func(t *testing.T) ([]*terms.BlockMaxTerm, []*blockedLayer, *terms.Terms) {
+		var blockMaxTerms []*terms.BlockMaxTerm
+		var allLayers []*blockedLayer
+		exhaustive := &terms.Terms{Count: len(queryTerms)}
+		for i, qt := range queryTerms {
+			propLayers := make([][]terms.PostingLayer, len(qt.layers))
+			for p, layers := range qt.layers {
+				for _, data := range layers {
+					layer := &blockedLayer{data: data, size: 16}
+					allLayers = append(allLayers, layer)
+					propLayers[p] = append(propLayers[p], layer)
+				}
+			}
+			term, err := terms.NewBlockMaxTerm(propLayers, "", i)
+			require.Nil(t, err)
+			term.Idf = qt.idf
+			term.SetMaxImpact(averagePropLength, b.config)
+			blockMaxTerms = append(blockMaxTerms, term)
+
+			if et := exhaustiveTerm(t, qt.layers, qt.idf, i); et != nil {
+				exhaustive.T = append(exhaustive.T, et)
+			}
+		}
+		return blockMaxTerms, allLayers, exhaustive
+	}
+
+	for _, limit := range []int{1, 10, 100} {
+		for _, filtered := range []bool{false, true} {
+			var allow helpers.AllowList
+			if filtered {
+				allow = helpers.NewAllowList()
+				for id := uint64(0); id < 5000; id += 3 {
+					allow.Insert(id)
+				}
+			}
+
+			blockMaxTerms, layers, exhaustive := build(t)
+			if filtered {
+				for _, term := range exhaustive.T {
+					data := term.Data[:0]
+					for _, d := range term.Data {
+						if allow.Contains(d.Id) {
+							data = append(data, d)
+						}
+					}
+					term.Data = data
+					term.IdPointer = data[0].Id
+				}
+			}
+
+			actual, err := b.getTopKHeapBlockMax(context.Background(), limit, blockMaxTerms,
+				averagePropLength, allow, false, len(queryTerms))
+			require.Nil(t, err)
+			expected := b.getTopKHeap(limit, exhaustive, averagePropLength, false)
+
+			actualIDs, actualScores := popAll(actual)
+			expectedIDs, expectedScores := popAll(expected)
+			assert.Equal(t, expectedIDs, actualIDs, "limit %d, filtered %v", limit, filtered)
+			assert.InDeltaSlice(t, expectedScores, actualScores, 1e-5)
+
+			if limit == 1 && !filtered {
+				decoded, total := 0, 0
+				for _, layer := range layers {
+					decoded += layer.decoded
+					total += len(layer.Blocks())
+				}
+				assert.Less(t, decoded, total/2, "most blocks should be skipped")
+			}
+		}
+	}
+}
+
+func TestBlockMaxWand_CanceledContext(t *testing.T) {
+	b := &BM25Searcher{config: schema.BM25Config{K1: 1.2, B: 0.75}}
+	layer := terms.NewDecodedPostingLayer([]terms.DocPointerWithScore{{Id: 1, Frequency: 1, PropLength: 5}})
+	term, err := terms.NewBlockMaxTerm([][]terms.PostingLayer{{layer}}, "", 0)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = b.getTopKHeapBlockMax(ctx, 10, []*terms.BlockMaxTerm{term}, 10, nil, false, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
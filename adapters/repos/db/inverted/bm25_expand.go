@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// maxTermExpansions bounds the number of terms of the index a single query
+// term is expanded to by fuzzy or prefix matching
+const maxTermExpansions = 50
+
+// termExpansion is a term of the index matched by a query term. Its weight
+// reduces the score of inexact matches.
+type termExpansion struct {
+	term   string
+	weight float64
+}
+
+func validateTermExpansion(params searchparams.KeywordRanking) error {
+	if params.Fuzziness < 0 || params.Fuzziness > searchparams.MaxFuzziness {
+		return fmt.Errorf("fuzziness must be between 0 and %d, got %d",
+			searchparams.MaxFuzziness, params.Fuzziness)
+	}
+	if params.FuzzyPrefixLength < 0 {
+		return fmt.Errorf("fuzzy prefix length must not be negative, got %d",
+			params.FuzzyPrefixLength)
+	}
+	return nil
+}
+
+// expandTerm returns the terms of the searchable indexes of the properties
+// which are within the fuzziness of the query term or start with it. The
+// query term itself is not part of the result. At most maxTermExpansions
+// terms are returned, the closest ones first.
+//
+// The term dictionary is scanned in lexicographical order, for fuzzy matching
+// starting at the first prefixLength characters of the query term. At most
+// termExpansionScanLimit terms are scanned per property, truncated reports
+// whether the scan stopped before all candidates were visited.
+func (b *BM25Searcher) expandTerm(ctx context.Context, term string, propNames []string,
+	fuzziness, prefixLength int, prefix bool, detector *stopwords.Detector,
+) (expansions []termExpansion, truncated bool, err error) {
+	fuzziness = maxEdits(term, fuzziness)
+	if fuzziness == 0 && !prefix {
+		return nil, false, nil
+	}
+
+	seek := []byte(term)
+	if fuzziness > 0 {
+		seek = []byte(firstRunes(term, prefixLength))
+	}
+	termLen := utf8.RuneCountInString(term)
+
+	weights := map[string]float64{}
+	for _, propName := range propNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return nil, false, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+
+		err := func() error {
+			// the postings of the terms are not needed, terms whose postings
+			// are all deleted are still visited but don't match any object
+			c := bucket.MapCursorKeyOnly()
+			defer c.Close()
+
+			i := 0
+			for k, _ := c.Seek(ctx, seek); k != nil && bytes.HasPrefix(k, seek); k, _ = c.Next(ctx) {
+				if i++; i > b.termExpansionScanLimit {
+					truncated = true
+					break
+				}
+				if i%1000 == 0 && ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				candidate := string(k)
+				if candidate == term || (detector != nil && detector.IsStopword(candidate)) {
+					continue
+				}
+				candidateLen := utf8.RuneCountInString(candidate)
+
+				weight := 0.
+				if prefix && bytes.HasPrefix(k, []byte(term)) {
+					weight = float64(termLen) / float64(candidateLen)
+				}
+				if fuzziness > 0 && abs(candidateLen-termLen) <= fuzziness {
+					if d := editDistance(term, candidate, fuzziness); d <= fuzziness {
+						weight = max(weight, 1-float64(d)/float64(min(termLen, candidateLen)))
+					}
+				}
+				if weight > weights[candidate] {
+					weights[candidate] = weight
+				}
+			}
+			return nil
+		}()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	expansions = make([]termExpansion, 0, len(weights))
+	for term, weight := range weights {
+		expansions = append(expansions, termExpansion{term: term, weight: weight})
+	}
+	sort.Slice(expansions, func(i, j int) bool {
+		if expansions[i].weight != expansions[j].weight {
+			return expansions[i].weight > expansions[j].weight
+		}
+		return expansions[i].term < expansions[j].term
+	})
+	if len(expansions) > maxTermExpansions {
+		expansions = expansions[:maxTermExpansions]
+	}
+	return expansions, truncated, nil
+}
+
+// maxEdits limits the fuzziness for short terms, which would otherwise match
+// a large share of the index: terms of up to two characters are matched
+// exactly, terms of up to five characters with at most one edit.
+func maxEdits(term string, fuzziness int) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return min(fuzziness, 1)
+	default:
+		return fuzziness
+	}
+}
+
+func firstRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// editDistance returns the optimal string alignment distance of a and b,
+// i.e. the Levenshtein distance which also counts the transposition of two
+// adjacent characters as a single edit. Distances above limit are reported
+// as limit+1.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > limit {
+		return limit + 1
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	// a transposition refers back two rows, so the computation can only stop
+	// once two consecutive rows exceed the limit
+	prevRowMin := 0
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit && prevRowMin > limit {
+			return limit + 1
+		}
+		prevRowMin = rowMin
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return min(prev[len(rb)], limit+1)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
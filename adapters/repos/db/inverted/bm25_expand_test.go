@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestEditDistance(t *testing.T) {
+	type testCase struct {
+		a, b     string
+		limit    int
+		expected int
+	}
+
+	testCases := []testCase{
+		{a: "weather", b: "weather", limit: 2, expected: 0},
+		{a: "weather", b: "wether", limit: 2, expected: 1},
+		{a: "weather", b: "weathers", limit: 2, expected: 1},
+		{a: "weather", b: "waether", limit: 2, expected: 1},
+		{a: "wetaher", b: "weather", limit: 2, expected: 1},
+		{a: "weather", b: "leather", limit: 2, expected: 1},
+		{a: "weather", b: "whether", limit: 2, expected: 2},
+		{a: "weather", b: "feathers", limit: 2, expected: 2},
+		{a: "weather", b: "heat", limit: 2, expected: 3},
+		{a: "weather", b: "completely", limit: 2, expected: 3},
+		{a: "ab", b: "ba", limit: 1, expected: 1},
+		{a: "café", b: "cafe", limit: 1, expected: 1},
+		{a: "", b: "ab", limit: 2, expected: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.expected, editDistance(tc.a, tc.b, tc.limit))
+			assert.Equal(t, tc.expected, editDistance(tc.b, tc.a, tc.limit))
+		})
+	}
+}
+
+func TestMaxEdits(t *testing.T) {
+	assert.Equal(t, 0, maxEdits("ab", 2))
+	assert.Equal(t, 1, maxEdits("abc", 2))
+	assert.Equal(t, 1, maxEdits("abcde", 2))
+	assert.Equal(t, 0, maxEdits("abcde", 0))
+	assert.Equal(t, 2, maxEdits("abcdef", 2))
+	assert.Equal(t, 1, maxEdits("abcdef", 1))
+}
+
+func TestFirstRunes(t *testing.T) {
+	assert.Equal(t, "é", firstRunes("école", 1))
+	assert.Equal(t, "ab", firstRunes("ab", 3))
+	assert.Equal(t, "", firstRunes("ab", 0))
+}
+
+func TestValidateTermExpansion(t *testing.T) {
+	assert.NoError(t, validateTermExpansion(searchparams.KeywordRanking{Fuzziness: 0}))
+	assert.NoError(t, validateTermExpansion(searchparams.KeywordRanking{Fuzziness: 2, Prefix: true}))
+	assert.ErrorContains(t, validateTermExpansion(searchparams.KeywordRanking{Fuzziness: 3}),
+		"fuzziness must be between 0 and 2")
+	assert.Error(t, validateTermExpansion(searchparams.KeywordRanking{Fuzziness: -1}))
+}
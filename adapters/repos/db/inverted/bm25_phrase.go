@@ -0,0 +1,340 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const proximityOperator = "NEAR/"
+
+// phraseClause is a phrase (`"exact phrase"`) or proximity (`term NEAR/3
+// term`) clause of a keyword query. Documents have to match all clauses of a
+// query. The occurrences of a clause are scored like an additional query
+// term, so documents containing a phrase more often rank higher.
+type phraseClause struct {
+	// operands of the clause, a phrase has a single operand, a proximity
+	// clause two. Every operand can consist of multiple terms which have to
+	// occur in sequence.
+	operands []string
+	// distance is the maximum number of positions between the operands of a
+	// proximity clause
+	distance int
+	// boost multiplies the score of the clause, it is set with a `^2.5`
+	// suffix
+	boost float64
+	// label of the clause in score explanations
+	label string
+}
+
+type queryPart struct {
+	text   string
+	quoted bool
+	boost  float64
+}
+
+// parsePhraseQuery splits a keyword query into the text used to score the
+// single terms and its phrase and proximity clauses. The terms of all
+// clauses are part of the text, so they are scored as single terms as well.
+func parsePhraseQuery(query string) (string, []phraseClause, error) {
+	parts := splitQuery(query)
+
+	text := make([]string, 0, len(parts))
+	var clauses []phraseClause
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
+		if i+2 < len(parts) && isProximityOperator(parts[i+1]) {
+			distance, err := strconv.Atoi(strings.TrimPrefix(parts[i+1].text, proximityOperator))
+			if err != nil || distance < 1 || distance >= PositionIncrementGap {
+				return "", nil, fmt.Errorf("invalid proximity operator %q: distance must be between 1 and %d",
+					parts[i+1].text, PositionIncrementGap-1)
+			}
+			right := parts[i+2]
+			clauses = append(clauses, phraseClause{
+				operands: []string{part.text, right.text},
+				distance: distance,
+				boost:    right.boost,
+				label:    fmt.Sprintf("%s %s %s", part.label(), parts[i+1].text, right.label()),
+			})
+			text = append(text, part.text, right.text)
+			i += 2
+			continue
+		}
+
+		if part.quoted {
+			clauses = append(clauses, phraseClause{
+				operands: []string{part.text},
+				boost:    part.boost,
+				label:    part.label(),
+			})
+		}
+		text = append(text, part.text)
+	}
+
+	return strings.Join(text, " "), clauses, nil
+}
+
+// splitQuery splits a query into quoted phrases and whitespace separated
+// words. A quote which is not closed lasts until the end of the query.
+func splitQuery(query string) []queryPart {
+	var parts []queryPart
+	for len(query) > 0 {
+		query = strings.TrimLeft(query, " \t\n\r")
+		if query == "" {
+			break
+		}
+
+		if query[0] == '"' {
+			end := strings.IndexByte(query[1:], '"')
+			if end < 0 {
+				end = len(query) - 1
+			}
+			phrase := strings.TrimSpace(query[1 : end+1])
+			query = query[min(end+2, len(query)):]
+
+			boost := 1.
+			if strings.HasPrefix(query, "^") {
+				word, rest := cutWord(query[1:])
+				if b, err := strconv.ParseFloat(word, 64); err == nil && b > 0 {
+					boost = b
+					query = rest
+				}
+			}
+			if phrase != "" {
+				parts = append(parts, queryPart{text: phrase, quoted: true, boost: boost})
+			}
+			continue
+		}
+
+		word, rest := cutWord(query)
+		query = rest
+		part := queryPart{text: word, boost: 1}
+		if i := strings.LastIndexByte(word, '^'); i > 0 {
+			if b, err := strconv.ParseFloat(word[i+1:], 64); err == nil && b > 0 {
+				part.text, part.boost = word[:i], b
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// cutWord returns the input up to the next whitespace or quote and the rest
+func cutWord(in string) (string, string) {
+	end := strings.IndexAny(in, " \t\n\r\"")
+	if end < 0 {
+		return in, ""
+	}
+	return in[:end], in[end:]
+}
+
+func isProximityOperator(part queryPart) bool {
+	return !part.quoted && strings.HasPrefix(part.text, proximityOperator)
+}
+
+func (p queryPart) label() string {
+	if p.quoted {
+		return `"` + p.text + `"`
+	}
+	return p.text
+}
+
+// phraseTerms evaluates the clauses on the given properties, which must have
+// a position index. It returns a term per clause holding the matching
+// documents and the ids of the documents matching all clauses.
+func (b *BM25Searcher) phraseTerms(ctx context.Context, N float64, class *models.Class,
+	clauses []phraseClause, propNames []string, propertyBoosts map[string]float32,
+) ([]*terms.Term, *sroar.Bitmap, error) {
+	results := make([]*terms.Term, len(clauses))
+	var matching *sroar.Bitmap
+	for i, clause := range clauses {
+		docs := map[uint64]*terms.DocPointerWithScore{}
+		for _, propName := range propNames {
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := b.matchPhraseClause(ctx, clause, prop, propertyBoosts[propName], docs); err != nil {
+				return nil, nil, fmt.Errorf("match %s in property %q: %w", clause.label, propName, err)
+			}
+		}
+
+		ids := sroar.NewBitmap()
+		data := make([]terms.DocPointerWithScore, 0, len(docs))
+		for id, doc := range docs {
+			ids.Set(id)
+			data = append(data, *doc)
+		}
+		sort.Slice(data, func(a, b int) bool { return data[a].Id < data[b].Id })
+
+		if matching == nil {
+			matching = ids
+		} else {
+			matching.And(ids)
+		}
+
+		n := float64(len(data))
+		results[i] = &terms.Term{
+			Idf:       math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * clause.boost,
+			Data:      data,
+			QueryTerm: clause.label,
+		}
+	}
+	return results, matching, nil
+}
+
+// matchPhraseClause adds the documents matching the clause in the property to
+// docs, the number of occurrences is their frequency
+func (b *BM25Searcher) matchPhraseClause(ctx context.Context, clause phraseClause,
+	prop *models.Property, propBoost float32, docs map[uint64]*terms.DocPointerWithScore,
+) error {
+	operands := make([][]string, len(clause.operands))
+	distinct := map[string]struct{}{}
+	for i, operand := range clause.operands {
+		operands[i] = helpers.Tokenize(prop.Tokenization, operand)
+		if len(operands[i]) == 0 {
+			// e.g. only punctuation, which can not be matched
+			return nil
+		}
+		for _, token := range operands[i] {
+			distinct[token] = struct{}{}
+		}
+	}
+
+	searchable := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+	if searchable == nil {
+		return fmt.Errorf("could not find searchable bucket")
+	}
+	positionsBucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+	if positionsBucket == nil {
+		return fmt.Errorf("could not find positions bucket")
+	}
+
+	// candidates are the documents containing all terms of the clause
+	var candidates []terms.DocPointerWithScore
+	first := true
+	for token := range distinct {
+		list, err := searchable.DocPointerWithScoreList(ctx, []byte(token), 1)
+		if err != nil {
+			return err
+		}
+		if first {
+			candidates, first = list, false
+		} else {
+			candidates = intersectDocPointers(candidates, list)
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+
+	for _, candidate := range candidates {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		positions := make(map[string][]uint32, len(distinct))
+		for token := range distinct {
+			raw, err := positionsBucket.Get(PositionsKey(candidate.Id, []byte(token)))
+			if err != nil {
+				return err
+			}
+			if positions[token], err = DecodePositions(raw); err != nil {
+				return err
+			}
+		}
+
+		var freq int
+		if len(operands) == 1 {
+			freq = len(phraseStarts(operandPositions(operands[0], positions)))
+		} else {
+			freq = countProximity(
+				phraseStarts(operandPositions(operands[0], positions)), len(operands[0]),
+				phraseStarts(operandPositions(operands[1], positions)), len(operands[1]),
+				clause.distance)
+		}
+		if freq == 0 {
+			continue
+		}
+
+		if doc, ok := docs[candidate.Id]; ok {
+			doc.Frequency += float32(freq) * propBoost
+			doc.PropLength += candidate.PropLength
+		} else {
+			docs[candidate.Id] = &terms.DocPointerWithScore{
+				Id:         candidate.Id,
+				Frequency:  float32(freq) * propBoost,
+				PropLength: candidate.PropLength,
+			}
+		}
+	}
+	return nil
+}
+
+func operandPositions(tokens []string, positions map[string][]uint32) [][]uint32 {
+	out := make([][]uint32, len(tokens))
+	for i, token := range tokens {
+		out[i] = positions[token]
+	}
+	return out
+}
+
+// countProximity counts the occurrences of the left operand which have an
+// occurrence of the right operand at most distance positions before or
+// after them
+func countProximity(left []uint32, leftLen int, right []uint32, rightLen int, distance int) int {
+	count := 0
+	for _, l := range left {
+		for _, r := range right {
+			var gap int
+			if l < r {
+				gap = int(r) - (int(l) + leftLen - 1)
+			} else {
+				gap = int(l) - (int(r) + rightLen - 1)
+			}
+			if gap >= 1 && gap <= distance {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// intersectDocPointers keeps the entries of a which are contained in b. The
+// lists are not required to be sorted by id, which segments written with the
+// legacy map layout are not.
+func intersectDocPointers(a, b []terms.DocPointerWithScore) []terms.DocPointerWithScore {
+	ids := make(map[uint64]struct{}, len(b))
+	for _, doc := range b {
+		ids[doc.Id] = struct{}{}
+	}
+
+	out := a[:0:0]
+	for _, doc := range a {
+		if _, ok := ids[doc.Id]; ok {
+			out = append(out, doc)
+		}
+	}
+	return out
+}
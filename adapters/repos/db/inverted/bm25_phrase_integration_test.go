@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestIntersectDocPointersLegacyMapLayout(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		dirName   = t.TempDir()
+		logger, _ = test.NewNullLogger()
+		bucket    = "searchable_legacy"
+		low       = uint64(0x0a0b0c0d0e0f1011)
+		high      = uint64(0x0a0b0c0d0e0f1012)
+	)
+
+	openBucket := func() (*lsmkv.Store, *lsmkv.Bucket) {
+		store, err := lsmkv.New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, bucket,
+			lsmkv.WithStrategy(lsmkv.StrategyMapCollection), lsmkv.WithLegacyMapSorting()))
+		return store, store.Bucket(bucket)
+	}
+
+	docKey := func(id uint64) []byte {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, id)
+		return key
+	}
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(1))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(2))
+
+	store, b := openBucket()
+	for _, term := range []string{"a", "b"} {
+		for _, id := range []uint64{low, high} {
+			require.Nil(t, b.MapSet([]byte(term), lsmkv.MapPair{Key: docKey(id), Value: value}))
+		}
+	}
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, store.Shutdown(ctx))
+
+	// segments written by earlier versions were not sorted by doc id, swap
+	// the doc ids of term "a" to recreate such a segment
+	segments, err := filepath.Glob(filepath.Join(dirName, bucket, "segment-*.db"))
+	require.Nil(t, err)
+	require.Len(t, segments, 1)
+	data, err := os.ReadFile(segments[0])
+	require.Nil(t, err)
+	lowPos, highPos := bytes.Index(data, docKey(low)), bytes.Index(data, docKey(high))
+	require.True(t, lowPos >= 0 && highPos > lowPos)
+	copy(data[lowPos:], docKey(high))
+	copy(data[highPos:], docKey(low))
+	require.Nil(t, os.WriteFile(segments[0], data, 0o600))
+
+	store, b = openBucket()
+	defer store.Shutdown(ctx)
+
+	a, err := b.DocPointerWithScoreList(ctx, []byte("a"), 1)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{high, low}, docPointerIDs(a))
+	sorted, err := b.DocPointerWithScoreList(ctx, []byte("b"), 1)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{low, high}, docPointerIDs(sorted))
+
+	assert.Equal(t, []uint64{high, low}, docPointerIDs(intersectDocPointers(a, sorted)))
+	assert.Equal(t, []uint64{low, high}, docPointerIDs(intersectDocPointers(sorted, a)))
+}
+
+func docPointerIDs(docs []terms.DocPointerWithScore) []uint64 {
+	ids := make([]uint64, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.Id
+	}
+	return ids
+}
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTermPositions(t *testing.T) {
+	t.Run("single value", func(t *testing.T) {
+		positions := TermPositions(models.PropertyTokenizationWord, []string{"A rose is a rose"})
+		assert.Equal(t, map[string][]uint32{
+			"a":    {0, 3},
+			"rose": {1, 4},
+			"is":   {2},
+		}, positions)
+	})
+
+	t.Run("multiple values are separated by a gap", func(t *testing.T) {
+		positions := TermPositions(models.PropertyTokenizationWord, []string{"one two", "", "three"})
+		assert.Equal(t, map[string][]uint32{
+			"one":   {0},
+			"two":   {1},
+			"three": {2 + 2*PositionIncrementGap},
+		}, positions)
+	})
+}
+
+func TestEncodePositions(t *testing.T) {
+	for _, positions := range [][]uint32{{}, {0}, {1, 2, 3}, {5, 300, 70000, 70001}} {
+		decoded, err := DecodePositions(EncodePositions(positions))
+		require.NoError(t, err)
+		assert.Equal(t, positions, decoded)
+	}
+
+	_, err := DecodePositions([]byte{0x80})
+	assert.Error(t, err)
+}
+
+func TestPhraseStarts(t *testing.T) {
+	assert.Equal(t, []uint32{1, 7}, phraseStarts([][]uint32{{1, 4, 7}, {2, 8}, {3, 9}}))
+	assert.Equal(t, []uint32{0, 3, 5}, phraseStarts([][]uint32{{0, 3, 5}}))
+	assert.Empty(t, phraseStarts([][]uint32{{1}, {3}}))
+	assert.Empty(t, phraseStarts(nil))
+}
+
+func TestParsePhraseQuery(t *testing.T) {
+	type testCase struct {
+		name            string
+		query           string
+		expectedText    string
+		expectedClauses []phraseClause
+		expectedErr     string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "no clauses",
+			query:        "breach of contract",
+			expectedText: "breach of contract",
+		},
+		{
+			name:         "phrase",
+			query:        `damages "breach of  contract" awarded`,
+			expectedText: "damages breach of  contract awarded",
+			expectedClauses: []phraseClause{
+				{operands: []string{"breach of  contract"}, boost: 1, label: `"breach of  contract"`},
+			},
+		},
+		{
+			name:         "boosted phrase",
+			query:        `"breach of contract"^2.5 damages`,
+			expectedText: "breach of contract damages",
+			expectedClauses: []phraseClause{
+				{operands: []string{"breach of contract"}, boost: 2.5, label: `"breach of contract"`},
+			},
+		},
+		{
+			name:         "unclosed phrase",
+			query:        `damages "breach of contract`,
+			expectedText: "damages breach of contract",
+			expectedClauses: []phraseClause{
+				{operands: []string{"breach of contract"}, boost: 1, label: `"breach of contract"`},
+			},
+		},
+		{
+			name:         "empty phrase",
+			query:        `"" damages`,
+			expectedText: "damages",
+		},
+		{
+			name:         "proximity",
+			query:        "breach NEAR/3 contract^2 damages",
+			expectedText: "breach contract damages",
+			expectedClauses: []phraseClause{
+				{operands: []string{"breach", "contract"}, distance: 3, boost: 2, label: "breach NEAR/3 contract"},
+			},
+		},
+		{
+			name:         "proximity of phrases",
+			query:        `"breach of contract" NEAR/5 "punitive damages"`,
+			expectedText: "breach of contract punitive damages",
+			expectedClauses: []phraseClause{
+				{
+					operands: []string{"breach of contract", "punitive damages"}, distance: 5, boost: 1,
+					label: `"breach of contract" NEAR/5 "punitive damages"`,
+				},
+			},
+		},
+		{
+			name:         "operator without operands",
+			query:        "NEAR/3 contract",
+			expectedText: "NEAR/3 contract",
+		},
+		{
+			name:        "invalid distance",
+			query:       "breach NEAR/x contract",
+			expectedErr: "invalid proximity operator",
+		},
+		{
+			name:        "distance too large",
+			query:       "breach NEAR/100 contract",
+			expectedErr: "invalid proximity operator",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			text, clauses, err := parsePhraseQuery(tc.query)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedText, text)
+			assert.Equal(t, tc.expectedClauses, clauses)
+		})
+	}
+}
+
+func TestCountProximity(t *testing.T) {
+	// right operand after the left one
+	assert.Equal(t, 1, countProximity([]uint32{2}, 1, []uint32{4}, 1, 2))
+	assert.Equal(t, 0, countProximity([]uint32{2}, 1, []uint32{4}, 1, 1))
+	// right operand before the left one
+	assert.Equal(t, 1, countProximity([]uint32{4}, 1, []uint32{3}, 1, 1))
+	// the length of the operands is considered
+	assert.Equal(t, 1, countProximity([]uint32{0}, 3, []uint32{3}, 1, 1))
+	assert.Equal(t, 1, countProximity([]uint32{5}, 1, []uint32{2}, 2, 2))
+	// overlapping operands do not match
+	assert.Equal(t, 0, countProximity([]uint32{0}, 3, []uint32{1}, 1, 5))
+	// every occurrence of the left operand is counted once
+	assert.Equal(t, 2, countProximity([]uint32{1, 10}, 1, []uint32{2, 3, 11}, 1, 2))
+}
+
+func TestIntersectDocPointers(t *testing.T) {
+	a := []terms.DocPointerWithScore{{Id: 1, Frequency: 1}, {Id: 3, Frequency: 2}, {Id: 5, Frequency: 3}, {Id: 9}}
+	b := []terms.DocPointerWithScore{{Id: 2}, {Id: 3}, {Id: 5}, {Id: 7}}
+	assert.Equal(t, []terms.DocPointerWithScore{{Id: 3, Frequency: 2}, {Id: 5, Frequency: 3}}, intersectDocPointers(a, b))
+	assert.Empty(t, intersectDocPointers(a, nil))
+}
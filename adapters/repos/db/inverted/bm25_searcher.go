@@ -47,6 +47,9 @@ type BM25Searcher struct {
 	propLenTracker propLengthRetriever
 	logger         logrus.FieldLogger
 	shardVersion   uint16
+	// termExpansionScanLimit bounds the number of terms of a property scanned
+	// for the fuzzy or prefix expansion of a query term
+	termExpansionScanLimit int
 }
 
 type propLengthRetriever interface {
@@ -59,28 +62,35 @@ type termListRequest struct {
 	duplicateTextBoost int
 	propertyNames      []string
 	propertyBoosts     map[string]float32
+	// weight of terms matched by fuzzy or prefix expansion, 1 for query terms
+	weight float64
 }
 
 func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
 	getClass func(string) *models.Class, propIndices propertyspecific.Indices,
 	classSearcher ClassSearcher, propLenTracker propLengthRetriever,
-	logger logrus.FieldLogger, shardVersion uint16,
+	logger logrus.FieldLogger, shardVersion uint16, termExpansionScanLimit int,
 ) *BM25Searcher {
 	return &BM25Searcher{
-		config:         config,
-		store:          store,
-		getClass:       getClass,
-		propIndices:    propIndices,
-		classSearcher:  classSearcher,
-		propLenTracker: propLenTracker,
-		logger:         logger.WithField("action", "bm25_search"),
-		shardVersion:   shardVersion,
+		config:                 config,
+		store:                  store,
+		getClass:               getClass,
+		propIndices:            propIndices,
+		classSearcher:          classSearcher,
+		propLenTracker:         propLenTracker,
+		logger:                 logger.WithField("action", "bm25_search"),
+		shardVersion:           shardVersion,
+		termExpansionScanLimit: termExpansionScanLimit,
 	}
 }
 
 func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList,
 	className schema.ClassName, limit int, keywordRanking searchparams.KeywordRanking, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	if err := validateTermExpansion(keywordRanking); err != nil {
+		return nil, nil, err
+	}
+
 	// WEAVIATE-471 - If a property is not searchable, return an error
 	for _, property := range keywordRanking.Properties {
 		if !PropertyHasSearchableIndex(b.getClass(className.String()), property) {
@@ -147,6 +157,7 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength := 0.
 	averagePropLengthCount := 0
+	var positionPropNames []string
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
 		propBoost := 1
@@ -182,6 +193,9 @@ func (b *BM25Searcher) wand(
 					prop.Tokenization, prop.Name)
 			}
 			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			if HasPositionIndex(prop) {
+				positionPropNames = append(positionPropNames, property)
+			}
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -196,19 +210,63 @@ func (b *BM25Searcher) wand(
 		averagePropLength = 40.0 // sane default, if all prop lengths are NaN or 0
 	}
 
+	// Phrase and proximity clauses are only parsed if any of the properties
+	// stores positions, otherwise the query is used as is
+	query := params.Query
+	var phraseClauses []phraseClause
+	if len(positionPropNames) > 0 {
+		var err error
+		query, phraseClauses, err = parsePhraseQuery(params.Query)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var phraseResults []*terms.Term
+	if len(phraseClauses) > 0 {
+		var matching *sroar.Bitmap
+		var err error
+		phraseResults, matching, err = b.phraseTerms(ctx, N, class, phraseClauses,
+			positionPropNames, propertyBoosts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// only documents matching all clauses are scored
+		if filterDocIds != nil {
+			matched := sroar.NewBitmap()
+			for _, id := range matching.ToArray() {
+				if filterDocIds.Contains(id) {
+					matched.Set(id)
+				}
+			}
+			matching = matched
+		}
+		if matching.IsEmpty() {
+			return nil, nil, nil
+		}
+		filterDocIds = helpers.NewAllowListFromBitmap(matching)
+	}
+
 	// 100 is a reasonable expected capacity for the total number of terms to query.
 	allRequests := make([]termListRequest, 0, 100)
+	// query terms whose expansion stopped at the scan limit
+	var truncatedTerms []string
 
 	for _, tokenization := range helpers.Tokenizations {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
-			queryTerms, duplicateBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+			queryTerms, duplicateBoosts := helpers.TokenizeAndCountDuplicates(tokenization, query)
 
 			// stopword filtering for word tokenization
 			if tokenization == models.PropertyTokenizationWord {
 				queryTerms, duplicateBoosts = b.removeStopwordsFromQueryTerms(
 					queryTerms, duplicateBoosts, stopWordDetector)
 			}
+			requested := make(map[string]struct{}, len(queryTerms))
+			for _, queryTerm := range queryTerms {
+				requested[queryTerm] = struct{}{}
+			}
 			for queryTermIndex, queryTerm := range queryTerms {
 				allRequests = append(allRequests, termListRequest{
 					term:               queryTerm,
@@ -216,11 +274,56 @@ func (b *BM25Searcher) wand(
 					duplicateTextBoost: duplicateBoosts[queryTermIndex],
 					propertyNames:      propNames,
 					propertyBoosts:     propertyBoosts,
+					weight:             1,
 				})
+
+				if params.Fuzziness == 0 && !params.Prefix {
+					continue
+				}
+				var detector *stopwords.Detector
+				if tokenization == models.PropertyTokenizationWord {
+					detector = stopWordDetector
+				}
+				expansions, truncated, err := b.expandTerm(ctx, queryTerm, propNames,
+					params.Fuzziness, params.FuzzyPrefixLength, params.Prefix, detector)
+				if err != nil {
+					return nil, nil, fmt.Errorf("expand term %q: %w", queryTerm, err)
+				}
+				if truncated {
+					b.logger.WithFields(logrus.Fields{
+						"query_term": queryTerm,
+						"scan_limit": b.termExpansionScanLimit,
+					}).Warn("term expansion stopped at the scan limit, matching terms may be missing")
+					truncatedTerms = append(truncatedTerms, queryTerm)
+				}
+				for _, expansion := range expansions {
+					if _, ok := requested[expansion.term]; ok {
+						continue
+					}
+					requested[expansion.term] = struct{}{}
+					allRequests = append(allRequests, termListRequest{
+						term:               expansion.term,
+						termId:             len(allRequests),
+						duplicateTextBoost: duplicateBoosts[queryTermIndex],
+						propertyNames:      propNames,
+						propertyBoosts:     propertyBoosts,
+						weight:             expansion.weight,
+					})
+				}
 			}
 		}
 	}
 
+	if limit > 0 && len(phraseClauses) == 0 {
+		objs, scores, err := b.wandBlockMax(ctx, N, filterDocIds, allRequests, propertyBoosts, limit,
+			averagePropLength, params.AdditionalExplanations, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		explainTruncatedExpansions(objs, truncatedTerms, params.AdditionalExplanations)
+		return objs, scores, nil
+	}
+
 	results := make([]*terms.Term, len(allRequests))
 
 	eg := enterrors.NewErrorGroupWrapper(b.logger)
@@ -231,6 +334,7 @@ func (b *BM25Searcher) wand(
 		termId := request.termId
 		propNames := request.propertyNames
 		duplicateBoost := request.duplicateTextBoost
+		weight := request.weight
 
 		eg.Go(func() (err error) {
 			defer func() {
@@ -251,6 +355,9 @@ func (b *BM25Searcher) wand(
 				err = termErr
 				return
 			}
+			if termResult != nil {
+				termResult.Idf *= weight
+			}
 			results[termId] = termResult
 			return
 		})
@@ -259,6 +366,29 @@ func (b *BM25Searcher) wand(
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
+
+	// clauses are scored like additional query terms
+	for i, phraseResult := range phraseResults {
+		phraseResult.QueryTermIndex = len(allRequests)
+		allRequests = append(allRequests, termListRequest{
+			term:   phraseClauses[i].label,
+			termId: len(allRequests),
+		})
+
+		data := make([]terms.DocPointerWithScore, 0, len(phraseResult.Data))
+		for _, doc := range phraseResult.Data {
+			if filterDocIds.Contains(doc.Id) {
+				data = append(data, doc)
+			}
+		}
+		if len(data) == 0 {
+			continue
+		}
+		phraseResult.Data = data
+		phraseResult.IdPointer = data[0].Id
+		results = append(results, phraseResult)
+	}
+
 	// all results. Sum up the length of the results from all terms to get an upper bound of how many results there are
 	if limit == 0 {
 		for _, res := range results {
@@ -281,7 +411,31 @@ func (b *BM25Searcher) wand(
 	}
 
 	topKHeap := b.getTopKHeap(limit, combinedTerms, averagePropLength, params.AdditionalExplanations)
-	return b.getTopKObjects(topKHeap, params.AdditionalExplanations, allRequests, additional)
+	objs, scores, err := b.getTopKObjects(topKHeap, params.AdditionalExplanations, allRequests, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+	explainTruncatedExpansions(objs, truncatedTerms, params.AdditionalExplanations)
+	return objs, scores, nil
+}
+
+// explainTruncatedExpansions marks the query terms whose fuzzy or prefix
+// expansion stopped at the scan limit in the score explanations, so that
+// results which may miss matching terms can be told apart
+func explainTruncatedExpansions(objs []*storobj.Object, truncatedTerms []string,
+	additionalExplanations bool,
+) {
+	if !additionalExplanations || len(truncatedTerms) == 0 {
+		return
+	}
+	for _, obj := range objs {
+		if obj.AdditionalProperties() == nil {
+			obj.Object.Additional = make(map[string]interface{})
+		}
+		for _, term := range truncatedTerms {
+			obj.Object.Additional["BM25F_"+term+"_expansionTruncated"] = true
+		}
+	}
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string,
@@ -588,6 +588,16 @@ func HasSearchableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether positions of the terms of the property should be indexed
+// Index holds positions of a term per document and property
+// (index created using bucket of StrategyReplace)
+func HasPositionIndex(prop *models.Property) bool {
+	if prop.IndexPositions == nil || !*prop.IndexPositions {
+		return false
+	}
+	return HasSearchableIndex(prop)
+}
+
 // Indicates whether property should be indexed
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
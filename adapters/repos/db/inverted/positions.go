@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+// PositionIncrementGap separates the positions of the values of text[]
+// properties, so that phrases and proximity queries do not match across
+// values
+const PositionIncrementGap = 100
+
+// TermPositions tokenizes the given values and returns the positions of
+// every term in the sequence of tokens, sorted in ascending order. Positions
+// of consecutive values are separated by PositionIncrementGap.
+func TermPositions(tokenization string, values []string) map[string][]uint32 {
+	positions := map[string][]uint32{}
+	offset := uint32(0)
+	for i, value := range values {
+		if i > 0 {
+			offset += PositionIncrementGap
+		}
+		tokens := helpers.Tokenize(tokenization, value)
+		for pos, token := range tokens {
+			positions[token] = append(positions[token], offset+uint32(pos))
+		}
+		offset += uint32(len(tokens))
+	}
+	return positions
+}
+
+// PositionsKey is the key of the positions of a term in a document. The doc
+// id prefix keeps all terms of a document next to each other.
+func PositionsKey(docID uint64, term []byte) []byte {
+	key := make([]byte, 8+len(term))
+	binary.BigEndian.PutUint64(key, docID)
+	copy(key[8:], term)
+	return key
+}
+
+// EncodePositions delta encodes sorted positions as uvarints
+func EncodePositions(positions []uint32) []byte {
+	buf := make([]byte, 0, len(positions)*2)
+	prev := uint32(0)
+	for _, pos := range positions {
+		buf = binary.AppendUvarint(buf, uint64(pos-prev))
+		prev = pos
+	}
+	return buf
+}
+
+// DecodePositions is the inverse of EncodePositions
+func DecodePositions(in []byte) ([]uint32, error) {
+	positions := make([]uint32, 0, len(in))
+	prev := uint32(0)
+	for len(in) > 0 {
+		delta, n := binary.Uvarint(in)
+		if n <= 0 {
+			return nil, fmt.Errorf("decode positions: invalid uvarint")
+		}
+		prev += uint32(delta)
+		positions = append(positions, prev)
+		in = in[n:]
+	}
+	return positions, nil
+}
+
+// phraseStarts returns the positions at which the terms occur in sequence,
+// given the sorted positions of every term of the phrase
+func phraseStarts(termPositions [][]uint32) []uint32 {
+	if len(termPositions) == 0 {
+		return nil
+	}
+
+	var starts []uint32
+	for _, start := range termPositions[0] {
+		matches := true
+		for i := 1; i < len(termPositions); i++ {
+			if !containsPosition(termPositions[i], start+uint32(i)) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			starts = append(starts, start)
+		}
+	}
+	return starts
+}
+
+func containsPosition(positions []uint32, pos uint32) bool {
+	i := sort.Search(len(positions), func(i int) bool { return positions[i] >= pos })
+	return i < len(positions) && positions[i] == pos
+}
@@ -0,0 +1,540 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package terms
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// BlockMax summarizes a block of a sorted posting list, so that the score of
+// every document in the block can be bounded without decoding it.
+type BlockMax struct {
+	// MaxId is the id of the last document in the block
+	MaxId         uint64
+	MaxFrequency  float32
+	MinPropLength float32
+}
+
+// PostingLayer is a single sorted layer of a posting list, i.e. the part of
+// it that is stored in a single segment or memtable. Blocks are decoded on
+// demand, so that blocks which cannot contain a competitive document are
+// never read.
+type PostingLayer interface {
+	Blocks() []BlockMax
+	// Block decodes the block at the given position. Tombstones are contained
+	// with a Frequency of 0.
+	Block(pos int) ([]DocPointerWithScore, error)
+	// Additions and Tombstones are the number of entries in the layer, they
+	// are used to estimate the document frequency without reading the layer.
+	Additions() int
+	Tombstones() int
+	// Decoded returns the entire layer if it is held in memory anyway
+	Decoded() ([]DocPointerWithScore, bool)
+}
+
+// NewDecodedPostingLayer wraps an already decoded (sorted) posting list, such
+// as the contents of a memtable or a short list on disk, as a single block.
+func NewDecodedPostingLayer(data []DocPointerWithScore) PostingLayer {
+	l := &decodedPostingLayer{data: data}
+	if len(data) == 0 {
+		return l
+	}
+
+	block := BlockMax{MaxId: data[len(data)-1].Id, MinPropLength: float32(math.MaxFloat32)}
+	for _, d := range data {
+		if d.Frequency == 0 {
+			l.tombstones++
+			continue
+		}
+		if d.Frequency > block.MaxFrequency {
+			block.MaxFrequency = d.Frequency
+		}
+		if d.PropLength < block.MinPropLength {
+			block.MinPropLength = d.PropLength
+		}
+	}
+	l.blocks = []BlockMax{block}
+	return l
+}
+
+type decodedPostingLayer struct {
+	data       []DocPointerWithScore
+	blocks     []BlockMax
+	tombstones int
+}
+
+func (l *decodedPostingLayer) Blocks() []BlockMax {
+	return l.blocks
+}
+
+func (l *decodedPostingLayer) Block(pos int) ([]DocPointerWithScore, error) {
+	return l.data, nil
+}
+
+func (l *decodedPostingLayer) Additions() int {
+	return len(l.data) - l.tombstones
+}
+
+func (l *decodedPostingLayer) Tombstones() int {
+	return l.tombstones
+}
+
+func (l *decodedPostingLayer) Decoded() ([]DocPointerWithScore, bool) {
+	return l.data, true
+}
+
+// layerCursor iterates a single layer, decoding only the blocks it lands on
+type layerCursor struct {
+	layer  PostingLayer
+	blocks []BlockMax
+	block  int
+	data   []DocPointerWithScore
+	pos    int
+}
+
+func newLayerCursor(layer PostingLayer) (*layerCursor, error) {
+	c := &layerCursor{layer: layer, blocks: layer.Blocks()}
+	return c, c.load()
+}
+
+func (c *layerCursor) exhausted() bool {
+	return c.block >= len(c.blocks)
+}
+
+func (c *layerCursor) load() error {
+	for !c.exhausted() {
+		data, err := c.layer.Block(c.block)
+		if err != nil {
+			return err
+		}
+		if len(data) > 0 {
+			c.data, c.pos = data, 0
+			return nil
+		}
+		c.block++
+	}
+	c.data, c.pos = nil, 0
+	return nil
+}
+
+func (c *layerCursor) current() DocPointerWithScore {
+	return c.data[c.pos]
+}
+
+func (c *layerCursor) id() uint64 {
+	if c.exhausted() {
+		return math.MaxUint64
+	}
+	return c.data[c.pos].Id
+}
+
+func (c *layerCursor) next() error {
+	c.pos++
+	if c.pos < len(c.data) {
+		return nil
+	}
+	c.block++
+	return c.load()
+}
+
+// advanceTo moves the cursor to the first entry with an id of at least minID.
+// Blocks that end before minID are skipped without being decoded.
+func (c *layerCursor) advanceTo(minID uint64) error {
+	if c.exhausted() || c.id() >= minID {
+		return nil
+	}
+
+	if c.blocks[c.block].MaxId < minID {
+		c.block = c.blockFor(minID)
+		if err := c.load(); err != nil {
+			return err
+		}
+		if c.exhausted() {
+			return nil
+		}
+	}
+
+	c.pos += sort.Search(len(c.data)-c.pos, func(i int) bool {
+		return c.data[c.pos+i].Id >= minID
+	})
+	if c.pos < len(c.data) {
+		return nil
+	}
+	// only reachable if the block metadata is off, continue with the next block
+	c.block++
+	if err := c.load(); err != nil {
+		return err
+	}
+	return c.advanceTo(minID)
+}
+
+// blockFor returns the position of the block that would contain id, starting
+// at the current block
+func (c *layerCursor) blockFor(id uint64) int {
+	return c.block + sort.Search(len(c.blocks)-c.block, func(i int) bool {
+		return c.blocks[c.block+i].MaxId >= id
+	})
+}
+
+// postingCursor merges the layers of a posting list. For documents present
+// in multiple layers the latest layer wins, tombstones remove the document.
+type postingCursor struct {
+	layers  []*layerCursor
+	id      uint64
+	current DocPointerWithScore
+}
+
+func newPostingCursor(layers []PostingLayer) (*postingCursor, error) {
+	p := &postingCursor{layers: make([]*layerCursor, 0, len(layers))}
+	for _, layer := range layers {
+		if len(layer.Blocks()) == 0 {
+			continue
+		}
+		c, err := newLayerCursor(layer)
+		if err != nil {
+			return nil, err
+		}
+		p.layers = append(p.layers, c)
+	}
+	return p, p.settle()
+}
+
+func (p *postingCursor) exhausted() bool {
+	return p.id == math.MaxUint64
+}
+
+// settle positions the cursor on the lowest id that is not deleted
+func (p *postingCursor) settle() error {
+	for {
+		p.id = math.MaxUint64
+		latest := -1
+		for i, l := range p.layers {
+			if id := l.id(); id <= p.id {
+				p.id = id
+				latest = i
+			}
+		}
+		if latest == -1 || p.id == math.MaxUint64 {
+			p.id = math.MaxUint64
+			return nil
+		}
+
+		p.current = p.layers[latest].current()
+		if p.current.Frequency != 0 {
+			return nil
+		}
+		// the latest version is a tombstone, skip the document entirely
+		if err := p.skipCurrent(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *postingCursor) skipCurrent() error {
+	for _, l := range p.layers {
+		if l.id() == p.id {
+			if err := l.next(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *postingCursor) next() error {
+	if p.exhausted() {
+		return nil
+	}
+	if err := p.skipCurrent(); err != nil {
+		return err
+	}
+	return p.settle()
+}
+
+func (p *postingCursor) advanceTo(minID uint64) error {
+	if p.id >= minID {
+		return nil
+	}
+	for _, l := range p.layers {
+		if err := l.advanceTo(minID); err != nil {
+			return err
+		}
+	}
+	return p.settle()
+}
+
+// blockMax bounds the frequency and prop length of all documents from id up
+// to the returned boundary (inclusive)
+func (p *postingCursor) blockMax(id uint64) (maxFrequency, minPropLength float32, boundary uint64, ok bool) {
+	minPropLength = math.MaxFloat32
+	boundary = math.MaxUint64
+	for _, l := range p.layers {
+		if l.exhausted() {
+			continue
+		}
+		pos := l.blockFor(id)
+		if pos >= len(l.blocks) {
+			continue
+		}
+		block := l.blocks[pos]
+		ok = true
+		if block.MaxFrequency > maxFrequency {
+			maxFrequency = block.MaxFrequency
+		}
+		if block.MinPropLength < minPropLength {
+			minPropLength = block.MinPropLength
+		}
+		if block.MaxId < boundary {
+			boundary = block.MaxId
+		}
+	}
+	return
+}
+
+// BlockMaxTerm is a query term whose posting lists (one per property, each
+// consisting of multiple layers) are evaluated lazily. Frequencies and prop
+// lengths of all properties are summed up, like for Term.
+type BlockMaxTerm struct {
+	Idf            float64
+	QueryTerm      string
+	QueryTermIndex int
+
+	props     []*postingCursor
+	id        uint64
+	maxImpact float64
+}
+
+// NewBlockMaxTerm creates a term from the layers of each property
+func NewBlockMaxTerm(propLayers [][]PostingLayer, queryTerm string, queryTermIndex int,
+) (*BlockMaxTerm, error) {
+	t := &BlockMaxTerm{
+		QueryTerm:      queryTerm,
+		QueryTermIndex: queryTermIndex,
+		props:          make([]*postingCursor, 0, len(propLayers)),
+	}
+	for _, layers := range propLayers {
+		p, err := newPostingCursor(layers)
+		if err != nil {
+			return nil, err
+		}
+		if !p.exhausted() {
+			t.props = append(t.props, p)
+		}
+	}
+	t.settle()
+	return t, nil
+}
+
+// DocumentFrequency returns the number of documents containing the term in
+// any of the properties. It is exact if all layers are decoded. Otherwise the
+// frequency of each property is estimated from the number of entries in its
+// layers and the highest one is used, as the union across properties can't
+// be determined without reading all lists.
+func DocumentFrequency(ctx context.Context, propLayers [][]PostingLayer) (n float64, exact bool, err error) {
+	union := map[uint64]struct{}{}
+	exact = true
+	for _, layers := range propLayers {
+		propEstimate := 0
+		decoded := make([][]DocPointerWithScore, 0, len(layers))
+		for _, layer := range layers {
+			propEstimate += layer.Additions() - layer.Tombstones()
+			if data, ok := layer.Decoded(); ok {
+				decoded = append(decoded, data)
+			}
+		}
+
+		propN := float64(propEstimate)
+		if len(decoded) == len(layers) {
+			merged, err := NewSortedDocPointerWithScoreMerger().Do(ctx, decoded)
+			if err != nil {
+				return 0, false, err
+			}
+			propN = float64(len(merged))
+			if exact {
+				for _, d := range merged {
+					union[d.Id] = struct{}{}
+				}
+			}
+		} else {
+			exact = false
+		}
+
+		if propN > n {
+			n = propN
+		}
+	}
+
+	if exact {
+		return float64(len(union)), true, nil
+	}
+	return max(n, 1), false, nil
+}
+
+func (t *BlockMaxTerm) settle() {
+	t.id = math.MaxUint64
+	for _, p := range t.props {
+		if p.id < t.id {
+			t.id = p.id
+		}
+	}
+}
+
+// SetMaxImpact computes the upper bound of the score of any document of the
+// term. It must be called once the Idf is set.
+func (t *BlockMaxTerm) SetMaxImpact(averagePropLength float64, config schema.BM25Config) {
+	maxFrequency, minPropLength := float32(0), float32(math.MaxFloat32)
+	for _, p := range t.props {
+		propMax := float32(0)
+		for _, l := range p.layers {
+			for _, b := range l.blocks {
+				if b.MaxFrequency > propMax {
+					propMax = b.MaxFrequency
+				}
+				if b.MinPropLength < minPropLength {
+					minPropLength = b.MinPropLength
+				}
+			}
+		}
+		maxFrequency += propMax
+	}
+	t.maxImpact = t.impact(maxFrequency, minPropLength, averagePropLength, config)
+}
+
+func (t *BlockMaxTerm) impact(frequency, propLength float32, averagePropLength float64,
+	config schema.BM25Config,
+) float64 {
+	if t.Idf <= 0 {
+		// a negative idf can only lower the score
+		return 0
+	}
+	freq := float64(frequency)
+	if freq <= 0 {
+		return 0
+	}
+	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(propLength)/averagePropLength))
+	return tf * t.Idf
+}
+
+func (t *BlockMaxTerm) Exhausted() bool {
+	return t.id == math.MaxUint64
+}
+
+func (t *BlockMaxTerm) Id() uint64 {
+	return t.id
+}
+
+func (t *BlockMaxTerm) MaxImpact() float64 {
+	return t.maxImpact
+}
+
+// BlockMaxImpact bounds the score of all documents from id up to the returned
+// boundary (inclusive) without decoding any blocks.
+func (t *BlockMaxTerm) BlockMaxImpact(id uint64, averagePropLength float64,
+	config schema.BM25Config,
+) (float64, uint64) {
+	maxFrequency, minPropLength := float32(0), float32(math.MaxFloat32)
+	boundary := uint64(math.MaxUint64)
+	for _, p := range t.props {
+		propMax, propMin, propBoundary, ok := p.blockMax(id)
+		if !ok {
+			continue
+		}
+		maxFrequency += propMax
+		if propMin < minPropLength {
+			minPropLength = propMin
+		}
+		if propBoundary < boundary {
+			boundary = propBoundary
+		}
+	}
+	return t.impact(maxFrequency, minPropLength, averagePropLength, config), boundary
+}
+
+// Score scores the current document, the caller must make sure that the
+// term is positioned on it.
+func (t *BlockMaxTerm) Score(averagePropLength float64, config schema.BM25Config) (float64, DocPointerWithScore) {
+	pair := DocPointerWithScore{Id: t.id}
+	for _, p := range t.props {
+		if p.id == t.id {
+			pair.Frequency += p.current.Frequency
+			pair.PropLength += p.current.PropLength
+		}
+	}
+	freq := float64(pair.Frequency)
+	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(pair.PropLength)/averagePropLength))
+	return tf * t.Idf, pair
+}
+
+func (t *BlockMaxTerm) Next() error {
+	id := t.id
+	for _, p := range t.props {
+		if p.id == id {
+			if err := p.next(); err != nil {
+				return err
+			}
+		}
+	}
+	t.settle()
+	return nil
+}
+
+func (t *BlockMaxTerm) AdvanceTo(minID uint64) error {
+	for _, p := range t.props {
+		if err := p.advanceTo(minID); err != nil {
+			return err
+		}
+	}
+	t.settle()
+	return nil
+}
+
+// PostingIterator iterates the merged layers of a single posting list in
+// order of ids. Blocks of the layers are only decoded once the iterator
+// lands on them, blocks skipped by AdvanceTo are never read.
+type PostingIterator struct {
+	cursor *postingCursor
+}
+
+// NewPostingIterator positions a new iterator on the first document of the
+// layers, which are ordered oldest first
+func NewPostingIterator(layers []PostingLayer) (*PostingIterator, error) {
+	c, err := newPostingCursor(layers)
+	if err != nil {
+		return nil, err
+	}
+	return &PostingIterator{cursor: c}, nil
+}
+
+func (it *PostingIterator) Exhausted() bool {
+	return it.cursor.exhausted()
+}
+
+func (it *PostingIterator) Id() uint64 {
+	return it.cursor.id
+}
+
+// Current returns the latest version of the current document
+func (it *PostingIterator) Current() DocPointerWithScore {
+	return it.cursor.current
+}
+
+func (it *PostingIterator) Next() error {
+	return it.cursor.next()
+}
+
+func (it *PostingIterator) AdvanceTo(minID uint64) error {
+	return it.cursor.advanceTo(minID)
+}
@@ -114,6 +114,12 @@ type Bucket struct {
 	// ON by default
 	calcCountNetAdditions bool
 
+	// Block max index of posting lists, which allows BM25 queries to skip
+	// blocks of documents that can't make it into the results. Only
+	// applicable to map collections holding searchable posting lists.
+	// OFF by default
+	useBlockMax bool
+
 	forceCompaction bool
 
 	// optionally supplied to prevent starting memory-intensive
@@ -188,6 +194,7 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			forceCompaction:       b.forceCompaction,
 			useBloomFilter:        b.useBloomFilter,
 			calcCountNetAdditions: b.calcCountNetAdditions,
+			useBlockMax:           b.useBlockMax,
 			maxSegmentSize:        b.maxSegmentSize,
 			cleanupInterval:       b.segmentsCleanupInterval,
 		}, b.allocChecker)
@@ -1283,3 +1290,83 @@ func (b *Bucket) DocPointerWithScoreList(ctx context.Context, key []byte, propBo
 
 	return terms.NewSortedDocPointerWithScoreMerger().Do(ctx, segments)
 }
+
+// DocPointerWithScoreLayers returns the posting lists of all keys split up by
+// segments and memtables, oldest first. In contrast to
+// DocPointerWithScoreList, lists covered by a block max index are not read
+// upfront, but block by block as they are accessed. The locks of the bucket
+// are only held while the layers are collected. The returned release func
+// must be called once the layers are no longer needed, until then segments
+// replaced by compactions are kept open.
+func (b *Bucket) DocPointerWithScoreLayers(ctx context.Context, keys [][]byte, propBoost float32,
+	cfgs ...MapListOption,
+) ([][]terms.PostingLayer, func(), error) {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+	b.disk.maintenanceLock.RLock()
+	defer b.disk.maintenanceLock.RUnlock()
+
+	c := MapListOptionConfig{}
+	for _, cfg := range cfgs {
+		cfg(&c)
+	}
+
+	decode := func(mem []MapPair) ([]terms.DocPointerWithScore, error) {
+		docPointers := make([]terms.DocPointerWithScore, len(mem))
+		for i, v := range mem {
+			if err := docPointers[i].FromKeyVal(v.Key, v.Value, v.Tombstone, propBoost); err != nil {
+				return nil, err
+			}
+		}
+		return docPointers, nil
+	}
+
+	out := make([][]terms.PostingLayer, len(keys))
+	for i, key := range keys {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+
+		layers, err := b.disk.postingLayers(key, propBoost)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		memtables := []*Memtable{b.active}
+		if b.flushing != nil {
+			memtables = []*Memtable{b.flushing, b.active}
+		}
+		for _, memtable := range memtables {
+			mem, err := memtable.getMap(key)
+			if err != nil && !errors.Is(err, lsmkv.NotFound) {
+				return nil, nil, err
+			}
+			if len(mem) == 0 {
+				continue
+			}
+			docPointers, err := decode(mem)
+			if err != nil {
+				return nil, nil, err
+			}
+			layers = append(layers, terms.NewDecodedPostingLayer(docPointers))
+		}
+
+		if c.legacyRequireManualSorting {
+			// Sort to support segments which were stored in an unsorted fashion,
+			// those are never covered by a block max index
+			for j, layer := range layers {
+				data, ok := layer.Decoded()
+				if !ok {
+					continue
+				}
+				sort.Slice(data, func(a, b int) bool {
+					return data[a].Id < data[b].Id
+				})
+				layers[j] = terms.NewDecodedPostingLayer(data)
+			}
+		}
+		out[i] = layers
+	}
+
+	return out, acquirePostingLayers(out), nil
+}
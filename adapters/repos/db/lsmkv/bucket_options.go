@@ -145,6 +145,13 @@ func WithCalcCountNetAdditions(calcCountNetAdditions bool) BucketOption {
 	}
 }
 
+func WithUseBlockMax(useBlockMax bool) BucketOption {
+	return func(b *Bucket) error {
+		b.useBlockMax = useBlockMax
+		return nil
+	}
+}
+
 func WithMaxSegmentSize(maxSegmentSize int64) BucketOption {
 	return func(b *Bucket) error {
 		b.maxSegmentSize = maxSegmentSize
@@ -267,3 +267,47 @@ func TestBucket_MemtableCountWithFlushing(t *testing.T) {
 		})
 	}
 }
+
+func TestBucket_SnapshotCursor(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, b.Shutdown(context.Background()))
+	})
+
+	require.Nil(t, b.Put([]byte("key-1"), []byte("value-1")))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.Put([]byte("key-2"), []byte("value-2")))
+	require.Nil(t, b.Put([]byte("key-3"), []byte("value-3")))
+
+	cursor := b.SnapshotCursor()
+	defer cursor.Close()
+
+	// changes after the snapshot was taken, including a flush, must not be
+	// visible to the cursor
+	require.Nil(t, b.Put([]byte("key-2"), []byte("updated")))
+	require.Nil(t, b.Delete([]byte("key-3")))
+	require.Nil(t, b.Put([]byte("key-4"), []byte("value-4")))
+	require.Nil(t, b.FlushAndSwitch())
+
+	var keys, values []string
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		keys = append(keys, string(k))
+		values = append(values, string(v))
+	}
+	assert.Equal(t, []string{"key-1", "key-2", "key-3"}, keys)
+	assert.Equal(t, []string{"value-1", "value-2", "value-3"}, values)
+
+	latest := b.Cursor()
+	defer latest.Close()
+	keys = nil
+	for k, _ := latest.First(); k != nil; k, _ = latest.Next() {
+		keys = append(keys, string(k))
+	}
+	assert.Equal(t, []string{"key-1", "key-2", "key-4"}, keys)
+}
@@ -42,6 +42,17 @@ type innerCursorMap interface {
 }
 
 func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
+	return b.mapCursor(false, cfgs...)
+}
+
+// MapCursorKeyOnly iterates the keys of the bucket without decoding their
+// values. Unlike MapCursor it does not skip keys whose values are all
+// deleted.
+func (b *Bucket) MapCursorKeyOnly(cfgs ...MapListOption) *CursorMap {
+	return b.mapCursor(true, cfgs...)
+}
+
+func (b *Bucket) mapCursor(keyOnly bool, cfgs ...MapListOption) *CursorMap {
 	b.flushLock.RLock()
 
 	c := MapListOptionConfig{}
@@ -49,7 +60,7 @@ func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
 		cfg(&c)
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newMapCursors()
+	innerCursors, unlockSegmentGroup := b.disk.newMapCursors(keyOnly)
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -69,15 +80,10 @@ func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
 		// being at the very top
 		innerCursors: innerCursors,
 		listCfg:      c,
+		keyOnly:      keyOnly,
 	}
 }
 
-func (b *Bucket) MapCursorKeyOnly(cfgs ...MapListOption) *CursorMap {
-	c := b.MapCursor(cfgs...)
-	c.keyOnly = true
-	return c
-}
-
 func (c *CursorMap) Seek(ctx context.Context, key []byte) ([]byte, []MapPair) {
 	c.seekAll(key)
 	return c.serveCurrentStateAndAdvance(ctx)
@@ -216,6 +222,13 @@ func (c *CursorMap) mergeDuplicatesInCurrentStateAndAdvance(ctx context.Context,
 	// appending := time.Duration(0)
 	// advancing := time.Duration(0)
 
+	if c.keyOnly {
+		for _, id := range ids {
+			c.advanceInner(id)
+		}
+		return key, nil
+	}
+
 	var perSegmentResults [][]MapPair
 
 	for _, id := range ids {
@@ -247,12 +260,7 @@ func (c *CursorMap) mergeDuplicatesInCurrentStateAndAdvance(ctx context.Context,
 		return c.Next(ctx)
 	}
 
-	// TODO remove keyOnly option, not used anyway
-	if !c.keyOnly {
-		return key, merged
-	} else {
-		return key, nil
-	}
+	return key, merged
 }
 
 func (c *CursorMap) advanceInner(id int) {
@@ -70,6 +70,36 @@ func (b *Bucket) Cursor() *CursorReplace {
 	}
 }
 
+// SnapshotCursor returns a cursor over a point-in-time view of the bucket.
+// Unlike Cursor it does not hold any locks for its lifetime, so writes and
+// flushes continue while it is in use, but are not visible to it. The
+// memtables are copied on creation and the disk segments are referenced
+// directly, therefore the caller has to make sure that compactions are
+// paused (see Store.PauseCompaction) and the bucket is not shut down until
+// the cursor is closed.
+func (b *Bucket) SnapshotCursor() *CursorReplace {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	if b.strategy != StrategyReplace {
+		panic("SnapshotCursor() called on strategy other than 'replace'")
+	}
+
+	innerCursors, unlockSegmentGroup := b.disk.newCursors()
+	defer unlockSegmentGroup()
+
+	if b.flushing != nil {
+		innerCursors = append(innerCursors, b.flushing.newSnapshotCursor())
+	}
+
+	innerCursors = append(innerCursors, b.active.newSnapshotCursor())
+
+	return &CursorReplace{
+		innerCursors: innerCursors,
+		unlock:       func() {},
+	}
+}
+
 // CursorWithSecondaryIndex holds a RLock for the flushing state. It needs to be closed using the
 // .Close() methods or otherwise the lock will never be released
 func (b *Bucket) CursorWithSecondaryIndex(pos int) *CursorReplace {
@@ -51,6 +51,29 @@ func (m *Memtable) newCursor() innerCursorReplace {
 	}
 }
 
+// newSnapshotCursor copies the nodes of the memtable, so that later writes
+// to the memtable are not visible to the cursor. Keys and values are not
+// copied as they are never modified in place.
+func (m *Memtable) newSnapshotCursor() innerCursorReplace {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := m.key.flattenInOrder()
+	data := make([]*binarySearchNode, len(nodes))
+	for i, n := range nodes {
+		data[i] = &binarySearchNode{key: n.key, value: n.value, tombstone: n.tombstone}
+	}
+
+	return &memtableCursor{
+		data: data,
+		keyFn: func(n *binarySearchNode) []byte {
+			return n.key
+		},
+		lock:   func() {},
+		unlock: func() {},
+	}
+}
+
 func (m *Memtable) newCursorWithSecondaryIndex(pos int) innerCursorReplace {
 	// This cursor is a really primitive approach, it actually requires
 	// flattening the entire memtable - even if the cursor were to point to the
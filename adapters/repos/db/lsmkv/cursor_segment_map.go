@@ -21,20 +21,23 @@ import (
 type segmentCursorMap struct {
 	segment    *segment
 	nextOffset uint64
+	// keyOnly skips decoding the values of a key
+	keyOnly bool
 }
 
-func (s *segment) newMapCursor() *segmentCursorMap {
+func (s *segment) newMapCursor(keyOnly bool) *segmentCursorMap {
 	return &segmentCursorMap{
 		segment: s,
+		keyOnly: keyOnly,
 	}
 }
 
-func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func()) {
+func (sg *SegmentGroup) newMapCursors(keyOnly bool) ([]innerCursorMap, func()) {
 	sg.maintenanceLock.RLock()
 	out := make([]innerCursorMap, len(sg.segments))
 
 	for i, segment := range sg.segments {
-		out[i] = segment.newMapCursor()
+		out[i] = segment.newMapCursor(keyOnly)
 	}
 
 	return out, sg.maintenanceLock.RUnlock
@@ -55,12 +58,9 @@ func (s *segmentCursorMap) seek(key []byte) ([]byte, []MapPair, error) {
 		return parsed.primaryKey, nil, err
 	}
 
-	pairs := make([]MapPair, len(parsed.values))
-	for i := range pairs {
-		if err := pairs[i].FromBytes(parsed.values[i].value, false); err != nil {
-			return nil, nil, err
-		}
-		pairs[i].Tombstone = parsed.values[i].tombstone
+	pairs, err := s.decodePairs(parsed)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return parsed.primaryKey, pairs, nil
@@ -80,12 +80,9 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 		return parsed.primaryKey, nil, err
 	}
 
-	pairs := make([]MapPair, len(parsed.values))
-	for i := range pairs {
-		if err := pairs[i].FromBytes(parsed.values[i].value, false); err != nil {
-			return nil, nil, err
-		}
-		pairs[i].Tombstone = parsed.values[i].tombstone
+	pairs, err := s.decodePairs(parsed)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return parsed.primaryKey, pairs, nil
@@ -108,15 +105,27 @@ func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 		return parsed.primaryKey, nil, err
 	}
 
+	pairs, err := s.decodePairs(parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parsed.primaryKey, pairs, nil
+}
+
+func (s *segmentCursorMap) decodePairs(parsed segmentCollectionNode) ([]MapPair, error) {
+	if s.keyOnly {
+		return nil, nil
+	}
+
 	pairs := make([]MapPair, len(parsed.values))
 	for i := range pairs {
 		if err := pairs[i].FromBytes(parsed.values[i].value, false); err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		pairs[i].Tombstone = parsed.values[i].tombstone
 	}
-
-	return parsed.primaryKey, pairs, nil
+	return pairs, nil
 }
 
 func (s *segmentCursorMap) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
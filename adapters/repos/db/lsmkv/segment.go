@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/sirupsen/logrus"
@@ -53,6 +54,15 @@ type segment struct {
 	// the net addition this segment adds with respect to all previous segments
 	calcCountNetAdditions bool // see bucket for more datails
 	countNetAdditions     int
+
+	useBlockMax bool // see bucket for more datails
+	blockMax    map[string]*segmentBlockMax
+
+	// readers counts the users of the segment outside of the maintenance lock
+	// of its segment group, see acquire
+	readersLock  sync.Mutex
+	readers      int
+	closePending bool
 }
 
 type diskIndex interface {
@@ -77,7 +87,8 @@ type diskIndex interface {
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	existsLower existsOnLowerSegmentsFn, mmapContents bool,
-	useBloomFilter bool, calcCountNetAdditions bool, overwriteDerived bool,
+	useBloomFilter bool, calcCountNetAdditions bool, useBlockMax bool,
+	overwriteDerived bool,
 ) (_ *segment, err error) {
 	defer func() {
 		p := recover()
@@ -137,6 +148,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		mmapContents:          mmapContents,
 		useBloomFilter:        useBloomFilter,
 		calcCountNetAdditions: calcCountNetAdditions,
+		useBlockMax:           useBlockMax,
 	}
 
 	// Using pread strategy requires file to remain open for segment lifetime
@@ -167,6 +179,11 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 			return nil, err
 		}
 	}
+	if seg.useBlockMax {
+		if err := seg.initBlockMax(overwriteDerived); err != nil {
+			return nil, err
+		}
+	}
 
 	return seg, nil
 }
@@ -187,6 +204,50 @@ func (s *segment) close() error {
 	return nil
 }
 
+// acquire registers a reader which keeps using the segment after the
+// maintenance lock of its segment group is released. It must be called while
+// the lock is held. The segment is not closed before the reader calls
+// release, even if it is replaced by a compaction in the meantime.
+func (s *segment) acquire() {
+	s.readersLock.Lock()
+	defer s.readersLock.Unlock()
+
+	s.readers++
+}
+
+// release unregisters a reader. The last reader of a segment which was
+// replaced in the meantime closes it.
+func (s *segment) release() {
+	s.readersLock.Lock()
+	defer s.readersLock.Unlock()
+
+	s.readers--
+	if s.readers > 0 || !s.closePending {
+		return
+	}
+	s.closePending = false
+	if err := s.close(); err != nil {
+		s.logger.WithField("action", "lsm_release_segment").
+			WithField("path", s.path).
+			WithError(err).
+			Error("close released segment")
+	}
+}
+
+// closeWhenReleased closes the segment right away if it has no readers,
+// otherwise it is closed by the last reader. Its files can still be renamed
+// or removed in the meantime.
+func (s *segment) closeWhenReleased() error {
+	s.readersLock.Lock()
+	defer s.readersLock.Unlock()
+
+	if s.readers > 0 {
+		s.closePending = true
+		return nil
+	}
+	return s.close()
+}
+
 func (s *segment) dropImmediately() error {
 	// support for persisting bloom filters and cnas was added in v1.17,
 	// therefore the files may not be present on segments created with previous
@@ -206,6 +267,10 @@ func (s *segment) dropImmediately() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.blockMaxPath()); err != nil {
+		return fmt.Errorf("drop block max index file: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -235,6 +300,10 @@ func (s *segment) dropMarked() error {
 		return fmt.Errorf("drop previously marked count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.blockMaxPath() + DeleteMarkerSuffix); err != nil {
+		return fmt.Errorf("drop previously marked block max index file: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -275,6 +344,12 @@ func (s *segment) markForDeletion() error {
 		}
 	}
 
+	if err := markDeleted(s.blockMaxPath()); err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("mark block max index file deleted: %w", err)
+		}
+	}
+
 	// for the segment itself, we're not accepting a NotExists error. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
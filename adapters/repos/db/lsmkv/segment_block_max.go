@@ -0,0 +1,408 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// blockMaxBlockSize is the number of posting list entries summarized by a
+// single block. Lists that fit into a single block are not indexed, as they
+// are read entirely anyway.
+const blockMaxBlockSize = 128
+
+// segmentBlockMax is the block max index of a single posting list (key) of a
+// segment. It allows to bound the BM25 score of each block and to only read
+// the blocks which can contain a competitive document.
+type segmentBlockMax struct {
+	additions  int
+	tombstones int
+	blocks     []terms.BlockMax
+	offsets    []nodeOffset
+}
+
+func (s *segment) blockMaxPath() string {
+	extless := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return fmt.Sprintf("%s.blockmax", extless)
+}
+
+func (s *segment) initBlockMax(overwrite bool) error {
+	if s.strategy != segmentindex.StrategyMapCollection {
+		// posting lists are only stored in map collections
+		return nil
+	}
+
+	path := s.blockMaxPath()
+
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		if overwrite {
+			err := os.Remove(path)
+			if err != nil {
+				return fmt.Errorf("delete existing block max index %s: %w", path, err)
+			}
+		} else {
+			err = s.loadBlockMaxFromDisk()
+			if err == nil {
+				return nil
+			}
+
+			if !errors.Is(err, ErrInvalidChecksum) {
+				// not a recoverable error
+				return err
+			}
+
+			// now continue re-calculating
+		}
+	}
+
+	before := time.Now()
+
+	if err := s.computeAndStoreBlockMax(path); err != nil {
+		return err
+	}
+
+	took := time.Since(before)
+	s.logger.WithField("action", "lsm_init_disk_segment_build_block_max").
+		WithField("path", s.path).
+		WithField("took", took).
+		Debugf("building block max index took %s\n", took)
+
+	return nil
+}
+
+func (s *segment) precomputeBlockMax() ([]string, error) {
+	if s.strategy != segmentindex.StrategyMapCollection {
+		return []string{}, nil
+	}
+
+	path := fmt.Sprintf("%s.tmp", s.blockMaxPath())
+	ok, err := fileExists(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("delete existing temp block max index %s: %w", path, err)
+		}
+	}
+
+	if err := s.computeAndStoreBlockMax(path); err != nil {
+		return nil, err
+	}
+
+	return []string{path}, nil
+}
+
+func (s *segment) computeAndStoreBlockMax(path string) error {
+	keys, err := s.index.AllKeys()
+	if err != nil {
+		return err
+	}
+
+	s.blockMax = map[string]*segmentBlockMax{}
+	countBuf := make([]byte, 8)
+	for _, key := range keys {
+		node, err := s.index.Get(key)
+		if err != nil {
+			return fmt.Errorf("get node of key %q: %w", key, err)
+		}
+		if node.End-node.Start < 8 {
+			continue
+		}
+		if err := s.copyNode(countBuf, nodeOffset{node.Start, node.Start + 8}); err != nil {
+			return err
+		}
+		if binary.LittleEndian.Uint64(countBuf) <= blockMaxBlockSize {
+			continue
+		}
+
+		contents := make([]byte, node.End-node.Start)
+		if err := s.copyNode(contents, nodeOffset{node.Start, node.End}); err != nil {
+			return err
+		}
+		bm, err := computeSegmentBlockMax(contents, node.Start)
+		if err != nil {
+			return fmt.Errorf("compute block max of key %q: %w", key, err)
+		}
+		s.blockMax[string(key)] = bm
+	}
+
+	if err := s.storeBlockMaxOnDisk(path); err != nil {
+		return fmt.Errorf("store block max index on disk: %w", err)
+	}
+
+	return nil
+}
+
+// computeSegmentBlockMax splits a collection node into blocks. The offsets
+// are absolute positions in the segment, start is the position of the node.
+func computeSegmentBlockMax(in []byte, start uint64) (*segmentBlockMax, error) {
+	bm := &segmentBlockMax{}
+
+	valuesLen := binary.LittleEndian.Uint64(in[0:8])
+	offset := uint64(8)
+
+	var block terms.BlockMax
+	blockStart := offset
+	for i := uint64(0); i < valuesLen; i++ {
+		if i%blockMaxBlockSize == 0 {
+			block = terms.BlockMax{MinPropLength: math.MaxFloat32}
+			blockStart = offset
+		}
+
+		tombstone := in[offset] == 0x01
+		valueLen := binary.LittleEndian.Uint64(in[offset+1 : offset+9])
+		offset += 9
+
+		var d terms.DocPointerWithScore
+		if err := d.FromBytes(in[offset:offset+valueLen], tombstone, 1); err != nil {
+			return nil, err
+		}
+		offset += valueLen
+
+		block.MaxId = d.Id
+		if tombstone || d.Frequency == 0 {
+			bm.tombstones++
+		} else {
+			bm.additions++
+			if d.Frequency > block.MaxFrequency {
+				block.MaxFrequency = d.Frequency
+			}
+			if d.PropLength < block.MinPropLength {
+				block.MinPropLength = d.PropLength
+			}
+		}
+
+		if (i+1)%blockMaxBlockSize == 0 || i+1 == valuesLen {
+			bm.blocks = append(bm.blocks, block)
+			bm.offsets = append(bm.offsets, nodeOffset{start + blockStart, start + offset})
+		}
+	}
+
+	return bm, nil
+}
+
+func (s *segment) storeBlockMaxOnDisk(path string) error {
+	buf := new(bytes.Buffer)
+
+	write := func(v any) {
+		// writing to a bytes.Buffer can't fail
+		_ = binary.Write(buf, binary.LittleEndian, v)
+	}
+
+	write(uint32(len(s.blockMax)))
+	for key, bm := range s.blockMax {
+		write(uint32(len(key)))
+		buf.WriteString(key)
+		write(uint32(bm.additions))
+		write(uint32(bm.tombstones))
+		write(uint32(len(bm.blocks)))
+		for i, block := range bm.blocks {
+			write(bm.offsets[i].start)
+			write(bm.offsets[i].end)
+			write(block.MaxId)
+			write(block.MaxFrequency)
+			write(block.MinPropLength)
+		}
+	}
+
+	return writeWithChecksum(buf.Bytes(), path)
+}
+
+func (s *segment) loadBlockMaxFromDisk() error {
+	data, err := loadWithChecksum(s.blockMaxPath(), -1)
+	if err != nil {
+		return err
+	}
+
+	r := bytes.NewReader(data)
+	read := func(v any) {
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, v)
+		}
+	}
+
+	var keys uint32
+	read(&keys)
+	blockMax := make(map[string]*segmentBlockMax, keys)
+	for i := uint32(0); i < keys && err == nil; i++ {
+		var keyLen, additions, tombstones, blocks uint32
+		read(&keyLen)
+		key := make([]byte, keyLen)
+		read(key)
+		read(&additions)
+		read(&tombstones)
+		read(&blocks)
+		bm := &segmentBlockMax{
+			additions:  int(additions),
+			tombstones: int(tombstones),
+			blocks:     make([]terms.BlockMax, blocks),
+			offsets:    make([]nodeOffset, blocks),
+		}
+		for j := range bm.blocks {
+			read(&bm.offsets[j].start)
+			read(&bm.offsets[j].end)
+			read(&bm.blocks[j].MaxId)
+			read(&bm.blocks[j].MaxFrequency)
+			read(&bm.blocks[j].MinPropLength)
+		}
+		blockMax[string(key)] = bm
+	}
+	if err != nil {
+		return fmt.Errorf("read block max index from disk: %w", err)
+	}
+
+	s.blockMax = blockMax
+	return nil
+}
+
+// postingLayer returns the posting list of key in this segment. If the list
+// is covered by the block max index, its blocks are only read on demand.
+func (s *segment) postingLayer(key []byte, propBoost float32) (terms.PostingLayer, error) {
+	if bm, ok := s.blockMax[string(key)]; ok {
+		blocks := make([]terms.BlockMax, len(bm.blocks))
+		for i, block := range bm.blocks {
+			blocks[i] = block
+			blocks[i].MaxFrequency *= propBoost
+		}
+		return &segmentPostingLayer{
+			segment:   s,
+			meta:      bm,
+			blocks:    blocks,
+			propBoost: propBoost,
+		}, nil
+	}
+
+	values, err := s.getCollection(key)
+	if err != nil {
+		return nil, err
+	}
+	decoded := make([]terms.DocPointerWithScore, len(values))
+	for i, v := range values {
+		if err := decoded[i].FromBytes(v.value, v.tombstone, propBoost); err != nil {
+			return nil, err
+		}
+	}
+	return terms.NewDecodedPostingLayer(decoded), nil
+}
+
+// segmentPostingLayer reads the blocks of a posting list lazily. It must only
+// be used while the segment group's maintenance lock is held or the segment
+// is acquired.
+type segmentPostingLayer struct {
+	segment   *segment
+	meta      *segmentBlockMax
+	blocks    []terms.BlockMax
+	propBoost float32
+}
+
+func (l *segmentPostingLayer) Blocks() []terms.BlockMax {
+	return l.blocks
+}
+
+func (l *segmentPostingLayer) Block(pos int) ([]terms.DocPointerWithScore, error) {
+	offset := l.meta.offsets[pos]
+	in := make([]byte, offset.end-offset.start)
+	if err := l.segment.copyNode(in, offset); err != nil {
+		return nil, fmt.Errorf("read block %d: %w", pos, err)
+	}
+
+	out := make([]terms.DocPointerWithScore, 0, blockMaxBlockSize)
+	for read := uint64(0); read < uint64(len(in)); {
+		tombstone := in[read] == 0x01
+		valueLen := binary.LittleEndian.Uint64(in[read+1 : read+9])
+		read += 9
+
+		var d terms.DocPointerWithScore
+		if err := d.FromBytes(in[read:read+valueLen], tombstone, l.propBoost); err != nil {
+			return nil, err
+		}
+		read += valueLen
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (l *segmentPostingLayer) Additions() int {
+	return l.meta.additions
+}
+
+func (l *segmentPostingLayer) Tombstones() int {
+	return l.meta.tombstones
+}
+
+func (l *segmentPostingLayer) Decoded() ([]terms.DocPointerWithScore, bool) {
+	return nil, false
+}
+
+// postingLayers returns the layers of all segments containing key, oldest
+// first. The caller must hold the maintenance lock for as long as the layers
+// are used, or acquire the segments of the lazily read layers, see
+// acquirePostingLayers.
+func (sg *SegmentGroup) postingLayers(key []byte, propBoost float32,
+) ([]terms.PostingLayer, error) {
+	out := make([]terms.PostingLayer, 0, len(sg.segments))
+	for _, segment := range sg.segments {
+		layer, err := segment.postingLayer(key, propBoost)
+		if err != nil {
+			if errors.Is(err, lsmkv.NotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, layer)
+	}
+	return out, nil
+}
+
+// acquirePostingLayers acquires the segments read by the lazily read layers,
+// so they can be used after the maintenance lock is released. The returned
+// func releases the segments again. The caller must hold the maintenance
+// lock.
+func acquirePostingLayers(layers [][]terms.PostingLayer) func() {
+	var segments []*segment
+	for _, keyLayers := range layers {
+		for _, layer := range keyLayers {
+			l, ok := layer.(*segmentPostingLayer)
+			if !ok || slices.Contains(segments, l.segment) {
+				continue
+			}
+			l.segment.acquire()
+			segments = append(segments, l.segment)
+		}
+	}
+
+	return func() {
+		for _, s := range segments {
+			s.release()
+		}
+	}
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func postingPair(docID uint64, frequency, propLength float32) MapPair {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(frequency))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(propLength))
+	return MapPair{Key: key, Value: value}
+}
+
+func TestBucketDocPointerWithScoreLayers(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func() *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyMapCollection), WithUseBlockMax(true))
+		require.Nil(t, err)
+		return b
+	}
+
+	b := newBucket()
+	for i := uint64(0); i < 300; i++ {
+		require.Nil(t, b.MapSet([]byte("common"), postingPair(i*2, float32(1+i%5), float32(10+i%7))))
+	}
+	require.Nil(t, b.MapSet([]byte("rare"), postingPair(3, 2, 10)))
+	require.Nil(t, b.FlushMemtable())
+
+	// a newer layer deleting and updating documents of the segment
+	require.Nil(t, b.MapDeleteKey([]byte("common"), postingPair(4, 0, 0).Key))
+	require.Nil(t, b.MapSet([]byte("common"), postingPair(6, 9, 3)))
+
+	entries, err := os.ReadDir(dirName)
+	require.Nil(t, err)
+	_, ok := findFileWithExt(entries, ".blockmax")
+	require.True(t, ok)
+
+	// iterating the layers yields the same list as reading it entirely
+	assertMerged := func(t *testing.T, b *Bucket, layers []terms.PostingLayer) {
+		term, err := terms.NewBlockMaxTerm([][]terms.PostingLayer{layers}, "common", 0)
+		require.Nil(t, err)
+		expected, err := b.DocPointerWithScoreList(ctx, []byte("common"), 2)
+		require.Nil(t, err)
+		var actual []terms.DocPointerWithScore
+		for !term.Exhausted() {
+			_, pair := term.Score(10, schema.BM25Config{K1: 1.2, B: 0.75})
+			actual = append(actual, pair)
+			require.Nil(t, term.Next())
+		}
+		assert.Equal(t, expected, actual)
+	}
+
+	assertLayers := func(t *testing.T, b *Bucket) {
+		layers, release, err := b.DocPointerWithScoreLayers(ctx,
+			[][]byte{[]byte("common"), []byte("rare"), []byte("missing")}, 2)
+		require.Nil(t, err)
+		defer release()
+		require.Len(t, layers, 3)
+
+		common := layers[0]
+		require.Len(t, common, 2)
+		_, decoded := common[0].Decoded()
+		assert.False(t, decoded, "the segment is covered by the block max index")
+		require.Len(t, common[0].Blocks(), 3)
+		assert.Equal(t, uint64(254), common[0].Blocks()[0].MaxId)
+		assert.Equal(t, float32(10), common[0].Blocks()[0].MaxFrequency, "boost is applied")
+		assert.Equal(t, float32(10), common[0].Blocks()[0].MinPropLength)
+		assert.Equal(t, 300, common[0].Additions())
+
+		block, err := common[0].Block(1)
+		require.Nil(t, err)
+		require.Len(t, block, 128)
+		assert.Equal(t, uint64(256), block[0].Id)
+
+		_, decoded = layers[1][0].Decoded()
+		assert.True(t, decoded, "short lists are not covered by the block max index")
+
+		assertMerged(t, b, common)
+
+		assert.Empty(t, layers[2])
+	}
+
+	t.Run("after flush", func(t *testing.T) {
+		assertLayers(t, b)
+	})
+
+	t.Run("after loading from disk", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+		b = newBucket()
+		assertLayers(t, b)
+	})
+
+	t.Run("after compaction", func(t *testing.T) {
+		defer b.Shutdown(ctx)
+
+		held, releaseHeld, err := b.DocPointerWithScoreLayers(ctx, [][]byte{[]byte("common")}, 2)
+		require.Nil(t, err)
+		require.Len(t, held[0], 2)
+		replaced := held[0][0].(*segmentPostingLayer).segment
+
+		// layers in use don't block compactions, the replaced segments are
+		// kept open until the layers are released
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		assert.True(t, replaced.closePending)
+		assertMerged(t, b, held[0])
+		releaseHeld()
+		assert.False(t, replaced.closePending)
+
+		layers, release, err := b.DocPointerWithScoreLayers(ctx, [][]byte{[]byte("common")}, 2)
+		require.Nil(t, err)
+		defer release()
+
+		require.Len(t, layers[0], 1)
+		_, decoded := layers[0][0].Decoded()
+		assert.False(t, decoded, "the compacted segment is covered by the block max index")
+		assertMerged(t, b, layers[0])
+	})
+}
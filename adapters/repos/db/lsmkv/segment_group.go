@@ -67,6 +67,7 @@ type SegmentGroup struct {
 	keepTombstones          bool // see bucket for more datails
 	useBloomFilter          bool // see bucket for more datails
 	calcCountNetAdditions   bool // see bucket for more datails
+	useBlockMax             bool // see bucket for more datails
 	compactLeftOverSegments bool // see bucket for more datails
 
 	allocChecker   memwatch.AllocChecker
@@ -87,6 +88,7 @@ type sgConfig struct {
 	keepTombstones        bool
 	useBloomFilter        bool
 	calcCountNetAdditions bool
+	useBlockMax           bool
 	forceCompaction       bool
 	maxSegmentSize        int64
 	cleanupInterval       time.Duration
@@ -114,6 +116,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		keepTombstones:          cfg.keepTombstones,
 		useBloomFilter:          cfg.useBloomFilter,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
+		useBlockMax:             cfg.useBlockMax,
 		compactLeftOverSegments: cfg.forceCompaction,
 		maxSegmentSize:          cfg.maxSegmentSize,
 		cleanupInterval:         cfg.cleanupInterval,
@@ -192,7 +195,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			// there is no need of bloom filters nor net addition counter re-calculation
 			rightSegment, err := newSegment(rightSegmentPath, logger,
 				metrics, sg.makeExistsOnLower(segmentIndex),
-				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 			if err != nil {
 				return nil, fmt.Errorf("init already compacted right segment %s: %w", rightSegmentFilename, err)
 			}
@@ -234,7 +237,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(rightSegmentPath, logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, true)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", rightSegmentFilename, err)
 		}
@@ -298,7 +301,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(filepath.Join(sg.dir, entry.Name()), logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
 		}
@@ -351,7 +354,7 @@ func (sg *SegmentGroup) add(path string) error {
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger,
 		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, true)
 	if err != nil {
 		return fmt.Errorf("init segment %s: %w", path, err)
 	}
@@ -582,7 +585,7 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	defer sg.maintenanceLock.Unlock()
 
 	for i, seg := range sg.segments {
-		if err := seg.close(); err != nil {
+		if err := seg.closeWhenReleased(); err != nil {
 			return err
 		}
 
@@ -574,7 +574,7 @@ func (sg *SegmentGroup) replaceSegment(segmentIdx int, tmpSegmentPath string,
 	countNetAdditions := oldSegment.countNetAdditions
 
 	precomputedFiles, err := preComputeSegmentMeta(tmpSegmentPath, countNetAdditions,
-		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax)
 	if err != nil {
 		return nil, fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -605,7 +605,7 @@ func (sg *SegmentGroup) replaceSegmentBlocking(
 
 	start := time.Now()
 
-	if err := oldSegment.close(); err != nil {
+	if err := oldSegment.closeWhenReleased(); err != nil {
 		return nil, fmt.Errorf("close disk segment %q: %w", oldSegment.path, err)
 	}
 	if err := oldSegment.markForDeletion(); err != nil {
@@ -632,7 +632,7 @@ func (sg *SegmentGroup) replaceSegmentBlocking(
 	}
 
 	newSegment, err := newSegment(segmentPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 	if err != nil {
 		return nil, fmt.Errorf("create new segment %q: %w", newSegment.path, err)
 	}
@@ -339,7 +339,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	// WIP: we could add a random suffix to the tmp file to avoid conflicts
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
 		updatedCountNetAdditions, sg.logger,
-		sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax)
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -396,11 +396,11 @@ func (sg *SegmentGroup) replaceCompactedSegmentsBlocking(
 	leftSegment := sg.segments[old1]
 	rightSegment := sg.segments[old2]
 
-	if err := leftSegment.close(); err != nil {
+	if err := leftSegment.closeWhenReleased(); err != nil {
 		return nil, nil, errors.Wrap(err, "close disk segment")
 	}
 
-	if err := rightSegment.close(); err != nil {
+	if err := rightSegment.closeWhenReleased(); err != nil {
 		return nil, nil, errors.Wrap(err, "close disk segment")
 	}
 
@@ -437,7 +437,7 @@ func (sg *SegmentGroup) replaceCompactedSegmentsBlocking(
 	}
 
 	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "create new segment")
 	}
@@ -29,6 +29,7 @@ import (
 // segments that might have a similar name.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	logger logrus.FieldLogger, useBloomFilter bool, calcCountNetAdditions bool,
+	useBlockMax bool,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -87,6 +88,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		secondaryIndexCount:   header.SecondaryIndices,
 		segmentStartPos:       header.IndexStart,
 		segmentEndPos:         uint64(fileInfo.Size()),
+		size:                  fileInfo.Size(),
 		strategy:              header.Strategy,
 		dataStartPos:          segmentindex.HeaderSize, // fixed value that's the same for all strategies
 		dataEndPos:            header.IndexStart,
@@ -94,6 +96,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		logger:                logger,
 		useBloomFilter:        useBloomFilter,
 		calcCountNetAdditions: calcCountNetAdditions,
+		useBlockMax:           useBlockMax,
 	}
 
 	if seg.secondaryIndexCount > 0 {
@@ -121,6 +124,13 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}
 		out = append(out, files...)
 	}
+	if seg.useBlockMax {
+		files, err := seg.precomputeBlockMax()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, files...)
+	}
 
 	return out, nil
 }
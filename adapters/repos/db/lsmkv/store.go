@@ -499,6 +499,44 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// AdoptBucket moves the bucket files found in dir into the store under the
+// given bucket name. The bucket in dir must not be loaded by any other store.
+// If a bucket with the same name is already registered, it is shut down and
+// its files are deleted first. The adopted bucket is not loaded, callers are
+// expected to load it with [Store.CreateOrLoadBucket] using its usual options.
+func (s *Store) AdoptBucket(ctx context.Context, bucketName, dir string) error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: adopting bucket %q from %q in store %q", ErrAlreadyClosed, bucketName, dir, s.dir)
+	}
+
+	s.bucketsLocks.Lock(bucketName)
+	defer s.bucketsLocks.Unlock(bucketName)
+
+	s.bucketAccessLock.Lock()
+	bucket := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if bucket != nil {
+		if err := bucket.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+		}
+	}
+
+	bucketDir := s.bucketDir(bucketName)
+	if err := os.RemoveAll(bucketDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucketDir)
+	}
+	if err := os.Rename(dir, bucketDir); err != nil {
+		return errors.Wrapf(err, "failed moving bucket dir '%s' to '%s'", dir, bucketDir)
+	}
+
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
@@ -14,6 +14,7 @@ package lsmkv
 import (
 	"context"
 	"os"
+	"path"
 	"sync"
 	"testing"
 
@@ -110,3 +111,41 @@ func TestCreateBucketConcurrency(t *testing.T) {
 	mockBucketCreator.AssertNumberOfCalls(t, "NewBucket", 1)
 	mockBucketCreator.AssertExpectations(t)
 }
+
+func TestAdoptBucket(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newStore := func(dir string) *Store {
+		store, err := New(dir, dir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		return store
+	}
+
+	srcDir := t.TempDir()
+	src := newStore(srcDir)
+	require.Nil(t, src.CreateOrLoadBucket(ctx, "bucket", WithStrategy(StrategyReplace)))
+	require.Nil(t, src.Bucket("bucket").Put([]byte("key"), []byte("new")))
+	require.Nil(t, src.Shutdown(ctx))
+
+	dst := newStore(t.TempDir())
+	require.Nil(t, dst.CreateOrLoadBucket(ctx, "bucket", WithStrategy(StrategyReplace)))
+	require.Nil(t, dst.Bucket("bucket").Put([]byte("key"), []byte("old")))
+	require.Nil(t, dst.Bucket("bucket").Put([]byte("other"), []byte("old")))
+
+	require.Nil(t, dst.AdoptBucket(ctx, "bucket", path.Join(srcDir, "bucket")))
+	require.Nil(t, dst.Bucket("bucket"))
+	require.NoDirExists(t, path.Join(srcDir, "bucket"))
+
+	require.Nil(t, dst.CreateOrLoadBucket(ctx, "bucket", WithStrategy(StrategyReplace)))
+	val, err := dst.Bucket("bucket").Get([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("new"), val)
+	val, err = dst.Bucket("bucket").Get([]byte("other"))
+	require.Nil(t, err)
+	require.Nil(t, val)
+	require.Nil(t, dst.Shutdown(ctx))
+}
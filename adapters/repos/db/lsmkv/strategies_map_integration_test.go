@@ -48,6 +48,13 @@ func TestMapCollectionStrategy(t *testing.T) {
 				WithStrategy(StrategyMapCollection),
 			},
 		},
+		{
+			name: "mapKeyOnlyCursors",
+			f:    mapKeyOnlyCursors,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+			},
+		},
 	}
 	tests.run(ctx, t)
 }
@@ -1261,3 +1268,59 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 		})
 	})
 }
+
+func mapKeyOnlyCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	for _, row := range []string{"row-a", "row-b", "row-c"} {
+		require.Nil(t, b.MapSet([]byte(row), MapPair{
+			Key:   []byte(row + "-key"),
+			Value: []byte(row + "-value"),
+		}))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+	// the only value of row-b is deleted in the memtable, row-c is updated
+	require.Nil(t, b.MapDeleteKey([]byte("row-b"), []byte("row-b-key")))
+	require.Nil(t, b.MapSet([]byte("row-c"), MapPair{
+		Key:   []byte("row-c-key"),
+		Value: []byte("row-c-value-updated"),
+	}))
+
+	read := func(c *CursorMap) (keys []string, values [][]MapPair) {
+		defer c.Close()
+		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
+			keys = append(keys, string(k))
+			values = append(values, v)
+		}
+		return keys, values
+	}
+
+	t.Run("key only cursor returns every key without values", func(t *testing.T) {
+		keys, values := read(b.MapCursorKeyOnly())
+		assert.Equal(t, []string{"row-a", "row-b", "row-c"}, keys)
+		assert.Equal(t, [][]MapPair{nil, nil, nil}, values)
+	})
+
+	t.Run("seek with key only cursor", func(t *testing.T) {
+		c := b.MapCursorKeyOnly()
+		defer c.Close()
+		k, v := c.Seek(ctx, []byte("row-b"))
+		assert.Equal(t, "row-b", string(k))
+		assert.Nil(t, v)
+	})
+
+	t.Run("regular cursor skips keys whose values are all deleted", func(t *testing.T) {
+		keys, values := read(b.MapCursor())
+		assert.Equal(t, []string{"row-a", "row-c"}, keys)
+		require.Len(t, values, 2)
+		assert.Equal(t, []byte("row-c-value-updated"), values[1][0].Value)
+	})
+}
@@ -20,9 +20,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -110,6 +113,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			ResourceUsage:                  m.db.config.ResourceUsage,
 			QueryMaximumResults:            m.db.config.QueryMaximumResults,
 			QueryNestedRefLimit:            m.db.config.QueryNestedRefLimit,
+			QueryTermExpansionScanLimit:    m.db.config.QueryTermExpansionScanLimit,
 			MemtablesFlushDirtyAfter:       m.db.config.MemtablesFlushDirtyAfter,
 			MemtablesInitialSizeMB:         m.db.config.MemtablesInitialSizeMB,
 			MemtablesMaxSizeMB:             m.db.config.MemtablesMaxSizeMB,
@@ -119,6 +123,10 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			SeparateObjectsCompactions:     m.db.config.SeparateObjectsCompactions,
 			MaxSegmentSize:                 m.db.config.MaxSegmentSize,
 			HNSWMaxLogSize:                 m.db.config.HNSWMaxLogSize,
+			ChangeFeedEnabled:              m.db.config.ChangeFeedEnabled,
+			ChangeFeedRetention:            m.db.config.ChangeFeedRetention,
+			ObjectsTTLDeleteInterval:       m.db.config.ObjectsTTLDeleteInterval,
+			VectorRecallMonitoring:         m.db.config.VectorRecallMonitoring,
 			HNSWWaitForCachePrefill:        m.db.config.HNSWWaitForCachePrefill,
 			HNSWFlatSearchConcurrency:      m.db.config.HNSWFlatSearchConcurrency,
 			VisitedListPoolMaxSize:         m.db.config.VisitedListPoolMaxSize,
@@ -128,6 +136,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			ForceFullReplicasSearch:        m.db.config.ForceFullReplicasSearch,
 			ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 			AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
+			AsyncReplicationPaused:         class.ReplicationConfig.AsyncPaused,
 			DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
 		},
 		shardState,
@@ -598,6 +607,24 @@ func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
 	return idx.updateVectorIndexConfigs(ctx, updated)
 }
 
+// ReindexVectorIndex rebuilds the vector index of the target vector with the
+// updated config in all local shards. The rebuild runs in the background.
+func (m *Migrator) ReindexVectorIndex(ctx context.Context,
+	className, targetVector string, updated schemaConfig.VectorIndexConfig,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot reindex vector index of non-existing index for %s", className)
+	}
+
+	return idx.reindexVectorIndex(ctx, targetVector, updated)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(
 	old, updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -611,6 +638,12 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeIVF:
+		return ivf.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeSPARSE:
+		return sparse.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -666,7 +699,7 @@ func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string
 	{
 		idx.Config.ReplicationFactor.Store(cfg.Factor)
 
-		if err := idx.updateAsyncReplication(ctx, cfg.AsyncEnabled); err != nil {
+		if err := idx.updateAsyncReplication(ctx, cfg.AsyncEnabled, cfg.AsyncPaused); err != nil {
 			return fmt.Errorf("update async replication for class %q: %w", className, err)
 		}
 	}
@@ -674,6 +707,45 @@ func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string
 	return nil
 }
 
+// DropUnownedShards drops the local shards of a class which belong to other
+// nodes only according to the given sharding state. This is the case once
+// the class was scaled in or the replicas were moved away from this node.
+func (m *Migrator) DropUnownedShards(ctx context.Context, className string, state *sharding.State) error {
+	if state == nil {
+		return nil
+	}
+
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+
+	var toRemove []string
+	idx.ForEachShard(func(name string, _ ShardLike) error {
+		if _, ok := state.Physical[name]; ok && !state.IsLocalShard(name) {
+			toRemove = append(toRemove, name)
+		}
+		return nil
+	})
+	if len(toRemove) == 0 {
+		return nil
+	}
+
+	if err := idx.dropShards(toRemove); err != nil {
+		return fmt.Errorf("drop unowned shards %v of class %q: %w", toRemove, className, err)
+	}
+	m.logger.WithField("action", "drop_unowned_shards").
+		WithField("class", className).
+		WithField("shards", toRemove).
+		Info("dropped local shards which are owned by other nodes")
+	return nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestMigrator_DropUnownedShards(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	shardState := multiShardState()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "DropUnownedShards"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+	localShards := func() []string {
+		var names []string
+		idx.ForEachShard(func(name string, _ ShardLike) error {
+			names = append(names, name)
+			return nil
+		})
+		sort.Strings(names)
+		return names
+	}
+	shards := shardState.AllPhysicalShards()
+	sort.Strings(shards)
+	require.Equal(t, shards, localShards())
+
+	t.Run("owned shards are kept", func(t *testing.T) {
+		require.Nil(t, migrator.DropUnownedShards(context.Background(), className, shardState))
+		assert.Equal(t, shards, localShards())
+	})
+
+	t.Run("shards moved to another node are dropped", func(t *testing.T) {
+		moved := shards[0]
+		shard, release, err := idx.getOrInitShard(context.Background(), moved)
+		require.Nil(t, err)
+		require.NotNil(t, shard)
+		release()
+		path := shardPath(idx.path(), moved)
+		_, err = os.Stat(path)
+		require.Nil(t, err)
+
+		updated := shardState.DeepCopy()
+		physical := updated.Physical[moved]
+		physical.BelongsToNodes = []string{"node2"}
+		updated.Physical[moved] = physical
+		updated.SetLocalName("node1")
+
+		require.Nil(t, migrator.DropUnownedShards(context.Background(), className, &updated))
+		assert.Equal(t, shards[1:], localShards())
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -206,13 +206,15 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 		}
 
 		shardStatus := &models.NodeShardStatus{
-			Name:                 name,
-			Class:                shard.Index().Config.ClassName.String(),
-			ObjectCount:          objectCount,
-			VectorIndexingStatus: shard.GetStatus().String(),
-			VectorQueueLength:    queueLen,
-			Compressed:           compressed,
-			Loaded:               true,
+			Name:                   name,
+			Class:                  shard.Index().Config.ClassName.String(),
+			ObjectCount:            objectCount,
+			VectorIndexingStatus:   shard.GetStatus().String(),
+			VectorQueueLength:      queueLen,
+			Compressed:             compressed,
+			Loaded:                 true,
+			VectorReindexes:        shard.VectorReindexStatuses(),
+			AsyncReplicationStatus: shard.AsyncReplicationStatus(),
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
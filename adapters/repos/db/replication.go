@@ -633,3 +633,20 @@ func (i *Index) FetchObjects(ctx context.Context,
 
 	return resp, nil
 }
+
+// SyncShardReplicas propagates the objects of a local shard which are missing
+// or outdated on its other replicas. It requires async replication.
+func (db *DB) SyncShardReplicas(ctx context.Context, class, shardName string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+
+	shard, release, err := idx.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+	defer release()
+
+	return shard.SyncReplicas(ctx)
+}
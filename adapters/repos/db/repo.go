@@ -178,6 +178,7 @@ type Config struct {
 	QueryLimit                     int64
 	QueryMaximumResults            int64
 	QueryNestedRefLimit            int64
+	QueryTermExpansionScanLimit    int
 	ResourceUsage                  config.ResourceUsage
 	MaxImportGoroutinesFactor      float64
 	MemtablesFlushDirtyAfter       int
@@ -189,6 +190,10 @@ type Config struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	ChangeFeedEnabled              bool
+	ChangeFeedRetention            time.Duration
+	ObjectsTTLDeleteInterval       time.Duration
+	VectorRecallMonitoring         config.VectorRecallMonitoring
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
@@ -201,6 +206,35 @@ type Config struct {
 	Replication                    replication.GlobalConfig
 }
 
+// IndexQueueSize returns the number of vectors waiting to be indexed across
+// all loaded shards. It is always zero if async indexing is disabled.
+func (db *DB) IndexQueueSize() int64 {
+	if !asyncEnabled() {
+		return 0
+	}
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indices = append(indices, index)
+	}
+	db.indexLock.RUnlock()
+
+	var size int64
+	for _, index := range indices {
+		index.ForEachLoadedShard(func(_ string, shard ShardLike) error {
+			if q := shard.Queue(); q != nil {
+				size += q.Size()
+			}
+			for _, q := range shard.Queues() {
+				size += q.Size()
+			}
+			return nil
+		})
+	}
+	return size
+}
+
 // GetIndex returns the index if it exists or nil if it doesn't
 // by default it will retry 3 times between 0-150 ms to get the index
 // to handle the eventual consistency.
@@ -25,11 +25,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/changefeed"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
@@ -37,6 +39,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -50,6 +53,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -86,7 +90,7 @@ type ShardLike interface {
 	ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error)
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
-	UpdateAsyncReplication(ctx context.Context, enabled bool) error
+	UpdateAsyncReplication(ctx context.Context, enabled, paused bool) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
 	DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID, deletionTime time.Time, dryRun bool) objects.BatchSimpleObjects // Delete many objects by id
 	DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error                                           // Delete object by id
@@ -102,6 +106,8 @@ type ShardLike interface {
 	AnalyzeObject(*storobj.Object) ([]inverted.Property, []inverted.NilProperty, error)
 	Aggregate(ctx context.Context, params aggregation.Params, modules *modules.Provider) (*aggregation.Result, error)
 	HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	SyncReplicas(ctx context.Context) error
+	ReadChanges(ctx context.Context, after uint64, limit int, withObjects bool, fn func(entchangefeed.Change) error) error
 	MergeObject(ctx context.Context, object objects.MergeDocument) error
 	Queue() *IndexQueue
 	Queues() map[string]*IndexQueue
@@ -159,6 +165,7 @@ type ShardLike interface {
 	updatePropertySpecificIndices(ctx context.Context, object *storobj.Object, status objectInsertStatus) error
 	updateVectorIndexIgnoreDelete(ctx context.Context, vector []float32, status objectInsertStatus) error
 	updateVectorIndexesIgnoreDelete(ctx context.Context, vectors map[string][]float32, status objectInsertStatus) error
+	updateMultiVectorIndexesIgnoreDelete(ctx context.Context, multiVectors map[string][][]float32, status objectInsertStatus) error
 	hasGeoIndex() bool
 
 	Metrics() *Metrics
@@ -170,6 +177,10 @@ type ShardLike interface {
 	// Debug methods
 	DebugResetVectorIndex(ctx context.Context, targetVector string) error
 	RepairIndex(ctx context.Context, targetVector string) error
+
+	ReindexVectorIndex(ctx context.Context, targetVector string) error
+	VectorReindexStatuses() []*models.VectorReindexStatus
+	AsyncReplicationStatus() *models.AsyncReplicationStatus
 }
 
 // Shard is the smallest completely-contained index unit. A shard manages
@@ -186,6 +197,9 @@ type Shard struct {
 	indexCheckpoints  *indexcheckpoint.Checkpoints
 	vectorIndex       VectorIndex
 	vectorIndexes     map[string]VectorIndex
+	sparseIndexes     map[string]*sparse.Index
+	reindexes         map[string]*vectorReindex
+	reindexesLock     sync.Mutex
 	metrics           *Metrics
 	promMetrics       *monitoring.PrometheusMetrics
 	slowQueryReporter helpers.SlowQueryReporter
@@ -198,6 +212,11 @@ type Shard struct {
 	hashtreeInitialized  atomic.Bool
 	hashBeaterCtx        context.Context
 	hashBeaterCancelFunc context.CancelFunc
+	// hashBeaterPaused is guarded by hashtreeRWMux
+	hashBeaterPaused bool
+
+	// changeFeed is nil unless the change feed is enabled
+	changeFeed *changefeed.Feed
 
 	objectPropagationNeededCond *sync.Cond
 	objectPropagationNeeded     bool
@@ -205,6 +224,10 @@ type Shard struct {
 	lastComparedHosts    []string
 	lastComparedHostsMux sync.RWMutex
 
+	hashBeatStatus hashBeatStatus
+	// replicaSyncLock serializes repairs of the replicas of the shard
+	replicaSyncLock sync.Mutex
+
 	status              ShardStatus
 	statusLock          sync.Mutex
 	propertyIndicesLock sync.RWMutex
@@ -255,6 +278,10 @@ func (s *Shard) pathLSM() string {
 }
 
 func (s *Shard) vectorIndexID(targetVector string) string {
+	return vectorIndexID(targetVector)
+}
+
+func vectorIndexID(targetVector string) string {
 	if targetVector != "" {
 		return fmt.Sprintf("vectors_%s", targetVector)
 	}
@@ -437,7 +464,15 @@ func (s *Shard) initHashTree(ctx context.Context) error {
 			return
 		}
 
+		s.hashtreeRWMux.Lock()
 		s.hashtreeInitialized.Store(true)
+		start := s.hashBeaterCtx.Err() == nil
+		if start && s.hashBeaterPaused {
+			// cancelled, so that resuming starts the hashbeater
+			s.stopHashBeater()
+			start = false
+		}
+		s.hashtreeRWMux.Unlock()
 
 		s.index.logger.
 			WithField("action", "async_replication").
@@ -445,16 +480,20 @@ func (s *Shard) initHashTree(ctx context.Context) error {
 			WithField("shard_name", s.name).
 			Info("hashtree successfully initialized")
 
-		s.initHashBeater()
+		if start {
+			s.initHashBeater()
+		}
 	}, s.index.logger)
 
 	return nil
 }
 
-func (s *Shard) UpdateAsyncReplication(ctx context.Context, enabled bool) error {
+func (s *Shard) UpdateAsyncReplication(ctx context.Context, enabled, paused bool) error {
 	s.hashtreeRWMux.Lock()
 	defer s.hashtreeRWMux.Unlock()
 
+	s.hashBeaterPaused = paused
+
 	if enabled {
 		if s.hashtree == nil {
 			err := s.initHashTree(ctx)
@@ -465,9 +504,21 @@ func (s *Shard) UpdateAsyncReplication(ctx context.Context, enabled bool) error
 			return nil
 		}
 
-		if s.hashBeaterCtx == nil || s.hashBeaterCtx.Err() != nil {
+		running := s.hashBeaterCtx != nil && s.hashBeaterCtx.Err() == nil
+
+		if paused {
+			if running {
+				s.stopHashBeater()
+			}
+			return nil
+		}
+
+		if !running {
 			s.hashBeaterCtx, s.hashBeaterCancelFunc = context.WithCancel(context.Background())
-			s.initHashBeater()
+			// otherwise it is started once the hashtree is initialized
+			if s.hashtreeInitialized.Load() {
+				s.initHashBeater()
+			}
 		}
 
 		return nil
@@ -651,6 +702,10 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	wg := new(sync.WaitGroup)
 	var err error
 	for targetName, targetCfg := range updated {
+		if targetCfg.IndexType() == vectorindex.VectorIndexTypeSPARSE {
+			// sparse indexes have no settings to update
+			continue
+		}
 		wg.Add(1)
 		if err = s.VectorIndexForName(targetName).UpdateUserConfig(targetCfg, wg.Done); err != nil {
 			break
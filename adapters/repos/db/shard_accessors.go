@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -40,6 +41,12 @@ func (s *Shard) VectorIndexForName(targetVector string) VectorIndex {
 	return s.vectorIndexes[targetVector]
 }
 
+// SparseIndexForName returns the index of a target vector holding sparse
+// vectors, nil if the target vector does not exist or holds dense vectors
+func (s *Shard) SparseIndexForName(targetVector string) *sparse.Index {
+	return s.sparseIndexes[targetVector]
+}
+
 func (s *Shard) Versioner() *shardVersioner {
 	return s.versioner
 }
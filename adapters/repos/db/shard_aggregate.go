@@ -35,6 +35,7 @@ func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, module
 
 	return aggregator.New(s.store, params, s.index.getSchema, s.index.classSearcher,
 		s.index.stopwords, s.versioner.Version(), queue, s.index.logger, s.GetPropertyLengthTracker(),
-		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory, modules).
+		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
+		s.index.Config.QueryTermExpansionScanLimit, s.bitmapFactory, modules).
 		Do(ctx)
 }
@@ -44,6 +44,12 @@ func (s *Shard) HaltForTransfer(ctx context.Context) (err error) {
 	if err = s.cycleCallbacks.geoPropsCombinedCallbacksCtrl.Deactivate(ctx); err != nil {
 		return fmt.Errorf("pause geo props maintenance: %w", err)
 	}
+	if err = s.cycleCallbacks.objectTTLCallbacksCtrl.Deactivate(ctx); err != nil {
+		return fmt.Errorf("pause object ttl deletion: %w", err)
+	}
+	if err = s.cycleCallbacks.vectorRecallCallbacksCtrl.Deactivate(ctx); err != nil {
+		return fmt.Errorf("pause vector recall monitoring: %w", err)
+	}
 	if s.hasTargetVectors() {
 		for targetVector, vectorIndex := range s.vectorIndexes {
 			if err = vectorIndex.SwitchCommitLogs(ctx); err != nil {
@@ -100,6 +106,12 @@ func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	g.Go(func() error {
 		return s.cycleCallbacks.geoPropsCombinedCallbacksCtrl.Activate()
 	})
+	g.Go(func() error {
+		return s.cycleCallbacks.objectTTLCallbacksCtrl.Activate()
+	})
+	g.Go(func() error {
+		return s.cycleCallbacks.vectorRecallCallbacksCtrl.Activate()
+	})
 
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("failed to resume maintenance cycles for shard '%s': %w", s.name, err)
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
+)
+
+var errChangeFeedDisabled = errors.New("change feed is not enabled")
+
+// mayRecordUpsert writes an insert or update of the object to the objects
+// bucket with write and records it in the change feed if enabled. objBytes is
+// the object as written.
+func (s *Shard) mayRecordUpsert(idBytes, objBytes []byte, timestamp int64,
+	status objectInsertStatus, write func() error,
+) error {
+	if s.changeFeed == nil {
+		return write()
+	}
+
+	op := entchangefeed.OperationInsert
+	if status.docIDChanged || status.docIDPreserved {
+		op = entchangefeed.OperationUpdate
+	}
+
+	_, err := s.changeFeed.Record(op, idBytes, timestamp, objBytes, write)
+	return err
+}
+
+// mayRecordDelete removes the object from the objects bucket with write and
+// records the deletion in the change feed if enabled
+func (s *Shard) mayRecordDelete(idBytes []byte, deletionTime time.Time, write func() error) error {
+	if s.changeFeed == nil {
+		return write()
+	}
+
+	if deletionTime.IsZero() {
+		deletionTime = time.Now()
+	}
+
+	_, err := s.changeFeed.Record(entchangefeed.OperationDelete, idBytes, deletionTime.UnixMilli(), nil, write)
+	return err
+}
+
+// ReadChanges calls fn for up to limit changes of the shard recorded after
+// the given sequence. It fails with changefeed.ErrTruncated if changes after
+// the sequence are no longer retained.
+func (s *Shard) ReadChanges(ctx context.Context, after uint64, limit int, withObjects bool,
+	fn func(entchangefeed.Change) error,
+) error {
+	if s.changeFeed == nil {
+		return errChangeFeedDisabled
+	}
+
+	if err := s.changeFeed.Read(after, limit, withObjects, fn); err != nil {
+		return fmt.Errorf("read change feed of shard %q: %w", s.ID(), err)
+	}
+	return nil
+}
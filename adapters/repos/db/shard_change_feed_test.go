@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
+)
+
+func TestShard_ChangeFeed(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+
+	t.Run("disabled", func(t *testing.T) {
+		shd, idx := testShard(t, ctx, className)
+		defer idx.drop()
+
+		require.Nil(t, shd.PutObject(ctx, testObject(className)))
+
+		err := shd.ReadChanges(ctx, 0, 10, false, func(entchangefeed.Change) error { return nil })
+		assert.ErrorIs(t, err, errChangeFeedDisabled)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		shd, idx := testShard(t, ctx, className, func(i *Index) {
+			i.Config.ChangeFeedEnabled = true
+		})
+		defer idx.drop()
+
+		obj := testObject(className)
+		obj.Object.LastUpdateTimeUnix = 1
+		require.Nil(t, shd.PutObject(ctx, obj))
+
+		obj.Object.LastUpdateTimeUnix = 2
+		obj.Object.Properties = map[string]interface{}{"name": "updated"}
+		require.Nil(t, shd.PutObject(ctx, obj))
+
+		require.Nil(t, shd.DeleteObject(ctx, obj.ID(), time.UnixMilli(3)))
+
+		var changes []entchangefeed.Change
+		err := shd.ReadChanges(ctx, 0, 10, true, func(c entchangefeed.Change) error {
+			changes = append(changes, c)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, changes, 3)
+
+		assert.Equal(t, entchangefeed.OperationInsert, changes[0].Operation)
+		assert.Equal(t, int64(1), changes[0].Timestamp)
+		require.NotNil(t, changes[0].Object)
+
+		assert.Equal(t, entchangefeed.OperationUpdate, changes[1].Operation)
+		assert.Equal(t, int64(2), changes[1].Timestamp)
+		require.NotNil(t, changes[1].Object)
+		assert.Equal(t, "updated", changes[1].Object.Properties().(map[string]interface{})["name"])
+
+		assert.Equal(t, entchangefeed.OperationDelete, changes[2].Operation)
+		assert.Equal(t, int64(3), changes[2].Timestamp)
+		assert.Nil(t, changes[2].Object)
+
+		for i, c := range changes {
+			assert.Equal(t, uint64(i+1), c.Sequence)
+			assert.Equal(t, obj.ID(), c.UUID)
+		}
+	})
+}
@@ -33,6 +33,9 @@ type shardCycleCallbacks struct {
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsCombinedCallbacksCtrl     cyclemanager.CycleCallbackCtrl
+
+	objectTTLCallbacksCtrl    cyclemanager.CycleCallbackCtrl
+	vectorRecallCallbacksCtrl cyclemanager.CycleCallbackCtrl
 }
 
 func (s *Shard) initCycleCallbacks() {
@@ -103,6 +106,12 @@ func (s *Shard) initCycleCallbacks() {
 	geoPropsCombinedCallbacksCtrl := cyclemanager.NewCombinedCallbackCtrl(2, s.index.logger,
 		geoPropsCommitLoggerCallbacksCtrl, geoPropsTombstoneCleanupCallbacksCtrl)
 
+	// activated once the shard is fully initialized
+	objectTTLCallbacksCtrl := s.index.cycleCallbacks.objectTTLCallbacks.Register(
+		id("object_ttl"), s.deleteExpiredObjects, cyclemanager.AsInactive())
+	vectorRecallCallbacksCtrl := s.index.cycleCallbacks.vectorRecallCallbacks.Register(
+		id("vector_recall"), s.measureVectorRecall, cyclemanager.AsInactive())
+
 	s.cycleCallbacks = &shardCycleCallbacks{
 		compactionCallbacks:        compactionCallbacks,
 		compactionCallbacksCtrl:    compactionCallbacksCtrl,
@@ -119,5 +128,8 @@ func (s *Shard) initCycleCallbacks() {
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsCombinedCallbacksCtrl:     geoPropsCombinedCallbacksCtrl,
+
+		objectTTLCallbacksCtrl:    objectTTLCallbacksCtrl,
+		vectorRecallCallbacksCtrl: vectorRecallCallbacksCtrl,
 	}
 }
@@ -50,6 +50,8 @@ func (s *Shard) drop() (err error) {
 	}
 	s.hashtreeRWMux.Unlock()
 
+	s.stopVectorReindexes()
+
 	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Second)
 	defer cancel()
 	s.index.logger.WithFields(logrus.Fields{
@@ -64,6 +66,8 @@ func (s *Shard) drop() (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
+		s.cycleCallbacks.vectorRecallCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
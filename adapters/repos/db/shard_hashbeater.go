@@ -16,11 +16,13 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/interval"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -77,7 +79,7 @@ func (s *Shard) initHashBeater() {
 					return
 				}
 
-				stats, err := s.hashBeat()
+				stats, err := s.hashBeat(s.hashBeaterCtx)
 				if s.hashBeaterCtx.Err() != nil {
 					return
 				}
@@ -223,6 +225,7 @@ type hashBeatStats struct {
 
 type hashBeatHostStats struct {
 	host                string
+	diffRanges          int
 	localObjects        int
 	remoteObjects       int
 	objectsPropagated   int
@@ -230,7 +233,7 @@ type hashBeatHostStats struct {
 	err                 error
 }
 
-func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
+func (s *Shard) hashBeat(ctx context.Context) (stats hashBeatStats, err error) {
 	s.hashtreeRWMux.RLock()
 	defer s.hashtreeRWMux.RUnlock()
 
@@ -239,9 +242,16 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 		return
 	}
 
+	defer func() {
+		// iterations interrupted by stopping the hashbeater are not reported
+		if ctx.Err() == nil {
+			s.recordHashBeat(stats, err)
+		}
+	}()
+
 	diffCalculationStart := time.Now()
 
-	replyCh, _, err := s.index.replicator.CollectShardDifferences(s.hashBeaterCtx, s.name, s.hashtree)
+	replyCh, _, err := s.index.replicator.CollectShardDifferences(ctx, s.name, s.hashtree)
 	if err != nil {
 		return stats, fmt.Errorf("collecting differences: %w", err)
 	}
@@ -265,6 +275,7 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 
 		objectProgationStart := time.Now()
 
+		diffRanges := 0
 		localObjects := 0
 		remoteObjects := 0
 		objectsPropagated := 0
@@ -272,8 +283,8 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 		var propagationErr error
 
 		for {
-			if s.hashBeaterCtx.Err() != nil {
-				return stats, s.hashBeaterCtx.Err()
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
 			}
 
 			initialToken, finalToken, err := rangeReader.Next()
@@ -285,8 +296,10 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 				break
 			}
 
+			diffRanges++
+
 			localObjs, remoteObjs, propagations, err := s.stepsTowardsShardConsistency(
-				s.hashBeaterCtx,
+				ctx,
 				s.name,
 				shardDiffReader.Host,
 				initialToken,
@@ -309,6 +322,7 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 
 		stat := hashBeatHostStats{
 			host:                shardDiffReader.Host,
+			diffRanges:          diffRanges,
 			localObjects:        localObjects,
 			remoteObjects:       remoteObjects,
 			objectsPropagated:   objectsPropagated,
@@ -457,6 +471,187 @@ func (s *Shard) stepsTowardsShardConsistency(ctx context.Context,
 	return localObjects, remoteObjects, propagations, nil
 }
 
+// maxReplicaSyncIterations bounds the hashbeat iterations run by SyncReplicas
+const maxReplicaSyncIterations = 100
+
+// SyncReplicas runs hashbeat iterations until no local object needs to be
+// propagated to the other replicas of the shard anymore. Objects which are
+// newer on another replica are propagated by the hashbeats of that replica.
+// It works while async replication is paused as well. Concurrent calls are
+// run one after another, the progress is reported by AsyncReplicationStatus.
+func (s *Shard) SyncReplicas(ctx context.Context) (err error) {
+	s.replicaSyncLock.Lock()
+	defer s.replicaSyncLock.Unlock()
+
+	s.recordRepairStart()
+	defer func() { s.recordRepairEnd(err) }()
+
+	return s.syncReplicas(ctx)
+}
+
+func (s *Shard) syncReplicas(ctx context.Context) error {
+	s.hashtreeRWMux.RLock()
+	enabled := s.hashtree != nil
+	s.hashtreeRWMux.RUnlock()
+	if !enabled || !s.index.asyncReplicationEnabled() {
+		return fmt.Errorf("async replication is disabled for shard %q", s.name)
+	}
+	if !s.hashtreeInitialized.Load() {
+		return fmt.Errorf("hashtree of shard %q is not initialized yet", s.name)
+	}
+
+	for it := 0; it < maxReplicaSyncIterations; it++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		stats, err := s.hashBeat(ctx)
+		if err != nil {
+			return fmt.Errorf("hashbeat iteration: %w", err)
+		}
+		propagated := 0
+		for _, stat := range stats.hostStats {
+			if stat.err != nil {
+				return fmt.Errorf("hashbeat iteration: %w: host %s", stat.err, stat.host)
+			}
+			propagated += stat.objectsPropagated
+		}
+		if propagated == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("replicas of shard %q not in sync after %d hashbeat iterations",
+		s.name, maxReplicaSyncIterations)
+}
+
+// hashBeatStatus keeps the outcome of the hashbeats of a shard, to report the
+// state of async replication through the nodes API.
+type hashBeatStatus struct {
+	sync.Mutex
+	lastHashBeat      time.Time
+	lastSync          time.Time
+	lastErr           error
+	objectsPropagated int64
+	hostStats         []hashBeatHostStats
+
+	repairRunning  bool
+	repairStarted  time.Time
+	repairFinished time.Time
+	repairErr      error
+}
+
+func (s *Shard) recordRepairStart() {
+	st := &s.hashBeatStatus
+	st.Lock()
+	defer st.Unlock()
+
+	st.repairRunning = true
+	st.repairStarted = time.Now()
+	st.repairFinished = time.Time{}
+	st.repairErr = nil
+}
+
+func (s *Shard) recordRepairEnd(err error) {
+	st := &s.hashBeatStatus
+	st.Lock()
+	defer st.Unlock()
+
+	st.repairRunning = false
+	st.repairFinished = time.Now()
+	st.repairErr = err
+}
+
+func (s *Shard) recordHashBeat(stats hashBeatStats, err error) {
+	st := &s.hashBeatStatus
+	st.Lock()
+	defer st.Unlock()
+
+	now := time.Now()
+	st.lastHashBeat = now
+	st.hostStats = stats.hostStats
+	for _, stat := range stats.hostStats {
+		st.objectsPropagated += int64(stat.objectsPropagated)
+		if stat.err != nil && err == nil {
+			err = fmt.Errorf("%w: host %s", stat.err, stat.host)
+		}
+	}
+	st.lastErr = err
+	if err == nil {
+		st.lastSync = now
+	}
+}
+
+// AsyncReplicationStatus returns the state of async replication of the
+// shard, nil if it is disabled. Replicas which didn't differ in the last
+// hashbeat have no target status.
+func (s *Shard) AsyncReplicationStatus() *models.AsyncReplicationStatus {
+	if !s.index.asyncReplicationEnabled() {
+		return nil
+	}
+
+	s.hashtreeRWMux.RLock()
+	enabled, paused := s.hashtree != nil, s.hashBeaterPaused
+	s.hashtreeRWMux.RUnlock()
+	if !enabled {
+		return nil
+	}
+
+	status := &models.AsyncReplicationStatus{
+		Status:            models.AsyncReplicationStatusStatusACTIVE,
+		LastComparedHosts: s.getLastComparedHosts(),
+		Targets:           []*models.AsyncReplicationTargetStatus{},
+	}
+	switch {
+	case !s.hashtreeInitialized.Load():
+		status.Status = models.AsyncReplicationStatusStatusINITIALIZING
+	case paused:
+		status.Status = models.AsyncReplicationStatusStatusPAUSED
+	}
+
+	st := &s.hashBeatStatus
+	st.Lock()
+	defer st.Unlock()
+
+	if !st.lastHashBeat.IsZero() {
+		status.LastHashbeatUnixMillis = st.lastHashBeat.UnixMilli()
+	}
+	if !st.lastSync.IsZero() {
+		status.LastSyncUnixMillis = st.lastSync.UnixMilli()
+	}
+	if st.lastErr != nil {
+		status.LastError = st.lastErr.Error()
+	}
+	status.ObjectsPropagated = st.objectsPropagated
+	if !st.repairStarted.IsZero() {
+		status.RepairStartedUnixMillis = st.repairStarted.UnixMilli()
+		switch {
+		case st.repairRunning:
+			status.RepairStatus = models.AsyncReplicationStatusRepairStatusRUNNING
+		case st.repairErr != nil:
+			status.RepairStatus = models.AsyncReplicationStatusRepairStatusFAILED
+			status.RepairError = st.repairErr.Error()
+		default:
+			status.RepairStatus = models.AsyncReplicationStatusRepairStatusSUCCEEDED
+		}
+	}
+	if !st.repairFinished.IsZero() {
+		status.RepairFinishedUnixMillis = st.repairFinished.UnixMilli()
+	}
+	for _, stat := range st.hostStats {
+		target := &models.AsyncReplicationTargetStatus{
+			Host:              stat.host,
+			Differences:       int64(stat.diffRanges),
+			LocalObjects:      int64(stat.localObjects),
+			RemoteObjects:     int64(stat.remoteObjects),
+			ObjectsPropagated: int64(stat.objectsPropagated),
+		}
+		if stat.err != nil {
+			target.Error = stat.err.Error()
+		}
+		status.Targets = append(status.Targets, target)
+	}
+	return status
+}
+
 func (s *Shard) stopHashBeater() {
 	s.hashBeaterCancelFunc()
 }
@@ -130,6 +130,10 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		}
 	}
 
+	if err := s.resumeVectorReindexes(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
 	s.initDimensionTracking()
 
 	if asyncEnabled() {
@@ -151,6 +155,12 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		}
 		enterrors.GoWrapper(f, s.index.logger)
 	}
+	if err := s.cycleCallbacks.objectTTLCallbacksCtrl.Activate(); err != nil {
+		return nil, fmt.Errorf("init shard %q: activate object ttl deletion: %w", s.ID(), err)
+	}
+	if err := s.cycleCallbacks.vectorRecallCallbacksCtrl.Activate(); err != nil {
+		return nil, fmt.Errorf("init shard %q: activate vector recall monitoring: %w", s.ID(), err)
+	}
 	s.NotifyReady()
 
 	if exists {
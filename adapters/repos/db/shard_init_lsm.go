@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/changefeed"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
@@ -96,8 +97,19 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	if s.index.Config.ChangeFeedEnabled {
+		s.changeFeed, err = changefeed.New(ctx, s.store, s.index.Config.ChangeFeedRetention,
+			s.memtableDirtyConfig(),
+			s.segmentCleanupConfig(),
+		)
+		if err != nil {
+			return fmt.Errorf("init shard %q: change feed: %w", s.ID(), err)
+		}
+	}
+
 	// Object bucket must be available, initHashTree depends on it
 	if s.index.asyncReplicationEnabled() {
+		s.hashBeaterPaused = s.index.asyncReplicationPaused()
 		err = s.initHashTree(ctx)
 		if err != nil {
 			return fmt.Errorf("init shard %q: shard hashtree: %w", s.ID(), err)
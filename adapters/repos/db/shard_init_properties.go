@@ -125,6 +125,9 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		searchableBucketOpts := append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
 		if s.versioner.Version() < 2 {
 			searchableBucketOpts = append(searchableBucketOpts, lsmkv.WithLegacyMapSorting())
+		} else {
+			// the block max index relies on posting lists being sorted by doc id
+			searchableBucketOpts = append(searchableBucketOpts, lsmkv.WithUseBlockMax(true))
 		}
 
 		if err := s.store.CreateOrLoadBucket(ctx,
@@ -135,6 +138,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasPositionIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketPositionsFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyReplace))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	if inverted.HasRangeableIndex(prop) {
 		if err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketRangeableFromPropNameLSM(prop.Name),
@@ -16,21 +16,38 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
 )
 
 func (s *Shard) initVectorIndex(ctx context.Context,
 	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	return s.initVectorIndexAt(ctx, targetVector, vectorIndexUserConfig, s.path(), s.store)
+}
+
+// initVectorIndexAt creates the vector index with its files under rootPath
+// and its buckets in the given store. Regular indexes live in the shard
+// directory and store, a shadow index built by a reindex lives next to them.
+func (s *Shard) initVectorIndexAt(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+	rootPath string, store *lsmkv.Store,
 ) (VectorIndex, error) {
 	var distProv distancer.Provider
 
@@ -76,17 +93,18 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			vecIdxID := s.vectorIndexID(targetVector)
 
 			vi, err := hnsw.New(hnsw.Config{
-				Logger:               s.index.logger,
-				RootPath:             s.path(),
-				ID:                   vecIdxID,
-				ShardName:            s.name,
-				ClassName:            s.index.Config.ClassName.String(),
-				PrometheusMetrics:    s.promMetrics,
-				VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
-				TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
-				DistanceProvider:     distProv,
+				Logger:                   s.index.logger,
+				RootPath:                 rootPath,
+				ID:                       vecIdxID,
+				ShardName:                s.name,
+				ClassName:                s.index.Config.ClassName.String(),
+				PrometheusMetrics:        s.promMetrics,
+				VectorForIDThunk:         hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
+				TempVectorForIDThunk:     hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
+				MultipleVectorForIDThunk: hnsw.NewMultipleVectorForIDThunk(targetVector, s.multiVectorByIndexID),
+				DistanceProvider:         distProv,
 				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-					return hnsw.NewCommitLogger(s.path(), vecIdxID,
+					return hnsw.NewCommitLogger(rootPath, vecIdxID,
 						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
 						hnsw.WithAllocChecker(s.index.allocChecker),
 						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
@@ -98,7 +116,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
 				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
-			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
+			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, store)
 			if err != nil {
 				return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 			}
@@ -122,11 +140,11 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		vi, err := flat.New(flat.Config{
 			ID:               vecIdxID,
 			TargetVector:     targetVector,
-			RootPath:         s.path(),
+			RootPath:         rootPath,
 			Logger:           s.index.logger,
 			DistanceProvider: distProv,
 			AllocChecker:     s.index.allocChecker,
-		}, flatUserConfig, s.store)
+		}, flatUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
 		}
@@ -151,25 +169,80 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TargetVector:         targetVector,
 			Logger:               s.index.logger,
 			DistanceProvider:     distProv,
-			RootPath:             s.path(),
+			RootPath:             rootPath,
 			ShardName:            s.name,
 			ClassName:            s.index.Config.ClassName.String(),
 			PrometheusMetrics:    s.promMetrics,
 			VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				return hnsw.NewCommitLogger(s.path(), vecIdxID,
+				return hnsw.NewCommitLogger(rootPath, vecIdxID,
 					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
 			},
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-		}, dynamicUserConfig, s.store)
+		}, dynamicUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		// a shard can actually have multiple vector indexes:
+		// - the main index, which is used for all normal object vectors
+		// - a geo property index for each geo prop in the schema
+		//
+		// here we label the main vector index as such.
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := diskann.New(diskann.Config{
+			ID:                 vecIdxID,
+			TargetVector:       targetVector,
+			RootPath:           rootPath,
+			Logger:             s.index.logger,
+			DistanceProvider:   distProv,
+			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, diskannUserConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeIVF:
+		ivfUserConfig, ok := vectorIndexUserConfig.(ivfent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("ivf vector index: config is not ivf.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		// a shard can actually have multiple vector indexes:
+		// - the main index, which is used for all normal object vectors
+		// - a geo property index for each geo prop in the schema
+		//
+		// here we label the main vector index as such.
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := ivf.New(ivf.Config{
+			ID:                 vecIdxID,
+			TargetVector:       targetVector,
+			RootPath:           rootPath,
+			Logger:             s.index.logger,
+			DistanceProvider:   distProv,
+			ReclusterCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, ivfUserConfig, store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: ivf index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeIVF)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
@@ -187,8 +260,17 @@ func hasTargetVectors(cfg schemaConfig.VectorIndexConfig, targetCfgs map[string]
 
 func (s *Shard) initTargetVectors(ctx context.Context) error {
 	s.vectorIndexes = make(map[string]VectorIndex)
+	s.sparseIndexes = make(map[string]*sparse.Index)
 	for targetVector, vectorIndexConfig := range s.index.vectorIndexUserConfigs {
-		vectorIndex, err := s.initVectorIndex(ctx, targetVector, vectorIndexConfig)
+		if vectorIndexConfig.IndexType() == vectorindex.VectorIndexTypeSPARSE {
+			sparseIndex, err := s.initSparseIndex(ctx, targetVector)
+			if err != nil {
+				return fmt.Errorf("cannot create sparse index for %q: %w", targetVector, err)
+			}
+			s.sparseIndexes[targetVector] = sparseIndex
+			continue
+		}
+		vectorIndex, err := s.initReindexedVectorIndex(ctx, targetVector, vectorIndexConfig)
 		if err != nil {
 			return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
 		}
@@ -197,6 +279,26 @@ func (s *Shard) initTargetVectors(ctx context.Context) error {
 	return nil
 }
 
+// initSparseIndex creates the bucket holding the posting lists of a target
+// vector with sparse vectors. Sparse vectors are not added to a vector index
+// and index queue, but indexed synchronously like the inverted index.
+func (s *Shard) initSparseIndex(ctx context.Context, targetVector string) (*sparse.Index, error) {
+	bucketName := helpers.BucketSparseVectorsLSM(targetVector)
+	if err := s.store.CreateOrLoadBucket(ctx, bucketName,
+		s.memtableDirtyConfig(),
+		s.dynamicMemtableSizing(),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		lsmkv.WithUseBlockMax(true),
+		s.segmentCleanupConfig(),
+	); err != nil {
+		return nil, err
+	}
+	return sparse.New(s.store.Bucket(bucketName)), nil
+}
+
 func (s *Shard) initTargetQueues() error {
 	s.queues = make(map[string]*IndexQueue)
 	for targetVector, vectorIndex := range s.vectorIndexes {
@@ -211,7 +313,7 @@ func (s *Shard) initTargetQueues() error {
 }
 
 func (s *Shard) initLegacyVector(ctx context.Context) error {
-	vectorindex, err := s.initVectorIndex(ctx, "", s.index.vectorIndexUserConfig)
+	vectorindex, err := s.initReindexedVectorIndex(ctx, "", s.index.vectorIndexUserConfig)
 	if err != nil {
 		return err
 	}
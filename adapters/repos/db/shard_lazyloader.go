@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	entchangefeed "github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/dto"
 
 	"github.com/go-openapi/strfmt"
@@ -266,11 +267,11 @@ func (l *LazyLoadShard) UpdateVectorIndexConfigs(ctx context.Context, updated ma
 	return l.shard.UpdateVectorIndexConfigs(ctx, updated)
 }
 
-func (l *LazyLoadShard) UpdateAsyncReplication(ctx context.Context, enabled bool) error {
+func (l *LazyLoadShard) UpdateAsyncReplication(ctx context.Context, enabled, paused bool) error {
 	if err := l.Load(ctx); err != nil {
 		return err
 	}
-	return l.shard.UpdateAsyncReplication(ctx, enabled)
+	return l.shard.UpdateAsyncReplication(ctx, enabled, paused)
 }
 
 func (l *LazyLoadShard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
@@ -452,6 +453,29 @@ func (l *LazyLoadShard) RepairIndex(ctx context.Context, targetVector string) er
 	return l.shard.RepairIndex(ctx, targetVector)
 }
 
+// ReindexVectorIndex does not load the shard, a shard that is not loaded
+// starts the reindex from its marker once it is loaded.
+func (l *LazyLoadShard) ReindexVectorIndex(ctx context.Context, targetVector string) error {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.ReindexVectorIndex(ctx, targetVector)
+}
+
+func (l *LazyLoadShard) VectorReindexStatuses() []*models.VectorReindexStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.VectorReindexStatuses()
+}
+
+func (l *LazyLoadShard) AsyncReplicationStatus() *models.AsyncReplicationStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.AsyncReplicationStatus()
+}
+
 func (l *LazyLoadShard) Shutdown(ctx context.Context) error {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
@@ -470,6 +494,22 @@ func (l *LazyLoadShard) preventShutdown() (release func(), err error) {
 	return l.shard.preventShutdown()
 }
 
+func (l *LazyLoadShard) ReadChanges(ctx context.Context, after uint64, limit int, withObjects bool,
+	fn func(entchangefeed.Change) error,
+) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.ReadChanges(ctx, after, limit, withObjects, fn)
+}
+
+func (l *LazyLoadShard) SyncReplicas(ctx context.Context) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.SyncReplicas(ctx)
+}
+
 func (l *LazyLoadShard) HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error) {
 	if !l.isLoaded() {
 		return []hashtree.Digest{}, nil
@@ -666,6 +706,11 @@ func (l *LazyLoadShard) updateVectorIndexesIgnoreDelete(ctx context.Context, vec
 	return l.shard.updateVectorIndexesIgnoreDelete(ctx, vectors, status)
 }
 
+func (l *LazyLoadShard) updateMultiVectorIndexesIgnoreDelete(ctx context.Context, multiVectors map[string][][]float32, status objectInsertStatus) error {
+	l.mustLoad()
+	return l.shard.updateMultiVectorIndexesIgnoreDelete(ctx, multiVectors, status)
+}
+
 func (l *LazyLoadShard) hasGeoIndex() bool {
 	l.mustLoad()
 	return l.shard.hasGeoIndex()
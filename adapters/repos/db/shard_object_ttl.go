@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// objectTTLDeleteBatchSize is the number of expired objects deleted at once,
+// the cycle checks whether it should abort between batches
+const objectTTLDeleteBatchSize = 1000
+
+// deleteExpiredObjects is the object ttl cycle callback of the shard. It
+// deletes all objects that are older than the ttl configured on the class.
+func (s *Shard) deleteExpiredObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if class == nil || class.ObjectTTLConfig == nil || !class.ObjectTTLConfig.Enabled {
+		return false
+	}
+	if s.isReadOnly() != nil {
+		return false
+	}
+
+	logger := s.index.logger.WithFields(logrus.Fields{
+		"action": "delete_expired_objects",
+		"class":  class.Class,
+		"shard":  s.name,
+	})
+
+	ctx := context.Background()
+	now := time.Now()
+	expiredBefore := now.Add(-time.Duration(class.ObjectTTLConfig.DefaultTTL) * time.Second)
+
+	expired, err := s.findDocIDsAllowList(ctx, objectTTLFilter(class, expiredBefore))
+	if err != nil {
+		logger.WithError(err).Error("find expired objects")
+		return false
+	}
+	if expired.IsEmpty() {
+		return false
+	}
+
+	deleted, err := s.deleteExpiredObjectBatches(ctx, expired.Iterator(), now, shouldAbort)
+	if err != nil {
+		logger.WithError(err).Error("delete expired objects")
+	}
+	logger.WithField("deleted", deleted).Debug("deleted expired objects")
+
+	return deleted > 0
+}
+
+// deleteExpiredObjectBatches pages through the doc ids of the expired objects,
+// only the uuids of a single batch are resolved and held in memory at a time
+func (s *Shard) deleteExpiredObjectBatches(ctx context.Context, expired helpers.AllowListIterator,
+	deletionTime time.Time, shouldAbort cyclemanager.ShouldAbortCallback,
+) (int, error) {
+	deleted := 0
+	uuids := make([]strfmt.UUID, 0, objectTTLDeleteBatchSize)
+	for exhausted := false; !exhausted; {
+		if shouldAbort() {
+			return deleted, nil
+		}
+
+		uuids = uuids[:0]
+		for len(uuids) < objectTTLDeleteBatchSize {
+			docID, ok := expired.Next()
+			if !ok {
+				exhausted = true
+				break
+			}
+			uuid, err := s.uuidFromDocID(docID)
+			if err != nil {
+				// the object has been deleted or updated in the meantime
+				continue
+			}
+			uuids = append(uuids, uuid)
+		}
+		if len(uuids) == 0 {
+			continue
+		}
+
+		for _, res := range s.DeleteObjectBatch(ctx, uuids, deletionTime, false) {
+			if res.Err != nil {
+				return deleted, fmt.Errorf("delete object %s: %w", res.UUID, res.Err)
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// objectTTLFilter matches all objects whose ttl reference time is before the
+// given time
+func objectTTLFilter(class *models.Class, before time.Time) *filters.LocalFilter {
+	deleteOn := class.ObjectTTLConfig.DeleteOn
+	if deleteOn == "" {
+		deleteOn = filters.InternalPropCreationTimeUnix
+	}
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(deleteOn),
+			},
+			Value: &filters.Value{
+				Value: before.UTC().Format(time.RFC3339Nano),
+				Type:  schema.DataTypeDate,
+			},
+		},
+	}
+}
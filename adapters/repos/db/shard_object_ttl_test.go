@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_DeleteExpiredObjects(t *testing.T) {
+	ctx := testCtx()
+	now := time.Now()
+	noAbort := func() bool { return false }
+
+	t.Run("creation time", func(t *testing.T) {
+		class := &models.Class{
+			Class:               "TestClass",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled:    true,
+				DefaultTTL: int64(time.Hour / time.Second),
+				DeleteOn:   "_creationTimeUnix",
+			},
+		}
+		shd, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+		defer idx.drop()
+
+		expired := testObject(class.Class)
+		expired.Object.CreationTimeUnix = now.Add(-2 * time.Hour).UnixMilli()
+		expired.Object.LastUpdateTimeUnix = expired.Object.CreationTimeUnix
+		live := testObject(class.Class)
+		live.Object.CreationTimeUnix = now.Add(-time.Minute).UnixMilli()
+		live.Object.LastUpdateTimeUnix = live.Object.CreationTimeUnix
+		require.Nil(t, shd.PutObject(ctx, expired))
+		require.Nil(t, shd.PutObject(ctx, live))
+
+		assert.True(t, shd.(*LazyLoadShard).shard.deleteExpiredObjects(noAbort))
+
+		exists, err := shd.Exists(ctx, expired.ID())
+		require.Nil(t, err)
+		assert.False(t, exists)
+		exists, err = shd.Exists(ctx, live.ID())
+		require.Nil(t, err)
+		assert.True(t, exists)
+
+		assert.False(t, shd.(*LazyLoadShard).shard.deleteExpiredObjects(noAbort), "nothing left to delete")
+	})
+
+	t.Run("date property", func(t *testing.T) {
+		class := &models.Class{
+			Class:               "TestClass",
+			InvertedIndexConfig: &models.InvertedIndexConfig{},
+			Properties: []*models.Property{
+				{Name: "sessionStart", DataType: schema.DataTypeDate.PropString()},
+			},
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled:    true,
+				DefaultTTL: 60,
+				DeleteOn:   "sessionStart",
+			},
+		}
+		shd, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+		defer idx.drop()
+
+		expired := testObject(class.Class)
+		expired.Object.Properties = map[string]interface{}{"sessionStart": now.Add(-time.Hour)}
+		live := testObject(class.Class)
+		live.Object.Properties = map[string]interface{}{"sessionStart": now}
+		require.Nil(t, shd.PutObject(ctx, expired))
+		require.Nil(t, shd.PutObject(ctx, live))
+
+		assert.True(t, shd.(*LazyLoadShard).shard.deleteExpiredObjects(noAbort))
+
+		exists, err := shd.Exists(ctx, expired.ID())
+		require.Nil(t, err)
+		assert.False(t, exists)
+		exists, err = shd.Exists(ctx, live.ID())
+		require.Nil(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		class := &models.Class{
+			Class:               "TestClass",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ObjectTTLConfig:     &models.ObjectTTLConfig{DefaultTTL: 1},
+		}
+		shd, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+		defer idx.drop()
+
+		obj := testObject(class.Class)
+		obj.Object.CreationTimeUnix = now.Add(-time.Hour).UnixMilli()
+		require.Nil(t, shd.PutObject(ctx, obj))
+
+		assert.False(t, shd.(*LazyLoadShard).shard.deleteExpiredObjects(noAbort))
+		exists, err := shd.Exists(ctx, obj.ID())
+		require.Nil(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -257,6 +257,65 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	return storobj.VectorFromBinary(bytes, container.Slice, targetVector)
 }
 
+func (s *Shard) multiVectorByIndexID(ctx context.Context, indexID uint64, targetVector string) ([][]float32, error) {
+	keyBuf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(keyBuf, indexID)
+
+	bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).GetBySecondary(0, keyBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes == nil {
+		return nil, storobj.NewErrNotFoundf(indexID,
+			"no object for doc id, it could have been deleted")
+	}
+
+	return storobj.MultiVectorFromBinary(bytes, targetVector)
+}
+
+// sparseObjectSearch returns the objects whose sparse vectors of the target
+// vector have the highest dot product with the query vector, together with
+// the dot products as scores
+func (s *Shard) sparseObjectSearch(ctx context.Context, limit int, allowList helpers.AllowList,
+	keywordRanking *searchparams.KeywordRanking, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	if keywordRanking.SparseVector == nil {
+		return nil, nil, errors.Errorf("sparse search: no sparse vector given")
+	}
+	sparseIndex := s.SparseIndexForName(keywordRanking.TargetVector)
+	if sparseIndex == nil {
+		return nil, nil, errors.Errorf("sparse search: target vector %q is not a sparse target vector",
+			keywordRanking.TargetVector)
+	}
+
+	ids, scores, err := sparseIndex.Search(ctx, *keywordRanking.SparseVector, limit, allowList)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "sparse search")
+	}
+
+	objs, err := storobj.ObjectsByDocID(s.store.Bucket(helpers.ObjectsBucketLSM),
+		ids, additional, nil, s.index.logger)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "sparse search: load objects")
+	}
+
+	// objects deleted in the meantime are skipped by ObjectsByDocID
+	if len(objs) != len(ids) {
+		kept := make([]float32, 0, len(objs))
+		j := 0
+		for i := range ids {
+			if j < len(objs) && objs[j].DocID == ids[i] {
+				kept = append(kept, scores[i])
+				j++
+			}
+		}
+		scores = kept
+	}
+
+	return objs, scores, nil
+}
+
 func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties, properties []string,
@@ -310,12 +369,17 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			filterDocIds = objs
 		}
 
+		if keywordRanking.Type == searchparams.KeywordRankingTypeSparse {
+			return s.sparseObjectSearch(ctx, limit, filterDocIds, keywordRanking, additional)
+		}
+
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
 			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher,
-			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
+			s.GetPropertyLengthTracker(), logger, s.versioner.Version(),
+			s.index.Config.QueryTermExpansionScanLimit)
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
 		if err != nil {
 			return nil, nil, err
@@ -397,6 +461,12 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 
 	s.activityTracker.Add(1)
 
+	ctx = helpers.WithFilterStrategy(ctx, additional.FilterStrategy)
+	var explain *helpers.VectorSearchExplain
+	if additional.ExplainVectorSearch {
+		ctx, explain = helpers.InitVectorSearchExplain(ctx)
+	}
+
 	var allowList helpers.AllowList
 	if filters != nil {
 		beforeFilter := time.Now()
@@ -409,6 +479,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 		s.metrics.FilteredVectorFilter(took)
 		helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
 		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+		explain.SetAllowListSize(allowList.Len())
 	}
 
 	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
@@ -483,6 +554,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 		if err != nil {
 			return nil, nil, err
 		}
+		storobj.AddVectorSearchExplain(objs, explain.Result(s.ID()))
 		return objs, dists, nil
 	}
 
@@ -514,6 +586,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 	}
 
 	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+	storobj.AddVectorSearchExplain(objs, explain.Result(s.ID()))
 	return objs, distCombined, nil
 }
 
@@ -657,14 +730,16 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
+	err = s.mayRecordDelete(idBytes, deletionTime, func() error {
+		if deletionTime.IsZero() {
+			return bucket.Delete(idBytes)
+		}
+		return bucket.DeleteWith(idBytes, deletionTime)
+	})
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	s.mayForwardReshard(idBytes)
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -0,0 +1,604 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+// An online reindex rebuilds the vector index of a target vector with a new
+// configuration, while the current index keeps serving reads and writes:
+//
+//  1. A marker next to the index records the config of the index on disk, so
+//     that a restarted shard loads it with the right settings.
+//  2. The live index is wrapped in a [reindexingIndex], which forwards writes
+//     and deletes to a shadow index built with the new config. The shadow has
+//     its own directory and lsm store.
+//  3. The shadow is filled from the object store in the background.
+//  4. The shadow is closed, the live index dropped and the files of the
+//     shadow moved into the shard, where the index is loaded again.
+//
+// The marker tracks the steps of the swap, a shard restarted in the middle
+// of it completes the swap on startup. A shard restarted while the shadow is
+// built starts the build over.
+
+const (
+	reindexStateBuilding = "building"
+	reindexStateDropping = "dropping"
+	reindexStateMoving   = "moving"
+
+	reindexFillBatchSize = 1000
+)
+
+type reindexMarker struct {
+	State             string          `json:"state"`
+	VectorIndexType   string          `json:"vectorIndexType"`
+	VectorIndexConfig json.RawMessage `json:"vectorIndexConfig"`
+}
+
+func reindexMarkerPath(shardPath, targetVector string) string {
+	return path.Join(shardPath, vectorIndexID(targetVector)+".reindex.json")
+}
+
+func reindexShadowPath(shardPath, targetVector string) string {
+	return path.Join(shardPath, vectorIndexID(targetVector)+".reindex.d")
+}
+
+// writeReindexMarker records the config of the vector index on disk before it
+// is reindexed. An existing marker is kept, as it describes the index on disk
+// if a reindex is started again before the previous one completed.
+func writeReindexMarker(shardPath, targetVector string, current schemaConfig.VectorIndexConfig) error {
+	markerPath := reindexMarkerPath(shardPath, targetVector)
+	if _, err := os.Stat(markerPath); err == nil {
+		return nil
+	}
+
+	cfg, err := json.Marshal(current)
+	if err != nil {
+		return errors.Wrap(err, "marshal vector index config")
+	}
+	return writeReindexMarkerFile(markerPath, &reindexMarker{
+		State:             reindexStateBuilding,
+		VectorIndexType:   current.IndexType(),
+		VectorIndexConfig: cfg,
+	})
+}
+
+func writeReindexMarkerFile(markerPath string, marker *reindexMarker) error {
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return errors.Wrap(err, "marshal reindex marker")
+	}
+
+	tmpPath := markerPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return errors.Wrapf(err, "write reindex marker %q", tmpPath)
+	}
+	return os.Rename(tmpPath, markerPath)
+}
+
+func readReindexMarker(markerPath string) (*reindexMarker, error) {
+	data, err := os.ReadFile(markerPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read reindex marker %q", markerPath)
+	}
+
+	var marker reindexMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal reindex marker %q", markerPath)
+	}
+	return &marker, nil
+}
+
+// config returns the config of the vector index the marker was written for.
+func (m *reindexMarker) config() (schemaConfig.VectorIndexConfig, error) {
+	var input map[string]interface{}
+	if err := json.Unmarshal(m.VectorIndexConfig, &input); err != nil {
+		return nil, errors.Wrap(err, "unmarshal vector index config")
+	}
+	return vectorindex.ParseAndValidateConfig(input, m.VectorIndexType)
+}
+
+// vectorReindex tracks the rebuild of the vector index of a target vector
+type vectorReindex struct {
+	targetVector string
+	index        *reindexingIndex
+	shadow       *shadowIndex
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	indexed atomic.Int64
+	total   int64
+
+	sync.Mutex
+	status string
+	err    error
+}
+
+func (r *vectorReindex) setStatus(status string, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.status = status
+	r.err = err
+}
+
+// stop interrupts the rebuild and waits for it to return. A swap that
+// already started is completed.
+func (r *vectorReindex) stop() {
+	r.cancel()
+	<-r.done
+}
+
+func (r *vectorReindex) toModel() *models.VectorReindexStatus {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.status == "" {
+		// completed
+		return nil
+	}
+
+	status := &models.VectorReindexStatus{
+		TargetVector: r.targetVector,
+		Status:       r.status,
+		Indexed:      r.indexed.Load(),
+		Total:        r.total,
+	}
+	if r.err != nil {
+		status.Error = r.err.Error()
+	}
+	return status
+}
+
+// ReindexVectorIndex rebuilds the vector index of the target vector with the
+// config currently set on the index, restarting a rebuild in progress. It
+// returns once the rebuild was started, the rebuild itself happens in the
+// background. It is a no-op if no reindex marker was written for the target
+// vector, which happens if the shard was created after the reindex started.
+func (s *Shard) ReindexVectorIndex(ctx context.Context, targetVector string) error {
+	s.reindexesLock.Lock()
+	defer s.reindexesLock.Unlock()
+
+	marker, err := readReindexMarker(reindexMarkerPath(s.path(), targetVector))
+	if err != nil {
+		return err
+	}
+	if marker == nil {
+		return nil
+	}
+
+	if r := s.reindexes[targetVector]; r != nil {
+		r.stop()
+	}
+
+	live := s.getVectorIndex(targetVector)
+	if live == nil {
+		return fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	if live.Multivector() {
+		return fmt.Errorf("reindexing the multi vector index of target vector %q is not supported", targetVector)
+	}
+
+	index, ok := live.(*reindexingIndex)
+	if !ok {
+		index = newReindexingIndex(live, reindexMarkerPath(s.path(), targetVector))
+	} else if err := index.setShadow(ctx, nil); err != nil {
+		return errors.Wrap(err, "discard previous shadow vector index")
+	}
+
+	shadow, err := s.newShadowIndex(ctx, targetVector)
+	if err != nil {
+		return err
+	}
+	if err := index.setShadow(ctx, shadow); err != nil {
+		return err
+	}
+	if !ok {
+		if err := s.setVectorIndex(targetVector, index); err != nil {
+			return err
+		}
+	}
+
+	reindexCtx, cancel := context.WithCancel(context.Background())
+	r := &vectorReindex{
+		targetVector: targetVector,
+		index:        index,
+		shadow:       shadow,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		total:        int64(s.ObjectCountAsync()),
+		status:       models.VectorReindexStatusStatusBUILDING,
+	}
+	if s.reindexes == nil {
+		s.reindexes = map[string]*vectorReindex{}
+	}
+	s.reindexes[targetVector] = r
+
+	enterrors.GoWrapper(func() { s.runVectorReindex(reindexCtx, r) }, s.index.logger)
+	return nil
+}
+
+// VectorReindexStatuses returns the progress of the vector index rebuilds
+// that are in progress or failed.
+func (s *Shard) VectorReindexStatuses() []*models.VectorReindexStatus {
+	s.reindexesLock.Lock()
+	defer s.reindexesLock.Unlock()
+
+	var statuses []*models.VectorReindexStatus
+	for _, r := range s.reindexes {
+		if status := r.toModel(); status != nil {
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].TargetVector < statuses[j].TargetVector
+	})
+	return statuses
+}
+
+// resumeVectorReindexes restarts the rebuilds that were interrupted by a
+// shutdown. The live indexes were loaded with the config in their markers.
+func (s *Shard) resumeVectorReindexes(ctx context.Context) error {
+	targetVectors := []string{""}
+	if s.hasTargetVectors() {
+		targetVectors = make([]string, 0, len(s.vectorIndexes))
+		for targetVector := range s.vectorIndexes {
+			targetVectors = append(targetVectors, targetVector)
+		}
+	}
+
+	for _, targetVector := range targetVectors {
+		if err := s.ReindexVectorIndex(ctx, targetVector); err != nil {
+			return fmt.Errorf("resume reindex of vector index %q: %w", targetVector, err)
+		}
+	}
+	return nil
+}
+
+// stopVectorReindexes interrupts the rebuilds in progress, it is called
+// before the vector indexes are shut down or dropped.
+func (s *Shard) stopVectorReindexes() {
+	s.reindexesLock.Lock()
+	defer s.reindexesLock.Unlock()
+
+	for _, r := range s.reindexes {
+		r.stop()
+	}
+}
+
+func (s *Shard) newShadowIndex(ctx context.Context, targetVector string) (*shadowIndex, error) {
+	dir := reindexShadowPath(s.path(), targetVector)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "remove previous shadow vector index at %q", dir)
+	}
+
+	logger := s.index.logger.WithFields(logrus.Fields{
+		"shard":         s.name,
+		"index":         s.index.ID(),
+		"class":         s.index.Config.ClassName,
+		"target_vector": targetVector,
+	})
+	store, err := lsmkv.New(path.Join(dir, "lsm"), dir, logger, nil,
+		s.cycleCallbacks.compactionCallbacks,
+		s.cycleCallbacks.compactionAuxCallbacks,
+		s.cycleCallbacks.flushCallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("init shadow lsmkv store at %s: %w", dir, err)
+	}
+
+	index, err := s.initVectorIndexAt(ctx, targetVector, s.index.vectorIndexConfig(targetVector), dir, store)
+	if err != nil {
+		_ = store.Shutdown(ctx)
+		return nil, fmt.Errorf("init shadow vector index: %w", err)
+	}
+
+	return &shadowIndex{
+		index:   index,
+		store:   store,
+		dir:     dir,
+		deleted: map[uint64]struct{}{},
+	}, nil
+}
+
+func (s *Shard) runVectorReindex(ctx context.Context, r *vectorReindex) {
+	defer close(r.done)
+
+	logger := s.index.logger.WithFields(logrus.Fields{
+		"action":        "vector_reindex",
+		"shard":         s.name,
+		"class":         s.index.Config.ClassName,
+		"target_vector": r.targetVector,
+	})
+	logger.Info("rebuilding vector index")
+
+	err := s.fillShadowIndex(ctx, r)
+	if err == nil {
+		r.setStatus(models.VectorReindexStatusStatusSWAPPING, nil)
+		err = s.swapShadowIndex(ctx, r)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			logger.Info("vector index rebuild interrupted")
+			return
+		}
+		logger.WithError(err).Error("vector index rebuild failed")
+		r.setStatus(models.VectorReindexStatusStatusFAILED, err)
+
+		r.index.Lock()
+		if r.index.shadow == r.shadow {
+			if err := r.shadow.discard(context.Background()); err != nil {
+				logger.WithError(err).Warn("discard shadow vector index")
+			}
+			r.index.shadow = nil
+		}
+		r.index.Unlock()
+		return
+	}
+
+	r.setStatus("", nil)
+	logger.Info("vector index rebuilt")
+}
+
+// fillShadowIndex adds the vectors of all objects in the store to the
+// shadow index. Vectors written concurrently are forwarded by the live index.
+func (s *Shard) fillShadowIndex(ctx context.Context, r *vectorReindex) error {
+	shadow := r.shadow
+
+	ids := make([]uint64, 0, reindexFillBatchSize)
+	vectors := make([][]float32, 0, reindexFillBatchSize)
+	addBatch := func() error {
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := shadow.index.AddBatch(ctx, ids, vectors); err != nil {
+			return errors.Wrap(err, "add vectors to shadow index")
+		}
+		r.indexed.Add(int64(len(ids)))
+		ids, vectors = ids[:0], vectors[:0]
+		return shadow.failure()
+	}
+
+	err := s.iterateOnLSMVectors(ctx, 0, r.targetVector, func(id uint64, vector []float32) error {
+		if len(vector) == 0 || shadow.isDeleted(id) || shadow.index.ContainsNode(id) {
+			return nil
+		}
+
+		ids = append(ids, id)
+		vectors = append(vectors, vector)
+		if len(ids) < reindexFillBatchSize {
+			return nil
+		}
+		return addBatch()
+	})
+	if err != nil {
+		return err
+	}
+	if err := addBatch(); err != nil {
+		return err
+	}
+
+	if err := shadow.completeFill(); err != nil {
+		return errors.Wrap(err, "delete vectors from shadow index")
+	}
+	return shadow.failure()
+}
+
+// swapShadowIndex replaces the live index with the shadow index. Reads and
+// writes of the target vector are blocked while the index is loaded from its
+// new location.
+func (s *Shard) swapShadowIndex(ctx context.Context, r *vectorReindex) error {
+	r.index.Lock()
+
+	if err := ctx.Err(); err != nil {
+		r.index.Unlock()
+		return err
+	}
+
+	// the swap is not interrupted once it started, a partial swap would
+	// leave the shard without a usable index until it is restarted
+	ctx = context.Background()
+
+	index, err := s.swapShadowIndexLocked(ctx, r)
+	r.index.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return s.setVectorIndex(r.targetVector, index)
+}
+
+func (s *Shard) swapShadowIndexLocked(ctx context.Context, r *vectorReindex) (VectorIndex, error) {
+	markerPath := reindexMarkerPath(s.path(), r.targetVector)
+	marker, err := readReindexMarker(markerPath)
+	if err != nil {
+		return nil, err
+	}
+	if marker == nil {
+		return nil, fmt.Errorf("reindex marker %q not found", markerPath)
+	}
+
+	if err := r.shadow.close(ctx); err != nil {
+		return nil, err
+	}
+	r.index.shadow = nil
+
+	marker.State = reindexStateDropping
+	if err := writeReindexMarkerFile(markerPath, marker); err != nil {
+		return nil, err
+	}
+	if err := r.index.live.Drop(ctx); err != nil {
+		return nil, errors.Wrap(err, "drop live vector index")
+	}
+
+	index, err := s.completeReindexSwap(ctx, r.targetVector, marker)
+	if err != nil {
+		return nil, err
+	}
+
+	r.index.live = index
+	r.index.reindexing = false
+	return index, nil
+}
+
+// completeReindexSwap moves the files of the shadow index into the shard and
+// loads the index from there. The live index must have been dropped.
+func (s *Shard) completeReindexSwap(ctx context.Context, targetVector string,
+	marker *reindexMarker,
+) (VectorIndex, error) {
+	markerPath := reindexMarkerPath(s.path(), targetVector)
+	if marker.State != reindexStateMoving {
+		marker.State = reindexStateMoving
+		if err := writeReindexMarkerFile(markerPath, marker); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.moveShadowIndexFiles(ctx, targetVector); err != nil {
+		return nil, errors.Wrap(err, "move shadow vector index")
+	}
+
+	index, err := s.initVectorIndex(ctx, targetVector, s.index.vectorIndexConfig(targetVector))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(markerPath); err != nil {
+		return nil, errors.Wrap(err, "remove reindex marker")
+	}
+	return index, nil
+}
+
+// moveShadowIndexFiles moves the files of the shadow index into the shard
+// directory and its buckets into the shard store, replacing the existing
+// ones. It can be repeated after being interrupted.
+func (s *Shard) moveShadowIndexFiles(ctx context.Context, targetVector string) error {
+	dir := reindexShadowPath(s.path(), targetVector)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == "lsm" {
+			continue
+		}
+
+		src, dst := path.Join(dir, entry.Name()), path.Join(s.path(), entry.Name())
+		if err := os.RemoveAll(dst); err != nil {
+			return errors.Wrapf(err, "remove %q", dst)
+		}
+		if err := os.Rename(src, dst); err != nil {
+			return errors.Wrapf(err, "move %q to %q", src, dst)
+		}
+	}
+
+	buckets, err := os.ReadDir(path.Join(dir, "lsm"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, bucket := range buckets {
+		if !bucket.IsDir() {
+			continue
+		}
+		if err := s.store.AdoptBucket(ctx, bucket.Name(), path.Join(dir, "lsm", bucket.Name())); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// initReindexedVectorIndex loads the vector index of the target vector,
+// taking a reindex interrupted by a shutdown into account. The index is
+// loaded with the config in the marker if the rebuild was in progress, or
+// the swap is completed if it had started.
+func (s *Shard) initReindexedVectorIndex(ctx context.Context, targetVector string,
+	vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	marker, err := readReindexMarker(reindexMarkerPath(s.path(), targetVector))
+	if err != nil {
+		return nil, err
+	}
+	if marker == nil {
+		return s.initVectorIndex(ctx, targetVector, vectorIndexUserConfig)
+	}
+
+	previous, err := marker.config()
+	if err != nil {
+		return nil, errors.Wrap(err, "reindex marker")
+	}
+
+	switch marker.State {
+	case reindexStateDropping:
+		live, err := s.initVectorIndex(ctx, targetVector, previous)
+		if err != nil {
+			return nil, errors.Wrap(err, "load previous vector index")
+		}
+		if err := live.Drop(ctx); err != nil {
+			return nil, errors.Wrap(err, "drop previous vector index")
+		}
+		return s.completeReindexSwap(ctx, targetVector, marker)
+	case reindexStateMoving:
+		return s.completeReindexSwap(ctx, targetVector, marker)
+	default:
+		// the shadow is built again once the shard is loaded
+		if err := os.RemoveAll(reindexShadowPath(s.path(), targetVector)); err != nil {
+			return nil, errors.Wrap(err, "remove shadow vector index")
+		}
+		return s.initVectorIndex(ctx, targetVector, previous)
+	}
+}
+
+// setVectorIndex replaces the vector index of the target vector in the shard
+// and in its queue.
+func (s *Shard) setVectorIndex(targetVector string, index VectorIndex) error {
+	q, err := s.getIndexQueue(targetVector)
+	if err != nil {
+		return err
+	}
+
+	if targetVector != "" {
+		// copy on write, the map is read without locks
+		indexes := maps.Clone(s.vectorIndexes)
+		indexes[targetVector] = index
+		s.vectorIndexes = indexes
+	} else {
+		s.vectorIndex = index
+	}
+
+	q.SwapIndex(index)
+	return nil
+}
@@ -60,6 +60,8 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
+		s.cycleCallbacks.vectorRecallCallbacksCtrl,
 	).Unregister(ctx)
 	ec.Add(err)
 
@@ -70,6 +72,8 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 	}
 	s.hashtreeRWMux.Unlock()
 
+	s.stopVectorReindexes()
+
 	if s.hasTargetVectors() {
 		// TODO run in parallel?
 		for targetVector, queue := range s.queues {
@@ -828,3 +828,60 @@ func TestTargetVectorsEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestTargetMultiVectorsEqual(t *testing.T) {
+	vec1 := []float32{1, 2, 3}
+	vec2 := []float32{2, 3, 4}
+	vec3 := []float32{3, 4, 5}
+
+	type testCase struct {
+		prevVecs      map[string][][]float32
+		nextVecs      map[string][][]float32
+		expectedEqual bool
+	}
+
+	testCases := []testCase{
+		{
+			prevVecs:      nil,
+			nextVecs:      map[string][][]float32{},
+			expectedEqual: true,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      nil,
+			expectedEqual: false,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			expectedEqual: true,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec2, vec1}},
+			expectedEqual: false,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec1, vec2, vec3}},
+			expectedEqual: false,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1}},
+			nextVecs:      map[string][][]float32{"other": {vec1}},
+			expectedEqual: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			eq := targetMultiVectorsEqual(tc.prevVecs, tc.nextVecs)
+
+			if tc.expectedEqual {
+				assert.True(t, eq)
+			} else {
+				assert.False(t, eq)
+			}
+		})
+	}
+}
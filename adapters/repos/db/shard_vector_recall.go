@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// vectorRecallSampleAttempts limits the number of random doc ids that are
+// tried per requested sample, doc ids of deleted objects are skipped
+const vectorRecallSampleAttempts = 10
+
+var errVectorRecallAborted = errors.New("vector recall measurement aborted")
+
+// measureVectorRecall is the vector recall cycle callback of the shard. For
+// every vector index it uses a sample of the stored vectors as queries and
+// compares the results of the index with a brute force search over the
+// object store. The measured recall@k is exported as a metric.
+func (s *Shard) measureVectorRecall(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if s.promMetrics == nil {
+		return false
+	}
+
+	indexes := map[string]VectorIndex{"": s.vectorIndex}
+	if s.hasTargetVectors() {
+		indexes = s.vectorIndexes
+	}
+
+	executed := false
+	for targetVector, index := range indexes {
+		if shouldAbort() {
+			break
+		}
+
+		logger := s.index.logger.WithFields(logrus.Fields{
+			"action":        "measure_vector_recall",
+			"class":         s.index.Config.ClassName,
+			"shard":         s.name,
+			"target_vector": targetVector,
+		})
+
+		started := time.Now()
+		recall, ok, err := s.vectorRecall(context.Background(), targetVector, index, shouldAbort)
+		if err != nil {
+			if !errors.Is(err, errVectorRecallAborted) {
+				logger.WithError(err).Error("measure vector recall")
+			}
+			continue
+		}
+		if !ok {
+			continue
+		}
+		executed = true
+
+		// Important: Never group classes/shards for this metric, the recall
+		// of different shards can not be combined into a single gauge
+		k := strconv.Itoa(s.index.Config.VectorRecallMonitoring.K)
+		s.promMetrics.VectorIndexRecall.
+			WithLabelValues(s.index.Config.ClassName.String(), s.name, targetVector, k).
+			Set(recall)
+		s.promMetrics.VectorIndexRecallDurations.
+			WithLabelValues(s.index.Config.ClassName.String(), s.name, targetVector).
+			Observe(float64(time.Since(started).Milliseconds()))
+		logger.WithField("recall", recall).Debug("measured vector recall")
+	}
+	return executed
+}
+
+// vectorRecall returns the recall@k of the given vector index. It returns
+// false if recall can not be measured at the moment, e.g. because the shard
+// is empty or vectors are still waiting to be indexed.
+func (s *Shard) vectorRecall(ctx context.Context, targetVector string, index VectorIndex,
+	shouldAbort cyclemanager.ShouldAbortCallback,
+) (float64, bool, error) {
+	if index == nil || index.Multivector() {
+		return 0, false, nil
+	}
+	// vectors in the queue are part of the ground truth, but can not be
+	// found through the index yet
+	if q, err := s.getIndexQueue(targetVector); err != nil || q.Size() > 0 {
+		return 0, false, nil
+	}
+
+	cfg := s.index.Config.VectorRecallMonitoring
+	queries, err := s.sampleVectors(ctx, targetVector, cfg.SampleSize)
+	if err != nil {
+		return 0, false, fmt.Errorf("sample vectors: %w", err)
+	}
+	if len(queries) == 0 {
+		return 0, false, nil
+	}
+
+	truth, err := s.bruteForceNeighbors(ctx, targetVector, index, queries, cfg.K, shouldAbort)
+	if err != nil {
+		return 0, false, err
+	}
+
+	found, expected := 0, 0
+	for i, query := range queries {
+		ids, _, err := index.SearchByVector(ctx, query, cfg.K, nil)
+		if err != nil {
+			return 0, false, fmt.Errorf("search vector index: %w", err)
+		}
+		found += countContained(ids, truth[i])
+		expected += len(truth[i])
+	}
+	if expected == 0 {
+		return 0, false, nil
+	}
+	return float64(found) / float64(expected), true, nil
+}
+
+// sampleVectors picks up to n random vectors from the object store
+func (s *Shard) sampleVectors(ctx context.Context, targetVector string, n int) ([][]float32, error) {
+	maxDocID := s.Counter().Get()
+	if maxDocID == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[uint64]struct{}, n)
+	vectors := make([][]float32, 0, n)
+	for attempt := 0; attempt < n*vectorRecallSampleAttempts && len(vectors) < n; attempt++ {
+		docID := uint64(rand.Int63n(int64(maxDocID)))
+		if _, ok := seen[docID]; ok {
+			continue
+		}
+		seen[docID] = struct{}{}
+
+		vector, err := s.vectorByIndexID(ctx, docID, targetVector)
+		if err != nil {
+			var notFound storobj.ErrNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return nil, err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+		vectors = append(vectors, vector)
+	}
+	return vectors, nil
+}
+
+// bruteForceNeighbors returns the ids of the k nearest neighbors of every
+// query by comparing it against every vector in the object store
+func (s *Shard) bruteForceNeighbors(ctx context.Context, targetVector string, index VectorIndex,
+	queries [][]float32, k int, shouldAbort cyclemanager.ShouldAbortCallback,
+) ([]map[uint64]struct{}, error) {
+	provider := index.DistancerProvider()
+	// cosine-dot requires normalized vectors, the indexes normalize on insert
+	normalize := func(vector []float32) []float32 { return vector }
+	if provider.Type() == "cosine-dot" {
+		normalize = distancer.Normalize
+	}
+
+	normalized := make([][]float32, len(queries))
+	heaps := make([]*priorityqueue.Queue[any], len(queries))
+	for i := range heaps {
+		normalized[i] = normalize(queries[i])
+		heaps[i] = priorityqueue.NewMax[any](k)
+	}
+
+	scanned := 0
+	err := s.iterateOnLSMVectors(ctx, 0, targetVector, func(id uint64, vector []float32) error {
+		if scanned++; scanned%1000 == 0 && shouldAbort() {
+			return errVectorRecallAborted
+		}
+		if len(vector) == 0 {
+			return nil
+		}
+
+		vector = normalize(vector)
+		for i, query := range normalized {
+			dist, err := provider.SingleDist(query, vector)
+			if err != nil {
+				return fmt.Errorf("calculate distance: %w", err)
+			}
+			if heaps[i].Len() < k {
+				heaps[i].Insert(id, dist)
+			} else if heaps[i].Top().Dist > dist {
+				heaps[i].Pop()
+				heaps[i].Insert(id, dist)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := make([]map[uint64]struct{}, len(heaps))
+	for i, heap := range heaps {
+		neighbors[i] = make(map[uint64]struct{}, heap.Len())
+		for heap.Len() > 0 {
+			neighbors[i][heap.Pop().ID] = struct{}{}
+		}
+	}
+	return neighbors, nil
+}
+
+func countContained(ids []uint64, set map[uint64]struct{}) int {
+	count := 0
+	for _, id := range ids {
+		if _, ok := set[id]; ok {
+			count++
+		}
+	}
+	return count
+}
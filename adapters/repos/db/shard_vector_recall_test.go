@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+func TestShard_MeasureVectorRecall(t *testing.T) {
+	ctx := testCtx()
+	noAbort := func() bool { return false }
+	withRecallMonitoring := func(idx *Index) {
+		idx.Config.VectorRecallMonitoring = config.VectorRecallMonitoring{
+			Enabled:    true,
+			SampleSize: 10,
+			K:          5,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		config    schemaConfig.VectorIndexConfig
+		minRecall float64
+	}{
+		{name: "flat", config: flatent.NewDefaultUserConfig(), minRecall: 1},
+		{name: "hnsw", config: enthnsw.NewDefaultUserConfig(), minRecall: 0.9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := &models.Class{Class: "RecallClass"}
+			shd, idx := testShardWithSettings(t, ctx, class, tt.config, false, false, withRecallMonitoring)
+			defer idx.drop()
+
+			objects := createRandomObjects(rand.New(rand.NewSource(7)), class.Class, 300, 16)
+			for _, obj := range objects {
+				require.Nil(t, shd.PutObject(ctx, obj))
+			}
+			for _, obj := range objects[:30] {
+				require.Nil(t, shd.DeleteObject(ctx, obj.ID(), time.Now()))
+			}
+			shard := shd.(*LazyLoadShard).shard
+
+			t.Run("recall against brute force", func(t *testing.T) {
+				recall, ok, err := shard.vectorRecall(ctx, "", shard.VectorIndex(), noAbort)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.GreaterOrEqual(t, recall, tt.minRecall)
+				assert.LessOrEqual(t, recall, 1.0)
+			})
+
+			t.Run("no metrics without monitoring", func(t *testing.T) {
+				assert.False(t, shard.measureVectorRecall(noAbort))
+			})
+
+			t.Run("recall is exported as metric", func(t *testing.T) {
+				metrics := monitoring.GetMetrics()
+				shard.promMetrics = metrics
+				defer func() { shard.promMetrics = nil }()
+
+				require.True(t, shard.measureVectorRecall(noAbort))
+				recall := testutil.ToFloat64(metrics.VectorIndexRecall.
+					WithLabelValues(class.Class, shard.Name(), "", "5"))
+				assert.GreaterOrEqual(t, recall, tt.minRecall)
+
+				require.Nil(t, metrics.DeleteShard(class.Class, shard.Name()))
+			})
+		})
+	}
+
+	t.Run("empty shard", func(t *testing.T) {
+		class := &models.Class{Class: "EmptyRecallClass"}
+		shd, idx := testShardWithSettings(t, ctx, class, flatent.NewDefaultUserConfig(), false, false, withRecallMonitoring)
+		defer idx.drop()
+
+		shard := shd.(*LazyLoadShard)
+		require.Nil(t, shard.Load(ctx))
+		_, ok, err := shard.shard.vectorRecall(ctx, "", shard.shard.VectorIndex(), noAbort)
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -150,16 +151,20 @@ func (b *deleteObjectsBatcher) setErrorAtIndex(err error, index int) {
 }
 
 func (s *Shard) findDocIDs(ctx context.Context, filters *filters.LocalFilter) ([]uint64, error) {
-	allowList, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
-		nil, s.index.classSearcher, s.index.stopwords, s.versioner.version, s.isFallbackToSearchable,
-		s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
-		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
+	allowList, err := s.findDocIDsAllowList(ctx, filters)
 	if err != nil {
 		return nil, err
 	}
 	return allowList.Slice(), nil
 }
 
+func (s *Shard) findDocIDsAllowList(ctx context.Context, filters *filters.LocalFilter) (helpers.AllowList, error) {
+	return inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+		nil, s.index.classSearcher, s.index.stopwords, s.versioner.version, s.isFallbackToSearchable,
+		s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
+}
+
 func (s *Shard) FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]strfmt.UUID, error) {
 	docs, err := s.findDocIDs(ctx, filters)
 	if err != nil {
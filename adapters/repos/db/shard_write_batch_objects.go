@@ -331,6 +331,15 @@ func (ob *objectsBatcher) storeAdditionalStorageWithAsyncQueue(ctx context.Conte
 			continue
 		}
 
+		// multi vectors are not supported by the async queue, they are
+		// always indexed synchronously
+		if len(object.MultiVectors) > 0 {
+			if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(ctx, object.MultiVectors, status); err != nil {
+				ob.setErrorAtIndex(errors.Wrap(err, "insert to multi vector index"), i)
+				continue
+			}
+		}
+
 		if len(object.Vector) == 0 && len(object.Vectors) == 0 {
 			continue
 		}
@@ -412,7 +421,7 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		return
 	}
 
-	if object.Vector != nil || len(object.Vectors) > 0 {
+	if object.Vector != nil || len(object.Vectors) > 0 || len(object.MultiVectors) > 0 {
 		// By this time all required deletes (e.g. because of DocID changes) have
 		// already been grouped and performed in bulk. Only the insertions are
 		// left. The motivation for this change is explained in
@@ -439,6 +448,12 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 					return
 				}
 			}
+			if len(object.MultiVectors) > 0 {
+				if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(ctx, object.MultiVectors, status); err != nil {
+					ob.setErrorAtIndex(errors.Wrap(err, "insert to multi vector index"), index)
+					return
+				}
+			}
 		} else {
 			if object.Vector != nil {
 				if err := ob.shard.updateVectorIndexIgnoreDelete(ctx, object.Vector, status); err != nil {
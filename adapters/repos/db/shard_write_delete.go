@@ -53,14 +53,16 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
+	err = s.mayRecordDelete(idBytes, deletionTime, func() error {
+		if deletionTime.IsZero() {
+			return bucket.Delete(idBytes)
+		}
+		return bucket.DeleteWith(idBytes, deletionTime)
+	})
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.mayForwardReshard(idBytes)
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -129,14 +131,16 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 
 	var err error
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
+	err = s.mayRecordDelete(idBytes, deletionTime, func() error {
+		if deletionTime.IsZero() {
+			return bucket.Delete(idBytes)
+		}
+		return bucket.DeleteWith(idBytes, deletionTime)
+	})
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.mayForwardReshard(idBytes)
 
 	err = s.cleanupInvertedIndexOnDelete(obj, docID)
 	if err != nil {
@@ -194,6 +198,18 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 
+	previousPositions, err := s.analyzePositions(previousObject)
+	if err != nil {
+		return fmt.Errorf("analyze positions of previous object: %w", err)
+	}
+	if err = s.deleteFromPositionIndicesLSM(previousPositions, docID); err != nil {
+		return fmt.Errorf("delete position indices: %w", err)
+	}
+
+	if err = s.deleteFromSparseIndicesLSM(previousObject.SparseVectors, docID); err != nil {
+		return fmt.Errorf("delete sparse indices: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		if s.hasTargetVectors() {
 			for vecName, vec := range previousObject.Vectors {
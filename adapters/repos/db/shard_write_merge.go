@@ -43,6 +43,20 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 				return errors.Wrapf(err, "Validate vector index for update of %v for target vector %s", merge.ID, targetVector)
 			}
 		}
+		for targetVector, vectors := range merge.MultiVectors {
+			vectorIndex := s.VectorIndexForName(targetVector)
+			if vectorIndex == nil {
+				return errors.Errorf("Validate vector index for update of %v for target vector %s: vector index not found", merge.ID, targetVector)
+			}
+			if err := vectorIndex.ValidateMultiBeforeInsert(multiVectorAsSlices(vectors)); err != nil {
+				return errors.Wrapf(err, "Validate multi vector index for update of %v for target vector %s", merge.ID, targetVector)
+			}
+		}
+		for targetVector := range merge.SparseVectors {
+			if s.SparseIndexForName(targetVector) == nil {
+				return errors.Errorf("Validate sparse index for update of %v for target vector %s: sparse index not found", merge.ID, targetVector)
+			}
+		}
 	} else {
 		if merge.Vector != nil {
 			// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
@@ -79,6 +93,11 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		for targetVector, vectors := range obj.MultiVectors {
+			if err := s.updateMultiVectorIndexForName(ctx, vectors, status, targetVector); err != nil {
+				return errors.Wrapf(err, "update multi vector index for target vector %s", targetVector)
+			}
+		}
 	} else {
 		if err := s.updateVectorIndex(ctx, obj.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -146,7 +165,9 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
 		}
 
-		if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
+		if err := s.mayRecordUpsert(idBytes, objBytes, obj.LastUpdateTimeUnix(), status, func() error {
+			return s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID)
+		}); err != nil {
 			return errors.Wrap(err, "upsert object data")
 		}
 
@@ -225,7 +246,9 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrapf(err, "marshal object %s to binary", obj.ID())
 	}
 
-	if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
+	if err := s.mayRecordUpsert(idBytes, objBytes, obj.LastUpdateTimeUnix(), status, func() error {
+		return s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID)
+	}); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
@@ -298,6 +321,9 @@ func mergeProps(previous *storobj.Object,
 		next.Vectors = vectorsAsMap(merge.Vectors)
 	}
 
+	next.MultiVectors = mergeMultiVectorsAsMap(previous.MultiVectors, merge.MultiVectors)
+	next.SparseVectors = mergeSparseVectorsAsMap(previous.SparseVectors, merge.SparseVectors)
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
@@ -314,3 +340,43 @@ func vectorsAsMap(in models.Vectors) map[string][]float32 {
 	}
 	return nil
 }
+
+// mergeMultiVectorsAsMap keeps the previous multi vectors of all targets which
+// are not part of the merge
+func mergeMultiVectorsAsMap(previous map[string][][]float32, in models.MultiVectors) map[string][][]float32 {
+	if len(in) == 0 {
+		return previous
+	}
+	out := make(map[string][][]float32, len(previous)+len(in))
+	for targetVector, vectors := range previous {
+		out[targetVector] = vectors
+	}
+	for targetVector, vectors := range in {
+		out[targetVector] = multiVectorAsSlices(vectors)
+	}
+	return out
+}
+
+// mergeSparseVectorsAsMap keeps the previous sparse vectors of all targets
+// which are not part of the merge
+func mergeSparseVectorsAsMap(previous map[string]models.SparseVector, in models.SparseVectors) map[string]models.SparseVector {
+	if len(in) == 0 {
+		return previous
+	}
+	out := make(map[string]models.SparseVector, len(previous)+len(in))
+	for targetVector, vector := range previous {
+		out[targetVector] = vector
+	}
+	for targetVector, vector := range in {
+		out[targetVector] = vector
+	}
+	return out
+}
+
+func multiVectorAsSlices(in models.MultiVector) [][]float32 {
+	out := make([][]float32, len(in))
+	for i, vector := range in {
+		out[i] = vector
+	}
+	return out
+}
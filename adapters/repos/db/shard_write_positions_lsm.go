@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// updatePositionIndicesLSM replaces the term positions of the previous
+// version of an object with the ones of the next version. Only properties
+// with indexPositions enabled are considered.
+func (s *Shard) updatePositionIndicesLSM(object *storobj.Object,
+	status objectInsertStatus, prevObject *storobj.Object,
+) error {
+	next, err := s.analyzePositions(object)
+	if err != nil {
+		return fmt.Errorf("analyze positions of next object: %w", err)
+	}
+
+	if prevObject != nil {
+		prev, err := s.analyzePositions(prevObject)
+		if err != nil {
+			return fmt.Errorf("analyze positions of previous object: %w", err)
+		}
+		if status.docID == status.oldDocID {
+			// positions of terms still present are overwritten below
+			for propName, terms := range prev {
+				for term := range terms {
+					if _, ok := next[propName][term]; ok {
+						delete(terms, term)
+					}
+				}
+			}
+		}
+		if err := s.deleteFromPositionIndicesLSM(prev, status.oldDocID); err != nil {
+			return err
+		}
+	}
+
+	for propName, terms := range next {
+		bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName))
+		if bucket == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", propName)
+		}
+		for term, positions := range terms {
+			if err := bucket.Put(inverted.PositionsKey(status.docID, []byte(term)),
+				inverted.EncodePositions(positions)); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", propName)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteFromPositionIndicesLSM(positions map[string]map[string][]uint32,
+	docID uint64,
+) error {
+	for propName, terms := range positions {
+		bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName))
+		if bucket == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", propName)
+		}
+		for term := range terms {
+			if err := bucket.Delete(inverted.PositionsKey(docID, []byte(term))); err != nil {
+				return errors.Wrapf(err, "delete term '%s' from positions index", term)
+			}
+		}
+	}
+	return nil
+}
+
+// analyzePositions returns the positions of the terms per property with
+// indexPositions enabled
+func (s *Shard) analyzePositions(object *storobj.Object) (map[string]map[string][]uint32, error) {
+	c := s.index.getSchema.ReadOnlyClass(object.Class().String())
+	if c == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", object.Class().String())
+	}
+
+	props, _ := object.Properties().(map[string]interface{})
+	out := map[string]map[string][]uint32{}
+	for _, prop := range c.Properties {
+		if !inverted.HasPositionIndex(prop) {
+			continue
+		}
+
+		var values []string
+		switch v := props[prop.Name].(type) {
+		case nil:
+			continue
+		case string:
+			values = []string{v}
+		case []string:
+			values = v
+		case []interface{}:
+			values = make([]string, 0, len(v))
+			for _, item := range v {
+				str, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("prop %q: expected text array, got %T", prop.Name, item)
+				}
+				values = append(values, str)
+			}
+		default:
+			return nil, fmt.Errorf("prop %q: expected text, got %T", prop.Name, v)
+		}
+
+		if positions := inverted.TermPositions(prop.Tokenization, values); len(positions) > 0 {
+			out[prop.Name] = positions
+		}
+	}
+	return out, nil
+}
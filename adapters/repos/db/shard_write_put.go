@@ -60,6 +60,11 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		for targetVector, vectors := range object.MultiVectors {
+			if err := s.updateMultiVectorIndexForName(ctx, vectors, status, targetVector); err != nil {
+				return errors.Wrapf(err, "update multi vector index for target vector %s", targetVector)
+			}
+		}
 	} else {
 		if err := s.updateVectorIndex(ctx, object.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -141,6 +146,29 @@ func (s *Shard) updateVectorIndexesIgnoreDelete(ctx context.Context,
 	return nil
 }
 
+// same as updateVectorIndexesIgnoreDelete, but for targets holding multiple
+// vectors per object
+func (s *Shard) updateMultiVectorIndexesIgnoreDelete(ctx context.Context,
+	multiVectors map[string][][]float32, status objectInsertStatus,
+) error {
+	if status.docIDPreserved || status.skipUpsert {
+		return nil
+	}
+
+	for targetVector, vectors := range multiVectors {
+		if len(vectors) == 0 {
+			continue
+		}
+		if vectorIndex := s.VectorIndexForName(targetVector); vectorIndex != nil {
+			if err := vectorIndex.AddMulti(ctx, status.docID, vectors); err != nil {
+				return errors.Wrapf(err, "insert doc id %d to multi vector index for target vector %s", status.docID, targetVector)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Shard) updateVectorIndex(ctx context.Context, vector []float32,
 	status objectInsertStatus,
 ) error {
@@ -197,6 +225,42 @@ func (s *Shard) updateVectorInVectorIndex(ctx context.Context, vector []float32,
 	return nil
 }
 
+// updateMultiVectorIndexForName mirrors updateVectorInVectorIndex for targets
+// holding multiple vectors per object. Multi vectors are not sent through the
+// async queue, they are always indexed synchronously.
+func (s *Shard) updateMultiVectorIndexForName(ctx context.Context, vectors [][]float32,
+	status objectInsertStatus, targetVector string,
+) error {
+	queue, ok := s.queues[targetVector]
+	if !ok {
+		return fmt.Errorf("vector queue not found for target vector %s", targetVector)
+	}
+	vectorIndex := s.VectorIndexForName(targetVector)
+	if vectorIndex == nil {
+		return fmt.Errorf("vector index not found for target vector %s", targetVector)
+	}
+
+	if status.docIDChanged {
+		if err := queue.Delete(status.oldDocID); err != nil {
+			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
+		}
+	}
+
+	if status.docIDPreserved || len(vectors) == 0 {
+		return nil
+	}
+
+	if err := vectorIndex.AddMulti(ctx, status.docID, vectors); err != nil {
+		return errors.Wrapf(err, "insert doc id %d to multi vector index", status.docID)
+	}
+
+	if err := vectorIndex.Flush(); err != nil {
+		return errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
+	return nil
+}
+
 func fetchObject(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, error) {
 	objBytes, err := bucket.Get(idBytes)
 	if err != nil {
@@ -229,6 +293,15 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 				}
 			}
 		}
+		for targetVector, vectors := range obj.MultiVectors {
+			vectorIndex := s.VectorIndexForName(targetVector)
+			if vectorIndex == nil {
+				return status, errors.Errorf("Validate vector index for %s: vector index not found for target vector %s", obj.ID(), targetVector)
+			}
+			if err := vectorIndex.ValidateMultiBeforeInsert(vectors); err != nil {
+				return status, errors.Wrapf(err, "Validate multi vector index %s for target vector %s", targetVector, obj.ID())
+			}
+		}
 	} else {
 		if obj.Vector != nil {
 			// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
@@ -259,6 +332,9 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		if err != nil {
 			return err
 		}
+		if err := s.index.validatePartitionKeyUnchanged(prevObj, obj); err != nil {
+			return err
+		}
 
 		status, err = s.determineInsertStatus(prevObj, obj)
 		if err != nil {
@@ -277,7 +353,9 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 
 		before = time.Now()
-		if err := s.upsertObjectDataLSM(bucket, idBytes, objBinary, status.docID); err != nil {
+		if err := s.mayRecordUpsert(idBytes, objBinary, obj.LastUpdateTimeUnix(), status, func() error {
+			return s.upsertObjectDataLSM(bucket, idBytes, objBinary, status.docID)
+		}); err != nil {
 			return errors.Wrap(err, "upsert object data")
 		}
 		s.metrics.PutObjectUpsertObject(before)
@@ -447,10 +525,15 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 	binary.BigEndian.PutUint64(tokenBytes[:], token)
 	copy(tokenBytes[8:], id)
 
-	return bucket.Put(id, data,
+	if err := bucket.Put(id, data,
 		lsmkv.WithSecondaryKey(helpers.ObjectsBucketLSMDocIDSecondaryIndex, docIDBytes),
 		lsmkv.WithSecondaryKey(helpers.ObjectsBucketLSMTokenRangeSecondaryIndex, tokenBytes[:]),
-	)
+	); err != nil {
+		return err
+	}
+
+	s.mayForwardReshard(id)
+	return nil
 }
 
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
@@ -528,6 +611,14 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 	s.metrics.InvertedExtend(before, len(propsToAdd))
 
+	if err := s.updatePositionIndicesLSM(object, status, prevObject); err != nil {
+		return fmt.Errorf("update position indices: %w", err)
+	}
+
+	if err := s.updateSparseIndicesLSM(object, status, prevObject); err != nil {
+		return fmt.Errorf("update sparse indices: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		if s.hasTargetVectors() {
 			for vecName, vec := range object.Vectors {
@@ -563,6 +654,12 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetVectorsEqual(prevObj.Vectors, nextObj.Vectors) {
 		return false, false
 	}
+	if !targetMultiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
+		return false, false
+	}
+	if !targetSparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return false, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
@@ -648,6 +745,26 @@ func targetVectorsEqual(prevTargetVectors, nextTargetVectors map[string][]float3
 	return true
 }
 
+func targetMultiVectorsEqual(prevMultiVectors, nextMultiVectors map[string][][]float32) bool {
+	if len(prevMultiVectors) != len(nextMultiVectors) {
+		return false
+	}
+
+	for vecName, prevVectors := range prevMultiVectors {
+		nextVectors, ok := nextMultiVectors[vecName]
+		if !ok || len(prevVectors) != len(nextVectors) {
+			return false
+		}
+		for i := range prevVectors {
+			if !common.VectorsEqual(prevVectors[i], nextVectors[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func addPropsEqual(prevAddProps, nextAddProps models.AdditionalProperties) bool {
 	return reflect.DeepEqual(prevAddProps, nextAddProps)
 }
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// updateSparseIndicesLSM replaces the postings of the sparse vectors of the
+// previous version of an object with the ones of the next version. Sparse
+// vectors are part of the comparison of the versions, so they are unchanged
+// if the doc id is preserved.
+func (s *Shard) updateSparseIndicesLSM(object *storobj.Object,
+	status objectInsertStatus, prevObject *storobj.Object,
+) error {
+	if status.docIDPreserved {
+		return nil
+	}
+
+	if prevObject != nil {
+		if err := s.deleteFromSparseIndicesLSM(prevObject.SparseVectors, status.oldDocID); err != nil {
+			return err
+		}
+	}
+
+	for targetVector, vector := range object.SparseVectors {
+		sparseIndex := s.SparseIndexForName(targetVector)
+		if sparseIndex == nil {
+			return fmt.Errorf("no sparse index for target vector %q found", targetVector)
+		}
+		if err := sparseIndex.Add(status.docID, vector); err != nil {
+			return fmt.Errorf("add doc id %d to sparse index for target vector %q: %w",
+				status.docID, targetVector, err)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteFromSparseIndicesLSM(sparseVectors map[string]models.SparseVector,
+	docID uint64,
+) error {
+	for targetVector, vector := range sparseVectors {
+		sparseIndex := s.SparseIndexForName(targetVector)
+		if sparseIndex == nil {
+			// the target vector was removed from the class
+			continue
+		}
+		if err := sparseIndex.Delete(docID, vector); err != nil {
+			return fmt.Errorf("delete doc id %d from sparse index for target vector %q: %w",
+				docID, targetVector, err)
+		}
+	}
+	return nil
+}
+
+func targetSparseVectorsEqual(prevSparseVectors, nextSparseVectors map[string]models.SparseVector) bool {
+	if len(prevSparseVectors) != len(nextSparseVectors) {
+		return false
+	}
+
+	for targetVector, prev := range prevSparseVectors {
+		next, ok := nextSparseVectors[targetVector]
+		if !ok || len(prev.Indices) != len(next.Indices) || len(prev.Values) != len(next.Values) {
+			return false
+		}
+		for i := range prev.Indices {
+			if prev.Indices[i] != next.Indices[i] {
+				return false
+			}
+		}
+		for i := range prev.Values {
+			if prev.Values[i] != next.Values[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	entsparse "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestSparseVectorSearch(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "SparseDocument"
+	class := &models.Class{
+		Class:               className,
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"dense": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			},
+			"splade": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "sparse",
+				VectorIndexConfig: entsparse.NewDefaultUserConfig(),
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "group",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	idOf := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	put := func(i int, group int, vec models.SparseVector) {
+		obj := &models.Object{
+			Class:         className,
+			ID:            idOf(i),
+			Properties:    map[string]interface{}{"group": float64(group)},
+			SparseVectors: models.SparseVectors{"splade": vec},
+		}
+		vectors := models.Vectors{"dense": []float32{1, 2, 3}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, nil, vectors, nil, 0))
+	}
+
+	put(0, 0, models.SparseVector{Indices: []uint32{1, 7, 300}, Values: []float32{0.5, 1.0, 0.2}})
+	put(1, 1, models.SparseVector{Indices: []uint32{7}, Values: []float32{3.0}})
+	put(2, 0, models.SparseVector{Indices: []uint32{1, 300}, Values: []float32{2.0, 2.0}})
+	put(3, 1, models.SparseVector{Indices: []uint32{42}, Values: []float32{1.0}})
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, limit int, filter *filters.LocalFilter, query models.SparseVector) ([]strfmt.UUID, []float32) {
+		kwr := &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: &query,
+			TargetVector: "splade",
+		}
+		res, scores, err := idx.objectSearch(context.TODO(), limit, filter, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, nil)
+		require.Nil(t, err)
+		require.Len(t, scores, len(res))
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids, scores
+	}
+
+	groupFilter := func(group int) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: "group",
+				},
+				Value: &filters.Value{Value: group, Type: schema.DataTypeInt},
+			},
+		}
+	}
+
+	query := models.SparseVector{Indices: []uint32{1, 7}, Values: []float32{1.0, 1.0}}
+
+	t.Run("dot product ranking", func(t *testing.T) {
+		ids, scores := search(t, 10, nil, query)
+		assert.Equal(t, []strfmt.UUID{idOf(1), idOf(2), idOf(0)}, ids)
+		assert.InDeltaSlice(t, []float32{3.0, 2.0, 1.5}, scores, 1e-6)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		ids, _ := search(t, 2, nil, query)
+		assert.Equal(t, []strfmt.UUID{idOf(1), idOf(2)}, ids)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		ids, _ := search(t, 10, groupFilter(0), query)
+		assert.Equal(t, []strfmt.UUID{idOf(2), idOf(0)}, ids)
+	})
+
+	t.Run("object is retrievable with its sparse vector", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), idOf(1), nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, models.SparseVector{Indices: []uint32{7}, Values: []float32{3.0}}, res.SparseVectors["splade"])
+	})
+
+	t.Run("update replaces postings", func(t *testing.T) {
+		put(1, 1, models.SparseVector{Indices: []uint32{42}, Values: []float32{5.0}})
+
+		ids, _ := search(t, 10, nil, query)
+		assert.Equal(t, []strfmt.UUID{idOf(2), idOf(0)}, ids)
+
+		ids, scores := search(t, 10, nil, models.SparseVector{Indices: []uint32{42}, Values: []float32{1.0}})
+		assert.Equal(t, []strfmt.UUID{idOf(1), idOf(3)}, ids)
+		assert.InDeltaSlice(t, []float32{5.0, 1.0}, scores, 1e-6)
+	})
+
+	t.Run("delete removes postings", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), className, idOf(2), time.Now(), nil, "", 0))
+
+		ids, _ := search(t, 10, nil, query)
+		assert.Equal(t, []strfmt.UUID{idOf(0)}, ids)
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: &query,
+			TargetVector: "dense",
+		}
+		_, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0, nil)
+		require.NotNil(t, err)
+	})
+}
@@ -18,6 +18,8 @@ const (
 	IndexTypeFlat    = "flat"
 	IndexTypeNoop    = "noop"
 	IndexTypeDynamic = "dynamic"
+	IndexTypeDiskANN = "diskann"
+	IndexTypeIVF     = "ivf"
 )
 
 type IndexStats interface {
@@ -33,6 +33,9 @@ type (
 	VectorForID[T float32 | byte | uint64] func(ctx context.Context, id uint64) ([]T, error)
 	TempVectorForID                        func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error)
 	MultiVectorForID                       func(ctx context.Context, ids []uint64) ([][]float32, []error)
+	// MultipleVectorForID returns all vectors (e.g. token vectors) of a single
+	// object of a multivector index
+	MultipleVectorForID func(ctx context.Context, id uint64) ([][]float32, error)
 )
 
 type TargetVectorForID[T float32 | byte | uint64] struct {
@@ -49,6 +52,15 @@ type TargetTempVectorForID struct {
 	TempVectorForIDThunk func(ctx context.Context, id uint64, container *VectorSlice, targetVector string) ([]float32, error)
 }
 
+type TargetMultipleVectorForID struct {
+	TargetVector             string
+	MultipleVectorForIDThunk func(ctx context.Context, id uint64, targetVector string) ([][]float32, error)
+}
+
+func (t TargetMultipleVectorForID) MultipleVectorForID(ctx context.Context, id uint64) ([][]float32, error) {
+	return t.MultipleVectorForIDThunk(ctx, id, t.TargetVector)
+}
+
 func (t TargetTempVectorForID) TempVectorForID(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error) {
 	return t.TempVectorForIDThunk(ctx, id, container, t.TargetVector)
 }
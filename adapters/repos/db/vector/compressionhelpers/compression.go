@@ -39,6 +39,41 @@ type ReturnDistancerFn func()
 type CommitLogger interface {
 	AddPQCompression(PQData) error
 	AddSQCompression(SQData) error
+	AddLowBitSQCompression(LowBitSQData) error
+}
+
+type compressorOptions struct {
+	rotation *Rotation
+}
+
+// CompressorOption configures optional behavior of the BQ and SQ compressors
+type CompressorOption func(*compressorOptions)
+
+// WithRotation applies a random rotation created from the seed to all
+// vectors before they are quantized
+func WithRotation(seed int64) CompressorOption {
+	return func(o *compressorOptions) {
+		o.rotation = NewRotation(seed)
+	}
+}
+
+func withCompressorOptions[T byte | uint64](q quantizer[T], opts []CompressorOption) quantizer[T] {
+	o := compressorOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.rotation == nil {
+		return q
+	}
+	return &rotatedQuantizer[T]{quantizer: q, rotation: o.rotation}
+}
+
+func rotateTrainingData(data [][]float32, opts []CompressorOption) [][]float32 {
+	o := compressorOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o.rotation.RotateAll(data)
 }
 
 type VectorCompressor interface {
@@ -329,10 +364,11 @@ func NewBQCompressor(
 	logger logrus.FieldLogger,
 	store *lsmkv.Store,
 	allocChecker memwatch.AllocChecker,
+	opts ...CompressorOption,
 ) (VectorCompressor, error) {
 	quantizer := NewBinaryQuantizer(distance)
 	bqVectorsCompressor := &quantizedVectorsCompressor[uint64]{
-		quantizer:       &quantizer,
+		quantizer:       withCompressorOptions[uint64](&quantizer, opts),
 		compressedStore: store,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
@@ -352,10 +388,11 @@ func NewHNSWSQCompressor(
 	data [][]float32,
 	store *lsmkv.Store,
 	allocChecker memwatch.AllocChecker,
+	opts ...CompressorOption,
 ) (VectorCompressor, error) {
-	quantizer := NewScalarQuantizer(data, distance)
+	quantizer := NewScalarQuantizer(rotateTrainingData(data, opts), distance)
 	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
-		quantizer:       quantizer,
+		quantizer:       withCompressorOptions[byte](quantizer, opts),
 		compressedStore: store,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
@@ -377,13 +414,70 @@ func RestoreHNSWSQCompressor(
 	dimensions uint16,
 	store *lsmkv.Store,
 	allocChecker memwatch.AllocChecker,
+	opts ...CompressorOption,
 ) (VectorCompressor, error) {
 	quantizer, err := RestoreScalarQuantizer(a, b, dimensions, distance)
 	if err != nil {
 		return nil, err
 	}
 	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
-		quantizer:       quantizer,
+		quantizer:       withCompressorOptions[byte](quantizer, opts),
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	sqVectorsCompressor.initCompressedStore()
+	sqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		sqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, logger,
+		0, allocChecker)
+	return sqVectorsCompressor, nil
+}
+
+func NewHNSWLowBitSQCompressor(
+	bits uint8,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+	opts ...CompressorOption,
+) (VectorCompressor, error) {
+	quantizer, err := NewLowBitScalarQuantizer(bits, rotateTrainingData(data, opts), distance)
+	if err != nil {
+		return nil, err
+	}
+	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       withCompressorOptions[byte](quantizer, opts),
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	sqVectorsCompressor.initCompressedStore()
+	sqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		sqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, logger,
+		0, allocChecker)
+	sqVectorsCompressor.cache.Grow(uint64(len(data)))
+	return sqVectorsCompressor, nil
+}
+
+func RestoreHNSWLowBitSQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data LowBitSQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+	opts ...CompressorOption,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreLowBitScalarQuantizer(data, distance)
+	if err != nil {
+		return nil, err
+	}
+	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       withCompressorOptions[byte](quantizer, opts),
 		compressedStore: store,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// LowBitScalarQuantizer compresses every dimension to 4 or 2 bits. Unlike
+// the 8 bit ScalarQuantizer it learns a separate range per dimension, which
+// is needed to keep an acceptable recall with this few codes. Distances are
+// computed with a lookup table built from the query, so any distance which
+// can be split into per dimension steps is supported.
+type LowBitScalarQuantizer struct {
+	bits       uint8
+	levels     int
+	dimensions int
+	lower      []float32
+	steps      []float32
+	distancer  distancer.Provider
+}
+
+type LowBitSQData struct {
+	Bits       uint8
+	Dimensions uint16
+	Lower      []float32
+	Steps      []float32
+}
+
+// SerializeLowBitSQData encodes the settings as a commit log entry of the
+// given type: bits (1 byte), dimensions (2 bytes) followed by the lower
+// bound and step of every dimension (4 bytes each)
+func SerializeLowBitSQData(commitType byte, data LowBitSQData) []byte {
+	toWrite := make([]byte, 4+8*len(data.Lower))
+	toWrite[0] = commitType
+	toWrite[1] = data.Bits
+	binary.LittleEndian.PutUint16(toWrite[2:4], data.Dimensions)
+	for i := range data.Lower {
+		binary.LittleEndian.PutUint32(toWrite[4+8*i:], math.Float32bits(data.Lower[i]))
+		binary.LittleEndian.PutUint32(toWrite[8+8*i:], math.Float32bits(data.Steps[i]))
+	}
+	return toWrite
+}
+
+func validLowBitSQBits(bits uint8) error {
+	if bits != 2 && bits != 4 {
+		return errors.Errorf("invalid number of bits %d for scalar quantization, "+
+			"supported values are 2 and 4", bits)
+	}
+	return nil
+}
+
+func NewLowBitScalarQuantizer(bits uint8, data [][]float32, distance distancer.Provider) (*LowBitScalarQuantizer, error) {
+	if err := validLowBitSQBits(bits); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("no data to train low bit scalar quantization")
+	}
+
+	dimensions := len(data[0])
+	lower := make([]float32, dimensions)
+	upper := make([]float32, dimensions)
+	copy(lower, data[0])
+	copy(upper, data[0])
+	for _, vec := range data[1:] {
+		for i := 0; i < dimensions && i < len(vec); i++ {
+			if vec[i] < lower[i] {
+				lower[i] = vec[i]
+			}
+			if vec[i] > upper[i] {
+				upper[i] = vec[i]
+			}
+		}
+	}
+
+	levels := 1 << bits
+	steps := make([]float32, dimensions)
+	for i := range steps {
+		steps[i] = (upper[i] - lower[i]) / float32(levels-1)
+	}
+
+	return &LowBitScalarQuantizer{
+		bits:       bits,
+		levels:     levels,
+		dimensions: dimensions,
+		lower:      lower,
+		steps:      steps,
+		distancer:  distance,
+	}, nil
+}
+
+func RestoreLowBitScalarQuantizer(data LowBitSQData, distance distancer.Provider) (*LowBitScalarQuantizer, error) {
+	if err := validLowBitSQBits(data.Bits); err != nil {
+		return nil, err
+	}
+	if len(data.Lower) != int(data.Dimensions) || len(data.Steps) != int(data.Dimensions) {
+		return nil, errors.Errorf("invalid low bit SQ settings: expected %d dimensions, "+
+			"got %d lower bounds and %d steps", data.Dimensions, len(data.Lower), len(data.Steps))
+	}
+
+	return &LowBitScalarQuantizer{
+		bits:       data.Bits,
+		levels:     1 << data.Bits,
+		dimensions: int(data.Dimensions),
+		lower:      data.Lower,
+		steps:      data.Steps,
+		distancer:  distance,
+	}, nil
+}
+
+func (sq *LowBitScalarQuantizer) codeLength() int {
+	return (sq.dimensions*int(sq.bits) + 7) / 8
+}
+
+func (sq *LowBitScalarQuantizer) code(compressed []byte, i int) int {
+	bit := i * int(sq.bits)
+	return int(compressed[bit/8]>>(bit%8)) & (sq.levels - 1)
+}
+
+func (sq *LowBitScalarQuantizer) Encode(vec []float32) []byte {
+	compressed := make([]byte, sq.codeLength())
+	for i := 0; i < sq.dimensions && i < len(vec); i++ {
+		code := 0
+		if sq.steps[i] > 0 {
+			code = int(math.Round(float64((vec[i] - sq.lower[i]) / sq.steps[i])))
+			if code < 0 {
+				code = 0
+			} else if code >= sq.levels {
+				code = sq.levels - 1
+			}
+		}
+		bit := i * int(sq.bits)
+		compressed[bit/8] |= byte(code << (bit % 8))
+	}
+	return compressed
+}
+
+// Decode returns the approximation of the vector represented by the codes
+func (sq *LowBitScalarQuantizer) Decode(compressed []byte) []float32 {
+	vec := make([]float32, sq.dimensions)
+	for i := range vec {
+		vec[i] = sq.lower[i] + float32(sq.code(compressed, i))*sq.steps[i]
+	}
+	return vec
+}
+
+func (sq *LowBitScalarQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+	return sq.distancer.SingleDist(sq.Decode(x), sq.Decode(y))
+}
+
+type LowBitSQDistancer struct {
+	x     []float32
+	query []float32
+	sq    *LowBitScalarQuantizer
+	table []float32
+}
+
+func (sq *LowBitScalarQuantizer) newDistancer(x, query []float32) *LowBitSQDistancer {
+	table := make([]float32, sq.dimensions*sq.levels)
+	q := make([]float32, 1)
+	v := make([]float32, 1)
+	for i := 0; i < sq.dimensions; i++ {
+		if i < len(query) {
+			q[0] = query[i]
+		} else {
+			q[0] = 0
+		}
+		for c := 0; c < sq.levels; c++ {
+			v[0] = sq.lower[i] + float32(c)*sq.steps[i]
+			table[i*sq.levels+c] = sq.distancer.Step(q, v)
+		}
+	}
+	return &LowBitSQDistancer{
+		x:     x,
+		query: query,
+		sq:    sq,
+		table: table,
+	}
+}
+
+func (sq *LowBitScalarQuantizer) NewDistancer(a []float32) *LowBitSQDistancer {
+	return sq.newDistancer(a, a)
+}
+
+func (d *LowBitSQDistancer) Distance(x []byte) (float32, error) {
+	if len(x) != d.sq.codeLength() {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), d.sq.codeLength())
+	}
+	sum := float32(0)
+	for i := 0; i < d.sq.dimensions; i++ {
+		sum += d.table[i*d.sq.levels+d.sq.code(x, i)]
+	}
+	return d.sq.distancer.Wrap(sum), nil
+}
+
+func (d *LowBitSQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.sq.distancer.SingleDist(d.x, x)
+	}
+	return d.sq.distancer.SingleDist(d.query, x)
+}
+
+func (sq *LowBitScalarQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return sq.NewDistancer(a)
+}
+
+func (sq *LowBitScalarQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return sq.newDistancer(nil, sq.Decode(a))
+}
+
+func (sq *LowBitScalarQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (sq *LowBitScalarQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (sq *LowBitScalarQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (sq *LowBitScalarQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+func (sq *LowBitScalarQuantizer) PersistCompression(logger CommitLogger) {
+	logger.AddLowBitSQCompression(LowBitSQData{
+		Bits:       sq.bits,
+		Dimensions: uint16(sq.dimensions),
+		Lower:      sq.lower,
+		Steps:      sq.steps,
+	})
+}
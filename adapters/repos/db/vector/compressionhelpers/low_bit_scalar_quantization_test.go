@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package compressionhelpers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func Test_LowBitSQEncode(t *testing.T) {
+	training := [][]float32{
+		{0, 0, 0, 0, -1},
+		{3, 3, 3, 3, 1},
+	}
+
+	t.Run("4 bits", func(t *testing.T) {
+		sq, err := compressionhelpers.NewLowBitScalarQuantizer(4, training, distancer.NewL2SquaredProvider())
+		require.Nil(t, err)
+		code := sq.Encode([]float32{0, 3, 1.5, 5, -2})
+		require.Len(t, code, 3)
+		assert.Equal(t, []float32{0, 3, 1.6, 3, -1}, sq.Decode(code))
+	})
+
+	t.Run("2 bits", func(t *testing.T) {
+		sq, err := compressionhelpers.NewLowBitScalarQuantizer(2, training, distancer.NewL2SquaredProvider())
+		require.Nil(t, err)
+		code := sq.Encode([]float32{0, 3, 1, 2, 1})
+		require.Len(t, code, 2)
+		assert.Equal(t, []float32{0, 3, 1, 2, 1}, sq.Decode(code))
+	})
+
+	t.Run("unsupported bits", func(t *testing.T) {
+		_, err := compressionhelpers.NewLowBitScalarQuantizer(8, training, distancer.NewL2SquaredProvider())
+		assert.NotNil(t, err)
+		_, err = compressionhelpers.NewLowBitScalarQuantizer(3, training, distancer.NewL2SquaredProvider())
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_LowBitSQDistance(t *testing.T) {
+	data, queries := testinghelpers.RandomVecsFixedSeed(200, 10, 64)
+	testinghelpers.Normalize(data)
+	testinghelpers.Normalize(queries)
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewDotProductProvider(),
+	}
+	for _, bits := range []uint8{2, 4} {
+		for _, provider := range providers {
+			sq, err := compressionhelpers.NewLowBitScalarQuantizer(bits, data, provider)
+			require.Nil(t, err)
+
+			for _, query := range queries {
+				d := sq.NewDistancer(query)
+				compressedQuery := sq.Encode(query)
+				for _, vec := range data[:20] {
+					code := sq.Encode(vec)
+
+					// the lookup table must match the distance to the decoded vector
+					expected, _ := provider.SingleDist(query, sq.Decode(code))
+					actual, err := d.Distance(code)
+					require.Nil(t, err)
+					assert.InDelta(t, expected, actual, 1e-4)
+
+					expected, _ = provider.SingleDist(sq.Decode(compressedQuery), sq.Decode(code))
+					actual, err = sq.DistanceBetweenCompressedVectors(compressedQuery, code)
+					require.Nil(t, err)
+					assert.InDelta(t, expected, actual, 1e-4)
+				}
+			}
+		}
+	}
+}
+
+func Test_LowBitSQRecall(t *testing.T) {
+	data, queries := testinghelpers.RandomVecsFixedSeed(1000, 20, 64)
+	testinghelpers.Normalize(data)
+	testinghelpers.Normalize(queries)
+	provider := distancer.NewL2SquaredProvider()
+	k := 10
+
+	sq, err := compressionhelpers.NewLowBitScalarQuantizer(4, data, provider)
+	require.Nil(t, err)
+	codes := make([][]byte, len(data))
+	for i, vec := range data {
+		codes[i] = sq.Encode(vec)
+	}
+
+	recall := 0.0
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(nil, data, query, k, distancerWrapper(provider))
+		d := sq.NewDistancer(query)
+		dists := make([]float32, len(codes))
+		for i, code := range codes {
+			dists[i], _ = d.Distance(code)
+		}
+		// rescore a candidate list of 4k, as the index does
+		found := topK(dists, 4*k)
+		for _, id := range truth {
+			for _, f := range found {
+				if uint64(f) == id {
+					recall++
+					break
+				}
+			}
+		}
+	}
+	recall /= float64(k * len(queries))
+	assert.Greater(t, recall, 0.9)
+}
+
+func Test_LowBitSQCompressor(t *testing.T) {
+	data, _ := testinghelpers.RandomVecsFixedSeed(100, 0, 32)
+	testinghelpers.Normalize(data)
+	provider := distancer.NewCosineDistanceProvider()
+
+	compressor, err := compressionhelpers.NewHNSWLowBitSQCompressor(4, provider, 1e12, nil, data,
+		testinghelpers.NewDummyStore(t), nil,
+		compressionhelpers.WithRotation(compressionhelpers.DefaultRotationSeed))
+	require.Nil(t, err)
+	for i, vec := range data {
+		compressor.Preload(uint64(i), vec)
+	}
+
+	logger := &lowBitSQRecorder{}
+	compressor.PersistCompression(logger)
+	require.NotNil(t, logger.data)
+	assert.Equal(t, uint8(4), logger.data.Bits)
+	assert.Equal(t, uint16(32), logger.data.Dimensions)
+
+	serialized := compressionhelpers.SerializeLowBitSQData(12, *logger.data)
+	assert.Equal(t, byte(12), serialized[0])
+	assert.Len(t, serialized, 4+8*32)
+
+	restored, err := compressionhelpers.RestoreHNSWLowBitSQCompressor(provider, 1e12, nil,
+		*logger.data, testinghelpers.NewDummyStore(t), nil,
+		compressionhelpers.WithRotation(compressionhelpers.DefaultRotationSeed))
+	require.Nil(t, err)
+	for i, vec := range data {
+		restored.Preload(uint64(i), vec)
+	}
+
+	for i := 1; i < 10; i++ {
+		expected, err := compressor.DistanceBetweenCompressedVectorsFromIDs(context.Background(), 0, uint64(i))
+		require.Nil(t, err)
+		actual, err := restored.DistanceBetweenCompressedVectorsFromIDs(context.Background(), 0, uint64(i))
+		require.Nil(t, err)
+		assert.Equal(t, expected, actual)
+
+		exact, _ := provider.SingleDist(data[0], data[i])
+		assert.InDelta(t, exact, actual, 0.1)
+	}
+
+	d, returnFn := compressor.NewDistancer(data[0])
+	defer returnFn()
+	dist, err := d.DistanceToFloat(data[1])
+	require.Nil(t, err)
+	exact, _ := provider.SingleDist(data[0], data[1])
+	assert.InDelta(t, exact, dist, 1e-4)
+}
+
+type lowBitSQRecorder struct {
+	data *compressionhelpers.LowBitSQData
+}
+
+func (r *lowBitSQRecorder) AddPQCompression(compressionhelpers.PQData) error { return nil }
+
+func (r *lowBitSQRecorder) AddSQCompression(compressionhelpers.SQData) error { return nil }
+
+func (r *lowBitSQRecorder) AddLowBitSQCompression(data compressionhelpers.LowBitSQData) error {
+	r.data = &data
+	return nil
+}
+
+func topK(dists []float32, k int) []int {
+	ids := make([]int, 0, k)
+	taken := make([]bool, len(dists))
+	for len(ids) < k && len(ids) < len(dists) {
+		best := -1
+		for i, d := range dists {
+			if !taken[i] && (best < 0 || d < dists[best]) {
+				best = i
+			}
+		}
+		taken[best] = true
+		ids = append(ids, best)
+	}
+	return ids
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const codebookHeaderSize = 6
+
+// MarshalCodebook serializes the centroids of a quantizer with k-means
+// encoders, for indexes which persist the quantizer outside of a commit log:
+// dims (uint16) | centroids (uint16) | segments (uint16) | centers of each segment
+func (pq *ProductQuantizer) MarshalCodebook() ([]byte, error) {
+	if pq.encoderType != UseKMeansEncoder {
+		return nil, errors.New("only k-means encoders can be serialized into a codebook")
+	}
+
+	buf := make([]byte, codebookHeaderSize, codebookHeaderSize+pq.dimensions*pq.ks*4)
+	binary.LittleEndian.PutUint16(buf[0:2], uint16(pq.dimensions))
+	binary.LittleEndian.PutUint16(buf[2:4], uint16(pq.ks))
+	binary.LittleEndian.PutUint16(buf[4:6], uint16(pq.m))
+	for _, encoder := range pq.kms {
+		buf = append(buf, encoder.ExposeDataForRestore()...)
+	}
+	return buf, nil
+}
+
+// CodebookSegments returns the number of segments of a serialized codebook
+func CodebookSegments(buf []byte) (int, error) {
+	if len(buf) < codebookHeaderSize {
+		return 0, errors.New("corrupt codebook: missing header")
+	}
+	return int(binary.LittleEndian.Uint16(buf[4:6])), nil
+}
+
+// RestoreProductQuantizerFromCodebook restores a quantizer serialized with
+// MarshalCodebook. Segments and centroids of cfg are taken from the codebook.
+func RestoreProductQuantizerFromCodebook(cfg ent.PQConfig, buf []byte,
+	distance distancer.Provider, logger logrus.FieldLogger,
+) (*ProductQuantizer, error) {
+	if len(buf) < codebookHeaderSize {
+		return nil, errors.New("corrupt codebook: missing header")
+	}
+	dims := int(binary.LittleEndian.Uint16(buf[0:2]))
+	centroids := int(binary.LittleEndian.Uint16(buf[2:4]))
+	segments := int(binary.LittleEndian.Uint16(buf[4:6]))
+	if segments == 0 || dims%segments != 0 {
+		return nil, fmt.Errorf("corrupt codebook: %d segments for %d dimensions", segments, dims)
+	}
+
+	ds := dims / segments
+	segmentSize := centroids * ds * 4
+	if len(buf) != codebookHeaderSize+segments*segmentSize {
+		return nil, fmt.Errorf("corrupt codebook: expected %d bytes, got %d",
+			codebookHeaderSize+segments*segmentSize, len(buf))
+	}
+
+	encoders := make([]PQEncoder, segments)
+	for i := range encoders {
+		segment := buf[codebookHeaderSize+i*segmentSize:]
+		centers := make([][]float32, centroids)
+		for c := range centers {
+			centers[c] = make([]float32, ds)
+			for j := range centers[c] {
+				centers[c][j] = math.Float32frombits(binary.LittleEndian.Uint32(segment[(c*ds+j)*4:]))
+			}
+		}
+		encoders[i] = NewKMeansWithCenters(centroids, ds, i, centers)
+	}
+
+	cfg.Centroids = centroids
+	cfg.Encoder.Type = ent.PQEncoderTypeKMeans
+	if cfg.Encoder.Distribution == "" {
+		// only used by tile encoders
+		cfg.Encoder.Distribution = ent.PQEncoderDistributionLogNormal
+	}
+	return NewProductQuantizerWithEncoders(cfg, distance, dims, encoders, logger)
+}
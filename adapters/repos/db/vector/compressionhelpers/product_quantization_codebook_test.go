@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestProductQuantizerCodebook(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(1000, 5, 16)
+	cfg := ent.PQConfig{
+		Enabled:   true,
+		Segments:  4,
+		Centroids: 32,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeKMeans,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+	}
+	provider := distancer.NewL2SquaredProvider()
+
+	pq, err := compressionhelpers.NewProductQuantizer(cfg, provider, 16, logger)
+	require.Nil(t, err)
+	require.Nil(t, pq.Fit(vectors))
+
+	codebook, err := pq.MarshalCodebook()
+	require.Nil(t, err)
+	segments, err := compressionhelpers.CodebookSegments(codebook)
+	require.Nil(t, err)
+	assert.Equal(t, 4, segments)
+
+	restored, err := compressionhelpers.RestoreProductQuantizerFromCodebook(ent.PQConfig{Enabled: true}, codebook, provider, logger)
+	require.Nil(t, err)
+
+	for _, vec := range vectors[:50] {
+		assert.Equal(t, pq.Encode(vec), restored.Encode(vec))
+	}
+	for _, query := range queries {
+		expected := pq.NewDistancer(query)
+		actual := restored.NewDistancer(query)
+		for _, vec := range vectors[:50] {
+			d1, err := expected.Distance(pq.Encode(vec))
+			require.Nil(t, err)
+			d2, err := actual.Distance(restored.Encode(vec))
+			require.Nil(t, err)
+			assert.Equal(t, d1, d2)
+		}
+	}
+
+	_, err = compressionhelpers.RestoreProductQuantizerFromCodebook(cfg, codebook[:len(codebook)-1], provider, logger)
+	assert.ErrorContains(t, err, "corrupt codebook")
+
+	tilePQ, err := compressionhelpers.NewProductQuantizer(ent.PQConfig{
+		Enabled:   true,
+		Segments:  4,
+		Centroids: 32,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeTile,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+	}, provider, 16, logger)
+	require.Nil(t, err)
+	_, err = tilePQ.MarshalCodebook()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+)
+
+const (
+	// DefaultRotationSeed is used by the vector indexes, the rotation is not
+	// persisted but recreated from the seed on startup
+	DefaultRotationSeed = 2024
+
+	rotationRounds       = 3
+	maxRotationBlockSize = 64
+)
+
+// Rotation is a random orthogonal transformation applied to vectors before
+// they are quantized. It spreads the information of dimensions with a large
+// magnitude over all dimensions, which improves the quality of BQ and SQ on
+// skewed embeddings. As it is orthogonal it preserves l2 distances and dot
+// products.
+//
+// Every round permutes the vector, flips the signs of random dimensions and
+// applies a Walsh-Hadamard transform to consecutive blocks of at most 64
+// dimensions. Block sizes are powers of two, so the number of dimensions is
+// preserved. The rotation is sized lazily by the first vector it is applied
+// to.
+type Rotation struct {
+	seed int64
+	once sync.Once
+
+	blocks       []int
+	permutations [][]int
+	signs        [][]float32
+}
+
+func NewRotation(seed int64) *Rotation {
+	return &Rotation{seed: seed}
+}
+
+func (r *Rotation) init(dimensions int) {
+	for remaining := dimensions; remaining > 0; {
+		block := maxRotationBlockSize
+		for block > remaining {
+			block /= 2
+		}
+		r.blocks = append(r.blocks, block)
+		remaining -= block
+	}
+
+	rnd := rand.New(rand.NewSource(r.seed))
+	r.permutations = make([][]int, rotationRounds)
+	r.signs = make([][]float32, rotationRounds)
+	for round := 0; round < rotationRounds; round++ {
+		r.permutations[round] = rnd.Perm(dimensions)
+		r.signs[round] = make([]float32, dimensions)
+		for i := range r.signs[round] {
+			r.signs[round][i] = 1
+			if rnd.Intn(2) == 0 {
+				r.signs[round][i] = -1
+			}
+		}
+	}
+}
+
+// Rotate returns a rotated copy of the vector. It is safe to call on a nil
+// rotation, in which case the vector is returned as is.
+func (r *Rotation) Rotate(vec []float32) []float32 {
+	if r == nil {
+		return vec
+	}
+	r.once.Do(func() { r.init(len(vec)) })
+	if len(vec) != len(r.signs[0]) {
+		// vectors of a different length are rejected by the index before they
+		// get here, keep them untouched rather than panicking
+		return vec
+	}
+
+	out := make([]float32, len(vec))
+	copy(out, vec)
+	tmp := make([]float32, len(vec))
+	for round := range r.permutations {
+		signs := r.signs[round]
+		for i, j := range r.permutations[round] {
+			tmp[i] = out[j] * signs[i]
+		}
+		start := 0
+		for _, block := range r.blocks {
+			walshHadamard(tmp[start : start+block])
+			start += block
+		}
+		out, tmp = tmp, out
+	}
+	return out
+}
+
+// RotateAll rotates every vector, nil vectors are kept
+func (r *Rotation) RotateAll(vecs [][]float32) [][]float32 {
+	if r == nil {
+		return vecs
+	}
+	out := make([][]float32, len(vecs))
+	for i, vec := range vecs {
+		if vec != nil {
+			out[i] = r.Rotate(vec)
+		}
+	}
+	return out
+}
+
+// walshHadamard applies the normalized fast Walsh-Hadamard transform in
+// place, the length of x must be a power of two
+func walshHadamard(x []float32) {
+	for h := 1; h < len(x); h *= 2 {
+		for i := 0; i < len(x); i += 2 * h {
+			for j := i; j < i+h; j++ {
+				a, b := x[j], x[j+h]
+				x[j], x[j+h] = a+b, a-b
+			}
+		}
+	}
+	norm := float32(1 / math.Sqrt(float64(len(x))))
+	for i := range x {
+		x[i] *= norm
+	}
+}
+
+// rotatedQuantizer rotates all uncompressed vectors before passing them to
+// the wrapped quantizer
+type rotatedQuantizer[T byte | uint64] struct {
+	quantizer[T]
+	rotation *Rotation
+}
+
+func (q *rotatedQuantizer[T]) Encode(vec []float32) []T {
+	return q.quantizer.Encode(q.rotation.Rotate(vec))
+}
+
+func (q *rotatedQuantizer[T]) NewQuantizerDistancer(a []float32) quantizerDistancer[T] {
+	return &rotatedDistancer[T]{
+		quantizerDistancer: q.quantizer.NewQuantizerDistancer(q.rotation.Rotate(a)),
+		rotation:           q.rotation,
+	}
+}
+
+func (q *rotatedQuantizer[T]) NewCompressedQuantizerDistancer(a []T) quantizerDistancer[T] {
+	return &rotatedDistancer[T]{
+		quantizerDistancer: q.quantizer.NewCompressedQuantizerDistancer(a),
+		rotation:           q.rotation,
+	}
+}
+
+func (q *rotatedQuantizer[T]) ReturnQuantizerDistancer(distancer quantizerDistancer[T]) {
+	if d, ok := distancer.(*rotatedDistancer[T]); ok {
+		q.quantizer.ReturnQuantizerDistancer(d.quantizerDistancer)
+	}
+}
+
+type rotatedDistancer[T byte | uint64] struct {
+	quantizerDistancer[T]
+	rotation *Rotation
+}
+
+func (d *rotatedDistancer[T]) DistanceToFloat(x []float32) (float32, error) {
+	return d.quantizerDistancer.DistanceToFloat(d.rotation.Rotate(x))
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func Test_Rotation(t *testing.T) {
+	for _, dims := range []int{1, 3, 64, 100, 1536} {
+		data, _ := testinghelpers.RandomVecsFixedSeed(20, 0, dims)
+		rotation := compressionhelpers.NewRotation(compressionhelpers.DefaultRotationSeed)
+		rotated := rotation.RotateAll(data)
+
+		for i := range data {
+			require.Len(t, rotated[i], dims)
+			assert.InDelta(t, norm(data[i]), norm(rotated[i]), 1e-3)
+		}
+
+		l2 := distancer.NewL2SquaredProvider()
+		dot := distancer.NewDotProductProvider()
+		for i := 1; i < len(data); i++ {
+			expected, _ := l2.SingleDist(data[0], data[i])
+			actual, _ := l2.SingleDist(rotated[0], rotated[i])
+			assert.InEpsilon(t, expected, actual, 1e-3)
+
+			expected, _ = dot.SingleDist(data[0], data[i])
+			actual, _ = dot.SingleDist(rotated[0], rotated[i])
+			assert.InDelta(t, expected, actual, 1e-2)
+		}
+	}
+
+	t.Run("the same seed leads to the same rotation", func(t *testing.T) {
+		vec := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		first := compressionhelpers.NewRotation(42).Rotate(vec)
+		second := compressionhelpers.NewRotation(42).Rotate(vec)
+		other := compressionhelpers.NewRotation(43).Rotate(vec)
+		assert.Equal(t, first, second)
+		assert.NotEqual(t, first, other)
+	})
+
+	t.Run("skewed dimensions are spread out", func(t *testing.T) {
+		vec := make([]float32, 128)
+		vec[0] = 1
+		rotated := compressionhelpers.NewRotation(compressionhelpers.DefaultRotationSeed).Rotate(vec)
+		for _, x := range rotated {
+			assert.Less(t, math.Abs(float64(x)), 0.5)
+		}
+	})
+
+	t.Run("nil rotation returns the input", func(t *testing.T) {
+		var rotation *compressionhelpers.Rotation
+		vec := []float32{1, 2, 3}
+		assert.Equal(t, vec, rotation.Rotate(vec))
+	})
+}
+
+func norm(vec []float32) float32 {
+	sum := float32(0)
+	for _, x := range vec {
+		sum += x * x
+	}
+	return float32(math.Sqrt(float64(sum)))
+}
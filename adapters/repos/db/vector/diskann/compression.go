@@ -0,0 +1,335 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	codesFileName = "codes"
+	pqCentroids   = 256
+)
+
+// The index keeps one representation per node in memory which is used to
+// navigate the graph. Until the product quantizer is trained these are the
+// full vectors, as the index is small at that point. Afterwards only the PQ
+// codes are kept in memory and persisted in the codes file, where every
+// record is a flags byte followed by the code.
+
+// approxDistanceFunc returns the distance of the query to the in-memory
+// representation of a node. ok is false if the node is unknown.
+type approxDistanceFunc func(id uint64) (dist float32, ok bool, err error)
+
+func (d *diskann) compressed() bool {
+	d.approxLock.RLock()
+	defer d.approxLock.RUnlock()
+	return d.pq != nil
+}
+
+// setApprox stores the in-memory representation of a node, vector has to be
+// normalized already
+func (d *diskann) setApprox(id uint64, vector []float32) error {
+	d.approxLock.Lock()
+	defer d.approxLock.Unlock()
+
+	if d.pq == nil {
+		if id >= uint64(len(d.vectors)) {
+			d.vectors = growSlice(d.vectors, id)
+		}
+		d.vectors[id] = vector
+		return nil
+	}
+
+	code := d.pq.Encode(vector)
+	segments := len(code)
+	if need := (id + 1) * uint64(segments); need > uint64(len(d.codes)) {
+		d.codes = growSlice(d.codes, need-1)
+	}
+	copy(d.codes[id*uint64(segments):], code)
+	return d.writeCode(id, code)
+}
+
+func (d *diskann) dropApprox(id uint64) error {
+	d.approxLock.Lock()
+	defer d.approxLock.Unlock()
+
+	if d.pq == nil {
+		if id < uint64(len(d.vectors)) {
+			d.vectors[id] = nil
+		}
+		return nil
+	}
+	return d.writeCode(id, nil)
+}
+
+// writeCode persists the code of a node, a nil code marks the record unused
+func (d *diskann) writeCode(id uint64, code []byte) error {
+	recordSize := 1 + d.pqSegments
+	buf := make([]byte, recordSize)
+	if code != nil {
+		buf[0] = nodeFlagPresent
+		copy(buf[1:], code)
+	}
+	if err := writeAt(d.codesFile, d.codesSnapshot.Load(), buf, int64(id)*int64(recordSize)); err != nil {
+		return errors.Wrapf(err, "write code of node %d", id)
+	}
+	return nil
+}
+
+func (d *diskann) approxDistancer(query []float32) (approxDistanceFunc, func()) {
+	d.approxLock.RLock()
+	pq := d.pq
+	d.approxLock.RUnlock()
+
+	if pq == nil {
+		return func(id uint64) (float32, bool, error) {
+			d.approxLock.RLock()
+			var vec []float32
+			if id < uint64(len(d.vectors)) {
+				vec = d.vectors[id]
+			}
+			d.approxLock.RUnlock()
+			if vec == nil {
+				return 0, false, nil
+			}
+			dist, err := d.distancerProvider.SingleDist(query, vec)
+			return dist, true, err
+		}, func() {}
+	}
+
+	distancer := pq.NewDistancer(query)
+	segments := uint64(d.pqSegments)
+	return func(id uint64) (float32, bool, error) {
+			if !d.hasNode(id) {
+				return 0, false, nil
+			}
+			d.approxLock.RLock()
+			defer d.approxLock.RUnlock()
+			if (id+1)*segments > uint64(len(d.codes)) {
+				return 0, false, nil
+			}
+			dist, err := distancer.Distance(d.codes[id*segments : (id+1)*segments])
+			return dist, true, err
+		}, func() {
+			pq.ReturnDistancer(distancer)
+		}
+}
+
+// approxDistanceBetween compares the in-memory representations of two nodes
+func (d *diskann) approxDistanceBetween(a, b uint64) (float32, error) {
+	d.approxLock.RLock()
+	defer d.approxLock.RUnlock()
+
+	if d.pq == nil {
+		if a >= uint64(len(d.vectors)) || b >= uint64(len(d.vectors)) ||
+			d.vectors[a] == nil || d.vectors[b] == nil {
+			return 0, fmt.Errorf("no vector for node %d or %d", a, b)
+		}
+		return d.distancerProvider.SingleDist(d.vectors[a], d.vectors[b])
+	}
+
+	segments := uint64(d.pqSegments)
+	if (a+1)*segments > uint64(len(d.codes)) || (b+1)*segments > uint64(len(d.codes)) {
+		return 0, fmt.Errorf("no code for node %d or %d", a, b)
+	}
+	return d.pq.DistanceBetweenCompressedVectors(
+		d.codes[a*segments:(a+1)*segments], d.codes[b*segments:(b+1)*segments])
+}
+
+// shouldTrain indicates that enough vectors have been indexed to train the
+// product quantizer
+func (d *diskann) shouldTrain() bool {
+	if d.compressed() {
+		return false
+	}
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+	return d.nodes.GetCardinality() >= d.pqTrainingLimit
+}
+
+// train fits the product quantizer to the vectors indexed so far, encodes
+// all nodes and drops the full vectors from memory. All other operations are
+// blocked while training.
+func (d *diskann) train() error {
+	d.compressActionLock.Lock()
+	defer d.compressActionLock.Unlock()
+
+	if d.compressed() {
+		return nil
+	}
+
+	before := time.Now()
+	dims := int(d.dims.Load())
+	segments := d.pqSegments
+	if segments == 0 {
+		segments = common.CalculateOptimalSegments(dims)
+	}
+	if dims%segments != 0 {
+		return fmt.Errorf("pq.segments (%d) must be a divisor of the dimensions (%d)",
+			segments, dims)
+	}
+
+	d.approxLock.RLock()
+	data := make([][]float32, 0, d.pqTrainingLimit)
+	for _, vec := range d.vectors {
+		if vec != nil {
+			data = append(data, vec)
+		}
+	}
+	d.approxLock.RUnlock()
+
+	pq, err := compressionhelpers.NewProductQuantizer(pqConfig(segments, d.pqTrainingLimit),
+		d.distancerProvider, dims, d.logger)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+	if err := pq.Fit(data); err != nil {
+		return errors.Wrap(err, "fit product quantizer")
+	}
+
+	d.approxLock.Lock()
+	defer d.approxLock.Unlock()
+
+	codes := make([]byte, len(d.vectors)*segments)
+	records := make([]byte, len(d.vectors)*(1+segments))
+	for id, vec := range d.vectors {
+		if vec == nil {
+			continue
+		}
+		code := pq.Encode(vec)
+		copy(codes[id*segments:], code)
+		records[id*(1+segments)] = nodeFlagPresent
+		copy(records[id*(1+segments)+1:], code)
+	}
+	if err := writeAt(d.codesFile, d.codesSnapshot.Load(), records, 0); err != nil {
+		return errors.Wrap(err, "write codes")
+	}
+	if err := d.codesFile.Sync(); err != nil {
+		return errors.Wrap(err, "sync codes")
+	}
+	codebook, err := pq.MarshalCodebook()
+	if err != nil {
+		return err
+	}
+	if err := d.putMetadata(metadataKeyCodebook, codebook); err != nil {
+		return err
+	}
+
+	d.pqSegments = segments
+	d.pq = pq
+	d.codes = codes
+	d.vectors = nil
+
+	d.logger.WithFields(logrus.Fields{
+		"action":   "diskann_train_pq",
+		"id":       d.id,
+		"count":    len(data),
+		"segments": segments,
+		"took":     time.Since(before),
+	}).Info("trained product quantizer, switched to compressed navigation")
+	return nil
+}
+
+func pqConfig(segments, trainingLimit int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:       true,
+		Segments:      segments,
+		Centroids:     pqCentroids,
+		TrainingLimit: trainingLimit,
+		Encoder: hnswent.PQEncoder{
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
+
+// loadApprox restores the in-memory representations on startup, either the
+// PQ codes from the codes file or the full vectors from the graph file
+func (d *diskann) loadApprox() error {
+	codebook, err := d.getMetadata(metadataKeyCodebook)
+	if err != nil {
+		return err
+	}
+	if codebook == nil {
+		return d.loadVectors()
+	}
+
+	segments, err := compressionhelpers.CodebookSegments(codebook)
+	if err != nil {
+		return err
+	}
+	pq, err := compressionhelpers.RestoreProductQuantizerFromCodebook(
+		pqConfig(segments, d.pqTrainingLimit), codebook, d.distancerProvider, d.logger)
+	if err != nil {
+		return errors.Wrap(err, "restore product quantizer")
+	}
+	records, err := io.ReadAll(d.codesFile)
+	if err != nil {
+		return errors.Wrap(err, "read codes")
+	}
+
+	count := len(records) / (1 + segments)
+	codes := make([]byte, count*segments)
+	d.nodesLock.Lock()
+	for id := 0; id < count; id++ {
+		record := records[id*(1+segments) : (id+1)*(1+segments)]
+		if record[0]&nodeFlagPresent == 0 {
+			continue
+		}
+		copy(codes[id*segments:], record[1:])
+		d.nodes.Set(uint64(id))
+	}
+	d.nodesLock.Unlock()
+
+	d.pqSegments = segments
+	d.pq = pq
+	d.codes = codes
+	return nil
+}
+
+func (d *diskann) loadVectors() error {
+	limit, err := d.graph.idLimit()
+	if err != nil {
+		return errors.Wrap(err, "stat graph file")
+	}
+	for id := uint64(0); id < limit; id++ {
+		n, err := d.graph.read(id)
+		if err != nil {
+			return err
+		}
+		if !n.present {
+			continue
+		}
+		d.nodes.Set(id)
+		if id >= uint64(len(d.vectors)) {
+			d.vectors = growSlice(d.vectors, id)
+		}
+		d.vectors[id] = n.vector
+	}
+	return nil
+}
+
+func growSlice[T any](s []T, id uint64) []T {
+	size := max(uint64(len(s))*2, id+1, 1024)
+	grown := make([]T, size)
+	copy(grown, s)
+	return grown
+}
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// Delete marks the nodes as deleted. They are excluded from search results
+// right away, the graph is repaired by the next tombstone cleanup.
+func (d *diskann) Delete(ids ...uint64) error {
+	d.nodesLock.Lock()
+	defer d.nodesLock.Unlock()
+
+	for _, id := range ids {
+		if d.nodes.Contains(id) {
+			d.tombstones.Set(id)
+		}
+	}
+	return nil
+}
+
+func (d *diskann) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	// the cycle is paused while a backup is running, so a snapshot is no
+	// longer needed once it runs again
+	if err := d.removeSnapshot(); err != nil {
+		d.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("remove backup snapshot")
+	}
+
+	executed, err := d.CleanUpTombstonedNodes(shouldAbort)
+	if err != nil {
+		d.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("tombstone cleanup errored")
+	}
+	return executed
+}
+
+// CleanUpTombstonedNodes removes the deleted nodes from the graph. Every node
+// with an edge to a deleted node is connected to the out-neighbors of the
+// deleted node instead, the combined list is pruned to the configured
+// degree. Afterwards the records of the deleted nodes are released.
+func (d *diskann) CleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	d.compressActionLock.RLock()
+	defer d.compressActionLock.RUnlock()
+
+	d.nodesLock.RLock()
+	deleted := d.tombstones.Clone()
+	live := d.nodes.Clone()
+	d.nodesLock.RUnlock()
+
+	if deleted.IsEmpty() {
+		return false, nil
+	}
+	live.AndNot(deleted)
+
+	if err := d.reassignEntrypoint(deleted, live); err != nil {
+		return true, err
+	}
+
+	deletedNeighbors := map[uint64][]uint64{}
+	it := live.NewIterator()
+	for i := 0; i < live.GetCardinality(); i++ {
+		if shouldAbort() {
+			return true, nil
+		}
+		if err := d.reconnect(it.Next(), deleted, deletedNeighbors); err != nil {
+			return true, err
+		}
+	}
+
+	it = deleted.NewIterator()
+	for i := 0; i < deleted.GetCardinality(); i++ {
+		if shouldAbort() {
+			return true, nil
+		}
+		if err := d.release(it.Next()); err != nil {
+			return true, err
+		}
+	}
+
+	if err := d.persistTombstones(); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// reassignEntrypoint moves the entrypoint to a node which is not about to be
+// removed, or resets it if the index is going to be empty
+func (d *diskann) reassignEntrypoint(deleted, live *sroar.Bitmap) error {
+	ep, ok := d.getEntrypoint()
+	if !ok || !deleted.Contains(ep) {
+		return nil
+	}
+	if live.IsEmpty() {
+		d.setEntrypoint(0, false)
+	} else {
+		d.setEntrypoint(live.Minimum(), true)
+	}
+	return d.persistEntrypoint()
+}
+
+// reconnect replaces the edges of a node which point to deleted nodes
+func (d *diskann) reconnect(id uint64, deleted *sroar.Bitmap,
+	deletedNeighbors map[uint64][]uint64,
+) error {
+	d.nodeLocks.Lock(id)
+	defer d.nodeLocks.Unlock(id)
+
+	n, err := d.graph.read(id)
+	if err != nil {
+		return err
+	}
+	if !n.present {
+		return nil
+	}
+
+	affected := false
+	for _, neighbor := range n.neighbors {
+		if deleted.Contains(neighbor) {
+			affected = true
+			break
+		}
+	}
+	if !affected {
+		return nil
+	}
+
+	seen := map[uint64]struct{}{id: {}}
+	candidates := make([]uint64, 0, len(n.neighbors))
+	add := func(candidate uint64) {
+		if _, ok := seen[candidate]; ok || deleted.Contains(candidate) {
+			return
+		}
+		seen[candidate] = struct{}{}
+		candidates = append(candidates, candidate)
+	}
+
+	for _, neighbor := range n.neighbors {
+		if !deleted.Contains(neighbor) {
+			add(neighbor)
+			continue
+		}
+
+		next, ok := deletedNeighbors[neighbor]
+		if !ok {
+			// deleted nodes are not modified anymore, their neighbors can be
+			// read without holding their lock
+			dn, err := d.graph.read(neighbor)
+			if err != nil {
+				return err
+			}
+			next = dn.neighbors
+			deletedNeighbors[neighbor] = next
+		}
+		for _, candidate := range next {
+			add(candidate)
+		}
+	}
+
+	if len(candidates) > d.maxDegree {
+		candidates, err = d.pruneNeighbors(id, candidates)
+		if err != nil {
+			return errors.Wrapf(err, "prune neighbors of node %d", id)
+		}
+	}
+	return d.graph.writeNeighbors(id, candidates)
+}
+
+// release frees the record of a deleted node unless it was added again in
+// the meantime
+func (d *diskann) release(id uint64) error {
+	d.nodeLocks.Lock(id)
+	defer d.nodeLocks.Unlock(id)
+
+	if !d.isTombstoned(id) {
+		return nil
+	}
+	if err := d.graph.clear(id); err != nil {
+		return err
+	}
+	if err := d.dropApprox(id); err != nil {
+		return err
+	}
+
+	d.nodesLock.Lock()
+	d.nodes.Remove(id)
+	d.tombstones.Remove(id)
+	d.nodesLock.Unlock()
+	return nil
+}
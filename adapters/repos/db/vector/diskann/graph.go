@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// graphPageSize is the unit of reads from the graph file. Records never
+	// span a page boundary unless a single record is larger than a page, so
+	// reading a node never costs more than the minimum number of pages.
+	graphPageSize = 4096
+
+	// the record header holds a flags byte, three reserved bytes and the
+	// degree as uint32
+	nodeHeaderSize  = 8
+	nodeFlagPresent = 1
+)
+
+// node is a single record of the graph file. Neighbors and the full
+// precision vector are co-located, so the vector required to rerank a node is
+// read together with the adjacency list needed to continue the search.
+type node struct {
+	present   bool
+	neighbors []uint64
+	vector    []float32
+}
+
+// graphFile stores fixed size node records addressed by the doc id. The file
+// is sparse, ids which were never added occupy no space on most file systems.
+//
+// Record layout: header | neighbors (capacity * uint64) | vector (dims * float32)
+type graphFile struct {
+	file         *os.File
+	dims         int
+	capacity     int
+	recordSize   int
+	nodesPerPage int
+	stride       int
+
+	// snapshot is set while a snapshot of the file is copied for a backup
+	snapshot atomic.Pointer[fileSnapshot]
+}
+
+func openGraphFile(path string, dims, capacity int) (*graphFile, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open graph file %q", path)
+	}
+
+	g := &graphFile{
+		file:       f,
+		dims:       dims,
+		capacity:   capacity,
+		recordSize: nodeHeaderSize + capacity*8 + dims*4,
+	}
+	if g.recordSize <= graphPageSize {
+		g.nodesPerPage = graphPageSize / g.recordSize
+	} else {
+		g.stride = (g.recordSize + graphPageSize - 1) / graphPageSize * graphPageSize
+	}
+	return g, nil
+}
+
+func (g *graphFile) offset(id uint64) int64 {
+	if g.nodesPerPage > 0 {
+		page := id / uint64(g.nodesPerPage)
+		slot := id % uint64(g.nodesPerPage)
+		return int64(page*graphPageSize + slot*uint64(g.recordSize))
+	}
+	return int64(id * uint64(g.stride))
+}
+
+// idLimit returns an exclusive upper bound of the ids stored in the file
+func (g *graphFile) idLimit() (uint64, error) {
+	info, err := g.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() == 0 {
+		return 0, nil
+	}
+	if g.nodesPerPage > 0 {
+		pages := (uint64(info.Size()) + graphPageSize - 1) / graphPageSize
+		return pages * uint64(g.nodesPerPage), nil
+	}
+	return (uint64(info.Size()) + uint64(g.stride) - 1) / uint64(g.stride), nil
+}
+
+func (g *graphFile) read(id uint64) (*node, error) {
+	buf := make([]byte, g.recordSize)
+	n, err := g.file.ReadAt(buf, g.offset(id))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	if n < g.recordSize {
+		// beyond the end of the file, the node was never written
+		return &node{}, nil
+	}
+	return g.decode(buf)
+}
+
+func (g *graphFile) decode(buf []byte) (*node, error) {
+	if buf[0]&nodeFlagPresent == 0 {
+		return &node{}, nil
+	}
+
+	degree := int(binary.LittleEndian.Uint32(buf[4:8]))
+	if degree > g.capacity {
+		return nil, fmt.Errorf("corrupt node record: degree %d exceeds capacity %d",
+			degree, g.capacity)
+	}
+
+	out := &node{
+		present:   true,
+		neighbors: make([]uint64, degree),
+		vector:    make([]float32, g.dims),
+	}
+	pos := nodeHeaderSize
+	for i := range out.neighbors {
+		out.neighbors[i] = binary.LittleEndian.Uint64(buf[pos+i*8:])
+	}
+	pos += g.capacity * 8
+	for i := range out.vector {
+		out.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[pos+i*4:]))
+	}
+	return out, nil
+}
+
+func (g *graphFile) write(id uint64, n *node) error {
+	if len(n.vector) != g.dims {
+		return fmt.Errorf("node %d has a vector of length %d, expected %d",
+			id, len(n.vector), g.dims)
+	}
+	buf := make([]byte, g.recordSize)
+	g.encodeNeighbors(buf, n.neighbors)
+	pos := nodeHeaderSize + g.capacity*8
+	for i, v := range n.vector {
+		binary.LittleEndian.PutUint32(buf[pos+i*4:], math.Float32bits(v))
+	}
+	if err := g.writeAt(buf, g.offset(id)); err != nil {
+		return errors.Wrapf(err, "write node %d", id)
+	}
+	return nil
+}
+
+// writeNeighbors replaces the adjacency list of an existing node without
+// touching its vector
+func (g *graphFile) writeNeighbors(id uint64, neighbors []uint64) error {
+	buf := make([]byte, nodeHeaderSize+g.capacity*8)
+	g.encodeNeighbors(buf, neighbors)
+	if err := g.writeAt(buf, g.offset(id)); err != nil {
+		return errors.Wrapf(err, "write neighbors of node %d", id)
+	}
+	return nil
+}
+
+func (g *graphFile) encodeNeighbors(buf []byte, neighbors []uint64) {
+	buf[0] = nodeFlagPresent
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(neighbors)))
+	for i, neighbor := range neighbors {
+		binary.LittleEndian.PutUint64(buf[nodeHeaderSize+i*8:], neighbor)
+	}
+}
+
+// clear marks the record of a node as unused
+func (g *graphFile) clear(id uint64) error {
+	if err := g.writeAt(make([]byte, nodeHeaderSize), g.offset(id)); err != nil {
+		return errors.Wrapf(err, "clear node %d", id)
+	}
+	return nil
+}
+
+func (g *graphFile) writeAt(buf []byte, off int64) error {
+	return writeAt(g.file, g.snapshot.Load(), buf, off)
+}
+
+func (g *graphFile) sync() error {
+	return g.file.Sync()
+}
+
+func (g *graphFile) close() error {
+	return g.file.Close()
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphFile(t *testing.T) {
+	t.Run("small records share a page", func(t *testing.T) {
+		g, err := openGraphFile(filepath.Join(t.TempDir(), "graph"), 4, 8)
+		require.Nil(t, err)
+		defer g.close()
+
+		assert.Equal(t, nodeHeaderSize+8*8+4*4, g.recordSize)
+		assert.Greater(t, g.nodesPerPage, 1)
+		// records never cross a page boundary
+		last := g.offset(uint64(g.nodesPerPage - 1))
+		assert.LessOrEqual(t, last+int64(g.recordSize), int64(graphPageSize))
+		assert.Equal(t, int64(graphPageSize), g.offset(uint64(g.nodesPerPage)))
+	})
+
+	t.Run("large records are aligned to pages", func(t *testing.T) {
+		g, err := openGraphFile(filepath.Join(t.TempDir(), "graph"), 1536, 84)
+		require.Nil(t, err)
+		defer g.close()
+
+		assert.Equal(t, int64(0), g.offset(1)%graphPageSize)
+		assert.Equal(t, int64(2*graphPageSize), g.offset(1))
+
+		require.Nil(t, g.write(2, &node{present: true, vector: make([]float32, 1536)}))
+		limit, err := g.idLimit()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), limit)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		g, err := openGraphFile(filepath.Join(t.TempDir(), "graph"), 3, 4)
+		require.Nil(t, err)
+		defer g.close()
+
+		require.Nil(t, g.write(7, &node{
+			present:   true,
+			neighbors: []uint64{1, 2, 3},
+			vector:    []float32{0.1, 0.2, 0.3},
+		}))
+
+		n, err := g.read(7)
+		require.Nil(t, err)
+		assert.True(t, n.present)
+		assert.Equal(t, []uint64{1, 2, 3}, n.neighbors)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, n.vector)
+
+		// never written, but within the file
+		n, err = g.read(3)
+		require.Nil(t, err)
+		assert.False(t, n.present)
+
+		// beyond the end of the file
+		n, err = g.read(1000)
+		require.Nil(t, err)
+		assert.False(t, n.present)
+
+		limit, err := g.idLimit()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(g.nodesPerPage), limit)
+
+		require.Nil(t, g.writeNeighbors(7, []uint64{4, 5, 6, 8}))
+		n, err = g.read(7)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 5, 6, 8}, n.neighbors)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, n.vector)
+
+		require.Nil(t, g.clear(7))
+		n, err = g.read(7)
+		require.Nil(t, err)
+		assert.False(t, n.present)
+	})
+
+	t.Run("degree larger than capacity", func(t *testing.T) {
+		g, err := openGraphFile(filepath.Join(t.TempDir(), "graph"), 3, 4)
+		require.Nil(t, err)
+		defer g.close()
+
+		buf := make([]byte, g.recordSize)
+		g.encodeNeighbors(buf, []uint64{1})
+		buf[4] = 9
+		_, err = g.decode(buf)
+		assert.ErrorContains(t, err, "exceeds capacity")
+	})
+}
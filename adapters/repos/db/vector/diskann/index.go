@@ -0,0 +1,858 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	graphFileName = "graph"
+
+	// degreeSlack allows the adjacency list of a node to grow beyond the
+	// configured degree before it is pruned, so that adding a reverse edge
+	// does not require a prune most of the time
+	degreeSlack = 1.3
+)
+
+// diskann is a Vamana graph index which keeps the graph and the full
+// precision vectors in a file on disk. Only a compressed representation of
+// the vectors is held in memory, it guides the search which reads the
+// records of the expanded nodes from disk and reranks them using their full
+// vectors.
+type diskann struct {
+	// compressActionLock is held for reading by all operations which access
+	// the graph and for writing while the product quantizer is trained
+	compressActionLock sync.RWMutex
+
+	id                string
+	targetVector      string
+	dir               string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+
+	maxDegree       int
+	capacity        int
+	pqSegments      int
+	pqTrainingLimit int
+
+	// mutable settings, read on every operation
+	buildSearchListSize atomic.Int64
+	searchListSize      atomic.Int64
+	beamWidth           atomic.Int64
+	flatSearchCutoff    atomic.Int64
+	alpha               atomic.Uint64
+
+	initLock  sync.Mutex
+	dims      atomic.Int32
+	graph     *graphFile
+	codesFile *os.File
+	metadata  *bolt.DB
+	nodeLocks *common.ShardedRWLocks
+
+	// codesSnapshot is set while a snapshot of the codes is copied for a
+	// backup
+	codesSnapshot atomic.Pointer[fileSnapshot]
+
+	// id of the entrypoint plus one, zero if the index is empty
+	entrypoint atomic.Uint64
+
+	nodesLock  sync.RWMutex
+	nodes      *sroar.Bitmap
+	tombstones *sroar.Bitmap
+
+	approxLock sync.RWMutex
+	vectors    [][]float32
+	pq         *compressionhelpers.ProductQuantizer
+	codes      []byte
+
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+func New(cfg Config, uc ent.UserConfig) (*diskann, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskann{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		dir:               filepath.Join(cfg.RootPath, fmt.Sprintf("%s.diskann.d", cfg.ID)),
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		maxDegree:         uc.MaxDegree,
+		capacity:          int(math.Ceil(float64(uc.MaxDegree) * degreeSlack)),
+		pqSegments:        uc.PQ.Segments,
+		pqTrainingLimit:   uc.PQ.TrainingLimit,
+		nodeLocks:         common.NewDefaultShardedRWLocks(),
+		nodes:             sroar.NewBitmap(),
+		tombstones:        sroar.NewBitmap(),
+	}
+	index.setUserConfig(uc)
+
+	if err := os.MkdirAll(index.dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "create %q", index.dir)
+	}
+	if err := index.restoreSnapshot(); err != nil {
+		return nil, errors.Wrap(err, "restore diskann snapshot")
+	}
+	if err := index.initMetadata(); err != nil {
+		return nil, err
+	}
+	if err := index.restore(); err != nil {
+		index.closeFiles()
+		return nil, errors.Wrap(err, "restore diskann index")
+	}
+
+	if cfg.TombstoneCallbacks != nil {
+		index.tombstoneCleanupCallbackCtrl = cfg.TombstoneCallbacks.Register(
+			fmt.Sprintf("diskann_%s", cfg.ID), index.tombstoneCleanup)
+	} else {
+		index.tombstoneCleanupCallbackCtrl = cyclemanager.NewCallbackCtrlNoop()
+	}
+
+	return index, nil
+}
+
+func (d *diskann) setUserConfig(uc ent.UserConfig) {
+	d.buildSearchListSize.Store(int64(uc.BuildSearchListSize))
+	d.searchListSize.Store(int64(uc.SearchListSize))
+	d.beamWidth.Store(int64(uc.BeamWidth))
+	d.flatSearchCutoff.Store(int64(uc.FlatSearchCutoff))
+	d.alpha.Store(math.Float64bits(uc.Alpha))
+}
+
+func (d *diskann) getAlpha() float32 {
+	return float32(math.Float64frombits(d.alpha.Load()))
+}
+
+// restore opens the files of an existing index, an index which never had a
+// vector added only consists of the metadata file
+func (d *diskann) restore() error {
+	dims, err := d.loadDimensions()
+	if err != nil {
+		return err
+	}
+	if dims == 0 {
+		return nil
+	}
+	if err := d.openFiles(dims); err != nil {
+		return err
+	}
+	if err := d.loadApprox(); err != nil {
+		return err
+	}
+	if err := d.loadEntrypoint(); err != nil {
+		return err
+	}
+	return d.loadTombstones()
+}
+
+func (d *diskann) openFiles(dims int) error {
+	graph, err := openGraphFile(filepath.Join(d.dir, graphFileName), dims, d.capacity)
+	if err != nil {
+		return err
+	}
+	codesPath := filepath.Join(d.dir, codesFileName)
+	codesFile, err := os.OpenFile(codesPath, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		graph.close()
+		return errors.Wrapf(err, "open codes file %q", codesPath)
+	}
+
+	d.graph = graph
+	d.codesFile = codesFile
+	d.dims.Store(int32(dims))
+	return nil
+}
+
+// ensureInitialized creates the files on the first insert, when the
+// dimensions are known
+func (d *diskann) ensureInitialized(dims int) error {
+	if d.dims.Load() != 0 {
+		return nil
+	}
+
+	d.initLock.Lock()
+	defer d.initLock.Unlock()
+
+	if d.dims.Load() != 0 {
+		return nil
+	}
+	if err := d.persistDimensions(dims); err != nil {
+		return err
+	}
+	return d.openFiles(dims)
+}
+
+func (d *diskann) getEntrypoint() (uint64, bool) {
+	ep := d.entrypoint.Load()
+	if ep == 0 {
+		return 0, false
+	}
+	return ep - 1, true
+}
+
+func (d *diskann) setEntrypoint(id uint64, ok bool) {
+	if !ok {
+		d.entrypoint.Store(0)
+		return
+	}
+	d.entrypoint.Store(id + 1)
+}
+
+// hasNode returns true for nodes which are part of the graph, including
+// tombstoned ones
+func (d *diskann) hasNode(id uint64) bool {
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+	return d.nodes.Contains(id)
+}
+
+func (d *diskann) isTombstoned(id uint64) bool {
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+	return d.tombstones.Contains(id)
+}
+
+func (d *diskann) normalized(vector []float32) []float32 {
+	if d.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (d *diskann) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := d.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *diskann) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+	if err := d.ensureInitialized(len(vector)); err != nil {
+		return err
+	}
+	if len(vector) != int(d.dims.Load()) {
+		return errors.Errorf("insert called with a vector of the wrong size")
+	}
+
+	if err := d.insert(ctx, id, d.normalized(vector)); err != nil {
+		return err
+	}
+
+	if d.shouldTrain() {
+		if err := d.train(); err != nil {
+			return errors.Wrap(err, "train product quantizer")
+		}
+	}
+	return nil
+}
+
+// insert adds the node to the graph following the Vamana insert: a greedy
+// search for the new vector collects the candidates which are pruned to the
+// out-neighbors of the node, then reverse edges are added to each of them.
+func (d *diskann) insert(ctx context.Context, id uint64, vector []float32) error {
+	d.compressActionLock.RLock()
+	defer d.compressActionLock.RUnlock()
+
+	var neighbors []uint64
+	if _, ok := d.getEntrypoint(); ok {
+		var err error
+		neighbors, err = d.findNeighbors(ctx, id, vector)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := d.writeNode(id, neighbors, vector); err != nil {
+		return err
+	}
+
+	if d.entrypoint.CompareAndSwap(0, id+1) {
+		if err := d.persistEntrypoint(); err != nil {
+			return err
+		}
+	}
+
+	for _, neighbor := range neighbors {
+		if err := d.addReverseEdge(neighbor, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeNode persists the record and the in-memory representation of a node.
+// The node lock is held throughout, so that a concurrent tombstone cleanup
+// can not remove a node which is re-added after it was deleted.
+func (d *diskann) writeNode(id uint64, neighbors []uint64, vector []float32) error {
+	d.nodeLocks.Lock(id)
+	defer d.nodeLocks.Unlock(id)
+
+	if err := d.setApprox(id, vector); err != nil {
+		return err
+	}
+	if err := d.graph.write(id, &node{present: true, neighbors: neighbors, vector: vector}); err != nil {
+		return err
+	}
+
+	d.nodesLock.Lock()
+	d.nodes.Set(id)
+	d.tombstones.Remove(id)
+	d.nodesLock.Unlock()
+	return nil
+}
+
+func (d *diskann) findNeighbors(ctx context.Context, id uint64, vector []float32) ([]uint64, error) {
+	approx, release := d.approxDistancer(vector)
+	defer release()
+
+	var candidates []pruneCandidate
+	vectors := map[uint64][]float32{}
+	err := d.beamSearch(ctx, int(d.buildSearchListSize.Load()), int(d.beamWidth.Load()), approx,
+		func(candidate uint64, n *node) error {
+			if candidate == id || d.isTombstoned(candidate) {
+				return nil
+			}
+			dist, err := d.distancerProvider.SingleDist(vector, n.vector)
+			if err != nil {
+				return err
+			}
+			candidates = append(candidates, pruneCandidate{id: candidate, dist: dist})
+			vectors[candidate] = n.vector
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "search neighbors of node %d", id)
+	}
+
+	sortPruneCandidates(candidates)
+	return robustPrune(candidates, d.getAlpha(), d.maxDegree, func(a, b uint64) (float32, error) {
+		return d.distancerProvider.SingleDist(vectors[a], vectors[b])
+	})
+}
+
+// addReverseEdge adds the new node to the adjacency list of its neighbor,
+// pruning the list using the in-memory representations once it exceeds its
+// capacity
+func (d *diskann) addReverseEdge(neighbor, id uint64) error {
+	d.nodeLocks.Lock(neighbor)
+	defer d.nodeLocks.Unlock(neighbor)
+
+	n, err := d.graph.read(neighbor)
+	if err != nil {
+		return err
+	}
+	if !n.present {
+		return nil
+	}
+	for _, existing := range n.neighbors {
+		if existing == id {
+			return nil
+		}
+	}
+
+	if len(n.neighbors) < d.capacity {
+		return d.graph.writeNeighbors(neighbor, append(n.neighbors, id))
+	}
+
+	pruned, err := d.pruneNeighbors(neighbor, append(n.neighbors, id))
+	if err != nil {
+		return err
+	}
+	return d.graph.writeNeighbors(neighbor, pruned)
+}
+
+// pruneNeighbors reduces the adjacency list of a node to the configured
+// degree, based on the in-memory representations of the vectors. Nodes which
+// are no longer part of the graph are dropped.
+func (d *diskann) pruneNeighbors(id uint64, neighbors []uint64) ([]uint64, error) {
+	candidates := make([]pruneCandidate, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		if neighbor == id || !d.hasNode(neighbor) {
+			continue
+		}
+		dist, err := d.approxDistanceBetween(id, neighbor)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, pruneCandidate{id: neighbor, dist: dist})
+	}
+	sortPruneCandidates(candidates)
+	return robustPrune(candidates, d.getAlpha(), d.maxDegree, d.approxDistanceBetween)
+}
+
+// fullVector returns the full precision vector of a node, from memory if the
+// index is not compressed yet, from disk otherwise
+func (d *diskann) fullVector(id uint64) ([]float32, error) {
+	d.approxLock.RLock()
+	if d.pq == nil {
+		var vec []float32
+		if id < uint64(len(d.vectors)) {
+			vec = d.vectors[id]
+		}
+		d.approxLock.RUnlock()
+		return vec, nil
+	}
+	d.approxLock.RUnlock()
+
+	n, err := d.readNode(id)
+	if err != nil || !n.present {
+		return nil, err
+	}
+	return n.vector, nil
+}
+
+func (d *diskann) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	d.compressActionLock.RLock()
+	defer d.compressActionLock.RUnlock()
+
+	if _, ok := d.getEntrypoint(); !ok || k <= 0 {
+		return nil, nil, nil
+	}
+	if allow != nil && allow.IsEmpty() {
+		return nil, nil, nil
+	}
+	if len(vector) != int(d.dims.Load()) {
+		return nil, nil, errors.Errorf("search called with a vector of length %d, the index has %d dimensions",
+			len(vector), d.dims.Load())
+	}
+
+	vector = d.normalized(vector)
+	if allow != nil && allow.Len() < int(d.flatSearchCutoff.Load()) {
+		return d.flatSearch(ctx, vector, k, allow)
+	}
+
+	heap := priorityqueue.NewMax[any](k)
+	approx, release := d.approxDistancer(vector)
+	defer release()
+
+	listSize := max(int(d.searchListSize.Load()), k)
+	err := d.beamSearch(ctx, listSize, int(d.beamWidth.Load()), approx,
+		func(id uint64, n *node) error {
+			if d.isTombstoned(id) || (allow != nil && !allow.Contains(id)) {
+				return nil
+			}
+			dist, err := d.distancerProvider.SingleDist(vector, n.vector)
+			if err != nil {
+				return err
+			}
+			insertToHeap(heap, k, id, dist)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "beam search")
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+// flatSearch compares the query to every allowed vector, it is used for
+// restrictive filters where a graph search would have to traverse large
+// parts of the graph to find enough matches
+func (d *diskann) flatSearch(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if !d.ContainsNode(id) {
+			continue
+		}
+		candidate, err := d.fullVector(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if candidate == nil {
+			continue
+		}
+		dist, err := d.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (d *diskann) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := d.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	var shouldContinue bool
+	var err error
+	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			d.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+func (d *diskann) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values
+	// are read on every single search
+	d.setUserConfig(parsed)
+
+	callback()
+	return nil
+}
+
+// Flush makes all changes durable. Records of the graph and the codes are
+// written in place, so they only need to be synced.
+func (d *diskann) Flush() error {
+	d.compressActionLock.RLock()
+	defer d.compressActionLock.RUnlock()
+
+	return d.flush()
+}
+
+// flush requires the compressActionLock to be held
+func (d *diskann) flush() error {
+	if d.graph == nil {
+		return nil
+	}
+	if err := d.graph.sync(); err != nil {
+		return errors.Wrap(err, "sync graph file")
+	}
+	if err := d.codesFile.Sync(); err != nil {
+		return errors.Wrap(err, "sync codes file")
+	}
+	return d.persistTombstones()
+}
+
+// SwitchCommitLogs prepares a backup. There is no commit log, the records of
+// the graph and the codes are changed in place, so the live files can't be
+// copied while the index is written to. Instead a snapshot of the files is
+// taken, ListFiles lists the snapshot. Writes are only blocked while the
+// snapshot is started, it is copied while the index is written to.
+func (d *diskann) SwitchCommitLogs(ctx context.Context) error {
+	s, err := d.prepareSnapshot()
+	if err != nil {
+		return errors.Wrap(err, "snapshot diskann files")
+	}
+	if err := d.copySnapshot(ctx, s); err != nil {
+		return errors.Wrap(err, "snapshot diskann files")
+	}
+	return nil
+}
+
+func (d *diskann) prepareSnapshot() (*snapshot, error) {
+	d.initLock.Lock()
+	defer d.initLock.Unlock()
+	d.compressActionLock.Lock()
+	defer d.compressActionLock.Unlock()
+
+	if err := d.flush(); err != nil {
+		return nil, err
+	}
+	return d.startSnapshot()
+}
+
+func (d *diskann) Shutdown(ctx context.Context) error {
+	if err := d.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann shutdown")
+	}
+	if err := d.Flush(); err != nil {
+		return err
+	}
+	return d.closeFiles()
+}
+
+func (d *diskann) Drop(ctx context.Context) error {
+	if err := d.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann drop")
+	}
+	if err := d.closeFiles(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(d.dir); err != nil {
+		return errors.Wrapf(err, "remove %q", d.dir)
+	}
+	return nil
+}
+
+func (d *diskann) closeFiles() error {
+	var errs []error
+	if d.graph != nil {
+		errs = append(errs, d.graph.close())
+	}
+	if d.codesFile != nil {
+		errs = append(errs, d.codesFile.Close())
+	}
+	if d.metadata != nil {
+		errs = append(errs, d.metadata.Close())
+	}
+	for _, err := range errs {
+		if err != nil {
+			return errors.Wrap(err, "close diskann files")
+		}
+	}
+	return nil
+}
+
+// ListFiles lists the files of the snapshot taken by SwitchCommitLogs
+func (d *diskann) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	dir := d.snapshotDir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %q", dir)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		relPath, err := filepath.Rel(basePath, filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		files = append(files, relPath)
+	}
+	return files, nil
+}
+
+func (d *diskann) PostStartup() {
+	// the in-memory representations are loaded when the index is created
+}
+
+func (d *diskann) Compressed() bool {
+	return d.compressed()
+}
+
+func (d *diskann) ValidateBeforeInsert(vector []float32) error {
+	dims := int(d.dims.Load())
+	if dims == 0 {
+		return nil
+	}
+	if dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (d *diskann) Multivector() bool {
+	return false
+}
+
+func (d *diskann) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the diskann index")
+}
+
+func (d *diskann) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("multivector is not supported by the diskann index")
+}
+
+func (d *diskann) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the diskann index")
+}
+
+func (d *diskann) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", d.id)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (d *diskann) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return d.distancerProvider.SingleDist(x, y)
+}
+
+func (d *diskann) ContainsNode(id uint64) bool {
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+	return d.nodes.Contains(id) && !d.tombstones.Contains(id)
+}
+
+func (d *diskann) AlreadyIndexed() uint64 {
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+	return uint64(d.nodes.GetCardinality())
+}
+
+func (d *diskann) Iterate(fn func(id uint64) bool) {
+	d.nodesLock.RLock()
+	ids := d.nodes.Clone()
+	ids.AndNot(d.tombstones)
+	d.nodesLock.RUnlock()
+
+	it := helpers.NewAllowListFromBitmap(ids).Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if !fn(id) {
+			break
+		}
+	}
+}
+
+func (d *diskann) DistancerProvider() distancer.Provider {
+	return d.distancerProvider
+}
+
+func (d *diskann) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = d.normalized(queryVector)
+	distFunc := func(nodeID uint64) (float32, error) {
+		vec, err := d.fullVector(nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if vec == nil {
+			return 0, fmt.Errorf("node %d not found", nodeID)
+		}
+		return d.distancerProvider.SingleDist(queryVector, vec)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (d *diskann) Stats() (common.IndexStats, error) {
+	d.nodesLock.RLock()
+	defer d.nodesLock.RUnlock()
+
+	return &DiskANNStats{
+		Dimensions: int(d.dims.Load()),
+		Nodes:      d.nodes.GetCardinality(),
+		Tombstones: d.tombstones.GetCardinality(),
+		Compressed: d.compressed(),
+	}, nil
+}
+
+type DiskANNStats struct {
+	Dimensions int  `json:"dimensions"`
+	Nodes      int  `json:"nodes"`
+	Tombstones int  `json:"tombstones"`
+	Compressed bool `json:"compressed"`
+}
+
+func (s *DiskANNStats) IndexType() common.IndexType {
+	return common.IndexTypeDiskANN
+}
@@ -0,0 +1,371 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func testUserConfig() ent.UserConfig {
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxDegree = 24
+	uc.BuildSearchListSize = 64
+	uc.SearchListSize = 64
+	uc.FlatSearchCutoff = 0
+	uc.PQ.TrainingLimit = 100_000
+	return uc
+}
+
+func newTestIndex(t *testing.T, rootPath string, uc ent.UserConfig) *diskann {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		ID:                 "vectors",
+		RootPath:           rootPath,
+		Logger:             logger,
+		DistanceProvider:   distancer.NewL2SquaredProvider(),
+		TombstoneCallbacks: cyclemanager.NewCallbackGroupNoop(),
+	}, uc)
+	require.Nil(t, err)
+	return index
+}
+
+func recall(t *testing.T, index *diskann, vectors, queries [][]float32, k int) float32 {
+	logger, _ := test.NewNullLogger()
+	var matches, total uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		ids, dists, err := index.SearchByVector(context.Background(), query, k, nil)
+		require.Nil(t, err)
+		require.Len(t, dists, len(ids))
+		for i := 1; i < len(dists); i++ {
+			require.LessOrEqual(t, dists[i-1], dists[i])
+		}
+		matches += testinghelpers.MatchesInLists(truth, ids)
+		total += uint64(len(truth))
+	}
+	return float32(matches) / float32(total)
+}
+
+func TestDiskANN(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(2000, 50, 32)
+
+	t.Run("recall of the full precision graph", func(t *testing.T) {
+		index := newTestIndex(t, t.TempDir(), testUserConfig())
+		defer index.Shutdown(ctx)
+
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(ctx, uint64(i), vec))
+		}
+
+		assert.False(t, index.Compressed())
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+		assert.Greater(t, recall(t, index, vectors, queries, 10), float32(0.9))
+	})
+
+	t.Run("recall after training the product quantizer", func(t *testing.T) {
+		uc := testUserConfig()
+		uc.PQ.TrainingLimit = 1000
+		uc.PQ.Segments = 8
+		index := newTestIndex(t, t.TempDir(), uc)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+
+		assert.True(t, index.Compressed())
+		assert.Nil(t, index.vectors)
+		assert.Greater(t, recall(t, index, vectors, queries, 10), float32(0.8))
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		uc := testUserConfig()
+		index := newTestIndex(t, t.TempDir(), uc)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 500), vectors[:500]))
+
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < 500; i += 2 {
+			allow.Insert(i)
+		}
+
+		for _, cutoff := range []int{0, 1000} {
+			uc.FlatSearchCutoff = cutoff
+			require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+			ids, _, err := index.SearchByVector(ctx, queries[0], 10, allow)
+			require.Nil(t, err)
+			require.NotEmpty(t, ids)
+			for _, id := range ids {
+				assert.Equal(t, uint64(0), id%2)
+			}
+		}
+
+		// the flat search is exact
+		filtered := make([][]float32, 500)
+		for i := 0; i < 500; i += 2 {
+			filtered[i] = vectors[i]
+		}
+		logger, _ := test.NewNullLogger()
+		truth, _ := testinghelpers.BruteForce(logger, filtered, queries[0], 10,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		ids, _, err := index.SearchByVector(ctx, queries[0], 10, allow)
+		require.Nil(t, err)
+		assert.Equal(t, truth, ids)
+	})
+
+	t.Run("deletes and tombstone cleanup", func(t *testing.T) {
+		uc := testUserConfig()
+		uc.PQ.TrainingLimit = 1000
+		index := newTestIndex(t, t.TempDir(), uc)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+
+		remaining := make([][]float32, len(vectors))
+		copy(remaining, vectors)
+		var deleted []uint64
+		for i := 0; i < len(vectors); i += 3 {
+			deleted = append(deleted, uint64(i))
+			remaining[i] = nil
+		}
+		// the entrypoint has to be moved
+		ep, _ := index.getEntrypoint()
+		if remaining[ep] != nil {
+			deleted = append(deleted, ep)
+			remaining[ep] = nil
+		}
+		require.Nil(t, index.Delete(deleted...))
+
+		for _, id := range deleted {
+			assert.False(t, index.ContainsNode(id))
+		}
+		ids, _, err := index.SearchByVector(ctx, vectors[0], 10, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(0))
+
+		executed, err := index.CleanUpTombstonedNodes(func() bool { return false })
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		stats, err := index.Stats()
+		require.Nil(t, err)
+		assert.Equal(t, 0, stats.(*DiskANNStats).Tombstones)
+		assert.Equal(t, len(vectors)-len(deleted), stats.(*DiskANNStats).Nodes)
+
+		ep, ok := index.getEntrypoint()
+		require.True(t, ok)
+		assert.NotNil(t, remaining[ep])
+
+		assert.Greater(t, recall(t, index, remaining, queries, 10), float32(0.8))
+
+		executed, err = index.CleanUpTombstonedNodes(func() bool { return false })
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+
+	t.Run("delete all nodes", func(t *testing.T) {
+		index := newTestIndex(t, t.TempDir(), testUserConfig())
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 10), vectors[:10]))
+		require.Nil(t, index.Delete(idsRange(0, 10)...))
+		_, err := index.CleanUpTombstonedNodes(func() bool { return false })
+		require.Nil(t, err)
+
+		ids, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+
+		require.Nil(t, index.Add(ctx, 3, vectors[3]))
+		ids, _, err = index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3}, ids)
+	})
+
+	for _, trainingLimit := range []int{1000, 100_000} {
+		name := "restart with compression"
+		if trainingLimit > len(vectors) {
+			name = "restart without compression"
+		}
+		t.Run(name, func(t *testing.T) {
+			uc := testUserConfig()
+			uc.PQ.TrainingLimit = trainingLimit
+			rootPath := t.TempDir()
+			index := newTestIndex(t, rootPath, uc)
+
+			require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+			require.Nil(t, index.Delete(5))
+			before, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+			require.Nil(t, err)
+			compressed := index.Compressed()
+			require.Nil(t, index.Shutdown(ctx))
+
+			index = newTestIndex(t, rootPath, uc)
+			defer index.Shutdown(ctx)
+
+			assert.Equal(t, compressed, index.Compressed())
+			assert.Equal(t, int32(32), index.dims.Load())
+			assert.False(t, index.ContainsNode(5))
+			assert.True(t, index.ContainsNode(6))
+			after, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, before, after)
+		})
+	}
+
+	t.Run("list files and drop", func(t *testing.T) {
+		rootPath := t.TempDir()
+		index := newTestIndex(t, rootPath, testUserConfig())
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 10), vectors[:10]))
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+
+		files, err := index.ListFiles(ctx, rootPath)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []string{
+			filepath.Join("vectors.diskann.d", snapshotDirName, codesFileName),
+			filepath.Join("vectors.diskann.d", snapshotDirName, graphFileName),
+			filepath.Join("vectors.diskann.d", snapshotDirName, metadataFileName),
+		}, files)
+
+		require.Nil(t, index.Drop(ctx))
+		_, err = os.Stat(filepath.Join(rootPath, "vectors.diskann.d"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("backup snapshot", func(t *testing.T) {
+		rootPath := t.TempDir()
+		index := newTestIndex(t, rootPath, testUserConfig())
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 100), vectors[:100]))
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+		files, err := index.ListFiles(ctx, rootPath)
+		require.Nil(t, err)
+		before, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+
+		// writes after the snapshot was taken are not part of the backup
+		require.Nil(t, index.AddBatch(ctx, idsRange(100, 200), vectors[100:200]))
+		require.Nil(t, index.Flush())
+
+		// restore the backup into another directory
+		restorePath := t.TempDir()
+		for _, file := range files {
+			data, err := os.ReadFile(filepath.Join(rootPath, file))
+			require.Nil(t, err)
+			require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(restorePath, file)), 0o755))
+			require.Nil(t, os.WriteFile(filepath.Join(restorePath, file), data, 0o600))
+		}
+		restored := newTestIndex(t, restorePath, testUserConfig())
+		defer restored.Shutdown(ctx)
+		assert.True(t, restored.ContainsNode(99))
+		assert.False(t, restored.ContainsNode(100))
+		after, _, err := restored.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+		_, err = os.Stat(restored.snapshotDir())
+		assert.True(t, os.IsNotExist(err))
+
+		// the snapshot is removed once maintenance is resumed
+		index.tombstoneCleanup(func() bool { return false })
+		_, err = os.Stat(index.snapshotDir())
+		assert.True(t, os.IsNotExist(err))
+
+		// or when the index is opened again
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+		require.Nil(t, index.Shutdown(ctx))
+		index = newTestIndex(t, rootPath, testUserConfig())
+		defer index.Shutdown(ctx)
+		_, err = os.Stat(index.snapshotDir())
+		assert.True(t, os.IsNotExist(err))
+		assert.True(t, index.ContainsNode(199))
+	})
+
+	t.Run("writes while the snapshot is copied", func(t *testing.T) {
+		rootPath := t.TempDir()
+		index := newTestIndex(t, rootPath, testUserConfig())
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 100), vectors[:100]))
+		before, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+
+		// writes are not blocked once the snapshot is started, they change
+		// the records of existing nodes in place
+		s, err := index.prepareSnapshot()
+		require.Nil(t, err)
+		require.Nil(t, index.AddBatch(ctx, idsRange(100, 200), vectors[100:200]))
+		require.Nil(t, index.Delete(idsRange(0, 50)...))
+		_, err = index.CleanUpTombstonedNodes(func() bool { return false })
+		require.Nil(t, err)
+		require.Nil(t, index.copySnapshot(ctx, s))
+		files, err := index.ListFiles(ctx, rootPath)
+		require.Nil(t, err)
+
+		restorePath := t.TempDir()
+		for _, file := range files {
+			data, err := os.ReadFile(filepath.Join(rootPath, file))
+			require.Nil(t, err)
+			require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(restorePath, file)), 0o755))
+			require.Nil(t, os.WriteFile(filepath.Join(restorePath, file), data, 0o600))
+		}
+		restored := newTestIndex(t, restorePath, testUserConfig())
+		defer restored.Shutdown(ctx)
+		assert.True(t, restored.ContainsNode(0))
+		assert.True(t, restored.ContainsNode(99))
+		assert.False(t, restored.ContainsNode(100))
+		after, _, err := restored.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		index := newTestIndex(t, t.TempDir(), testUserConfig())
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.ValidateBeforeInsert(vectors[0]))
+		require.Nil(t, index.Add(ctx, 0, vectors[0]))
+		assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2}))
+		assert.NotNil(t, index.Add(ctx, 1, []float32{1, 2}))
+		_, _, err := index.SearchByVector(ctx, []float32{1, 2}, 10, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func idsRange(from, to int) []uint64 {
+	ids := make([]uint64, 0, to-from)
+	for i := from; i < to; i++ {
+		ids = append(ids, uint64(i))
+	}
+	return ids
+}
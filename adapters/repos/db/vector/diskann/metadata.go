@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	metadataFileName = "meta.db"
+	metadataBucket   = "diskann"
+
+	metadataKeyDimensions = "dimensions"
+	metadataKeyEntrypoint = "entrypoint"
+	metadataKeyCodebook   = "codebook"
+	metadataKeyTombstones = "tombstones"
+)
+
+func (d *diskann) initMetadata() error {
+	path := filepath.Join(d.dir, metadataFileName)
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(metadataBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return errors.Wrap(err, "init metadata bucket")
+	}
+
+	d.metadata = db
+	return nil
+}
+
+// getMetadata returns a copy of the value, nil if the key is not set
+func (d *diskann) getMetadata(key string) ([]byte, error) {
+	var value []byte
+	err := d.metadata.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket([]byte(metadataBucket)).Get([]byte(key)); v != nil {
+			value = make([]byte, len(v))
+			copy(value, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get metadata %q", key)
+	}
+	return value, nil
+}
+
+func (d *diskann) putMetadata(key string, value []byte) error {
+	err := d.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(metadataBucket))
+		if value == nil {
+			return b.Delete([]byte(key))
+		}
+		return b.Put([]byte(key), value)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "put metadata %q", key)
+	}
+	return nil
+}
+
+func (d *diskann) loadDimensions() (int, error) {
+	v, err := d.getMetadata(metadataKeyDimensions)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	return int(binary.LittleEndian.Uint32(v)), nil
+}
+
+func (d *diskann) persistDimensions(dims int) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dims))
+	return d.putMetadata(metadataKeyDimensions, buf)
+}
+
+func (d *diskann) loadEntrypoint() error {
+	v, err := d.getMetadata(metadataKeyEntrypoint)
+	if err != nil || v == nil {
+		return err
+	}
+	d.setEntrypoint(binary.LittleEndian.Uint64(v), true)
+	return nil
+}
+
+func (d *diskann) persistEntrypoint() error {
+	ep, ok := d.getEntrypoint()
+	if !ok {
+		return d.putMetadata(metadataKeyEntrypoint, nil)
+	}
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, ep)
+	return d.putMetadata(metadataKeyEntrypoint, buf)
+}
+
+func (d *diskann) loadTombstones() error {
+	v, err := d.getMetadata(metadataKeyTombstones)
+	if err != nil || v == nil {
+		return err
+	}
+	d.nodesLock.Lock()
+	d.tombstones = sroar.FromBufferWithCopy(v)
+	d.nodesLock.Unlock()
+	return nil
+}
+
+func (d *diskann) persistTombstones() error {
+	d.nodesLock.RLock()
+	buf := d.tombstones.ToBufferWithCopy()
+	d.nodesLock.RUnlock()
+	return d.putMetadata(metadataKeyTombstones, buf)
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+type searchCandidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+// candidateList is the bounded list of closest candidates of the beam search,
+// sorted by their approximate distance
+type candidateList struct {
+	items []searchCandidate
+	size  int
+}
+
+func (l *candidateList) insert(id uint64, dist float32) {
+	if len(l.items) >= l.size && dist >= l.items[len(l.items)-1].dist {
+		return
+	}
+	pos := sort.Search(len(l.items), func(i int) bool { return l.items[i].dist > dist })
+	if len(l.items) < l.size {
+		l.items = append(l.items, searchCandidate{})
+	}
+	copy(l.items[pos+1:], l.items[pos:])
+	l.items[pos] = searchCandidate{id: id, dist: dist}
+}
+
+// next marks up to n of the closest unexpanded candidates as expanded and
+// returns their ids
+func (l *candidateList) next(n int) []uint64 {
+	ids := make([]uint64, 0, n)
+	for i := range l.items {
+		if len(ids) == n {
+			break
+		}
+		if !l.items[i].expanded {
+			l.items[i].expanded = true
+			ids = append(ids, l.items[i].id)
+		}
+	}
+	return ids
+}
+
+type expandedNode struct {
+	id   uint64
+	node *node
+}
+
+// beamSearch runs the greedy search of the Vamana graph. The candidates are
+// ordered by their approximate distance to the query, so only the nodes which
+// are actually expanded have to be read from disk. Up to beamWidth nodes are
+// read concurrently in every round. visit is called for every expanded node,
+// it receives the full precision vector of the node, e.g. to rerank it.
+func (d *diskann) beamSearch(ctx context.Context, listSize, beamWidth int,
+	approx approxDistanceFunc, visit func(id uint64, n *node) error,
+) error {
+	ep, ok := d.getEntrypoint()
+	if !ok {
+		return nil
+	}
+	dist, ok, err := approx(ep)
+	if err != nil || !ok {
+		return err
+	}
+
+	list := &candidateList{items: make([]searchCandidate, 0, listSize), size: listSize}
+	list.insert(ep, dist)
+	visited := map[uint64]struct{}{ep: {}}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ids := list.next(beamWidth)
+		if len(ids) == 0 {
+			return nil
+		}
+
+		nodes, err := d.readNodes(ids)
+		if err != nil {
+			return err
+		}
+
+		for _, expanded := range nodes {
+			if !expanded.node.present {
+				// removed by a concurrent cleanup
+				continue
+			}
+			if err := visit(expanded.id, expanded.node); err != nil {
+				return err
+			}
+
+			for _, neighbor := range expanded.node.neighbors {
+				if _, ok := visited[neighbor]; ok {
+					continue
+				}
+				visited[neighbor] = struct{}{}
+
+				dist, ok, err := approx(neighbor)
+				if err != nil {
+					return err
+				}
+				if ok {
+					list.insert(neighbor, dist)
+				}
+			}
+		}
+	}
+}
+
+// readNodes reads the records of the given nodes, concurrently if there is
+// more than one as the search is bound by the latency of the disk
+func (d *diskann) readNodes(ids []uint64) ([]expandedNode, error) {
+	nodes := make([]expandedNode, len(ids))
+	if len(ids) == 1 {
+		n, err := d.readNode(ids[0])
+		if err != nil {
+			return nil, err
+		}
+		nodes[0] = expandedNode{id: ids[0], node: n}
+		return nodes, nil
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(d.logger)
+	for i := range ids {
+		i := i
+		eg.Go(func() error {
+			n, err := d.readNode(ids[i])
+			if err != nil {
+				return err
+			}
+			nodes[i] = expandedNode{id: ids[i], node: n}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func (d *diskann) readNode(id uint64) (*node, error) {
+	d.nodeLocks.RLock(id)
+	defer d.nodeLocks.RUnlock(id)
+	return d.graph.read(id)
+}
+
+type pruneCandidate struct {
+	id   uint64
+	dist float32
+}
+
+// robustPrune selects at most maxDegree neighbors out of the candidates,
+// which have to be sorted by their distance to the node. A candidate is
+// skipped if an already selected neighbor is closer to it by a factor of
+// alpha than the node itself, which keeps long range edges in the graph.
+func robustPrune(candidates []pruneCandidate, alpha float32, maxDegree int,
+	distBetween func(a, b uint64) (float32, error),
+) ([]uint64, error) {
+	selected := make([]uint64, 0, maxDegree)
+	pruned := make([]bool, len(candidates))
+
+	for i := range candidates {
+		if len(selected) >= maxDegree {
+			break
+		}
+		if pruned[i] {
+			continue
+		}
+		selected = append(selected, candidates[i].id)
+
+		for j := i + 1; j < len(candidates); j++ {
+			if pruned[j] {
+				continue
+			}
+			dist, err := distBetween(candidates[i].id, candidates[j].id)
+			if err != nil {
+				return nil, err
+			}
+			if alpha*dist <= candidates[j].dist {
+				pruned[j] = true
+			}
+		}
+	}
+	return selected, nil
+}
+
+func sortPruneCandidates(candidates []pruneCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].dist != candidates[j].dist {
+			return candidates[i].dist < candidates[j].dist
+		}
+		return candidates[i].id < candidates[j].id
+	})
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
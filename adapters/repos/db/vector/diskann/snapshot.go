@@ -0,0 +1,263 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// snapshotDirName is the directory within the index directory which holds
+// the copy of the files taken for a backup. Restoring a backup recreates
+// only the snapshot, its files are moved in place when the index is opened.
+const snapshotDirName = "snapshot"
+
+func (d *diskann) snapshotDir() string {
+	return filepath.Join(d.dir, snapshotDirName)
+}
+
+// snapshotChunkSize is the unit in which the graph and the codes are copied
+// into a snapshot. A write to a chunk which was not copied yet copies it
+// first, so it should be small enough not to delay writes noticeably.
+const snapshotChunkSize = 64 * 1024
+
+// startSnapshot prepares the snapshot of the files of the index. It requires
+// writes to be blocked. Only the small metadata is copied right away, of the
+// graph and the codes just the length is recorded. They are copied by
+// copySnapshot afterwards while the index is written to.
+func (d *diskann) startSnapshot() (*snapshot, error) {
+	dir := d.snapshotDir()
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "remove %q", dir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "create %q", dir)
+	}
+
+	s := &snapshot{}
+	if d.graph != nil {
+		graph, err := newFileSnapshot(d.graph.file, filepath.Join(dir, graphFileName))
+		if err != nil {
+			return nil, err
+		}
+		s.graph = graph
+		codes, err := newFileSnapshot(d.codesFile, filepath.Join(dir, codesFileName))
+		if err != nil {
+			graph.close()
+			return nil, err
+		}
+		s.codes = codes
+	}
+
+	path := filepath.Join(dir, metadataFileName)
+	if err := d.metadata.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(path, 0o600)
+	}); err != nil {
+		s.close()
+		return nil, errors.Wrapf(err, "copy metadata to %q", path)
+	}
+
+	if s.graph != nil {
+		d.graph.snapshot.Store(s.graph)
+		d.codesSnapshot.Store(s.codes)
+	}
+	return s, nil
+}
+
+// copySnapshot copies the state recorded by startSnapshot into the snapshot
+// directory. Writes don't need to be blocked, they preserve the regions they
+// overwrite in the snapshot before changing the files.
+func (d *diskann) copySnapshot(ctx context.Context, s *snapshot) error {
+	defer func() {
+		if s.graph != nil {
+			d.graph.snapshot.CompareAndSwap(s.graph, nil)
+			d.codesSnapshot.CompareAndSwap(s.codes, nil)
+		}
+		s.close()
+	}()
+
+	for _, f := range []*fileSnapshot{s.graph, s.codes} {
+		if f == nil {
+			continue
+		}
+		if err := f.copy(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshot is a snapshot of the files of the index which is being copied
+type snapshot struct {
+	graph *fileSnapshot
+	codes *fileSnapshot
+}
+
+func (s *snapshot) close() {
+	if s.graph != nil {
+		s.graph.close()
+	}
+	if s.codes != nil {
+		s.codes.close()
+	}
+}
+
+// fileSnapshot is the copy of a file as it was when the snapshot was
+// started, while the file itself is written to in place. Chunks are copied
+// either in order by copy or by a write to the file through writeAt, which
+// copies the chunks it overwrites first.
+type fileSnapshot struct {
+	sync.Mutex
+	src    *os.File
+	dst    *os.File
+	size   int64
+	copied []bool
+	closed bool
+}
+
+func newFileSnapshot(src *os.File, path string) (*fileSnapshot, error) {
+	info, err := src.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "stat %q", src.Name())
+	}
+	dst, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create %q", path)
+	}
+	size := info.Size()
+	return &fileSnapshot{
+		src:    src,
+		dst:    dst,
+		size:   size,
+		copied: make([]bool, (size+snapshotChunkSize-1)/snapshotChunkSize),
+	}, nil
+}
+
+// copy copies all chunks which were not copied by a write yet
+func (s *fileSnapshot) copy(ctx context.Context) error {
+	for chunk := range s.copied {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrapf(err, "copy %q", s.src.Name())
+		}
+		s.Lock()
+		err := s.copyChunk(chunk)
+		s.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if err := s.dst.Sync(); err != nil {
+		return errors.Wrapf(err, "sync %q", s.dst.Name())
+	}
+	return nil
+}
+
+// preserve copies the chunks overlapping the given region before it is
+// overwritten. Regions beyond the length of the file when the snapshot was
+// started are not part of the snapshot.
+func (s *fileSnapshot) preserve(off, length int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	end := min(off+length, s.size)
+	for chunk := off / snapshotChunkSize; chunk*snapshotChunkSize < end; chunk++ {
+		if err := s.copyChunk(int(chunk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyChunk requires the lock to be held
+func (s *fileSnapshot) copyChunk(chunk int) error {
+	if s.copied[chunk] {
+		return nil
+	}
+	off := int64(chunk) * snapshotChunkSize
+	buf := make([]byte, min(snapshotChunkSize, s.size-off))
+	if _, err := s.src.ReadAt(buf, off); err != nil {
+		return errors.Wrapf(err, "read %q at %d", s.src.Name(), off)
+	}
+	if _, err := s.dst.WriteAt(buf, off); err != nil {
+		return errors.Wrapf(err, "write %q at %d", s.dst.Name(), off)
+	}
+	s.copied[chunk] = true
+	return nil
+}
+
+func (s *fileSnapshot) close() {
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		s.dst.Close()
+	}
+}
+
+// writeAt writes buf to f at off. If a snapshot of f is being copied, the
+// overwritten region is preserved in the snapshot first.
+func writeAt(f *os.File, snapshot *fileSnapshot, buf []byte, off int64) error {
+	if snapshot != nil {
+		if err := snapshot.preserve(off, int64(len(buf))); err != nil {
+			return errors.Wrap(err, "preserve snapshot")
+		}
+	}
+	_, err := f.WriteAt(buf, off)
+	return err
+}
+
+// removeSnapshot removes the snapshot of a released backup
+func (d *diskann) removeSnapshot() error {
+	dir := d.snapshotDir()
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "remove %q", dir)
+	}
+	return nil
+}
+
+// restoreSnapshot moves the files of a restored snapshot in place. A
+// snapshot next to the files of the index is left over from a backup of
+// this index and is removed.
+func (d *diskann) restoreSnapshot() error {
+	dir := d.snapshotDir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "list %q", dir)
+	}
+
+	if _, err := os.Stat(filepath.Join(d.dir, metadataFileName)); err == nil {
+		return d.removeSnapshot()
+	}
+
+	for _, entry := range entries {
+		src, dst := filepath.Join(dir, entry.Name()), filepath.Join(d.dir, entry.Name())
+		if err := os.Rename(src, dst); err != nil {
+			return errors.Wrapf(err, "move %q to %q", src, dst)
+		}
+	}
+	return d.removeSnapshot()
+}
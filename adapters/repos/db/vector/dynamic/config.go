@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
@@ -70,6 +71,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "upgradeTo",
+			accessor: func(c ent.UserConfig) interface{} { return c.UpgradeTarget() },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -83,6 +88,11 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HnswUC, updatedParsed.HnswUC); err != nil {
 		return err
 	}
+	if initialParsed.UpgradeTarget() == ent.UpgradeToDiskANN {
+		if err := diskann.ValidateUserConfigUpdate(initialParsed.DiskANNUC, updatedParsed.DiskANNUC); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
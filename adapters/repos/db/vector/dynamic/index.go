@@ -19,7 +19,6 @@ import (
 	"math"
 	"os"
 	"path/filepath"
-	"runtime"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -29,14 +28,16 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
-	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	bolt "go.etcd.io/bbolt"
@@ -82,6 +83,13 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+// dynamic starts out as a flat index and is upgraded to hnsw or diskann once
+// it holds more vectors than the threshold. If a downgrade threshold is set,
+// an upgraded index which shrinks below it is switched back to flat.
+//
+// Switching in either direction happens online: the new index is built in
+// the background while the current one keeps serving reads and writes, see
+// switchIndex.
 type dynamic struct {
 	sync.RWMutex
 	id                    string
@@ -96,12 +104,22 @@ type dynamic struct {
 	tempVectorForIDThunk  common.TempVectorForID
 	distanceProvider      distancer.Provider
 	makeCommitLoggerThunk hnsw.MakeCommitLogger
-	threshold             uint64
+	threshold             atomic.Uint64
+	downgradeThreshold    atomic.Uint64
 	index                 VectorIndex
 	upgraded              atomic.Bool
 	tombstoneCallbacks    cyclemanager.CycleCallbackGroup
+	upgradeTo             string
+	flatUC                flatent.UserConfig
 	hnswUC                hnswent.UserConfig
+	diskannUC             diskannent.UserConfig
 	db                    *bolt.DB
+
+	// migration is set while the index is switched between flat and the
+	// upgrade target, it is guarded by the RWMutex
+	migration *migration
+	// switchLock serializes upgrades and downgrades
+	switchLock sync.Mutex
 }
 
 func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
@@ -119,14 +137,6 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		logger = l
 	}
 
-	flatConfig := flat.Config{
-		ID:               cfg.ID,
-		RootPath:         cfg.RootPath,
-		TargetVector:     cfg.TargetVector,
-		Logger:           cfg.Logger,
-		DistanceProvider: cfg.DistanceProvider,
-	}
-
 	index := &dynamic{
 		id:                    cfg.ID,
 		targetVector:          cfg.TargetVector,
@@ -140,10 +150,14 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		distanceProvider:      cfg.DistanceProvider,
 		makeCommitLoggerThunk: cfg.MakeCommitLoggerThunk,
 		store:                 store,
-		threshold:             uc.Threshold,
 		tombstoneCallbacks:    cfg.TombstoneCallbacks,
+		upgradeTo:             uc.UpgradeTarget(),
+		flatUC:                uc.FlatUC,
 		hnswUC:                uc.HnswUC,
+		diskannUC:             uc.DiskANNUC,
 	}
+	index.threshold.Store(uc.Threshold)
+	index.downgradeThreshold.Store(uc.DowngradeThreshold)
 
 	path := filepath.Join(cfg.RootPath, "index.db")
 
@@ -177,29 +191,13 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 	index.db = db
 	if upgraded {
 		index.upgraded.Store(true)
-		hnsw, err := hnsw.New(
-			hnsw.Config{
-				Logger:                index.logger,
-				RootPath:              index.rootPath,
-				ID:                    index.id,
-				ShardName:             index.shardName,
-				ClassName:             index.className,
-				PrometheusMetrics:     index.prometheusMetrics,
-				VectorForIDThunk:      index.vectorForIDThunk,
-				TempVectorForIDThunk:  index.tempVectorForIDThunk,
-				DistanceProvider:      index.distanceProvider,
-				MakeCommitLoggerThunk: index.makeCommitLoggerThunk,
-			},
-			index.hnswUC,
-			index.tombstoneCallbacks,
-			index.store,
-		)
+		upgradedIndex, err := index.newUpgradedIndex()
 		if err != nil {
 			return nil, err
 		}
-		index.index = hnsw
+		index.index = upgradedIndex
 	} else {
-		flat, err := flat.New(flatConfig, uc.FlatUC, store)
+		flat, err := index.newFlatIndex()
 		if err != nil {
 			return nil, err
 		}
@@ -218,19 +216,37 @@ func (dynamic *dynamic) Compressed() bool {
 func (dynamic *dynamic) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.AddBatch(ctx, ids, vectors)
+	if err := dynamic.index.AddBatch(ctx, ids, vectors); err != nil {
+		return err
+	}
+	if dynamic.migration != nil {
+		return dynamic.migration.addBatch(ctx, ids, vectors)
+	}
+	return nil
 }
 
 func (dynamic *dynamic) Add(ctx context.Context, id uint64, vector []float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Add(ctx, id, vector)
+	if err := dynamic.index.Add(ctx, id, vector); err != nil {
+		return err
+	}
+	if dynamic.migration != nil {
+		return dynamic.migration.addBatch(ctx, []uint64{id}, [][]float32{vector})
+	}
+	return nil
 }
 
 func (dynamic *dynamic) Delete(ids ...uint64) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Delete(ids...)
+	if err := dynamic.index.Delete(ids...); err != nil {
+		return err
+	}
+	if dynamic.migration != nil {
+		dynamic.migration.delete(ids...)
+	}
+	return nil
 }
 
 func (dynamic *dynamic) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
@@ -251,17 +267,23 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 		callback()
 		return errors.Errorf("config is not UserConfig, but %T", updated)
 	}
-	if dynamic.upgraded.Load() {
-		dynamic.RLock()
-		defer dynamic.RUnlock()
-		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
-	} else {
-		dynamic.hnswUC = parsed.HnswUC
-		dynamic.RLock()
-		defer dynamic.RUnlock()
-		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
+	dynamic.threshold.Store(parsed.Threshold)
+	dynamic.downgradeThreshold.Store(parsed.DowngradeThreshold)
+
+	dynamic.Lock()
+	defer dynamic.Unlock()
+	// the configs of both sides are kept, they are used when the index is
+	// switched the next time
+	dynamic.flatUC = parsed.FlatUC
+	dynamic.hnswUC = parsed.HnswUC
+	dynamic.diskannUC = parsed.DiskANNUC
+	if !dynamic.upgraded.Load() {
+		return dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
 	}
-	return nil
+	if dynamic.upgradeTo == ent.UpgradeToDiskANN {
+		return dynamic.index.UpdateUserConfig(parsed.DiskANNUC, callback)
+	}
+	return dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
 }
 
 func (dynamic *dynamic) Drop(ctx context.Context) error {
@@ -307,6 +329,22 @@ func (dynamic *dynamic) ValidateBeforeInsert(vector []float32) error {
 	return dynamic.index.ValidateBeforeInsert(vector)
 }
 
+func (dynamic *dynamic) Multivector() bool {
+	return false
+}
+
+func (dynamic *dynamic) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.New("multivector is not supported by the dynamic index")
+}
+
+func (dynamic *dynamic) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.New("multivector is not supported by the dynamic index")
+}
+
+func (dynamic *dynamic) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	return errors.New("multivector is not supported by the dynamic index")
+}
+
 func (dynamic *dynamic) PostStartup() {
 	dynamic.Lock()
 	defer dynamic.Unlock()
@@ -355,17 +393,26 @@ func (dynamic *dynamic) QueryVectorDistancer(queryVector []float32) common.Query
 
 func (dynamic *dynamic) ShouldUpgrade() (bool, int) {
 	if !dynamic.upgraded.Load() {
-		return true, int(dynamic.threshold)
+		return true, int(dynamic.threshold.Load())
 	}
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return (dynamic.index).(upgradableIndexer).ShouldUpgrade()
+	// the diskann index compresses itself and has no further upgrade
+	upgradable, ok := dynamic.index.(upgradableIndexer)
+	if !ok {
+		return false, 0
+	}
+	return upgradable.ShouldUpgrade()
 }
 
 func (dynamic *dynamic) Upgraded() bool {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.upgraded.Load() && dynamic.index.(upgradableIndexer).Upgraded()
+	if !dynamic.upgraded.Load() {
+		return false
+	}
+	upgradable, ok := dynamic.index.(upgradableIndexer)
+	return !ok || upgradable.Upgraded()
 }
 
 func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
@@ -376,13 +423,71 @@ func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
 }
 
 func (dynamic *dynamic) Upgrade(callback func()) error {
-	dynamic.Lock()
-	defer dynamic.Unlock()
 	if dynamic.upgraded.Load() {
-		return dynamic.index.(upgradableIndexer).Upgrade(callback)
+		dynamic.Lock()
+		defer dynamic.Unlock()
+		upgradable, ok := dynamic.index.(upgradableIndexer)
+		if !ok {
+			callback()
+			return nil
+		}
+		return upgradable.Upgrade(callback)
 	}
+	defer callback()
+
+	if err := dynamic.switchIndex(true, dynamic.newUpgradedIndex, dynamic.flatVectors); err != nil {
+		return errors.Wrap(err, "upgrade")
+	}
+	return nil
+}
 
-	index, err := hnsw.New(
+// ShouldDowngrade indicates that the upgraded index holds fewer vectors than
+// the downgrade threshold
+func (dynamic *dynamic) ShouldDowngrade() bool {
+	threshold := dynamic.downgradeThreshold.Load()
+	if threshold == 0 || !dynamic.upgraded.Load() {
+		return false
+	}
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	return dynamic.index.AlreadyIndexed() < threshold
+}
+
+// Downgrade switches an upgraded index back to a flat index
+func (dynamic *dynamic) Downgrade(callback func()) error {
+	defer callback()
+
+	if err := dynamic.switchIndex(false, dynamic.newFlatIndex, dynamic.upgradedVectors); err != nil {
+		return errors.Wrap(err, "downgrade")
+	}
+	return nil
+}
+
+func (dynamic *dynamic) newFlatIndex() (VectorIndex, error) {
+	return flat.New(flat.Config{
+		ID:               dynamic.id,
+		RootPath:         dynamic.rootPath,
+		TargetVector:     dynamic.targetVector,
+		Logger:           dynamic.logger,
+		DistanceProvider: dynamic.distanceProvider,
+	}, dynamic.flatUC, dynamic.store)
+}
+
+// newUpgradedIndex creates the index which replaces the flat index once the
+// threshold is reached
+func (dynamic *dynamic) newUpgradedIndex() (VectorIndex, error) {
+	if dynamic.upgradeTo == ent.UpgradeToDiskANN {
+		return diskann.New(diskann.Config{
+			ID:                 dynamic.id,
+			TargetVector:       dynamic.targetVector,
+			RootPath:           dynamic.rootPath,
+			Logger:             dynamic.logger,
+			DistanceProvider:   dynamic.distanceProvider,
+			TombstoneCallbacks: dynamic.tombstoneCallbacks,
+		}, dynamic.diskannUC)
+	}
+
+	return hnsw.New(
 		hnsw.Config{
 			Logger:                dynamic.logger,
 			RootPath:              dynamic.rootPath,
@@ -399,71 +504,6 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		dynamic.tombstoneCallbacks,
 		dynamic.store,
 	)
-	if err != nil {
-		callback()
-		return err
-	}
-
-	bucket := dynamic.store.Bucket(helpers.VectorsBucketLSM)
-
-	g := werrors.NewErrorGroupWrapper(dynamic.logger)
-	workerCount := runtime.GOMAXPROCS(0)
-	type task struct {
-		id     uint64
-		vector []float32
-	}
-
-	ch := make(chan task, workerCount)
-
-	// For now use an unlimited context here – for backward compatibility. This
-	// is probably not ideal and I assume also an upgrade operation should have
-	// some sort of a timeout.
-	ctx := context.TODO()
-
-	for i := 0; i < workerCount; i++ {
-		g.Go(func() error {
-			for t := range ch {
-				err := index.Add(ctx, t.id, t.vector)
-				if err != nil {
-					return err
-				}
-			}
-
-			return nil
-		})
-	}
-
-	cursor := bucket.Cursor()
-
-	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
-		id := binary.BigEndian.Uint64(k)
-		vc := make([]float32, len(v)/4)
-		float32SliceFromByteSlice(v, vc)
-
-		ch <- task{id: id, vector: vc}
-	}
-	cursor.Close()
-
-	close(ch)
-
-	err = g.Wait()
-	if err != nil {
-		callback()
-		return errors.Wrap(err, "upgrade")
-	}
-
-	err = dynamic.db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket(dynamicBucket)
-		return b.Put([]byte(composerUpgradedKey), []byte{1})
-	})
-	if err != nil {
-		return errors.Wrap(err, "update dynamic")
-	}
-
-	dynamic.index = index
-	dynamic.upgraded.Store(true)
-	callback()
-	return nil
 }
 
 func (dynamic *dynamic) Iterate(fn func(id uint64) bool) {
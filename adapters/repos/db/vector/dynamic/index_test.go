@@ -15,12 +15,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
@@ -108,6 +110,213 @@ func TestDynamic(t *testing.T) {
 	assert.True(t, latency1 > latency2)
 }
 
+func TestDynamicUpgradeToDiskANN(t *testing.T) {
+	ctx := context.Background()
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 2_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	rootPath := t.TempDir()
+	distancer := distancer.NewL2SquaredProvider()
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	})
+	store := testinghelpers.NewDummyStore(t)
+	uc := ent.NewDefaultUserConfig()
+	uc.Threshold = uint64(vectors_size)
+	uc.Distance = distancer.Type()
+	uc.UpgradeTo = ent.UpgradeToDiskANN
+	uc.DiskANNUC.MaxDegree = 24
+	newIndex := func() dynamic.VectorIndex {
+		index, err := dynamic.New(dynamic.Config{
+			RootPath:              rootPath,
+			ID:                    "diskann-upgrade-test",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distancer,
+			TombstoneCallbacks:    cyclemanager.NewCallbackGroupNoop(),
+		}, uc, store)
+		require.Nil(t, err)
+		return index
+	}
+
+	index := newIndex()
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(ctx, i, vectors[i])
+	})
+	upgradable := index.(interface {
+		Upgraded() bool
+		Upgrade(callback func()) error
+		ShouldUpgrade() (bool, int)
+	})
+	assert.False(t, upgradable.Upgraded())
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.Nil(t, upgradable.Upgrade(func() {
+		wg.Done()
+	}))
+	wg.Wait()
+
+	assert.True(t, upgradable.Upgraded())
+	shouldUpgrade, _ := upgradable.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+	_, err := os.Stat(filepath.Join(rootPath, "diskann-upgrade-test.diskann.d"))
+	assert.Nil(t, err)
+	recall, _ := recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.9)
+
+	// the upgraded index is restored on startup
+	require.Nil(t, index.Shutdown(ctx))
+	index = newIndex()
+	defer index.Shutdown(ctx)
+	assert.Equal(t, uint64(vectors_size), index.AlreadyIndexed())
+	recall, _ = recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.9)
+}
+
+func TestDynamicDowngrade(t *testing.T) {
+	ctx := context.Background()
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 2_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	rootPath := t.TempDir()
+	distancer := distancer.NewL2SquaredProvider()
+	store := testinghelpers.NewDummyStore(t)
+	tombstoneCallbacks := cyclemanager.NewCallbackGroup("tombstones", logger, 1)
+	var vectorsLock sync.RWMutex
+	uc := ent.NewDefaultUserConfig()
+	uc.Threshold = uint64(vectors_size)
+	uc.DowngradeThreshold = uint64(vectors_size / 2)
+	uc.Distance = distancer.Type()
+	uc.HnswUC.EFConstruction = 64
+	uc.HnswUC.EF = 64
+	type downgradable interface {
+		dynamic.VectorIndex
+		Upgrade(callback func()) error
+		ShouldUpgrade() (bool, int)
+		ShouldDowngrade() bool
+		Downgrade(callback func()) error
+	}
+	newIndex := func() downgradable {
+		index, err := dynamic.New(dynamic.Config{
+			RootPath:              rootPath,
+			ID:                    "downgrade-test",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				vectorsLock.RLock()
+				defer vectorsLock.RUnlock()
+				vec := vectors[int(id)]
+				if vec == nil {
+					return nil, storobj.NewErrNotFoundf(id, "nil vec")
+				}
+				return vec, nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				vectorsLock.RLock()
+				defer vectorsLock.RUnlock()
+				copy(container.Slice, vectors[int(id)])
+				return vectors[int(id)], nil
+			},
+			TombstoneCallbacks: tombstoneCallbacks,
+		}, uc, store)
+		require.Nil(t, err)
+		return index
+	}
+	switchWith := func(fn func(callback func()) error) {
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, fn(func() {
+			wg.Done()
+		}))
+		wg.Wait()
+	}
+
+	index := newIndex()
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(ctx, i, vectors[i])
+	})
+	switchWith(index.Upgrade)
+	shouldUpgrade, _ := index.ShouldUpgrade()
+	require.False(t, shouldUpgrade)
+	assert.False(t, index.ShouldDowngrade())
+
+	// delete the first three quarters of the vectors, the count of the
+	// upgraded index drops once the tombstones are cleaned up
+	deleted := vectors_size * 3 / 4
+	ids := make([]uint64, 0, deleted)
+	vectorsLock.Lock()
+	for i := 0; i < deleted; i++ {
+		vectors[i] = nil
+		ids = append(ids, uint64(i))
+	}
+	vectorsLock.Unlock()
+	require.Nil(t, index.Delete(ids...))
+	tombstoneCallbacks.CycleCallback(func() bool { return false })
+	require.True(t, index.ShouldDowngrade())
+
+	// the index keeps serving reads and writes while it is downgraded
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := deleted; i < vectors_size; i++ {
+			index.SearchByVector(ctx, vectors[i], k, nil)
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+	}()
+	switchWith(index.Downgrade)
+	wg.Wait()
+
+	shouldUpgrade, _ = index.ShouldUpgrade()
+	assert.True(t, shouldUpgrade)
+	assert.False(t, index.ShouldDowngrade())
+	for i := 0; i < vectors_size; i++ {
+		assert.Equal(t, i >= deleted, index.ContainsNode(uint64(i)))
+	}
+	remaining := vectors[deleted:]
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, remaining, queries[i], k, distanceWrapper(distancer))
+		for j := range truths[i] {
+			truths[i][j] += uint64(deleted)
+		}
+	})
+	recall, _ := recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.99)
+
+	// the downgraded index is restored on startup
+	require.Nil(t, index.Shutdown(ctx))
+	index = newIndex()
+	shouldUpgrade, _ = index.ShouldUpgrade()
+	assert.True(t, shouldUpgrade)
+	for i := 0; i < vectors_size; i++ {
+		assert.Equal(t, i >= deleted, index.ContainsNode(uint64(i)))
+	}
+	recall, _ = recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.99)
+
+	// and can be upgraded again
+	switchWith(index.Upgrade)
+	shouldUpgrade, _ = index.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+	recall, _ = recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.9)
+	require.Nil(t, index.Shutdown(ctx))
+}
+
 func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
 	currentIndexing := os.Getenv("ASYNC_INDEXING")
 	os.Unsetenv("ASYNC_INDEXING")
@@ -0,0 +1,304 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	werrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+	bolt "go.etcd.io/bbolt"
+)
+
+// migration forwards the writes to the index which is being built while the
+// dynamic index switches between flat and the upgrade target
+type migration struct {
+	target VectorIndex
+
+	sync.Mutex
+	// deleted holds the ids which were deleted while the target was filled,
+	// they are removed from the target before it replaces the current index
+	deleted map[uint64]struct{}
+}
+
+func newMigration(target VectorIndex) *migration {
+	return &migration{
+		target:  target,
+		deleted: make(map[uint64]struct{}),
+	}
+}
+
+func (m *migration) addBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	m.Lock()
+	for _, id := range ids {
+		delete(m.deleted, id)
+	}
+	m.Unlock()
+	return m.target.AddBatch(ctx, ids, vectors)
+}
+
+func (m *migration) delete(ids ...uint64) {
+	m.Lock()
+	defer m.Unlock()
+	for _, id := range ids {
+		m.deleted[id] = struct{}{}
+	}
+}
+
+func (m *migration) isDeleted(id uint64) bool {
+	m.Lock()
+	defer m.Unlock()
+	_, ok := m.deleted[id]
+	return ok
+}
+
+// applyDeletes must be called while no more writes are forwarded
+func (m *migration) applyDeletes() error {
+	ids := make([]uint64, 0, len(m.deleted))
+	for id := range m.deleted {
+		if m.target.ContainsNode(id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return m.target.Delete(ids...)
+}
+
+// vectorSource calls fn for every vector of the current index
+type vectorSource func(ctx context.Context, fn func(id uint64, vector []float32) error) error
+
+// switchIndex replaces the current index with a new index. The new index
+// is filled with the vectors of the current index in the background, reads
+// and writes are served by the current index in the meantime and writes are
+// forwarded to the target. The lock is only held for writing to install the
+// migration and to swap the indexes once the target is complete.
+func (dynamic *dynamic) switchIndex(upgraded bool, create func() (VectorIndex, error), source vectorSource) error {
+	dynamic.switchLock.Lock()
+	defer dynamic.switchLock.Unlock()
+
+	if dynamic.upgraded.Load() == upgraded {
+		// switched by a concurrent call
+		return nil
+	}
+	target, err := dynamic.newEmptyIndex(create)
+	if err != nil {
+		return err
+	}
+
+	// For now use an unlimited context here – for backward compatibility. This
+	// is probably not ideal and I assume also a switch operation should have
+	// some sort of a timeout.
+	ctx := context.TODO()
+	before := time.Now()
+
+	m := newMigration(target)
+	dynamic.Lock()
+	dynamic.migration = m
+	previous := dynamic.index
+	dynamic.Unlock()
+
+	if err := dynamic.fill(ctx, m, source); err != nil {
+		dynamic.Lock()
+		dynamic.migration = nil
+		dynamic.Unlock()
+		if dropErr := target.Drop(ctx); dropErr != nil {
+			dynamic.logger.WithError(dropErr).Warn("failed to drop incomplete index")
+		}
+		return err
+	}
+
+	dynamic.Lock()
+	if err := m.applyDeletes(); err != nil {
+		dynamic.migration = nil
+		dynamic.Unlock()
+		return errors.Wrap(err, "apply deletes")
+	}
+	if err := dynamic.persistUpgraded(upgraded); err != nil {
+		dynamic.migration = nil
+		dynamic.Unlock()
+		return err
+	}
+	dynamic.index = target
+	dynamic.migration = nil
+	dynamic.upgraded.Store(upgraded)
+	dynamic.Unlock()
+
+	// the previous index is no longer reachable, no operation can be in
+	// progress on it
+	if err := previous.Drop(ctx); err != nil {
+		dynamic.logger.WithError(err).Warn("failed to drop previous index")
+	}
+
+	dynamic.logger.WithFields(logrus.Fields{
+		"action":   "dynamic_switch_index",
+		"id":       dynamic.id,
+		"upgraded": upgraded,
+		"count":    target.AlreadyIndexed(),
+		"took":     time.Since(before),
+	}).Info("switched vector index")
+	return nil
+}
+
+// fill adds the vectors of the source to the target of the migration
+func (dynamic *dynamic) fill(ctx context.Context, m *migration, source vectorSource) error {
+	// a failing worker cancels gctx, which stops the source from waiting for
+	// workers which are no longer receiving
+	g, gctx := werrors.NewErrorGroupWithContextWrapper(dynamic.logger, ctx)
+	workerCount := runtime.GOMAXPROCS(0)
+	type task struct {
+		id     uint64
+		vector []float32
+	}
+
+	ch := make(chan task, workerCount)
+	for i := 0; i < workerCount; i++ {
+		g.Go(func() error {
+			for t := range ch {
+				// deleted or already added by a forwarded write
+				if m.isDeleted(t.id) || m.target.ContainsNode(t.id) {
+					continue
+				}
+				if err := m.target.Add(gctx, t.id, t.vector); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	err := source(gctx, func(id uint64, vector []float32) error {
+		select {
+		case ch <- task{id: id, vector: vector}:
+			return nil
+		case <-gctx.Done():
+			return gctx.Err()
+		}
+	})
+	close(ch)
+
+	if waitErr := g.Wait(); waitErr != nil {
+		return errors.Wrap(waitErr, "add vectors")
+	}
+	return err
+}
+
+// newEmptyIndex makes sure that the index does not contain vectors of an
+// earlier phase, e.g. the flat bucket after an upgrade or the files of an
+// interrupted switch
+func (dynamic *dynamic) newEmptyIndex(create func() (VectorIndex, error)) (VectorIndex, error) {
+	index, err := create()
+	if err != nil {
+		return nil, err
+	}
+	if index.AlreadyIndexed() > 0 || index.Compressed() {
+		if err := index.Drop(context.Background()); err != nil {
+			return nil, errors.Wrap(err, "drop stale index")
+		}
+		if index, err = create(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the flat index keeps its vectors in the buckets of the shard, which
+	// are neither counted on startup nor removed when the index is dropped
+	var stale []uint64
+	index.Iterate(func(id uint64) bool {
+		stale = append(stale, id)
+		return true
+	})
+	if len(stale) > 0 {
+		if err := index.Delete(stale...); err != nil {
+			return nil, errors.Wrap(err, "delete stale vectors")
+		}
+	}
+	return index, nil
+}
+
+// flatVectors reads the vectors from the bucket of the flat index
+func (dynamic *dynamic) flatVectors(ctx context.Context, fn func(id uint64, vector []float32) error) error {
+	bucketName := helpers.VectorsBucketLSM
+	if dynamic.targetVector != "" {
+		bucketName = fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, dynamic.targetVector)
+	}
+
+	cursor := dynamic.store.Bucket(bucketName).Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		id := binary.BigEndian.Uint64(k)
+		vc := make([]float32, len(v)/4)
+		float32SliceFromByteSlice(v, vc)
+		if err := fn(id, vc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradedVectors reads the vectors of the ids in the upgraded index from the
+// object store, as neither hnsw nor diskann expose their vectors
+func (dynamic *dynamic) upgradedVectors(ctx context.Context, fn func(id uint64, vector []float32) error) error {
+	if dynamic.vectorForIDThunk == nil {
+		return errors.New("no vector source for the upgraded index")
+	}
+
+	dynamic.RLock()
+	current := dynamic.index
+	dynamic.RUnlock()
+
+	var ids []uint64
+	current.Iterate(func(id uint64) bool {
+		ids = append(ids, id)
+		return true
+	})
+
+	for _, id := range ids {
+		vector, err := dynamic.vectorForIDThunk(ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// deleted in the meantime
+				continue
+			}
+			return errors.Wrapf(err, "get vector %d", id)
+		}
+		if err := fn(id, vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dynamic *dynamic) persistUpgraded(upgraded bool) error {
+	value := []byte{0}
+	if upgraded {
+		value[0] = 1
+	}
+	err := dynamic.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(dynamicBucket)
+		return b.Put([]byte(composerUpgradedKey), value)
+	})
+	if err != nil {
+		return errors.Wrap(err, "update dynamic")
+	}
+	return nil
+}
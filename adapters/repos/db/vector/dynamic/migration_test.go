@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingIndex fails to add any vector
+type failingIndex struct {
+	VectorIndex
+}
+
+func (failingIndex) ContainsNode(id uint64) bool {
+	return false
+}
+
+func (failingIndex) Add(ctx context.Context, id uint64, vector []float32) error {
+	return errors.New("add failed")
+}
+
+func TestFillStopsWhenWorkersFail(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	dynamic := &dynamic{logger: logger}
+	m := newMigration(failingIndex{})
+
+	// the source has many more vectors than the workers can buffer, it must
+	// not block once all workers have returned
+	source := func(ctx context.Context, fn func(id uint64, vector []float32) error) error {
+		for id := uint64(0); id < 100_000; id++ {
+			if err := fn(id, []float32{1, 2, 3}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- dynamic.fill(context.Background(), m, source)
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, "add failed")
+	case <-time.After(10 * time.Second):
+		t.Fatal("fill did not return after the workers failed")
+	}
+}
@@ -47,6 +47,22 @@ func TestFlatUserConfigUpdates(t *testing.T) {
 					"bq is immutable: " +
 						"attempted change from \"true\" to \"false\""),
 			},
+			{
+				name:    "attempting to change sq bits",
+				initial: ent.UserConfig{SQ: ent.CompressionUserConfig{Enabled: true, Bits: 8}},
+				update:  ent.UserConfig{SQ: ent.CompressionUserConfig{Enabled: true, Bits: 4}},
+				expectedError: errors.Errorf(
+					"sq.bits is immutable: " +
+						"attempted change from \"8\" to \"4\""),
+			},
+			{
+				name:    "attempting to change index dimensions",
+				initial: ent.UserConfig{IndexDimensions: 256},
+				update:  ent.UserConfig{IndexDimensions: 512},
+				expectedError: errors.Errorf(
+					"indexDimensions is immutable: " +
+						"attempted change from \"256\" to \"512\""),
+			},
 			{
 				name:    "attempting to change distance",
 				initial: ent.UserConfig{Distance: "cosine"},
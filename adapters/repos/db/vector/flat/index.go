@@ -39,6 +39,7 @@ import (
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	bolt "go.etcd.io/bbolt"
 )
@@ -62,6 +63,19 @@ type flat struct {
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	// rotation is applied before BQ and SQ encoding, nil unless enabled
+	rotation *compressionhelpers.Rotation
+	// indexDimensions limits the BQ codes to a prefix of the vectors, 0
+	// encodes the full vectors
+	indexDimensions int
+
+	// sq is nil until sqTrainingLimit vectors were added, sqUntrained counts
+	// them until then
+	sq              sqQuantizer
+	sqLock          sync.RWMutex
+	sqBits          int
+	sqTrainingLimit int
+	sqUntrained     int64
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -98,11 +112,18 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		pool:                 newPools(),
 		store:                store,
 		concurrentCacheReads: runtime.GOMAXPROCS(0) * 2,
+		indexDimensions:      uc.IndexDimensions,
+		sqBits:               uc.SQ.Bits,
+		sqTrainingLimit:      uc.SQ.TrainingLimit,
 	}
 	if err := index.initBuckets(context.Background()); err != nil {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
 	}
 
+	if (uc.BQ.Enabled && uc.BQ.Rotation) || (uc.SQ.Enabled && uc.SQ.Rotation) {
+		index.rotation = compressionhelpers.NewRotation(compressionhelpers.DefaultRotationSeed)
+	}
+
 	if uc.BQ.Enabled && uc.BQ.Cache {
 		index.bqCache = cache.NewShardedUInt64LockCache(
 			index.getBQVector, uc.VectorCacheMaxObjects, cfg.Logger, 0, cfg.AllocChecker)
@@ -112,9 +133,25 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		return nil, err
 	}
 
+	if index.isSQ() {
+		if err := index.initSQ(); err != nil {
+			return nil, fmt.Errorf("init flat scalar quantizer: %w", err)
+		}
+	}
+
 	return index, nil
 }
 
+// encodeBQ encodes the indexed part of a normalized vector. The uncompressed
+// vectors are always stored in full, so results are rescored with the full
+// vectors.
+func (index *flat) encodeBQ(vector []float32) []uint64 {
+	if index.indexDimensions > 0 && len(vector) > index.indexDimensions {
+		vector = index.normalized(vector[:index.indexDimensions])
+	}
+	return index.bq.Encode(index.rotation.Rotate(vector))
+}
+
 func (flat *flat) getBQVector(ctx context.Context, id uint64) ([]uint64, error) {
 	key := flat.pool.byteSlicePool.Get(8)
 	defer flat.pool.byteSlicePool.Put(key)
@@ -222,7 +259,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.isBQ() || index.isSQ() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompation(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -323,7 +360,7 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
 
 	if index.isBQ() {
-		vectorBQ := index.bq.Encode(vector)
+		vectorBQ := index.encodeBQ(vector)
 		if index.isBQCached() {
 			index.bqCache.Grow(id)
 			index.bqCache.Preload(id, vectorBQ)
@@ -331,6 +368,12 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+
+	if index.isSQ() {
+		if err := index.addSQ(id, vector); err != nil {
+			return err
+		}
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
@@ -348,7 +391,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.isBQ() || index.isSQ() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -368,9 +411,16 @@ func (index *flat) searchTimeRescore(k int) int {
 }
 
 func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if allow != nil {
+		// the flat index always applies filters by brute force
+		helpers.VectorSearchExplainFromContext(ctx).AddFilterStrategy(hnswent.FilterStrategyFlat)
+	}
+
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(ctx, vector, k, allow)
+	case compressionSQ:
+		return index.searchByVectorSQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -386,7 +436,7 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 
 	vector = index.normalized(vector)
 
-	if err := index.findTopVectors(heap, allow, k,
+	if err := index.findTopVectors(ctx, heap, allow, k,
 		index.store.Bucket(index.getBucketName()).Cursor,
 		index.createDistanceCalc(vector),
 	); err != nil {
@@ -414,14 +464,14 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 	defer index.pqResults.Put(heap)
 
 	vector = index.normalized(vector)
-	vectorBQ := index.bq.Encode(vector)
+	vectorBQ := index.encodeBQ(vector)
 
 	if index.isBQCached() {
-		if err := index.findTopVectorsCached(heap, allow, rescore, vectorBQ); err != nil {
+		if err := index.findTopVectorsCached(ctx, heap, allow, rescore, vectorBQ); err != nil {
 			return nil, nil, err
 		}
 	} else {
-		if err := index.findTopVectors(heap, allow, rescore,
+		if err := index.findTopVectors(ctx, heap, allow, rescore,
 			index.store.Bucket(index.getCompressedBucketName()).Cursor,
 			index.createDistanceCalcBQ(vectorBQ),
 		); err != nil {
@@ -429,6 +479,39 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		}
 	}
 
+	return index.rescoreHeap(ctx, heap, vector, k)
+}
+
+func (index *flat) searchByVectorSQ(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	sq := index.getSQ()
+	if sq == nil {
+		// not trained yet, the compressed bucket is still empty
+		return index.searchByVector(ctx, vector, k, allow)
+	}
+
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	vectorSQ := index.encodeSQ(sq, vector)
+	if err := index.findTopVectors(ctx, heap, allow, rescore,
+		index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		func(vecAsBytes []byte) (float32, error) {
+			return sq.DistanceBetweenCompressedVectors(vecAsBytes, vectorSQ)
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return index.rescoreHeap(ctx, heap, vector, k)
+}
+
+// rescoreHeap replaces the compressed distances of the candidates in the heap
+// with the distances of the uncompressed vectors and keeps the best k
+func (index *flat) rescoreHeap(ctx context.Context, heap *priorityqueue.Queue[any],
+	vector []float32, k int,
+) ([]uint64, []float32, error) {
 	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
@@ -436,6 +519,7 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 	for i := range idsSlice.slice {
 		idsSlice.slice[i] = heap.Pop().ID
 	}
+	helpers.VectorSearchExplainFromContext(ctx).AddRescoredCandidates(len(idsSlice.slice))
 
 	// we expect to be mostly IO-bound, so more goroutines than CPUs is fine
 	distancesUncompressedVectors := make([]float32, len(idsSlice.slice))
@@ -497,7 +581,7 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 
 // populates given heap with smallest distances and corresponding ids calculated by
 // distanceCalc
-func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
+func (index *flat) findTopVectors(ctx context.Context, heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
 ) error {
@@ -505,6 +589,10 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	var v []byte
 	var id uint64
 	allowMax := uint64(0)
+	distanceComputations := 0
+	defer func() {
+		helpers.VectorSearchExplainFromContext(ctx).AddDistanceComputations(distanceComputations)
+	}()
 
 	cursor := cursorFn()
 	defer cursor.Close()
@@ -530,6 +618,7 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
+			distanceComputations++
 			distance, err := distanceCalc(v)
 			if err != nil {
 				return err
@@ -542,11 +631,15 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 
 // populates given heap with smallest distances and corresponding ids calculated by
 // distanceCalc
-func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
+func (index *flat) findTopVectorsCached(ctx context.Context, heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, vectorBQ []uint64,
 ) error {
 	var id uint64
 	allowMax := uint64(0)
+	distanceComputations := 0
+	defer func() {
+		helpers.VectorSearchExplainFromContext(ctx).AddDistanceComputations(distanceComputations)
+	}()
 
 	if allow != nil {
 		// nothing allowed, skip search
@@ -573,6 +666,7 @@ func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
 			if len(vec) == 0 {
 				continue
 			}
+			distanceComputations++
 			distance, err := index.bq.DistanceBetweenCompressedVectors(vec, vectorBQ)
 			if err != nil {
 				return err
@@ -750,9 +844,30 @@ func (index *flat) ListFiles(ctx context.Context, basePath string) ([]string, er
 }
 
 func (i *flat) ValidateBeforeInsert(vector []float32) error {
+	if i.indexDimensions > 0 && len(vector) < i.indexDimensions {
+		return errors.Errorf("new node has a vector with length %v, "+
+			"but the index is configured to index the first %v dimensions",
+			len(vector), i.indexDimensions)
+	}
 	return nil
 }
 
+func (i *flat) Multivector() bool {
+	return false
+}
+
+func (i *flat) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the flat index")
+}
+
+func (i *flat) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("multivector is not supported by the flat index")
+}
+
+func (i *flat) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the flat index")
+}
+
 func (index *flat) PostStartup() {
 	if !index.isBQCached() {
 		return
@@ -935,6 +1050,26 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "indexDimensions",
+			accessor: func(c flatent.UserConfig) interface{} { return c.IndexDimensions },
+		},
+		{
+			name:     "bq.rotation",
+			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Rotation },
+		},
+		{
+			name:     "sq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.SQ.Enabled },
+		},
+		{
+			name:     "sq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.SQ.Bits },
+		},
+		{
+			name:     "sq.rotation",
+			accessor: func(c flatent.UserConfig) interface{} { return c.SQ.Rotation },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -972,7 +1107,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 		if index.bqCache == nil {
 			distFunc = defaultDistFunc
 		} else {
-			queryVecEncode := index.bq.Encode(queryVector)
+			queryVecEncode := index.encodeBQ(queryVector)
 			distFunc = func(nodeID uint64) (float32, error) {
 				if int32(nodeID) > index.bqCache.Len() {
 					return -1, fmt.Errorf("node %v is larger than the cache size %v", nodeID, index.bqCache.Len())
@@ -18,6 +18,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/rand"
 	"os"
 	"runtime"
 	"strconv"
@@ -355,3 +356,178 @@ func TestConcurrentReads(t *testing.T) {
 		})
 	}
 }
+
+func TestFlat_TruncatedBQ(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 128
+	indexDimensions := 64
+	k := 10
+
+	// the last dimensions mostly repeat the first ones, so the prefix carries
+	// most of the information like in embeddings trained for truncation
+	r := rand.New(rand.NewSource(7))
+	data := make([][]float32, 1020)
+	for i := range data {
+		data[i] = make([]float32, dimensions)
+		for j := range data[i] {
+			if j < indexDimensions {
+				data[i][j] = r.Float32()*2 - 1
+			} else {
+				data[i][j] = data[i][j-indexDimensions] + (r.Float32()*2-1)/4
+			}
+		}
+	}
+	testinghelpers.Normalize(data)
+	vectors, queries := data[:1000], data[1000:]
+	provider := distancer.NewCosineDistanceProvider()
+
+	for _, cache := range []bool{false, true} {
+		t.Run(fmt.Sprintf("cache=%v", cache), func(t *testing.T) {
+			dirName := t.TempDir()
+			logger, _ := test.NewNullLogger()
+			store, err := lsmkv.New(dirName, dirName, logger, nil,
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			defer store.Shutdown(context.Background())
+
+			index, err := New(Config{
+				ID:               "truncated",
+				RootPath:         dirName,
+				DistanceProvider: provider,
+			}, flatent.UserConfig{
+				BQ: flatent.CompressionUserConfig{
+					Enabled:      true,
+					RescoreLimit: 20 * k,
+					Cache:        cache,
+				},
+				IndexDimensions:       indexDimensions,
+				VectorCacheMaxObjects: 1e12,
+			}, store)
+			require.Nil(t, err)
+			defer index.Shutdown(context.Background())
+			if cache {
+				index.PostStartup()
+			}
+
+			assert.NotNil(t, index.ValidateBeforeInsert(make([]float32, indexDimensions-1)))
+			for id, vec := range vectors {
+				require.Nil(t, index.ValidateBeforeInsert(vec))
+				require.Nil(t, index.Add(ctx, uint64(id), vec))
+			}
+
+			// the codes only cover the indexed dimensions
+			assert.Len(t, index.encodeBQ(vectors[0]), indexDimensions/64)
+
+			var relevant uint64
+			for _, q := range queries {
+				truth, _ := testinghelpers.BruteForce(logger, vectors, q, k, distanceWrapper(provider))
+				ids, dists, err := index.SearchByVector(ctx, q, k, nil)
+				require.Nil(t, err)
+				relevant += testinghelpers.MatchesInLists(truth, ids)
+
+				// distances are computed with the full vectors
+				for i, id := range ids {
+					expected, _ := provider.SingleDist(q, vectors[id])
+					assert.InDelta(t, expected, dists[i], 1e-4)
+				}
+			}
+			recall := float32(relevant) / float32(k*len(queries))
+			assert.Greater(t, recall, float32(0.8))
+		})
+	}
+}
+
+func TestFlat_SQ(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 64
+	trainingLimit := 500
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, dimensions)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	provider := distancer.NewCosineDistanceProvider()
+	logger, _ := test.NewNullLogger()
+
+	for _, bits := range []int{8, 4, 2} {
+		for _, rotation := range []bool{false, true} {
+			t.Run(fmt.Sprintf("bits=%d rotation=%v", bits, rotation), func(t *testing.T) {
+				dirName := t.TempDir()
+				uc := flatent.UserConfig{
+					SQ: flatent.CompressionUserConfig{
+						Enabled:       true,
+						RescoreLimit:  20 * k,
+						Rotation:      rotation,
+						Bits:          bits,
+						TrainingLimit: trainingLimit,
+					},
+				}
+				open := func() (*lsmkv.Store, *flat) {
+					store, err := lsmkv.New(dirName, dirName, logger, nil,
+						cyclemanager.NewCallbackGroupNoop(),
+						cyclemanager.NewCallbackGroupNoop(),
+						cyclemanager.NewCallbackGroupNoop())
+					require.Nil(t, err)
+					index, err := New(Config{
+						ID:               "sq",
+						RootPath:         dirName,
+						DistanceProvider: provider,
+					}, uc, store)
+					require.Nil(t, err)
+					return store, index
+				}
+				search := func(index *flat) {
+					var relevant uint64
+					for _, q := range queries {
+						truth, _ := testinghelpers.BruteForce(logger, vectors, q, k, distanceWrapper(provider))
+						ids, dists, err := index.SearchByVector(ctx, q, k, nil)
+						require.Nil(t, err)
+						relevant += testinghelpers.MatchesInLists(truth, ids)
+
+						// distances are computed with the full vectors
+						for i, id := range ids {
+							expected, _ := provider.SingleDist(q, vectors[id])
+							assert.InDelta(t, expected, dists[i], 1e-4)
+						}
+					}
+					recall := float32(relevant) / float32(k*len(queries))
+					assert.Greater(t, recall, float32(0.9))
+				}
+
+				// restart before the training limit is reached, the vectors
+				// stored so far still count towards it
+				store, index := open()
+				for id := 0; id < trainingLimit/2; id++ {
+					require.Nil(t, index.Add(ctx, uint64(id), vectors[id]))
+				}
+				assert.Nil(t, index.getSQ())
+				require.Nil(t, index.Shutdown(ctx))
+				require.Nil(t, store.Shutdown(ctx))
+
+				store, index = open()
+				for id := trainingLimit / 2; id < len(vectors); id++ {
+					require.Nil(t, index.Add(ctx, uint64(id), vectors[id]))
+					if id == trainingLimit-2 {
+						assert.Nil(t, index.getSQ())
+					}
+				}
+				require.NotNil(t, index.getSQ())
+				// a deleted copy of a query must not show up in its results
+				require.Nil(t, index.Add(ctx, uint64(len(vectors)), queries[0]))
+				require.Nil(t, index.Delete(uint64(len(vectors))))
+				search(index)
+				require.Nil(t, index.Shutdown(ctx))
+				require.Nil(t, store.Shutdown(ctx))
+
+				// the trained quantizer is restored from the metadata file
+				store, index = open()
+				defer store.Shutdown(ctx)
+				defer index.Shutdown(ctx)
+				require.NotNil(t, index.getSQ())
+				search(index)
+			})
+		}
+	}
+}
@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/binary"
+	"math"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	bolt "go.etcd.io/bbolt"
+)
+
+const sqMetadataKey = "sq"
+
+// sqQuantizer is implemented by the 8 bit and the 2/4 bit scalar quantizers
+type sqQuantizer interface {
+	Encode(vec []float32) []byte
+	DistanceBetweenCompressedVectors(x, y []byte) (float32, error)
+	PersistCompression(logger compressionhelpers.CommitLogger)
+}
+
+func (index *flat) isSQ() bool {
+	return index.compression == compressionSQ
+}
+
+// getSQ returns the scalar quantizer, nil until enough vectors were added to
+// train it. Until then searches use the uncompressed vectors.
+func (index *flat) getSQ() sqQuantizer {
+	index.sqLock.RLock()
+	defer index.sqLock.RUnlock()
+	return index.sq
+}
+
+func (index *flat) encodeSQ(sq sqQuantizer, vector []float32) []byte {
+	return sq.Encode(index.rotation.Rotate(vector))
+}
+
+// addSQ stores the SQ code of a vector which was already written to the
+// uncompressed bucket. The first vector which reaches the training limit
+// trains the quantizer and encodes all vectors stored so far.
+func (index *flat) addSQ(id uint64, vector []float32) error {
+	index.sqLock.RLock()
+	sq := index.sq
+	if sq != nil {
+		index.storeCompressedVector(id, index.encodeSQ(sq, vector))
+	}
+	index.sqLock.RUnlock()
+	if sq != nil {
+		return nil
+	}
+
+	if atomic.AddInt64(&index.sqUntrained, 1) < int64(index.sqTrainingLimit) {
+		return nil
+	}
+	return index.trainSQ()
+}
+
+// trainSQ holds the write lock for the whole training, so no vector is
+// encoded with a different quantizer. Vectors stored in the uncompressed
+// bucket before the lock was taken are encoded here, vectors stored later see
+// the trained quantizer in addSQ.
+func (index *flat) trainSQ() error {
+	index.sqLock.Lock()
+	defer index.sqLock.Unlock()
+
+	if index.sq != nil {
+		return nil
+	}
+
+	data := make([][]float32, 0, index.sqTrainingLimit)
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	for k, v := cursor.First(); k != nil && len(data) < index.sqTrainingLimit; k, v = cursor.Next() {
+		if len(v) == 0 {
+			continue
+		}
+		vec := float32SliceFromByteSlice(v, make([]float32, len(v)/4))
+		data = append(data, index.rotation.Rotate(vec))
+	}
+	cursor.Close()
+
+	sq, err := index.newSQ(data)
+	if err != nil {
+		return errors.Wrap(err, "train scalar quantizer")
+	}
+	if err := index.setSQ(sq); err != nil {
+		return err
+	}
+
+	encoded := 0
+	cursor = index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if len(v) == 0 {
+			continue
+		}
+		vec := float32SliceFromByteSlice(v, make([]float32, len(v)/4))
+		index.storeCompressedVector(binary.BigEndian.Uint64(k), index.encodeSQ(sq, vec))
+		encoded++
+	}
+	index.sq = sq
+
+	index.logger.WithFields(logrus.Fields{
+		"action":   "flat_train_sq",
+		"bits":     index.sqBits,
+		"count":    encoded,
+		"index_id": index.id,
+	}).Infof("trained scalar quantizer and encoded %d vectors", encoded)
+	return nil
+}
+
+func (index *flat) newSQ(data [][]float32) (sqQuantizer, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no vectors to train on")
+	}
+	if index.sqBits == 8 {
+		return compressionhelpers.NewScalarQuantizer(data, index.distancerProvider), nil
+	}
+	return compressionhelpers.NewLowBitScalarQuantizer(uint8(index.sqBits), data, index.distancerProvider)
+}
+
+// initSQ restores a quantizer trained before a restart. An untrained index
+// counts the stored vectors, so training starts once the limit is reached,
+// no matter how many restarts happened in between.
+func (index *flat) initSQ() error {
+	sq, err := index.fetchSQ()
+	if err != nil {
+		return err
+	}
+	if sq != nil {
+		index.sq = sq
+		return nil
+	}
+
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+	stored := int64(0)
+	for k, _ := cursor.First(); k != nil && stored < int64(index.sqTrainingLimit); k, _ = cursor.Next() {
+		stored++
+	}
+	atomic.StoreInt64(&index.sqUntrained, stored)
+	return nil
+}
+
+// setSQ persists the trained quantizer in the metadata file, the layout is
+// bits (1 byte), dimensions (2 bytes), followed by pairs of float32 values:
+// range and lower bound for 8 bits, lower bound and step of every dimension
+// for 2 and 4 bits
+func (index *flat) setSQ(sq sqQuantizer) error {
+	p := &sqPersister{}
+	sq.PersistCompression(p)
+	if p.err != nil {
+		return p.err
+	}
+
+	err := index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		return b.Put([]byte(sqMetadataKey), p.data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "set scalar quantizer")
+	}
+	return nil
+}
+
+func (index *flat) fetchSQ() (sqQuantizer, error) {
+	var data []byte
+	err := index.metadata.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return nil
+		}
+		if v := b.Get([]byte(sqMetadataKey)); v != nil {
+			data = make([]byte, len(v))
+			copy(data, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch scalar quantizer")
+	}
+	if data == nil {
+		return nil, nil
+	}
+	if len(data) < 3 {
+		return nil, errors.Errorf("invalid scalar quantizer metadata of length %d", len(data))
+	}
+
+	bits := data[0]
+	dims := binary.LittleEndian.Uint16(data[1:3])
+	values := make([]float32, (len(data)-3)/4)
+	for i := range values {
+		values[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[3+4*i:]))
+	}
+	if int(bits) != index.sqBits {
+		return nil, errors.Errorf("scalar quantizer was trained with %d bits, "+
+			"but the index is configured with %d bits", bits, index.sqBits)
+	}
+
+	if bits == 8 {
+		if len(values) != 2 {
+			return nil, errors.Errorf("invalid scalar quantizer metadata of length %d", len(data))
+		}
+		return compressionhelpers.RestoreScalarQuantizer(values[0], values[1], dims,
+			index.distancerProvider)
+	}
+
+	if len(values) != 2*int(dims) {
+		return nil, errors.Errorf("invalid scalar quantizer metadata of length %d", len(data))
+	}
+	lower := make([]float32, dims)
+	steps := make([]float32, dims)
+	for i := range lower {
+		lower[i] = values[2*i]
+		steps[i] = values[2*i+1]
+	}
+	return compressionhelpers.RestoreLowBitScalarQuantizer(compressionhelpers.LowBitSQData{
+		Bits:       bits,
+		Dimensions: dims,
+		Lower:      lower,
+		Steps:      steps,
+	}, index.distancerProvider)
+}
+
+// sqPersister collects the settings of a trained quantizer in the layout
+// described on setSQ
+type sqPersister struct {
+	data []byte
+	err  error
+}
+
+func (p *sqPersister) AddPQCompression(compressionhelpers.PQData) error {
+	p.err = errors.New("PQ is not supported for flat indices")
+	return p.err
+}
+
+func (p *sqPersister) AddSQCompression(data compressionhelpers.SQData) error {
+	p.data = make([]byte, 11)
+	p.data[0] = 8
+	binary.LittleEndian.PutUint16(p.data[1:3], data.Dimensions)
+	binary.LittleEndian.PutUint32(p.data[3:7], math.Float32bits(data.A))
+	binary.LittleEndian.PutUint32(p.data[7:11], math.Float32bits(data.B))
+	return nil
+}
+
+func (p *sqPersister) AddLowBitSQCompression(data compressionhelpers.LowBitSQData) error {
+	p.data = make([]byte, 3+8*len(data.Lower))
+	p.data[0] = data.Bits
+	binary.LittleEndian.PutUint16(p.data[1:3], data.Dimensions)
+	for i := range data.Lower {
+		binary.LittleEndian.PutUint32(p.data[3+8*i:], math.Float32bits(data.Lower[i]))
+		binary.LittleEndian.PutUint32(p.data[7+8*i:], math.Float32bits(data.Steps[i]))
+	}
+	return nil
+}
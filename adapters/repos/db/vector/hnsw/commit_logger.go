@@ -278,6 +278,7 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddLowBitSQ
 )
 
 func (t HnswCommitType) String() string {
@@ -308,6 +309,8 @@ func (t HnswCommitType) String() string {
 		return "AddProductQuantizer"
 	case AddSQ:
 		return "AddScalarQuantizer"
+	case AddLowBitSQ:
+		return "AddLowBitScalarQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -330,6 +333,13 @@ func (l *hnswCommitLogger) AddSQCompression(data compressionhelpers.SQData) erro
 	return l.commitLogger.AddSQCompression(data)
 }
 
+func (l *hnswCommitLogger) AddLowBitSQCompression(data compressionhelpers.LowBitSQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddLowBitSQCompression(data)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
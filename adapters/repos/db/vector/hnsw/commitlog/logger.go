@@ -43,6 +43,7 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddLowBitSQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -107,6 +108,11 @@ func (l *Logger) AddSQCompression(data compressionhelpers.SQData) error {
 	return err
 }
 
+func (l *Logger) AddLowBitSQCompression(data compressionhelpers.LowBitSQData) error {
+	_, err := l.bufw.Write(compressionhelpers.SerializeLowBitSQData(byte(AddLowBitSQ), data))
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
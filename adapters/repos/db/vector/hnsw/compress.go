@@ -83,11 +83,20 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 				h.pqConfig.Enabled = false
 				return fmt.Errorf("compressing vectors: %w", err)
 			}
+		} else if cfg.SQ.Enabled && cfg.SQ.Bits > 0 && cfg.SQ.Bits < 8 {
+			var err error
+			h.compressor, err = compressionhelpers.NewHNSWLowBitSQCompressor(
+				uint8(cfg.SQ.Bits), h.distancerProvider, 1e12, h.logger, cleanData,
+				h.store, h.allocChecker, compressorOptions(cfg.SQ.Rotation)...)
+			if err != nil {
+				h.sqConfig.Enabled = false
+				return fmt.Errorf("compressing vectors: %w", err)
+			}
 		} else if cfg.SQ.Enabled {
 			var err error
 			h.compressor, err = compressionhelpers.NewHNSWSQCompressor(
 				h.distancerProvider, 1e12, h.logger, cleanData, h.store,
-				h.allocChecker)
+				h.allocChecker, compressorOptions(cfg.SQ.Rotation)...)
 			if err != nil {
 				h.sqConfig.Enabled = false
 				return fmt.Errorf("compressing vectors: %w", err)
@@ -97,7 +106,8 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 	} else {
 		var err error
 		h.compressor, err = compressionhelpers.NewBQCompressor(
-			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker,
+			compressorOptions(cfg.BQ.Rotation)...)
 		if err != nil {
 			return err
 		}
@@ -114,3 +124,15 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 	h.cache.Drop()
 	return nil
 }
+
+// compressorOptions returns the options shared by the BQ and SQ compressors.
+// The rotation is recreated from a fixed seed, so it does not need to be
+// persisted with the compression data.
+func compressorOptions(rotation bool) []compressionhelpers.CompressorOption {
+	if !rotation {
+		return nil
+	}
+	return []compressionhelpers.CompressorOption{
+		compressionhelpers.WithRotation(compressionhelpers.DefaultRotationSeed),
+	}
+}
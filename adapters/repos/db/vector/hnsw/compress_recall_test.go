@@ -143,3 +143,75 @@ func Test_NoRaceCompressionRecall(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+func Test_NoRaceLowBitSQCompressionRecall(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 64
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(2000, 50, dimensions)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	k := 10
+
+	logger, _ := test.NewNullLogger()
+	provider := distancer.NewCosineDistanceProvider()
+	truths := make([][]uint64, len(queries))
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(provider))
+	}
+
+	// minimum recall by number of bits, 2 bits leave only four levels per
+	// dimension so even with rescoring some neighbors are missed
+	minRecall := map[int]float32{2: 0.75, 4: 0.9}
+	for _, bits := range []int{2, 4} {
+		t.Run(fmt.Sprintf("%d bits with rotation", bits), func(t *testing.T) {
+			uc := ent.NewDefaultUserConfig()
+			uc.EF = 64
+			uc.VectorCacheMaxObjects = 10e12
+			index, err := hnsw.New(hnsw.Config{
+				RootPath:              t.TempDir(),
+				ID:                    "lowbitsq",
+				MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+				ClassName:             "LowBitSQ",
+				ShardName:             "shard",
+				DistanceProvider:      provider,
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					if int(id) >= len(vectors) {
+						return nil, storobj.NewErrNotFoundf(id, "out of range")
+					}
+					return vectors[int(id)], nil
+				},
+				TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+					copy(container.Slice, vectors[int(id)])
+					return container.Slice, nil
+				},
+			}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			assert.Nil(t, err)
+			compressionhelpers.Concurrently(logger, uint64(len(vectors)), func(id uint64) {
+				index.Add(ctx, id, vectors[id])
+			})
+
+			uc.SQ = ent.SQConfig{
+				Enabled:       true,
+				TrainingLimit: ent.DefaultSQTrainingLimit,
+				RescoreLimit:  100,
+				Bits:          bits,
+				Rotation:      true,
+			}
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			index.UpdateUserConfig(uc, func() {
+				defer wg.Done()
+
+				var relevant uint64
+				for i := range queries {
+					results, _, _ := index.SearchByVector(ctx, queries[i], k, nil)
+					relevant += testinghelpers.MatchesInLists(truths[i], results)
+				}
+				recall := float32(relevant) / float32(k*len(queries))
+				assert.Greater(t, recall, minRecall[bits])
+			})
+			wg.Wait()
+			assert.True(t, index.Compressed())
+		})
+	}
+}
@@ -65,6 +65,10 @@ func (c *MemoryCondensor) Do(fileName string) error {
 			if err := c.AddSQCompression(*res.CompressionSQData); err != nil {
 				return fmt.Errorf("write sq data: %w", err)
 			}
+		} else if res.CompressionLowBitSQData != nil {
+			if err := c.AddLowBitSQCompression(*res.CompressionLowBitSQData); err != nil {
+				return fmt.Errorf("write low bit sq data: %w", err)
+			}
 		} else {
 			return errors.Wrap(err, "unavailable compression data")
 		}
@@ -317,6 +321,11 @@ func (c *MemoryCondensor) AddSQCompression(data compressionhelpers.SQData) error
 	return err
 }
 
+func (c *MemoryCondensor) AddLowBitSQCompression(data compressionhelpers.LowBitSQData) error {
+	_, err := c.newLog.Write(compressionhelpers.SerializeLowBitSQData(byte(AddLowBitSQ), data))
+	return err
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
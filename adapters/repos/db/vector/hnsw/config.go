@@ -32,12 +32,14 @@ type Config struct {
 	MakeCommitLoggerThunk MakeCommitLogger
 	VectorForIDThunk      common.VectorForID[float32]
 	TempVectorForIDThunk  common.TempVectorForID
-	Logger                logrus.FieldLogger
-	DistanceProvider      distancer.Provider
-	PrometheusMetrics     *monitoring.PrometheusMetrics
-	AllocChecker          memwatch.AllocChecker
-	WaitForCachePrefill   bool
-	FlatSearchConcurrency int
+	// MultipleVectorForIDThunk is only required for multivector indexes
+	MultipleVectorForIDThunk common.MultipleVectorForID
+	Logger                   logrus.FieldLogger
+	DistanceProvider         distancer.Provider
+	PrometheusMetrics        *monitoring.PrometheusMetrics
+	AllocChecker             memwatch.AllocChecker
+	WaitForCachePrefill      bool
+	FlatSearchConcurrency    int
 
 	// metadata for monitoring
 	ShardName string
@@ -87,3 +89,11 @@ func NewTempVectorForIDThunk(targetVector string, fn func(ctx context.Context, i
 	}
 	return t.TempVectorForID
 }
+
+func NewMultipleVectorForIDThunk(targetVector string, fn func(ctx context.Context, id uint64, targetVector string) ([][]float32, error)) common.MultipleVectorForID {
+	t := common.TargetMultipleVectorForID{
+		TargetVector:             targetVector,
+		MultipleVectorForIDThunk: fn,
+	}
+	return t.MultipleVectorForID
+}
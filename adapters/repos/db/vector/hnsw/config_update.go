@@ -56,6 +56,31 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "indexDimensions",
+			accessor: func(c ent.UserConfig) interface{} { return c.IndexDimensions },
+		},
+	}
+
+	// the quantization settings are only fixed once vectors were compressed
+	// with them, they can still be chosen when compression is turned on
+	if initialParsed.SQ.Enabled {
+		immutableFields = append(immutableFields,
+			immutableParameter{
+				name:     "sq.bits",
+				accessor: func(c ent.UserConfig) interface{} { return c.SQ.Bits },
+			},
+			immutableParameter{
+				name:     "sq.rotation",
+				accessor: func(c ent.UserConfig) interface{} { return c.SQ.Rotation },
+			},
+		)
+	}
+	if initialParsed.BQ.Enabled {
+		immutableFields = append(immutableFields, immutableParameter{
+			name:     "bq.rotation",
+			accessor: func(c ent.UserConfig) interface{} { return c.BQ.Rotation },
+		})
 	}
 
 	for _, u := range immutableFields {
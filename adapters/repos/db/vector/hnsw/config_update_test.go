@@ -63,6 +63,14 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to change index dimensions",
+				initial: ent.UserConfig{IndexDimensions: 0},
+				update:  ent.UserConfig{IndexDimensions: 256},
+				expectedError: errors.Errorf(
+					"indexDimensions is immutable: " +
+						"attempted change from \"0\" to \"256\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -95,6 +103,40 @@ func TestUserConfigUpdates(t *testing.T) {
 				},
 				expectedError: nil,
 			},
+			{
+				name: "changing sq bits before enabling sq",
+				initial: ent.UserConfig{
+					SQ: ent.SQConfig{Enabled: false, Bits: 8},
+				},
+				update: ent.UserConfig{
+					SQ: ent.SQConfig{Enabled: true, Bits: 4, Rotation: true},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "attempting to change sq bits",
+				initial: ent.UserConfig{
+					SQ: ent.SQConfig{Enabled: true, Bits: 8},
+				},
+				update: ent.UserConfig{
+					SQ: ent.SQConfig{Enabled: true, Bits: 4},
+				},
+				expectedError: errors.Errorf(
+					"sq.bits is immutable: " +
+						"attempted change from \"8\" to \"4\""),
+			},
+			{
+				name: "attempting to change bq rotation",
+				initial: ent.UserConfig{
+					BQ: ent.BQConfig{Enabled: true},
+				},
+				update: ent.UserConfig{
+					BQ: ent.BQConfig{Enabled: true, Rotation: true},
+				},
+				expectedError: errors.Errorf(
+					"bq.rotation is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
 			{
 				name: "setting bq compression on",
 				initial: ent.UserConfig{
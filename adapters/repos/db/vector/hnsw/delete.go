@@ -40,6 +40,13 @@ type breakCleanUpTombstonedNodesFunc func() bool
 // Delete attaches a tombstone to an item so it can be periodically cleaned up
 // later and the edges reassigned
 func (h *hnsw) Delete(ids ...uint64) error {
+	if h.Multivector() {
+		return h.deleteMulti(ids...)
+	}
+	return h.deleteNodes(ids...)
+}
+
+func (h *hnsw) deleteNodes(ids ...uint64) error {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -809,6 +816,9 @@ func (h *hnsw) removeTombstonesAndNodes(deleteList helpers.AllowList, breakClean
 		if err := h.commitLog.RemoveTombstone(id); err != nil {
 			return false, err
 		}
+		if h.Multivector() {
+			h.multivectors.removeNodes(id)
+		}
 	}
 
 	return true, nil
@@ -30,16 +30,17 @@ type Deserializer struct {
 }
 
 type DeserializationResult struct {
-	Nodes             []*vertex
-	NodesDeleted      map[uint64]struct{}
-	Entrypoint        uint64
-	Level             uint16
-	Tombstones        map[uint64]struct{}
-	TombstonesDeleted map[uint64]struct{}
-	EntrypointChanged bool
-	CompressionPQData *compressionhelpers.PQData
-	CompressionSQData *compressionhelpers.SQData
-	Compressed        bool
+	Nodes                   []*vertex
+	NodesDeleted            map[uint64]struct{}
+	Entrypoint              uint64
+	Level                   uint16
+	Tombstones              map[uint64]struct{}
+	TombstonesDeleted       map[uint64]struct{}
+	EntrypointChanged       bool
+	CompressionPQData       *compressionhelpers.PQData
+	CompressionSQData       *compressionhelpers.SQData
+	CompressionLowBitSQData *compressionhelpers.LowBitSQData
+	Compressed              bool
 
 	// If there is no entry for the links at a level to be replaced, we must
 	// assume that all links were appended and prior state must exist
@@ -150,6 +151,8 @@ func (d *Deserializer) Do(fd *bufio.Reader, initialState *DeserializationResult,
 		case AddSQ:
 			err = d.ReadSQ(fd, out)
 			readThisRound = 10
+		case AddLowBitSQ:
+			readThisRound, err = d.ReadLowBitSQ(fd, out)
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -622,6 +625,35 @@ func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) error {
 	return nil
 }
 
+func (d *Deserializer) ReadLowBitSQ(r io.Reader, res *DeserializationResult) (int, error) {
+	bits, err := d.readByte(r)
+	if err != nil {
+		return 0, err
+	}
+	dims, err := d.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+	data := compressionhelpers.LowBitSQData{
+		Bits:       bits,
+		Dimensions: dims,
+		Lower:      make([]float32, dims),
+		Steps:      make([]float32, dims),
+	}
+	for i := range data.Lower {
+		if data.Lower[i], err = d.readFloat32(r); err != nil {
+			return 0, err
+		}
+		if data.Steps[i], err = d.readFloat32(r); err != nil {
+			return 0, err
+		}
+	}
+	res.CompressionLowBitSQData = &data
+	res.Compressed = true
+
+	return 3 + 8*int(dims), nil
+}
+
 func (d *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	d.resetResusableBuffer(8)
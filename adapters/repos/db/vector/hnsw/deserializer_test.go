@@ -501,3 +501,41 @@ func TestDeserializerTotalReadPQ(t *testing.T) {
 		t.Logf("deserializeSize: %v\n", deserializeSize)
 	})
 }
+
+func TestDeserializerReadLowBitSQ(t *testing.T) {
+	rootPath := t.TempDir()
+	ctx := context.Background()
+
+	logger, _ := test.NewNullLogger()
+	commitLogger, err := NewCommitLogger(rootPath, "tmpLogger", logger,
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	sqData := compressionhelpers.LowBitSQData{
+		Bits:       4,
+		Dimensions: 3,
+		Lower:      []float32{-1, 0, 0.5},
+		Steps:      []float32{0.1, 0.2, 0.3},
+	}
+	require.Nil(t, commitLogger.AddLowBitSQCompression(sqData))
+	require.Nil(t, commitLogger.Flush())
+	require.Nil(t, commitLogger.Shutdown(ctx))
+
+	commitLoggerPath := rootPath + "/tmpLogger.hnsw.commitlog.d"
+	fileName, found, err := getCurrentCommitLogFileName(commitLoggerPath)
+	require.Nil(t, err)
+	require.True(t, found)
+
+	fd, err := os.Open(commitLoggerPath + "/" + fileName)
+	require.Nil(t, err)
+	defer fd.Close()
+
+	nullLogger, _ := test.NewNullLogger()
+	res, deserializeSize, err := NewDeserializer(nullLogger).Do(bufio.NewReader(fd), nil, true)
+	require.Nil(t, err)
+
+	assert.Equal(t, 1+3+8*3, deserializeSize)
+	assert.True(t, res.Compressed)
+	require.NotNil(t, res.CompressionLowBitSQData)
+	assert.Equal(t, sqData, *res.CompressionLowBitSQData)
+}
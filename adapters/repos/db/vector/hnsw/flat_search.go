@@ -32,6 +32,9 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 		limit = k
 	}
 
+	fullQueryVector := queryVector
+	queryVector = h.indexVector(queryVector)
+
 	h.RLock()
 	nodeSize := uint64(len(h.nodes))
 	h.RUnlock()
@@ -55,11 +58,14 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 		candidates = append(candidates, candidate)
 	}
 
+	explain := helpers.VectorSearchExplainFromContext(ctx)
 	eg := enterrors.NewErrorGroupWrapper(h.logger)
 	for workerID := 0; workerID < h.flatSearchConcurrency; workerID++ {
 		workerID := workerID
 		eg.Go(func() error {
 			localResults := priorityqueue.NewMax[any](limit)
+			distanceComputations := 0
+			defer func() { explain.AddDistanceComputations(distanceComputations) }()
 			var e storobj.ErrNotFound
 			for idPos := workerID; idPos < len(candidates); idPos += h.flatSearchConcurrency {
 				candidate := candidates[idPos]
@@ -81,6 +87,7 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 					continue
 				}
 
+				distanceComputations++
 				dist, err := h.distToNode(compressorDistancer, candidate, queryVector)
 				if errors.As(err, &e) {
 					h.handleDeletedNode(e.DocID, "flatSearch")
@@ -112,14 +119,12 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 
 	beforeRescore := time.Now()
 	if h.shouldRescore() {
-		compressorDistancer, fn := h.compressor.NewDistancer(queryVector)
-		if err := h.rescore(ctx, results, k, compressorDistancer); err != nil {
+		if err := h.rescore(ctx, results, k, h.rescoreDistancer(fullQueryVector, compressorDistancer)); err != nil {
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
 			took := time.Since(beforeRescore)
 			helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
 			return nil, nil, fmt.Errorf("flat search: %w", err)
 		}
-		fn()
 		took := time.Since(beforeRescore)
 		helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
 	}
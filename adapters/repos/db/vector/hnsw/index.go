@@ -104,7 +104,12 @@ type hnsw struct {
 	TempVectorForIDThunk common.TempVectorForID
 	multiVectorForID     common.MultiVectorForID
 	trackDimensionsOnce  sync.Once
-	dims                 int32
+
+	// only set for multivector indexes, see multivector.go
+	multivectors        *multivectorMapping
+	multipleVectorForID common.MultipleVectorForID
+
+	dims int32
 
 	cache               cache.Cache[float32]
 	waitForCachePrefill bool
@@ -159,7 +164,10 @@ type hnsw struct {
 
 	compressed   atomic.Bool
 	doNotRescore bool
-	acornSearch  atomic.Bool
+	// indexDimensions limits the index to a prefix of the vectors, 0 indexes
+	// the full vectors
+	indexDimensions int
+	acornSearch     atomic.Bool
 
 	compressor compressionhelpers.VectorCompressor
 	pqConfig   ent.PQConfig
@@ -202,6 +210,7 @@ type CommitLogger interface {
 	SwitchCommitLogs(bool) error
 	AddPQCompression(compressionhelpers.PQData) error
 	AddSQCompression(compressionhelpers.SQData) error
+	AddLowBitSQCompression(compressionhelpers.LowBitSQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -221,10 +230,31 @@ type MakeCommitLogger func() (CommitLogger, error)
 func New(cfg Config, uc ent.UserConfig,
 	tombstoneCallbacks cyclemanager.CycleCallbackGroup, store *lsmkv.Store,
 ) (*hnsw, error) {
+	var multivectors *multivectorMapping
+	if uc.Multivector.Enabled {
+		if cfg.MultipleVectorForIDThunk == nil {
+			return nil, errors.New("invalid config: multipleVectorForIDThunk cannot be nil for multivector index")
+		}
+		if store == nil {
+			return nil, errors.New("invalid config: store cannot be nil for multivector index")
+		}
+		// the graph operates on node ids, the vectors of which are resolved
+		// through the objects they belong to
+		multivectors = newMultivectorMapping()
+		cfg.VectorForIDThunk = multivectors.nodeVectorForID(cfg.MultipleVectorForIDThunk)
+		cfg.TempVectorForIDThunk = multivectors.nodeTempVectorForID(cfg.MultipleVectorForIDThunk)
+	}
+
 	if err := cfg.Validate(); err != nil {
 		return nil, errors.Wrap(err, "invalid config")
 	}
 
+	if uc.IndexDimensions > 0 {
+		// only the cached vectors are truncated, rescoring reads the full
+		// vectors through the temp vector thunk
+		cfg.VectorForIDThunk = truncatedVectorForID(cfg.VectorForIDThunk, uc.IndexDimensions)
+	}
+
 	if cfg.Logger == nil {
 		logger := logrus.New()
 		logger.Out = io.Discard
@@ -287,12 +317,16 @@ func New(cfg Config, uc ent.UserConfig,
 		pqConfig:             uc.PQ,
 		bqConfig:             uc.BQ,
 		sqConfig:             uc.SQ,
+		indexDimensions:      uc.IndexDimensions,
 		rescoreConcurrency:   2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:     common.NewDefaultShardedRWLocks(),
 
 		store:                  store,
 		allocChecker:           cfg.AllocChecker,
 		visitedListPoolMaxSize: cfg.VisitedListPoolMaxSize,
+
+		multivectors:        multivectors,
+		multipleVectorForID: cfg.MultipleVectorForIDThunk,
 	}
 	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
 
@@ -300,7 +334,7 @@ func New(cfg Config, uc ent.UserConfig,
 		var err error
 		index.compressor, err = compressionhelpers.NewBQCompressor(
 			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
-			cfg.AllocChecker)
+			cfg.AllocChecker, compressorOptions(uc.BQ.Rotation)...)
 		if err != nil {
 			return nil, err
 		}
@@ -313,6 +347,12 @@ func New(cfg Config, uc ent.UserConfig,
 		return nil, errors.Wrapf(err, "init index %q", index.id)
 	}
 
+	if index.Multivector() {
+		if err := index.initMultivector(); err != nil {
+			return nil, errors.Wrapf(err, "init multivector mapping of index %q", index.id)
+		}
+	}
+
 	// TODO common_cycle_manager move to poststartup?
 	id := strings.Join([]string{
 		"hnsw", "tombstone_cleanup",
@@ -650,6 +690,14 @@ func (h *hnsw) DistanceBetweenVectors(x, y []float32) (float32, error) {
 }
 
 func (h *hnsw) ContainsNode(id uint64) bool {
+	if h.Multivector() {
+		doc, ok := h.multivectors.doc(id)
+		if !ok || doc.count == 0 {
+			return false
+		}
+		id = doc.firstNodeID
+	}
+
 	h.RLock()
 	h.shardedNodeLocks.RLock(id)
 	exists := len(h.nodes) > int(id) && h.nodes[id] != nil
@@ -660,6 +708,11 @@ func (h *hnsw) ContainsNode(id uint64) bool {
 }
 
 func (h *hnsw) Iterate(fn func(id uint64) bool) {
+	if h.Multivector() {
+		h.iterateMulti(fn)
+		return
+	}
+
 	var id uint64
 
 	for {
@@ -719,6 +772,9 @@ func (h *hnsw) Upgraded() bool {
 }
 
 func (h *hnsw) AlreadyIndexed() uint64 {
+	if h.Multivector() {
+		return uint64(h.multivectors.docCount())
+	}
 	return uint64(h.cache.CountVectors())
 }
 
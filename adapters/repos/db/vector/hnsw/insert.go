@@ -26,13 +26,17 @@ import (
 func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 	dims := int(atomic.LoadInt32(&h.dims))
 
+	if err := h.validateIndexDimensions(vector); err != nil {
+		return err
+	}
+
 	// no vectors exist
 	if dims == 0 {
 		return nil
 	}
 
 	// check if vector length is the same as existing nodes
-	if dims != len(vector) {
+	if dims != h.indexedLength(len(vector)) {
 		return fmt.Errorf("new node has a vector with length %v. "+
 			"Existing nodes have vectors with length %v", len(vector), dims)
 	}
@@ -41,6 +45,18 @@ func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 }
 
 func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if h.Multivector() {
+		// a single vector is a multi vector made of just one vector
+		multiVectors := make([][][]float32, len(vectors))
+		for i := range vectors {
+			multiVectors[i] = [][]float32{vectors[i]}
+		}
+		return h.AddMultiBatch(ctx, ids, multiVectors)
+	}
+	return h.addBatch(ctx, ids, vectors)
+}
+
+func (h *hnsw) addBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -51,7 +67,7 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 		return errors.Errorf("insertBatch called with empty lists")
 	}
 	h.trackDimensionsOnce.Do(func() {
-		atomic.StoreInt32(&h.dims, int32(len(vectors[0])))
+		atomic.StoreInt32(&h.dims, int32(h.indexedLength(len(vectors[0]))))
 	})
 	levels := make([]int, len(ids))
 	maxId := uint64(0)
@@ -94,7 +110,10 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 
 		h.metrics.InsertVector()
 
-		vector = h.normalizeVec(vector)
+		if err := h.validateIndexDimensions(vector); err != nil {
+			return err
+		}
+		vector = h.indexVector(h.normalizeVec(vector))
 		err := h.addOne(ctx, vector, node)
 		if err != nil {
 			return err
@@ -0,0 +1,517 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// In a multivector index every object is represented by a list of vectors
+// (e.g. one per token for ColBERT style late interaction models). Each of
+// those vectors is inserted as a separate node into the graph, the nodes of
+// an object are allocated as a contiguous block of ids. The mapping between
+// doc ids and node ids is persisted in an lsmkv bucket, so the graph itself
+// is identical to a regular hnsw graph.
+//
+// The public methods of the index accept and return doc ids, the translation
+// to node ids happens here. Queries are passed as the concatenation of their
+// vectors, so they can travel through the regular search path. The candidates
+// found for every query vector are rescored using MaxSim late interaction.
+
+type multivectorDoc struct {
+	firstNodeID uint64
+	count       uint32
+}
+
+type multivectorNode struct {
+	docID uint64
+	pos   uint32
+}
+
+type multivectorMapping struct {
+	sync.RWMutex
+	bucket     *lsmkv.Bucket
+	nextNodeID uint64
+	docs       map[uint64]multivectorDoc
+	nodes      map[uint64]multivectorNode
+}
+
+func multivectorMappingBucketName(indexID string) string {
+	return fmt.Sprintf("%s_%s", helpers.MultivectorMappingBucketLSM, indexID)
+}
+
+func newMultivectorMapping() *multivectorMapping {
+	return &multivectorMapping{
+		docs:  map[uint64]multivectorDoc{},
+		nodes: map[uint64]multivectorNode{},
+	}
+}
+
+// load restores the mapping from the bucket. Node ids are never reused, so
+// allocations continue after the highest node id present either in the
+// mapping or in the graph.
+func (m *multivectorMapping) load(ctx context.Context, store *lsmkv.Store, indexID string, maxGraphNodeID uint64) error {
+	name := multivectorMappingBucketName(indexID)
+	if err := store.CreateOrLoadBucket(ctx, name, lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", name)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.bucket = store.Bucket(name)
+	m.nextNodeID = maxGraphNodeID
+
+	c := m.bucket.Cursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v) != 12 {
+			return errors.Errorf("invalid multivector mapping entry of length %d/%d", len(k), len(v))
+		}
+		docID := binary.BigEndian.Uint64(k)
+		doc := multivectorDoc{
+			firstNodeID: binary.BigEndian.Uint64(v[:8]),
+			count:       binary.BigEndian.Uint32(v[8:]),
+		}
+		m.docs[docID] = doc
+		m.mapNodesUnlocked(docID, doc)
+	}
+	return nil
+}
+
+// allocate reserves the node ids for the count vectors of docID. Only the
+// nodes are mapped to the doc, so their vectors can be resolved while they
+// are inserted. The doc keeps its previous mapping until commit is called.
+func (m *multivectorMapping) allocate(docID uint64, count int) multivectorDoc {
+	m.Lock()
+	defer m.Unlock()
+
+	doc := multivectorDoc{firstNodeID: m.nextNodeID, count: uint32(count)}
+	m.mapNodesUnlocked(docID, doc)
+	return doc
+}
+
+// commit persists the mapping of docID to the allocated nodes once their
+// vectors were added. If the doc was already mapped the previous node ids
+// are returned, so they can be deleted.
+func (m *multivectorMapping) commit(docID uint64, doc multivectorDoc) (previous []uint64, err error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.bucket != nil {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, docID)
+		value := make([]byte, 12)
+		binary.BigEndian.PutUint64(value[:8], doc.firstNodeID)
+		binary.BigEndian.PutUint32(value[8:], doc.count)
+		if err := m.bucket.Put(key, value); err != nil {
+			return nil, errors.Wrapf(err, "persist multivector mapping of doc id %d", docID)
+		}
+	}
+	if old, ok := m.docs[docID]; ok {
+		previous = old.nodeIDs()
+	}
+	m.docs[docID] = doc
+	return previous, nil
+}
+
+func (m *multivectorMapping) mapNodesUnlocked(docID uint64, doc multivectorDoc) {
+	last := doc.firstNodeID + uint64(doc.count)
+	if last > m.nextNodeID {
+		m.nextNodeID = last
+	}
+	for i := uint32(0); i < doc.count; i++ {
+		m.nodes[doc.firstNodeID+uint64(i)] = multivectorNode{docID: docID, pos: i}
+	}
+}
+
+// removeNodes drops the reverse mapping of nodes which are no longer part of
+// the graph
+func (m *multivectorMapping) removeNodes(nodeIDs ...uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, nodeID := range nodeIDs {
+		delete(m.nodes, nodeID)
+	}
+}
+
+// remove drops the mapping of the given doc ids and returns their node ids.
+// The reverse mapping of the nodes is kept, as they stay in the graph as
+// tombstones until they are cleaned up, see removeNodes.
+func (m *multivectorMapping) remove(docIDs ...uint64) ([]uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var nodeIDs []uint64
+	key := make([]byte, 8)
+	for _, docID := range docIDs {
+		doc, ok := m.docs[docID]
+		if !ok {
+			continue
+		}
+		if m.bucket != nil {
+			binary.BigEndian.PutUint64(key, docID)
+			if err := m.bucket.Delete(key); err != nil {
+				return nil, errors.Wrapf(err, "delete multivector mapping of doc id %d", docID)
+			}
+		}
+		delete(m.docs, docID)
+		nodeIDs = append(nodeIDs, doc.nodeIDs()...)
+	}
+	return nodeIDs, nil
+}
+
+func (m *multivectorMapping) node(nodeID uint64) (multivectorNode, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	node, ok := m.nodes[nodeID]
+	return node, ok
+}
+
+func (m *multivectorMapping) doc(docID uint64) (multivectorDoc, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	doc, ok := m.docs[docID]
+	return doc, ok
+}
+
+func (m *multivectorMapping) docCount() int {
+	m.RLock()
+	defer m.RUnlock()
+
+	return len(m.docs)
+}
+
+// sortedDocIDs returns a snapshot of the mapped doc ids
+func (m *multivectorMapping) sortedDocIDs() []uint64 {
+	m.RLock()
+	docIDs := make([]uint64, 0, len(m.docs))
+	for docID := range m.docs {
+		docIDs = append(docIDs, docID)
+	}
+	m.RUnlock()
+
+	sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+	return docIDs
+}
+
+// nodeAllowList translates an allow list of doc ids into the allow list of
+// their node ids
+func (m *multivectorMapping) nodeAllowList(allow helpers.AllowList) helpers.AllowList {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := helpers.NewAllowList()
+	it := allow.Iterator()
+	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		if doc, ok := m.docs[docID]; ok {
+			nodes.Insert(doc.nodeIDs()...)
+		}
+	}
+	return nodes
+}
+
+func (d multivectorDoc) nodeIDs() []uint64 {
+	ids := make([]uint64, d.count)
+	for i := range ids {
+		ids[i] = d.firstNodeID + uint64(i)
+	}
+	return ids
+}
+
+// nodeVectorForID resolves the vector of a node through the vectors of the
+// object it belongs to
+func (m *multivectorMapping) nodeVectorForID(thunk common.MultipleVectorForID) common.VectorForID[float32] {
+	return func(ctx context.Context, nodeID uint64) ([]float32, error) {
+		node, ok := m.node(nodeID)
+		if !ok {
+			return nil, storobj.NewErrNotFoundf(nodeID, "no multivector mapping for node")
+		}
+		vectors, err := thunk(ctx, node.docID)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				return nil, storobj.NewErrNotFoundf(nodeID, "no object for doc id %d", node.docID)
+			}
+			return nil, err
+		}
+		if int(node.pos) >= len(vectors) {
+			return nil, storobj.NewErrNotFoundf(nodeID,
+				"doc id %d has %d vectors, node points at %d", node.docID, len(vectors), node.pos)
+		}
+		return vectors[node.pos], nil
+	}
+}
+
+func (m *multivectorMapping) nodeTempVectorForID(thunk common.MultipleVectorForID) common.TempVectorForID {
+	vectorForID := m.nodeVectorForID(thunk)
+	return func(ctx context.Context, nodeID uint64, container *common.VectorSlice) ([]float32, error) {
+		vector, err := vectorForID(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		if cap(container.Slice) < len(vector) {
+			container.Slice = make([]float32, len(vector))
+		}
+		container.Slice = container.Slice[:len(vector)]
+		copy(container.Slice, vector)
+		return container.Slice, nil
+	}
+}
+
+func (h *hnsw) Multivector() bool {
+	return h.multivectors != nil
+}
+
+func (h *hnsw) initMultivector() error {
+	h.RLock()
+	maxNodeID := uint64(0)
+	for i := len(h.nodes) - 1; i >= 0; i-- {
+		if h.nodes[i] != nil {
+			maxNodeID = uint64(i) + 1
+			break
+		}
+	}
+	h.RUnlock()
+
+	return h.multivectors.load(context.Background(), h.store, h.id, maxNodeID)
+}
+
+func (h *hnsw) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	if !h.Multivector() {
+		return errors.Errorf("multivector is not enabled for this index")
+	}
+	if len(vectors) == 0 {
+		return errors.Errorf("multi vector must contain at least one vector")
+	}
+
+	dims := int(atomic.LoadInt32(&h.dims))
+	if dims == 0 {
+		dims = len(vectors[0])
+	}
+	for _, vector := range vectors {
+		if len(vector) != dims {
+			return fmt.Errorf("new node has a multi vector with vectors of length %v. "+
+				"Existing nodes have vectors with length %v", len(vector), dims)
+		}
+	}
+	return nil
+}
+
+func (h *hnsw) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return h.AddMultiBatch(ctx, []uint64{docID}, [][][]float32{vectors})
+}
+
+func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	if !h.Multivector() {
+		return errors.Errorf("multivector is not enabled for this index")
+	}
+	if len(docIDs) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+
+	for i, docID := range docIDs {
+		if len(vectors[i]) == 0 {
+			return errors.Errorf("insert called with empty multi vector")
+		}
+
+		doc := h.multivectors.allocate(docID, len(vectors[i]))
+		if err := h.addBatch(ctx, doc.nodeIDs(), vectors[i]); err != nil {
+			h.releaseMultiNodes(doc.nodeIDs())
+			return errors.Wrapf(err, "insert multi vector of doc id %d", docID)
+		}
+		previous, err := h.multivectors.commit(docID, doc)
+		if err != nil {
+			h.releaseMultiNodes(doc.nodeIDs())
+			return err
+		}
+		if len(previous) > 0 {
+			if err := h.deleteNodes(previous...); err != nil {
+				return errors.Wrapf(err, "delete previous vectors of doc id %d", docID)
+			}
+		}
+	}
+	return nil
+}
+
+// releaseMultiNodes discards the nodes of a multi vector which could not be
+// added. Nodes which were already inserted into the graph are tombstoned,
+// their reverse mapping is dropped once they are cleaned up.
+func (h *hnsw) releaseMultiNodes(nodeIDs []uint64) {
+	var inserted, missing []uint64
+	for _, nodeID := range nodeIDs {
+		if h.nodeByID(nodeID) != nil {
+			inserted = append(inserted, nodeID)
+		} else {
+			missing = append(missing, nodeID)
+		}
+	}
+	h.multivectors.removeNodes(missing...)
+	if len(inserted) > 0 {
+		if err := h.deleteNodes(inserted...); err != nil {
+			h.logger.WithError(err).Warn("failed to delete vectors of failed multi vector insert")
+		}
+	}
+}
+
+func (h *hnsw) iterateMulti(fn func(id uint64) bool) {
+	for _, docID := range h.multivectors.sortedDocIDs() {
+		if h.shutdownCtx.Err() != nil || h.resetCtx.Err() != nil {
+			return
+		}
+		if h.ContainsNode(docID) && !fn(docID) {
+			return
+		}
+	}
+}
+
+func (h *hnsw) deleteMulti(docIDs ...uint64) error {
+	nodeIDs, err := h.multivectors.remove(docIDs...)
+	if err != nil {
+		return err
+	}
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+	return h.deleteNodes(nodeIDs...)
+}
+
+// searchByMultiVector searches the nearest nodes of every query vector and
+// ranks the objects they belong to by their MaxSim distance: the sum of the
+// distances of every query vector to its closest vector of the object.
+func (h *hnsw) searchByMultiVector(ctx context.Context, vector []float32,
+	k int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	dims := int(atomic.LoadInt32(&h.dims))
+	if dims == 0 || k <= 0 {
+		return nil, nil, nil
+	}
+	if len(vector) == 0 || len(vector)%dims != 0 {
+		return nil, nil, errors.Errorf("multi vector query of length %d doesn't match "+
+			"vectors of length %d", len(vector), dims)
+	}
+
+	queries := make([][]float32, len(vector)/dims)
+	for i := range queries {
+		queries[i] = h.normalizeVec(vector[i*dims : (i+1)*dims])
+	}
+
+	var nodeAllowList helpers.AllowList
+	if allowList != nil {
+		nodeAllowList = h.multivectors.nodeAllowList(allowList)
+	}
+
+	// every object is represented by several nodes, so the nearest k nodes of
+	// a query vector may belong to far fewer than k objects. The candidate
+	// pool per query vector is therefore sized by ef rather than k.
+	nodesPerQuery := h.searchTimeEF(k)
+	candidates := map[uint64]struct{}{}
+	for _, query := range queries {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		nodeIDs, _, err := h.searchByVector(ctx, query, nodesPerQuery, nodeAllowList)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, nodeID := range nodeIDs {
+			if node, ok := h.multivectors.node(nodeID); ok {
+				candidates[node.docID] = struct{}{}
+			}
+		}
+	}
+
+	docIDs := make([]uint64, 0, len(candidates))
+	dists := make([]float32, 0, len(candidates))
+	for docID := range candidates {
+		if _, ok := h.multivectors.doc(docID); !ok {
+			// deleted while searching
+			continue
+		}
+		vectors, err := h.multipleVectorForID(ctx, docID)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return nil, nil, errors.Wrapf(err, "get multi vector of doc id %d", docID)
+		}
+		dist, err := h.maxSimDistance(queries, vectors)
+		if err != nil {
+			return nil, nil, err
+		}
+		docIDs = append(docIDs, docID)
+		dists = append(dists, dist)
+	}
+
+	sort.Sort(byDistance{ids: docIDs, dists: dists})
+	if len(docIDs) > k {
+		docIDs, dists = docIDs[:k], dists[:k]
+	}
+	return docIDs, dists, nil
+}
+
+func (h *hnsw) maxSimDistance(queries, vectors [][]float32) (float32, error) {
+	normalized := make([][]float32, len(vectors))
+	for i := range vectors {
+		normalized[i] = h.normalizeVec(vectors[i])
+	}
+
+	var sum float32
+	for _, query := range queries {
+		var best float32
+		for i, vector := range normalized {
+			dist, err := h.distancerProvider.SingleDist(query, vector)
+			if err != nil {
+				return 0, errors.Wrap(err, "calculate distance")
+			}
+			if i == 0 || dist < best {
+				best = dist
+			}
+		}
+		sum += best
+	}
+	return sum, nil
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (b byDistance) Len() int { return len(b.ids) }
+
+func (b byDistance) Less(i, j int) bool {
+	if b.dists[i] == b.dists[j] {
+		return b.ids[i] < b.ids[j]
+	}
+	return b.dists[i] < b.dists[j]
+}
+
+func (b byDistance) Swap(i, j int) {
+	b.ids[i], b.ids[j] = b.ids[j], b.ids[i]
+	b.dists[i], b.dists[j] = b.dists[j], b.dists[i]
+}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func newMultivectorTestIndex(t *testing.T, docs map[uint64][][]float32) *hnsw {
+	uc := ent.NewDefaultUserConfig()
+	uc.Multivector = ent.MultivectorConfig{Enabled: true, Aggregation: ent.MultivectorAggregationMaxSim}
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "multivector-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		MultipleVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+			vectors, ok := docs[id]
+			if !ok {
+				return nil, storobj.NewErrNotFoundf(id, "not found")
+			}
+			return vectors, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	t.Cleanup(func() { index.Shutdown(context.Background()) })
+	return index
+}
+
+func concatVectors(vectors [][]float32) []float32 {
+	var out []float32
+	for _, vector := range vectors {
+		out = append(out, vector...)
+	}
+	return out
+}
+
+func TestMultivector(t *testing.T) {
+	ctx := context.Background()
+	docs := map[uint64][][]float32{
+		0: {{1, 0, 0}, {0, 1, 0}},
+		1: {{0, 0, 1}},
+		2: {{1, 1, 0}, {0, 1, 1}, {1, 0, 1}},
+		3: {{5, 5, 5}, {6, 6, 6}},
+	}
+
+	index := newMultivectorTestIndex(t, docs)
+	require.True(t, index.Multivector())
+
+	for _, docID := range []uint64{0, 1, 2, 3} {
+		require.Nil(t, index.ValidateMultiBeforeInsert(docs[docID]))
+		require.Nil(t, index.AddMulti(ctx, docID, docs[docID]))
+	}
+	assert.Equal(t, uint64(4), index.AlreadyIndexed())
+	assert.True(t, index.ContainsNode(2))
+	assert.False(t, index.ContainsNode(7))
+
+	t.Run("validate dimensions", func(t *testing.T) {
+		assert.NotNil(t, index.ValidateMultiBeforeInsert([][]float32{{1, 2}}))
+		assert.NotNil(t, index.ValidateMultiBeforeInsert(nil))
+	})
+
+	t.Run("search ranks by MaxSim", func(t *testing.T) {
+		query := [][]float32{{1, 0, 0}, {0, 1, 0}}
+		ids, dists, err := index.SearchByVector(ctx, concatVectors(query), 4, nil)
+		require.Nil(t, err)
+		// doc 0 matches both query vectors exactly, doc 2 is the closest
+		// remaining doc: 1 + 1, doc 1: 2 + 2 and doc 3 is far away
+		assert.Equal(t, []uint64{0, 2, 1, 3}, ids)
+		assert.Equal(t, float32(0), dists[0])
+		assert.Equal(t, float32(2), dists[1])
+		assert.Equal(t, float32(4), dists[2])
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, []float32{1, 0, 0}, 4, helpers.NewAllowList(1, 3))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 3}, ids)
+	})
+
+	t.Run("invalid query length", func(t *testing.T) {
+		_, _, err := index.SearchByVector(ctx, []float32{1, 0}, 4, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+		delete(docs, 0)
+
+		ids, _, err := index.SearchByVector(ctx, []float32{1, 0, 0, 0, 1, 0}, 4, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1, 3}, ids)
+		assert.False(t, index.ContainsNode(0))
+		assert.Equal(t, uint64(3), index.AlreadyIndexed())
+	})
+
+	t.Run("update replaces previous vectors", func(t *testing.T) {
+		docs[1] = [][]float32{{1, 0, 0}, {0, 1, 0}}
+		require.Nil(t, index.AddMulti(ctx, 1, docs[1]))
+
+		ids, dists, err := index.SearchByVector(ctx, []float32{1, 0, 0, 0, 1, 0}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+		assert.Equal(t, float32(0), dists[0])
+
+		var iterated []uint64
+		index.Iterate(func(id uint64) bool {
+			iterated = append(iterated, id)
+			return true
+		})
+		assert.Equal(t, []uint64{1, 2, 3}, iterated)
+	})
+
+	t.Run("failed update keeps previous vectors", func(t *testing.T) {
+		before, ok := index.multivectors.doc(1)
+		require.True(t, ok)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.NotNil(t, index.AddMulti(canceled, 1, [][]float32{{1, 1, 1}}))
+
+		after, ok := index.multivectors.doc(1)
+		require.True(t, ok)
+		assert.Equal(t, before, after)
+		ids, _, err := index.SearchByVector(ctx, []float32{1, 0, 0, 0, 1, 0}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+	})
+
+	t.Run("tombstone cleanup prunes the reverse mapping", func(t *testing.T) {
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+
+		var nodeIDs []uint64
+		for _, docID := range index.multivectors.sortedDocIDs() {
+			doc, ok := index.multivectors.doc(docID)
+			require.True(t, ok)
+			nodeIDs = append(nodeIDs, doc.nodeIDs()...)
+		}
+		mapped := make([]uint64, 0, len(index.multivectors.nodes))
+		for nodeID := range index.multivectors.nodes {
+			mapped = append(mapped, nodeID)
+		}
+		assert.ElementsMatch(t, nodeIDs, mapped)
+	})
+}
+
+func TestMultivectorRequiresThunk(t *testing.T) {
+	uc := ent.NewDefaultUserConfig()
+	uc.Multivector = ent.MultivectorConfig{Enabled: true, Aggregation: ent.MultivectorAggregationMaxSim}
+
+	_, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "multivector-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	assert.NotNil(t, err)
+}
+
+func TestMultivectorMappingPersistence(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	newStore := func() *lsmkv.Store {
+		store, err := lsmkv.New(dir, dir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		return store
+	}
+
+	store := newStore()
+	mapping := newMultivectorMapping()
+	require.Nil(t, mapping.load(ctx, store, "main", 0))
+
+	doc := mapping.allocate(10, 3)
+	assert.Equal(t, []uint64{0, 1, 2}, doc.nodeIDs())
+	previous, err := mapping.commit(10, doc)
+	require.Nil(t, err)
+	assert.Empty(t, previous)
+
+	_, err = mapping.commit(11, mapping.allocate(11, 2))
+	require.Nil(t, err)
+
+	// the previous mapping is kept until the new one is committed
+	doc = mapping.allocate(10, 1)
+	assert.Equal(t, []uint64{5}, doc.nodeIDs())
+	current, ok := mapping.doc(10)
+	require.True(t, ok)
+	assert.Equal(t, []uint64{0, 1, 2}, current.nodeIDs())
+	previous, err = mapping.commit(10, doc)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0, 1, 2}, previous)
+
+	// allocations which are never committed are not persisted
+	mapping.allocate(12, 2)
+
+	removed, err := mapping.remove(11)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{3, 4}, removed)
+	require.Nil(t, store.Shutdown(ctx))
+
+	store = newStore()
+	defer store.Shutdown(ctx)
+	restored := newMultivectorMapping()
+	require.Nil(t, restored.load(ctx, store, "main", 0))
+
+	assert.Equal(t, 1, restored.docCount())
+	doc, ok = restored.doc(10)
+	require.True(t, ok)
+	assert.Equal(t, []uint64{5}, doc.nodeIDs())
+	node, ok := restored.node(5)
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), node.docID)
+	_, ok = restored.doc(11)
+	assert.False(t, ok)
+	_, ok = restored.doc(12)
+	assert.False(t, ok)
+
+	// node ids are never reused
+	assert.Equal(t, []uint64{6}, restored.allocate(13, 1).nodeIDs())
+}
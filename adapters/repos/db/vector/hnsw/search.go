@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -69,14 +70,23 @@ func (h *hnsw) autoEfFromK(k int) int {
 
 func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 	k int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if h.Multivector() {
+		return h.searchByMultiVector(ctx, vector, k, allowList)
+	}
+	return h.searchByVector(ctx, vector, k, allowList)
+}
+
+func (h *hnsw) searchByVector(ctx context.Context, vector []float32,
+	k int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
 	vector = h.normalizeVec(vector)
-	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+	if h.useFlatSearch(ctx, allowList) {
 		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		helpers.VectorSearchExplainFromContext(ctx).AddFilterStrategy(ent.FilterStrategyFlat)
 		return h.flatSearch(ctx, vector, k, h.searchTimeEF(k), allowList)
 	}
 	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
@@ -164,7 +174,7 @@ func (h *hnsw) SearchByVectorDistance(ctx context.Context, vector []float32,
 }
 
 func (h *hnsw) shouldRescore() bool {
-	return h.compressed.Load() && !h.doNotRescore
+	return (h.compressed.Load() || h.truncated()) && !h.doNotRescore
 }
 
 func (h *hnsw) cacheSize() int64 {
@@ -177,14 +187,42 @@ func (h *hnsw) cacheSize() int64 {
 	return size
 }
 
-func (h *hnsw) acornParams(allowList helpers.AllowList) (bool, int) {
+// useFlatSearch decides whether a filtered search is done as a brute force
+// search over the allow list instead of traversing the graph. A filter
+// strategy set for the query takes precedence over the flatSearchCutoff.
+func (h *hnsw) useFlatSearch(ctx context.Context, allowList helpers.AllowList) bool {
+	if allowList == nil {
+		return false
+	}
+
+	switch helpers.FilterStrategyFromContext(ctx) {
+	case ent.FilterStrategyFlat:
+		return true
+	case ent.FilterStrategySweeping, ent.FilterStrategyAcorn:
+		return false
+	}
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	return !h.forbidFlat && allowList.Len() < flatSearchCutoff
+}
+
+func (h *hnsw) acornParams(ctx context.Context, allowList helpers.AllowList) (bool, int) {
 	useAcorn := h.acornSearch.Load()
+	override := helpers.FilterStrategyFromContext(ctx)
+	switch override {
+	case ent.FilterStrategyAcorn:
+		useAcorn = true
+	case ent.FilterStrategySweeping:
+		useAcorn = false
+	}
 	var M int
 
 	if allowList != nil && useAcorn {
 		cacheSize := h.cacheSize()
 		allowListSize := allowList.Len()
-		if cacheSize != 0 && float32(allowListSize)/float32(cacheSize) > defaultAcornMaxFilterPercentage {
+		// an explicit override skips the heuristic
+		if override == "" && cacheSize != 0 &&
+			float32(allowListSize)/float32(cacheSize) > defaultAcornMaxFilterPercentage {
 			useAcorn = false
 		}
 		M = int(cacheSize / int64(max(1, allowListSize)))
@@ -199,16 +237,20 @@ func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context,
 	allowList helpers.AllowList, compressorDistancer compressionhelpers.CompressorDistancer) (*priorityqueue.Queue[any], error,
 ) {
 	start := time.Now()
+	var nodesVisited, distanceComputations int
 	defer func() {
 		took := time.Since(start)
 		helpers.AnnotateSlowQueryLog(ctx, fmt.Sprintf("knn_search_layer_%d_took", level), took)
+		explain := helpers.VectorSearchExplainFromContext(ctx)
+		explain.AddNodesVisited(nodesVisited)
+		explain.AddDistanceComputations(distanceComputations)
 	}()
 	h.pools.visitedListsLock.RLock()
 	visited := h.pools.visitedLists.Borrow()
 	visitedExp := h.pools.visitedLists.Borrow()
 	h.pools.visitedListsLock.RUnlock()
 
-	useAcorn, M := h.acornParams(allowList)
+	useAcorn, M := h.acornParams(ctx, allowList)
 
 	candidates := h.pools.pqCandidates.GetMin(ef)
 	results := h.pools.pqResults.GetMax(ef)
@@ -276,6 +318,7 @@ func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context,
 			// just cleaned up while we were waiting for a read lock
 			continue
 		}
+		nodesVisited++
 
 		candidateNode.Lock()
 		if candidateNode.level < level {
@@ -390,6 +433,7 @@ func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context,
 
 			// make sure we never visit this neighbor again
 			visited.Visit(neighborID)
+			distanceComputations++
 			var distance float32
 			var err error
 			if h.compressed.Load() {
@@ -692,10 +736,19 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 		return nil, nil, nil
 	}
 
-	useAcorn, _ := h.acornParams(allowList)
+	queryVec := searchVec
+	searchVec = h.indexVector(searchVec)
 
-	if allowList != nil && useAcorn {
-		allowList = NewFastSet(allowList)
+	useAcorn, _ := h.acornParams(ctx, allowList)
+
+	explain := helpers.VectorSearchExplainFromContext(ctx)
+	if allowList != nil {
+		if useAcorn {
+			explain.AddFilterStrategy(ent.FilterStrategyAcorn)
+			allowList = NewFastSet(allowList)
+		} else {
+			explain.AddFilterStrategy(ent.FilterStrategySweeping)
+		}
 	}
 
 	if k < 0 {
@@ -788,6 +841,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 			entryPointDistance, _ := h.distToNode(compressorDistancer, entryPoint, searchVec)
 			eps.Insert(entryPoint, entryPointDistance)
 		}
+		explain.AddDistanceComputations(len(seeds))
 	}
 	res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, ef, 0, allowList, compressorDistancer)
 	if err != nil {
@@ -796,7 +850,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 
 	beforeRescore := time.Now()
 	if h.shouldRescore() {
-		if err := h.rescore(ctx, res, k, compressorDistancer); err != nil {
+		if err := h.rescore(ctx, res, k, h.rescoreDistancer(queryVec, compressorDistancer)); err != nil {
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "knn_search_rescore")
 			took := time.Since(beforeRescore)
 			helpers.AnnotateSlowQueryLog(ctx, "knn_search_rescore_took", took)
@@ -828,7 +882,18 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 
 func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
 	queryVector = h.normalizeVec(queryVector)
-	if h.compressed.Load() {
+	if h.truncated() {
+		// distances must not depend on the truncation, so they are computed
+		// with the full vectors
+		dist := h.rescoreDistancer(queryVector, nil)
+		f := func(nodeID uint64) (float32, error) {
+			if int(nodeID) > len(h.nodes) {
+				return -1, fmt.Errorf("node %v is larger than the cache size %v", nodeID, len(h.nodes))
+			}
+			return h.distanceFromBytesToFloatNode(dist, nodeID)
+		}
+		return common.QueryVectorDistancer{DistanceFunc: f}
+	} else if h.compressed.Load() {
 		dist, returnFn := h.compressor.NewDistancer(queryVector)
 		f := func(nodeID uint64) (float32, error) {
 			if int(nodeID) > len(h.nodes) {
@@ -865,6 +930,7 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 		i--
 	}
 	res.Reset()
+	helpers.VectorSearchExplainFromContext(ctx).AddRescoredCandidates(len(ids))
 
 	mu := sync.Mutex{} // protect res
 	addID := func(id uint64, dist float32) {
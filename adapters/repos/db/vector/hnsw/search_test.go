@@ -171,24 +171,116 @@ func TestAcornPercentage(t *testing.T) {
 	t.Run("check acorn params on different filter percentags", func(t *testing.T) {
 		vectorIndex.acornSearch.Store(false)
 		allowList := helpers.NewAllowList(1, 2, 3)
-		useAcorn, M := vectorIndex.acornParams(allowList)
+		useAcorn, M := vectorIndex.acornParams(context.Background(), allowList)
 		assert.False(t, useAcorn)
 		assert.Equal(t, 0, M)
 
 		vectorIndex.acornSearch.Store(true)
 
-		useAcorn, M = vectorIndex.acornParams(allowList)
+		useAcorn, M = vectorIndex.acornParams(context.Background(), allowList)
 		assert.True(t, useAcorn)
 		assert.Equal(t, 3, M)
 
 		vectorIndex.acornSearch.Store(true)
 
 		largerAllowList := helpers.NewAllowList(1, 2, 3, 4, 5)
-		useAcorn, M = vectorIndex.acornParams(largerAllowList)
+		useAcorn, M = vectorIndex.acornParams(context.Background(), largerAllowList)
 		// should be false as allow list percentage is 50%
 		assert.False(t, useAcorn)
 		assert.Equal(t, 2, M)
 	})
+
+	t.Run("check acorn params with a filter strategy override", func(t *testing.T) {
+		largerAllowList := helpers.NewAllowList(1, 2, 3, 4, 5)
+
+		vectorIndex.acornSearch.Store(false)
+		ctx := helpers.WithFilterStrategy(context.Background(), ent.FilterStrategyAcorn)
+		useAcorn, M := vectorIndex.acornParams(ctx, largerAllowList)
+		// the percentage heuristic does not apply to explicit overrides
+		assert.True(t, useAcorn)
+		assert.Equal(t, 2, M)
+
+		vectorIndex.acornSearch.Store(true)
+		ctx = helpers.WithFilterStrategy(context.Background(), ent.FilterStrategySweeping)
+		useAcorn, _ = vectorIndex.acornParams(ctx, helpers.NewAllowList(1, 2, 3))
+		assert.False(t, useAcorn)
+	})
+}
+
+func TestFilterStrategyOverrideAndExplain(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(1000, 1, 16)
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(context.Background())
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filter-strategy-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10000,
+		FlatSearchCutoff:      ent.DefaultFlatSearchCutoff,
+	}, cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(context.TODO(), uint64(i), vec))
+	}
+
+	ids := make([]uint64, 0, 300)
+	for i := uint64(0); i < 1000; i += 3 {
+		ids = append(ids, i)
+	}
+	allowList := helpers.NewAllowList(ids...)
+
+	search := func(t *testing.T, strategy string, allow helpers.AllowList) ([]uint64, *helpers.VectorSearchExplain) {
+		ctx, explain := helpers.InitVectorSearchExplain(context.Background())
+		ctx = helpers.WithFilterStrategy(ctx, strategy)
+		res, _, err := index.SearchByVector(ctx, queries[0], 10, allow)
+		require.Nil(t, err)
+		require.Len(t, res, 10)
+		if allow != nil {
+			for _, id := range res {
+				assert.True(t, allow.Contains(id))
+			}
+		}
+		return res, explain
+	}
+
+	t.Run("without override the allow list is below the flat search cutoff", func(t *testing.T) {
+		_, explain := search(t, "", allowList)
+		res := explain.Result("shard")
+		assert.Equal(t, ent.FilterStrategyFlat, res.FilterStrategy)
+		assert.Equal(t, int64(len(ids)), res.DistanceComputations)
+		assert.Zero(t, res.NodesVisited)
+	})
+
+	for _, strategy := range []string{ent.FilterStrategySweeping, ent.FilterStrategyAcorn} {
+		t.Run(strategy, func(t *testing.T) {
+			_, explain := search(t, strategy, allowList)
+			res := explain.Result("shard")
+			assert.Equal(t, strategy, res.FilterStrategy)
+			assert.Equal(t, "shard", res.Shard)
+			assert.Positive(t, res.NodesVisited)
+			assert.Positive(t, res.DistanceComputations)
+			assert.Zero(t, res.RescoredCandidates)
+		})
+	}
+
+	t.Run("unfiltered", func(t *testing.T) {
+		_, explain := search(t, ent.FilterStrategyFlat, nil)
+		res := explain.Result("shard")
+		// overrides only apply to filtered searches
+		assert.Empty(t, res.FilterStrategy)
+		assert.Equal(t, -1, res.AllowListSize)
+		assert.Positive(t, res.NodesVisited)
+	})
 }
 
 func TestRescore(t *testing.T) {
@@ -169,6 +169,22 @@ func (h *hnsw) restoreFromDisk() error {
 				data.Dimensions,
 				h.store,
 				h.allocChecker,
+				compressorOptions(h.sqConfig.Rotation)...,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring compressed data.")
+			}
+		} else if state.CompressionLowBitSQData != nil {
+			data := state.CompressionLowBitSQData
+			h.dims = int32(data.Dimensions)
+			h.compressor, err = compressionhelpers.RestoreHNSWLowBitSQCompressor(
+				h.distancerProvider,
+				1e12,
+				h.logger,
+				*data,
+				h.store,
+				h.allocChecker,
+				compressorOptions(h.sqConfig.Rotation)...,
 			)
 			if err != nil {
 				return errors.Wrap(err, "Restoring compressed data.")
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// An index with indexDimensions set only holds the first n dimensions of
+// every vector in its cache, graph and compressed vectors. The full vectors
+// remain in the object store and are used to rescore the candidates of every
+// search, the same way compressed vectors are rescored.
+
+func (h *hnsw) truncated() bool {
+	return h.indexDimensions > 0
+}
+
+// indexedLength is the length of the indexed part of a vector of the given
+// length
+func (h *hnsw) indexedLength(length int) int {
+	if h.truncated() && length > h.indexDimensions {
+		return h.indexDimensions
+	}
+	return length
+}
+
+// indexVector returns the indexed part of a normalized vector. As the prefix
+// of a normalized vector is not normalized itself, it is normalized again.
+func (h *hnsw) indexVector(vec []float32) []float32 {
+	if h.indexedLength(len(vec)) == len(vec) {
+		return vec
+	}
+	return h.normalizeVec(truncateVector(vec, h.indexDimensions))
+}
+
+// truncateVector copies the prefix, so the full vector is not kept alive by
+// the cache
+func truncateVector(vec []float32, dims int) []float32 {
+	out := make([]float32, dims)
+	copy(out, vec)
+	return out
+}
+
+func truncatedVectorForID(thunk common.VectorForID[float32], dims int) common.VectorForID[float32] {
+	return func(ctx context.Context, id uint64) ([]float32, error) {
+		vec, err := thunk(ctx, id)
+		if err != nil || len(vec) <= dims {
+			return vec, err
+		}
+		return truncateVector(vec, dims), nil
+	}
+}
+
+func (h *hnsw) validateIndexDimensions(vector []float32) error {
+	if h.truncated() && len(vector) < h.indexDimensions {
+		return fmt.Errorf("new node has a vector with length %v, "+
+			"but the index is configured to index the first %v dimensions",
+			len(vector), h.indexDimensions)
+	}
+	return nil
+}
+
+// rescoreDistancer returns the distancer candidates are rescored with.
+// Truncated indexes rescore against the full query vector, compressed ones
+// against the query vector the search was run with.
+func (h *hnsw) rescoreDistancer(queryVector []float32,
+	compressorDistancer compressionhelpers.CompressorDistancer,
+) compressionhelpers.CompressorDistancer {
+	if h.truncated() {
+		return &fullVectorDistancer{distancer: h.distancerProvider.New(queryVector)}
+	}
+	return compressorDistancer
+}
+
+// fullVectorDistancer computes distances between the full query vector and
+// the full vectors loaded from the object store
+type fullVectorDistancer struct {
+	distancer distancer.Distancer
+}
+
+func (d *fullVectorDistancer) DistanceToNode(id uint64) (float32, error) {
+	return 0, errors.Errorf("distance to node %d: full vectors are not cached", id)
+}
+
+func (d *fullVectorDistancer) DistanceToFloat(vec []float32) (float32, error) {
+	return d.distancer.Distance(vec)
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// matryoshkaVecs creates vectors with most of their information in the first
+// dimensions, similar to embeddings trained for truncation
+func matryoshkaVecs(size, dimensions int) [][]float32 {
+	r := rand.New(rand.NewSource(7))
+	vecs := make([][]float32, size)
+	for i := range vecs {
+		vecs[i] = make([]float32, dimensions)
+		for j := range vecs[i] {
+			vecs[i][j] = (r.Float32()*2 - 1) / (1 + float32(j)/4)
+		}
+	}
+	return vecs
+}
+
+func TestTruncatedIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dimensions := 128
+	indexDimensions := 32
+	k := 10
+
+	data := matryoshkaVecs(2050, dimensions)
+	testinghelpers.Normalize(data)
+	vectors, queries := data[:2000], data[2000:]
+
+	for _, provider := range []distancer.Provider{
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewL2SquaredProvider(),
+	} {
+		t.Run(provider.Type(), func(t *testing.T) {
+			uc := ent.NewDefaultUserConfig()
+			uc.EF = 64
+			uc.IndexDimensions = indexDimensions
+			index, err := New(Config{
+				RootPath:              t.TempDir(),
+				ID:                    "truncated",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				ClassName:             "Truncated",
+				ShardName:             "shard",
+				DistanceProvider:      provider,
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					if int(id) >= len(vectors) {
+						return nil, storobj.NewErrNotFoundf(id, "out of range")
+					}
+					return vectors[int(id)], nil
+				},
+				TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+					if cap(container.Slice) < dimensions {
+						container.Slice = make([]float32, dimensions)
+					}
+					container.Slice = container.Slice[:dimensions]
+					copy(container.Slice, vectors[int(id)])
+					return container.Slice, nil
+				},
+			}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+
+			compressionhelpers.Concurrently(logger, uint64(len(vectors)), func(id uint64) {
+				require.Nil(t, index.Add(ctx, id, vectors[id]))
+			})
+
+			t.Run("only the prefix is cached", func(t *testing.T) {
+				assert.Equal(t, int32(indexDimensions), index.dims)
+				vec, err := index.cache.Get(ctx, 0)
+				require.Nil(t, err)
+				assert.Len(t, vec, indexDimensions)
+			})
+
+			t.Run("vectors shorter than the index dimensions are rejected", func(t *testing.T) {
+				assert.NotNil(t, index.ValidateBeforeInsert(make([]float32, indexDimensions-1)))
+				assert.Nil(t, index.ValidateBeforeInsert(make([]float32, dimensions)))
+			})
+
+			allowList := helpers.NewAllowList()
+			allowList.Insert(makeRange(0, 100)...)
+			for _, tc := range []struct {
+				name       string
+				allowList  helpers.AllowList
+				candidates [][]float32
+			}{
+				{name: "graph search", candidates: vectors},
+				{name: "flat search", allowList: allowList, candidates: vectors[:100]},
+			} {
+				t.Run(tc.name+" is rescored with the full vectors", func(t *testing.T) {
+					var relevant uint64
+					for _, q := range queries {
+						truth, _ := testinghelpers.BruteForce(logger, tc.candidates, q, k, distanceWrapper(provider))
+						ids, dists, err := index.SearchByVector(ctx, q, k, tc.allowList)
+						require.Nil(t, err)
+						require.Len(t, ids, k)
+						relevant += testinghelpers.MatchesInLists(truth, ids)
+
+						// the returned distances are the ones of the full vectors
+						for i, id := range ids {
+							expected, _ := provider.SingleDist(index.normalizeVec(q), index.normalizeVec(vectors[id]))
+							assert.InDelta(t, expected, dists[i], 1e-4)
+						}
+					}
+					recall := float32(relevant) / float32(k*len(queries))
+					assert.Greater(t, recall, float32(0.9))
+				})
+			}
+
+			t.Run("query vector distancer uses the full vectors", func(t *testing.T) {
+				d := index.QueryVectorDistancer(queries[0])
+				dist, err := d.DistanceFunc(3)
+				require.Nil(t, err)
+				expected, _ := provider.SingleDist(index.normalizeVec(queries[0]), index.normalizeVec(vectors[3]))
+				assert.InDelta(t, expected, dist, 1e-4)
+			})
+		})
+	}
+}
@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+// shouldCluster indicates that enough vectors have been added for the first
+// clustering
+func (i *ivf) shouldCluster() bool {
+	if i.currentGeneration() != nil {
+		return false
+	}
+	return i.vectors().Count() >= int(i.trainingLimit.Load())
+}
+
+// shouldRecluster indicates that enough vectors were added or deleted since
+// the last clustering that the lists no longer reflect the distribution
+func (i *ivf) shouldRecluster() bool {
+	g := i.currentGeneration()
+	if g == nil {
+		return i.shouldCluster()
+	}
+	return float64(i.changes.Load()) >= i.getReclusterThreshold()*float64(max(g.count, 1))
+}
+
+func (i *ivf) maintenance(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	executed, err := i.runMaintenance(context.Background(), shouldAbort)
+	if err != nil {
+		i.logger.WithField("action", "ivf_maintenance").
+			WithError(err).Error("ivf maintenance errored")
+	}
+	return executed
+}
+
+// runMaintenance removes the postings of obsolete generations and starts a
+// re-clustering once the threshold of changes is reached
+func (i *ivf) runMaintenance(ctx context.Context, shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	i.clusterLock.Lock()
+	cleaned, err := i.cleanupObsolete(ctx, shouldAbort)
+	i.clusterLock.Unlock()
+	if err != nil {
+		return cleaned, err
+	}
+	if shouldAbort() || !i.shouldRecluster() {
+		return cleaned, nil
+	}
+	return true, i.cluster(ctx, false)
+}
+
+// cluster partitions all vectors into a new generation of lists. The
+// centroids and the quantizer are trained on a sample, then the postings of
+// all vectors are written to the new generation, which replaces the current
+// one once it is complete. Inserts and deletes are not blocked, they are
+// applied to both generations in the meantime. The initial clustering is
+// skipped if another insert has completed it already.
+func (i *ivf) cluster(ctx context.Context, initial bool) error {
+	i.clusterLock.Lock()
+	defer i.clusterLock.Unlock()
+
+	if _, err := i.cleanupObsolete(ctx, func() bool { return false }); err != nil {
+		return err
+	}
+
+	count := i.vectors().Count()
+	current := i.currentGeneration()
+	if initial && current != nil {
+		return nil
+	}
+	if count == 0 || (current == nil && count < int(i.trainingLimit.Load())) {
+		return nil
+	}
+
+	before := time.Now()
+	sample, err := i.sample(ctx, int(i.trainingLimit.Load()))
+	if err != nil {
+		return errors.Wrap(err, "sample vectors")
+	}
+	if len(sample) == 0 {
+		return nil
+	}
+	nlists := i.targetLists(count, len(sample))
+	dims := int(i.dims.Load())
+
+	centroids := compressionhelpers.NewKMeans(nlists, dims, 0)
+	if err := centroids.Fit(sample); err != nil {
+		return errors.Wrap(err, "fit centroids")
+	}
+	q, err := trainQuantizer(i.compression, sample, dims, i.pqSegments,
+		i.distancerProvider, i.logger)
+	if err != nil {
+		return err
+	}
+
+	next := &generation{
+		id:        1,
+		count:     count,
+		centroids: centroids,
+		quantizer: q,
+	}
+	if current != nil {
+		next.id = current.id + 1
+	}
+
+	// if the process stops before the new generation is complete, its
+	// postings are removed on the next run
+	obsolete, err := i.loadObsolete()
+	if err != nil {
+		return err
+	}
+	if err := i.putMetadata(map[string][]byte{
+		metadataKeyObsolete: marshalObsolete(append(obsolete,
+			obsoleteGeneration{id: next.id, nlists: nlists})),
+	}); err != nil {
+		return err
+	}
+
+	i.generationLock.Lock()
+	i.pending = next
+	i.generationLock.Unlock()
+	i.changes.Store(0)
+
+	if err := i.writePostings(ctx, next); err != nil {
+		i.generationLock.Lock()
+		i.pending = nil
+		i.generationLock.Unlock()
+		return errors.Wrapf(err, "write postings of generation %d", next.id)
+	}
+
+	if err := i.switchGeneration(next, obsolete); err != nil {
+		return err
+	}
+
+	i.logger.WithFields(logrus.Fields{
+		"action":      "ivf_cluster",
+		"id":          i.id,
+		"generation":  next.id,
+		"count":       count,
+		"sample":      len(sample),
+		"nlists":      nlists,
+		"compression": q.kind(),
+		"took":        time.Since(before),
+	}).Info("clustered vectors into lists")
+
+	if _, err := i.cleanupObsolete(ctx, func() bool { return false }); err != nil {
+		// the postings are removed by the next maintenance cycle
+		i.logger.WithField("action", "ivf_cluster").WithError(err).
+			Warn("removing postings of previous generation failed")
+	}
+	return nil
+}
+
+// switchGeneration makes the pending generation the current one, the
+// previous generation becomes obsolete
+func (i *ivf) switchGeneration(next *generation, obsolete []obsoleteGeneration) error {
+	buf, err := marshalGeneration(next)
+	if err != nil {
+		return err
+	}
+
+	i.generationLock.Lock()
+	defer i.generationLock.Unlock()
+
+	if i.current != nil {
+		obsolete = append(obsolete, obsoleteGeneration{id: i.current.id, nlists: i.current.nlists()})
+	}
+	if err := i.putMetadata(map[string][]byte{
+		metadataKeyGeneration: buf,
+		metadataKeyObsolete:   marshalObsolete(obsolete),
+	}); err != nil {
+		return err
+	}
+	i.current = next
+	i.pending = nil
+	return nil
+}
+
+// targetLists returns the configured number of lists or the square root of
+// the vector count, which balances the number of lists which are compared to
+// the query and the length of each list
+func (i *ivf) targetLists(count, sampleSize int) int {
+	nlists := int(i.nlists.Load())
+	if nlists == 0 {
+		nlists = int(math.Round(math.Sqrt(float64(count))))
+	}
+	return max(1, min(nlists, sampleSize, ent.MaximumNLists))
+}
+
+// sample draws a uniform random sample of up to size vectors
+func (i *ivf) sample(ctx context.Context, size int) ([][]float32, error) {
+	sample := make([][]float32, 0, size)
+	seen := 0
+	err := i.iterateVectors(ctx, func(_ uint64, vector []float32) (bool, error) {
+		seen++
+		if len(sample) < size {
+			sample = append(sample, vector)
+		} else if r := rand.Intn(seen); r < size {
+			sample[r] = vector
+		}
+		return true, nil
+	})
+	return sample, err
+}
+
+func (i *ivf) writePostings(ctx context.Context, g *generation) error {
+	return i.iterateVectors(ctx, func(id uint64, vector []float32) (bool, error) {
+		err := i.postings().MapSet(g.rowKey(vector), lsmkv.MapPair{
+			Key:   idKey(id),
+			Value: g.quantizer.encode(vector),
+		})
+		return err == nil, err
+	})
+}
+
+// cleanupObsolete deletes the postings of generations which are no longer
+// used, it must be called with the clusterLock held
+func (i *ivf) cleanupObsolete(ctx context.Context, shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	obsolete, err := i.loadObsolete()
+	if err != nil || len(obsolete) == 0 {
+		return false, err
+	}
+
+	postings := i.postings()
+	for len(obsolete) > 0 {
+		o := obsolete[0]
+		for list := 0; list < o.nlists; list++ {
+			if shouldAbort() {
+				return true, nil
+			}
+			row := postingsRowKey(o.id, uint64(list))
+			pairs, err := postings.MapList(ctx, row)
+			if err != nil {
+				return true, errors.Wrapf(err, "read list %d of generation %d", list, o.id)
+			}
+			for _, pair := range pairs {
+				if pair.Tombstone {
+					continue
+				}
+				if err := postings.MapDeleteKey(row, pair.Key); err != nil {
+					return true, errors.Wrapf(err, "delete posting of generation %d", o.id)
+				}
+			}
+		}
+		obsolete = obsolete[1:]
+		if err := i.putMetadata(map[string][]byte{
+			metadataKeyObsolete: marshalObsolete(obsolete),
+		}); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,583 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	vectorsBucketPrefix  = "vectors_ivf"
+	postingsBucketPrefix = "vectors_ivf_postings"
+)
+
+// ivf is an inverted file index. The vectors are partitioned into lists by
+// the nearest centroid of a k-means clustering, a search only compares the
+// query to the vectors in the nprobe lists with the closest centroids.
+//
+// The full vectors are stored in a replace bucket keyed by the doc id. The
+// posting lists are rows of a map collection bucket, where the row key is the
+// generation of the clustering and the list, every entry maps a doc id to the
+// (optionally compressed) vector. Postings are compared to the query first,
+// the best candidates are then rescored using the full vectors.
+//
+// Until enough vectors for the first clustering have been added, searches
+// compare the query to every vector. Afterwards inserts are added to the
+// list of their nearest centroid, the clustering is repeated in the
+// background once enough vectors were changed to make the lists unbalanced.
+type ivf struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+	metadata          *bolt.DB
+
+	compression byte
+	pqSegments  int
+
+	// mutable settings, read on every operation
+	nlists             atomic.Int64
+	nprobe             atomic.Int64
+	trainingLimit      atomic.Int64
+	rescoreLimit       atomic.Int64
+	reclusterThreshold atomic.Uint64
+
+	initLock sync.Mutex
+	dims     atomic.Int32
+
+	// changes counts the inserts and deletes since the last clustering
+	changes atomic.Int64
+
+	// generationLock is held for reading while postings are written and for
+	// writing while the current generation is replaced. Postings are written
+	// to the current and - during a re-clustering - the pending generation.
+	generationLock sync.RWMutex
+	current        *generation
+	pending        *generation
+
+	// clusterLock serializes clustering and the cleanup of obsolete postings
+	clusterLock sync.Mutex
+
+	reclusterCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+// generation is one clustering of the vectors together with the quantizer
+// which encodes the postings of its lists
+type generation struct {
+	id        uint32
+	count     int
+	centroids *compressionhelpers.KMeans
+	quantizer quantizer
+}
+
+func (g *generation) nlists() int {
+	return len(g.centroids.Centers())
+}
+
+func (g *generation) rowKey(vector []float32) []byte {
+	return postingsRowKey(g.id, g.centroids.Nearest(vector))
+}
+
+func postingsRowKey(generation uint32, list uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint32(key[0:4], generation)
+	binary.BigEndian.PutUint32(key[4:8], uint32(list))
+	return key
+}
+
+func idKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*ivf, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &ivf{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		pqSegments:        uc.PQ.Segments,
+	}
+	switch {
+	case uc.PQ.Enabled:
+		index.compression = compressionPQ
+	case uc.SQ.Enabled:
+		index.compression = compressionSQ
+	default:
+		index.compression = compressionNone
+	}
+	index.setUserConfig(uc)
+
+	if err := index.initBuckets(context.Background()); err != nil {
+		return nil, err
+	}
+	if err := index.initMetadata(); err != nil {
+		return nil, err
+	}
+	if err := index.restore(); err != nil {
+		index.metadata.Close()
+		return nil, errors.Wrap(err, "restore ivf index")
+	}
+
+	if cfg.ReclusterCallbacks != nil {
+		index.reclusterCallbackCtrl = cfg.ReclusterCallbacks.Register(
+			fmt.Sprintf("ivf_%s", cfg.ID), index.maintenance)
+	} else {
+		index.reclusterCallbackCtrl = cyclemanager.NewCallbackCtrlNoop()
+	}
+
+	return index, nil
+}
+
+func (i *ivf) setUserConfig(uc ent.UserConfig) {
+	i.nlists.Store(int64(uc.NLists))
+	i.nprobe.Store(int64(uc.NProbe))
+	i.trainingLimit.Store(int64(uc.TrainingLimit))
+	i.rescoreLimit.Store(int64(uc.RescoreLimit))
+	i.reclusterThreshold.Store(math.Float64bits(uc.ReclusterThreshold))
+}
+
+func (i *ivf) getReclusterThreshold() float64 {
+	return math.Float64frombits(i.reclusterThreshold.Load())
+}
+
+func (i *ivf) getBucketName(prefix string) string {
+	if i.targetVector != "" {
+		return fmt.Sprintf("%s_%s", prefix, i.targetVector)
+	}
+	return prefix
+}
+
+func (i *ivf) initBuckets(ctx context.Context) error {
+	if err := i.store.CreateOrLoadBucket(ctx, i.getBucketName(vectorsBucketPrefix),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithUseBloomFilter(true),
+		// the count decides when the first clustering happens and how many
+		// lists are created
+		lsmkv.WithCalcCountNetAdditions(true),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", i.getBucketName(vectorsBucketPrefix))
+	}
+
+	if err := i.store.CreateOrLoadBucket(ctx, i.getBucketName(postingsBucketPrefix),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", i.getBucketName(postingsBucketPrefix))
+	}
+	return nil
+}
+
+func (i *ivf) vectors() *lsmkv.Bucket {
+	return i.store.Bucket(i.getBucketName(vectorsBucketPrefix))
+}
+
+func (i *ivf) postings() *lsmkv.Bucket {
+	return i.store.Bucket(i.getBucketName(postingsBucketPrefix))
+}
+
+func (i *ivf) restore() error {
+	dims, err := i.loadDimensions()
+	if err != nil {
+		return err
+	}
+	i.dims.Store(int32(dims))
+
+	buf, err := i.getMetadata(metadataKeyGeneration)
+	if err != nil {
+		return err
+	}
+	if buf != nil {
+		g, err := i.unmarshalGeneration(buf)
+		if err != nil {
+			return err
+		}
+		i.current = g
+	}
+	return i.loadChanges()
+}
+
+// ensureInitialized persists the dimensions on the first insert
+func (i *ivf) ensureInitialized(dims int) error {
+	if i.dims.Load() != 0 {
+		return nil
+	}
+
+	i.initLock.Lock()
+	defer i.initLock.Unlock()
+
+	if i.dims.Load() != 0 {
+		return nil
+	}
+	if err := i.persistDimensions(dims); err != nil {
+		return err
+	}
+	i.dims.Store(int32(dims))
+	return nil
+}
+
+func (i *ivf) normalized(vector []float32) []float32 {
+	if i.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+// generations returns the generations which receive new postings
+func (i *ivf) generations() []*generation {
+	gens := make([]*generation, 0, 2)
+	if i.current != nil {
+		gens = append(gens, i.current)
+	}
+	if i.pending != nil {
+		gens = append(gens, i.pending)
+	}
+	return gens
+}
+
+func (i *ivf) currentGeneration() *generation {
+	i.generationLock.RLock()
+	defer i.generationLock.RUnlock()
+	return i.current
+}
+
+func (i *ivf) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for n := range ids {
+		if err := i.Add(ctx, ids[n], vectors[n]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *ivf) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+	if err := i.ensureInitialized(len(vector)); err != nil {
+		return err
+	}
+	if len(vector) != int(i.dims.Load()) {
+		return errors.Errorf("insert called with a vector of the wrong size")
+	}
+
+	if err := i.insert(id, i.normalized(vector)); err != nil {
+		return err
+	}
+
+	if i.shouldCluster() {
+		if err := i.cluster(ctx, true); err != nil {
+			return errors.Wrap(err, "cluster vectors")
+		}
+	}
+	return nil
+}
+
+func (i *ivf) insert(id uint64, vector []float32) error {
+	i.generationLock.RLock()
+	defer i.generationLock.RUnlock()
+
+	key := idKey(id)
+	previous, err := i.vectorByKey(key)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		if err := i.removePostings(key, previous); err != nil {
+			return err
+		}
+	}
+
+	if err := i.vectors().Put(key, byteSliceFromFloat32Slice(vector, make([]byte, len(vector)*4))); err != nil {
+		return errors.Wrapf(err, "put vector %d", id)
+	}
+	for _, g := range i.generations() {
+		if err := i.postings().MapSet(g.rowKey(vector), lsmkv.MapPair{
+			Key:   key,
+			Value: g.quantizer.encode(vector),
+		}); err != nil {
+			return errors.Wrapf(err, "add posting %d", id)
+		}
+	}
+
+	i.changes.Add(1)
+	return nil
+}
+
+func (i *ivf) Delete(ids ...uint64) error {
+	i.generationLock.RLock()
+	defer i.generationLock.RUnlock()
+
+	for _, id := range ids {
+		key := idKey(id)
+		vector, err := i.vectorByKey(key)
+		if err != nil {
+			return err
+		}
+		if vector == nil {
+			continue
+		}
+		if err := i.removePostings(key, vector); err != nil {
+			return err
+		}
+		if err := i.vectors().Delete(key); err != nil {
+			return errors.Wrapf(err, "delete vector %d", id)
+		}
+		i.changes.Add(1)
+	}
+	return nil
+}
+
+// removePostings must be called with the generationLock held for reading
+func (i *ivf) removePostings(key []byte, vector []float32) error {
+	for _, g := range i.generations() {
+		if err := i.postings().MapDeleteKey(g.rowKey(vector), key); err != nil {
+			return errors.Wrapf(err, "delete posting %d", binary.BigEndian.Uint64(key))
+		}
+	}
+	return nil
+}
+
+func (i *ivf) vectorByKey(key []byte) ([]float32, error) {
+	buf, err := i.vectors().Get(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get vector %d", binary.BigEndian.Uint64(key))
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return float32SliceFromByteSlice(buf, make([]float32, len(buf)/4)), nil
+}
+
+func (i *ivf) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values
+	// are read on every single search
+	i.setUserConfig(parsed)
+
+	callback()
+	return nil
+}
+
+func (i *ivf) Flush() error {
+	// Shard will take care of handling store's buckets
+	return i.persistChanges()
+}
+
+func (i *ivf) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (i *ivf) Shutdown(ctx context.Context) error {
+	if err := i.reclusterCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "ivf shutdown")
+	}
+	if err := i.persistChanges(); err != nil {
+		return err
+	}
+	if err := i.metadata.Close(); err != nil {
+		return errors.Wrap(err, "close metadata")
+	}
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (i *ivf) Drop(ctx context.Context) error {
+	if err := i.reclusterCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "ivf drop")
+	}
+	if err := i.metadata.Close(); err != nil {
+		return errors.Wrap(err, "close metadata")
+	}
+	// Shard::drop will take care of handling store's buckets
+	return i.removeMetadataFile()
+}
+
+func (i *ivf) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	relPath, err := filepath.Rel(basePath, filepath.Join(i.rootPath, i.getMetadataFile()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relative path: %w", err)
+	}
+	return []string{relPath}, nil
+}
+
+func (i *ivf) PostStartup() {
+	// the centroids are loaded when the index is created, everything else
+	// is read from the buckets on demand
+}
+
+func (i *ivf) Compressed() bool {
+	g := i.currentGeneration()
+	return g != nil && g.quantizer.kind() != compressionNone
+}
+
+func (i *ivf) ValidateBeforeInsert(vector []float32) error {
+	dims := int(i.dims.Load())
+	if dims == 0 {
+		return nil
+	}
+	if dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (i *ivf) Multivector() bool {
+	return false
+}
+
+func (i *ivf) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the ivf index")
+}
+
+func (i *ivf) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("multivector is not supported by the ivf index")
+}
+
+func (i *ivf) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	return errors.Errorf("multivector is not supported by the ivf index")
+}
+
+func (i *ivf) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", i.id)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (i *ivf) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return i.distancerProvider.SingleDist(x, y)
+}
+
+func (i *ivf) ContainsNode(id uint64) bool {
+	vector, err := i.vectorByKey(idKey(id))
+	return err == nil && vector != nil
+}
+
+func (i *ivf) AlreadyIndexed() uint64 {
+	return uint64(i.vectors().Count())
+}
+
+func (i *ivf) Iterate(fn func(id uint64) bool) {
+	err := i.iterateVectors(context.Background(), func(id uint64, _ []float32) (bool, error) {
+		return fn(id), nil
+	})
+	if err != nil {
+		i.logger.WithField("action", "ivf_iterate").WithError(err).
+			Error("iterating vectors failed")
+	}
+}
+
+func (i *ivf) DistancerProvider() distancer.Provider {
+	return i.distancerProvider
+}
+
+func (i *ivf) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = i.normalized(queryVector)
+	distFunc := func(nodeID uint64) (float32, error) {
+		vec, err := i.vectorByKey(idKey(nodeID))
+		if err != nil {
+			return 0, err
+		}
+		if vec == nil {
+			return 0, fmt.Errorf("node %d not found", nodeID)
+		}
+		return i.distancerProvider.SingleDist(queryVector, vec)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (i *ivf) Stats() (common.IndexStats, error) {
+	stats := &IVFStats{
+		Dimensions: int(i.dims.Load()),
+		Vectors:    i.vectors().Count(),
+		Changes:    int(i.changes.Load()),
+	}
+	if g := i.currentGeneration(); g != nil {
+		stats.Generation = g.id
+		stats.Lists = g.nlists()
+		stats.Compressed = g.quantizer.kind() != compressionNone
+	}
+	return stats, nil
+}
+
+type IVFStats struct {
+	Dimensions int    `json:"dimensions"`
+	Vectors    int    `json:"vectors"`
+	Lists      int    `json:"lists"`
+	Generation uint32 `json:"generation"`
+	Changes    int    `json:"changes"`
+	Compressed bool   `json:"compressed"`
+}
+
+func (s *IVFStats) IndexType() common.IndexType {
+	return common.IndexTypeIVF
+}
@@ -0,0 +1,393 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func testUserConfig() ent.UserConfig {
+	uc := ent.NewDefaultUserConfig()
+	uc.TrainingLimit = 1000
+	uc.NProbe = 16
+	return uc
+}
+
+func newTestStore(t *testing.T, rootPath string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(rootPath, rootPath, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func newTestIndex(t *testing.T, rootPath string, uc ent.UserConfig, store *lsmkv.Store) *ivf {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		ID:                 "vectors",
+		RootPath:           rootPath,
+		Logger:             logger,
+		DistanceProvider:   distancer.NewL2SquaredProvider(),
+		ReclusterCallbacks: cyclemanager.NewCallbackGroupNoop(),
+	}, uc, store)
+	require.Nil(t, err)
+	return index
+}
+
+func recall(t *testing.T, index *ivf, vectors, queries [][]float32, k int) float32 {
+	logger, _ := test.NewNullLogger()
+	var matches, total uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		ids, dists, err := index.SearchByVector(context.Background(), query, k, nil)
+		require.Nil(t, err)
+		require.Len(t, dists, len(ids))
+		for i := 1; i < len(dists); i++ {
+			require.LessOrEqual(t, dists[i-1], dists[i])
+		}
+		matches += testinghelpers.MatchesInLists(truth, ids)
+		total += uint64(len(truth))
+	}
+	return float32(matches) / float32(total)
+}
+
+func neverAbort() bool {
+	return false
+}
+
+func TestIVF(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(3000, 50, 32)
+
+	t.Run("exact search before the first clustering", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 500), vectors[:500]))
+
+		assert.Nil(t, index.currentGeneration())
+		assert.Equal(t, uint64(500), index.AlreadyIndexed())
+		assert.Equal(t, float32(1), recall(t, index, vectors[:500], queries, 10))
+	})
+
+	for _, tc := range []struct {
+		name      string
+		configure func(uc *ent.UserConfig)
+		minRecall float32
+	}{
+		{
+			name:      "uncompressed postings",
+			configure: func(uc *ent.UserConfig) {},
+			minRecall: 0.8,
+		},
+		{
+			name: "pq compressed postings",
+			configure: func(uc *ent.UserConfig) {
+				uc.PQ.Enabled = true
+				uc.PQ.Segments = 8
+			},
+			minRecall: 0.7,
+		},
+		{
+			name: "sq compressed postings",
+			configure: func(uc *ent.UserConfig) {
+				uc.SQ.Enabled = true
+			},
+			minRecall: 0.8,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := testUserConfig()
+			tc.configure(&uc)
+			rootPath := t.TempDir()
+			store := newTestStore(t, rootPath)
+			defer store.Shutdown(ctx)
+			index := newTestIndex(t, rootPath, uc, store)
+			defer index.Shutdown(ctx)
+
+			require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+
+			g := index.currentGeneration()
+			require.NotNil(t, g)
+			assert.Equal(t, uint32(1), g.id)
+			// the square root of the count at the time of the clustering
+			assert.Equal(t, 32, g.nlists())
+			assert.Equal(t, uc.PQ.Enabled || uc.SQ.Enabled, index.Compressed())
+			assert.Greater(t, recall(t, index, vectors, queries, 10), tc.minRecall)
+		})
+	}
+
+	t.Run("filtered search", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < uint64(len(vectors)); i += 2 {
+			allow.Insert(i)
+		}
+		ids, _, err := index.SearchByVector(ctx, queries[0], 10, allow)
+		require.Nil(t, err)
+		require.Len(t, ids, 10)
+		for _, id := range ids {
+			assert.Equal(t, uint64(0), id%2)
+		}
+
+		// the probed lists contain fewer matches than requested, all allowed
+		// vectors are compared instead
+		restrictive := helpers.NewAllowList(7, 1234, 2999)
+		ids, _, err = index.SearchByVector(ctx, queries[0], 10, restrictive)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{7, 1234, 2999}, ids)
+	})
+
+	t.Run("deletes and updates", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+
+		remaining := make([][]float32, len(vectors))
+		copy(remaining, vectors)
+		var deleted []uint64
+		for i := 0; i < len(vectors); i += 3 {
+			deleted = append(deleted, uint64(i))
+			remaining[i] = nil
+		}
+		require.Nil(t, index.Delete(deleted...))
+
+		for _, id := range deleted {
+			assert.False(t, index.ContainsNode(id))
+		}
+		ids, _, err := index.SearchByVector(ctx, vectors[0], 10, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(0))
+		assert.Equal(t, uint64(len(vectors)-len(deleted)), index.AlreadyIndexed())
+		assert.Greater(t, recall(t, index, remaining, queries, 10), float32(0.8))
+
+		// updating a vector replaces its posting
+		require.Nil(t, index.Add(ctx, 1, queries[0]))
+		ids, dists, err := index.SearchByVector(ctx, queries[0], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+		assert.Equal(t, float32(0), dists[0])
+
+		g := index.currentGeneration()
+		var found [][]byte
+		for list := 0; list < g.nlists(); list++ {
+			postings, err := index.postings().MapList(ctx, postingsRowKey(g.id, uint64(list)))
+			require.Nil(t, err)
+			for _, posting := range postings {
+				if string(posting.Key) == string(idKey(1)) {
+					found = append(found, posting.Value)
+				}
+			}
+		}
+		require.Len(t, found, 1)
+		assert.Equal(t, g.quantizer.encode(queries[0]), found[0])
+	})
+
+	t.Run("re-clustering", func(t *testing.T) {
+		uc := testUserConfig()
+		uc.ReclusterThreshold = 0.5
+		uc.PQ.Enabled = true
+		uc.PQ.Segments = 8
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, uc, store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 1000), vectors[:1000]))
+		first := index.currentGeneration()
+		require.NotNil(t, first)
+		assert.False(t, index.shouldRecluster())
+
+		executed, err := index.runMaintenance(ctx, neverAbort)
+		require.Nil(t, err)
+		assert.False(t, executed)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(1000, len(vectors)), vectors[1000:]))
+		assert.True(t, index.shouldRecluster())
+
+		executed, err = index.runMaintenance(ctx, neverAbort)
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		second := index.currentGeneration()
+		assert.Equal(t, uint32(2), second.id)
+		assert.Equal(t, len(vectors), second.count)
+		assert.Equal(t, 55, second.nlists())
+		assert.Equal(t, int64(0), index.changes.Load())
+		assert.Greater(t, recall(t, index, vectors, queries, 10), float32(0.7))
+
+		// the postings of the first generation are removed
+		for list := 0; list < first.nlists(); list++ {
+			postings, err := index.postings().MapList(ctx, postingsRowKey(first.id, uint64(list)))
+			require.Nil(t, err)
+			assert.Empty(t, postings)
+		}
+		obsolete, err := index.loadObsolete()
+		require.Nil(t, err)
+		assert.Empty(t, obsolete)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		uc := testUserConfig()
+		uc.SQ.Enabled = true
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		index := newTestIndex(t, rootPath, uc, store)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, len(vectors)), vectors))
+		require.Nil(t, index.Delete(5))
+		before, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		changes := index.changes.Load()
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index = newTestIndex(t, rootPath, uc, store)
+		defer index.Shutdown(ctx)
+
+		assert.True(t, index.Compressed())
+		assert.Equal(t, int32(32), index.dims.Load())
+		assert.Equal(t, changes, index.changes.Load())
+		assert.Equal(t, uint32(1), index.currentGeneration().id)
+		assert.False(t, index.ContainsNode(5))
+		assert.True(t, index.ContainsNode(6))
+		after, _, err := index.SearchByVector(ctx, queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("interrupted clustering is cleaned up", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 1000), vectors[:1000]))
+		g := index.currentGeneration()
+
+		// simulate postings of a generation which was never completed
+		stale := &generation{id: g.id + 1, centroids: g.centroids, quantizer: g.quantizer}
+		require.Nil(t, index.writePostings(ctx, stale))
+		require.Nil(t, index.putMetadata(map[string][]byte{
+			metadataKeyObsolete: marshalObsolete([]obsoleteGeneration{{id: stale.id, nlists: g.nlists()}}),
+		}))
+
+		executed, err := index.runMaintenance(ctx, neverAbort)
+		require.Nil(t, err)
+		assert.True(t, executed)
+		for list := 0; list < g.nlists(); list++ {
+			postings, err := index.postings().MapList(ctx, postingsRowKey(stale.id, uint64(list)))
+			require.Nil(t, err)
+			assert.Empty(t, postings)
+		}
+		assert.Equal(t, g, index.currentGeneration())
+	})
+
+	t.Run("list files and drop", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+
+		require.Nil(t, index.AddBatch(ctx, idsRange(0, 10), vectors[:10]))
+		require.Nil(t, index.Flush())
+
+		files, err := index.ListFiles(ctx, rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"ivf.db"}, files)
+
+		require.Nil(t, index.Drop(ctx))
+		_, err = os.Stat(filepath.Join(rootPath, "ivf.db"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		rootPath := t.TempDir()
+		store := newTestStore(t, rootPath)
+		defer store.Shutdown(ctx)
+		index := newTestIndex(t, rootPath, testUserConfig(), store)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.ValidateBeforeInsert(vectors[0]))
+		require.Nil(t, index.Add(ctx, 0, vectors[0]))
+		assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2}))
+		assert.NotNil(t, index.Add(ctx, 1, []float32{1, 2}))
+		_, _, err := index.SearchByVector(ctx, []float32{1, 2}, 10, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestIVFValidateUserConfigUpdate(t *testing.T) {
+	initial := ent.NewDefaultUserConfig()
+
+	updated := initial
+	updated.NProbe = 32
+	updated.NLists = 128
+	assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+	updated = initial
+	updated.PQ.Enabled = true
+	assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "pq.enabled is immutable")
+
+	updated = initial
+	updated.Distance = "dot"
+	assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "distance is immutable")
+}
+
+func idsRange(from, to int) []uint64 {
+	ids := make([]uint64, 0, to-from)
+	for i := from; i < to; i++ {
+		ids = append(ids, uint64(i))
+	}
+	return ids
+}
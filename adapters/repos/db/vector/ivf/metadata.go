@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	metadataPrefix = "ivf"
+	metadataBucket = "ivf"
+
+	metadataKeyDimensions = "dimensions"
+	metadataKeyGeneration = "generation"
+	metadataKeyObsolete   = "obsolete"
+	metadataKeyChanges    = "changes"
+
+	generationHeaderSize = 4 + 4 + 4 + 8 + 1 + 4
+)
+
+func (i *ivf) getMetadataFile() string {
+	if i.targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Clean(i.targetVector)
+		cleanTarget = filepath.Base(cleanTarget)
+		return fmt.Sprintf("%s_%s.db", metadataPrefix, cleanTarget)
+	}
+	return fmt.Sprintf("%s.db", metadataPrefix)
+}
+
+func (i *ivf) removeMetadataFile() error {
+	path := filepath.Join(i.rootPath, i.getMetadataFile())
+	if err := os.Remove(path); err != nil {
+		return errors.Wrapf(err, "remove metadata file %q", path)
+	}
+	return nil
+}
+
+func (i *ivf) initMetadata() error {
+	path := filepath.Join(i.rootPath, i.getMetadataFile())
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(metadataBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return errors.Wrap(err, "init metadata bucket")
+	}
+
+	i.metadata = db
+	return nil
+}
+
+// getMetadata returns a copy of the value, nil if the key is not set
+func (i *ivf) getMetadata(key string) ([]byte, error) {
+	var value []byte
+	err := i.metadata.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket([]byte(metadataBucket)).Get([]byte(key)); v != nil {
+			value = make([]byte, len(v))
+			copy(value, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get metadata %q", key)
+	}
+	return value, nil
+}
+
+// putMetadata writes all values in a single transaction, a nil value
+// deletes the key
+func (i *ivf) putMetadata(values map[string][]byte) error {
+	err := i.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(metadataBucket))
+		for key, value := range values {
+			var err error
+			if value == nil {
+				err = b.Delete([]byte(key))
+			} else {
+				err = b.Put([]byte(key), value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "put metadata")
+	}
+	return nil
+}
+
+func (i *ivf) loadDimensions() (int, error) {
+	v, err := i.getMetadata(metadataKeyDimensions)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	return int(binary.LittleEndian.Uint32(v)), nil
+}
+
+func (i *ivf) persistDimensions(dims int) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dims))
+	return i.putMetadata(map[string][]byte{metadataKeyDimensions: buf})
+}
+
+func (i *ivf) loadChanges() error {
+	v, err := i.getMetadata(metadataKeyChanges)
+	if err != nil || v == nil {
+		return err
+	}
+	i.changes.Store(int64(binary.LittleEndian.Uint64(v)))
+	return nil
+}
+
+func (i *ivf) persistChanges() error {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(i.changes.Load()))
+	return i.putMetadata(map[string][]byte{metadataKeyChanges: buf})
+}
+
+func marshalGeneration(g *generation) ([]byte, error) {
+	q, err := g.quantizer.marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal quantizer")
+	}
+	centers := g.centroids.Centers()
+	dims := len(centers[0])
+
+	buf := make([]byte, generationHeaderSize, generationHeaderSize+len(q)+len(centers)*dims*4)
+	binary.LittleEndian.PutUint32(buf[0:4], g.id)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(centers)))
+	binary.LittleEndian.PutUint32(buf[8:12], uint32(dims))
+	binary.LittleEndian.PutUint64(buf[12:20], uint64(g.count))
+	buf[20] = g.quantizer.kind()
+	binary.LittleEndian.PutUint32(buf[21:25], uint32(len(q)))
+	buf = append(buf, q...)
+	for _, center := range centers {
+		buf = append(buf, byteSliceFromFloat32Slice(center, make([]byte, dims*4))...)
+	}
+	return buf, nil
+}
+
+func (i *ivf) unmarshalGeneration(buf []byte) (*generation, error) {
+	if len(buf) < generationHeaderSize {
+		return nil, fmt.Errorf("corrupt generation: %d bytes", len(buf))
+	}
+	g := &generation{
+		id:    binary.LittleEndian.Uint32(buf[0:4]),
+		count: int(binary.LittleEndian.Uint64(buf[12:20])),
+	}
+	nlists := int(binary.LittleEndian.Uint32(buf[4:8]))
+	dims := int(binary.LittleEndian.Uint32(buf[8:12]))
+	kind := buf[20]
+	qLen := int(binary.LittleEndian.Uint32(buf[21:25]))
+	if len(buf) != generationHeaderSize+qLen+nlists*dims*4 {
+		return nil, fmt.Errorf("corrupt generation: expected %d bytes, got %d",
+			generationHeaderSize+qLen+nlists*dims*4, len(buf))
+	}
+
+	q, err := restoreQuantizer(kind, buf[generationHeaderSize:generationHeaderSize+qLen],
+		i.distancerProvider, i.logger)
+	if err != nil {
+		return nil, err
+	}
+	g.quantizer = q
+
+	centers := make([][]float32, nlists)
+	offset := generationHeaderSize + qLen
+	for c := range centers {
+		centers[c] = float32SliceFromByteSlice(buf[offset:offset+dims*4], make([]float32, dims))
+		offset += dims * 4
+	}
+	g.centroids = newCentroids(centers)
+	return g, nil
+}
+
+func newCentroids(centers [][]float32) *compressionhelpers.KMeans {
+	return compressionhelpers.NewKMeansWithCenters(len(centers), len(centers[0]), 0, centers)
+}
+
+// obsoleteGeneration identifies posting lists which are no longer in use
+type obsoleteGeneration struct {
+	id     uint32
+	nlists int
+}
+
+func (i *ivf) loadObsolete() ([]obsoleteGeneration, error) {
+	v, err := i.getMetadata(metadataKeyObsolete)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	obsolete := make([]obsoleteGeneration, len(v)/8)
+	for n := range obsolete {
+		obsolete[n] = obsoleteGeneration{
+			id:     binary.LittleEndian.Uint32(v[n*8:]),
+			nlists: int(binary.LittleEndian.Uint32(v[n*8+4:])),
+		}
+	}
+	return obsolete, nil
+}
+
+func marshalObsolete(obsolete []obsoleteGeneration) []byte {
+	if len(obsolete) == 0 {
+		return nil
+	}
+	buf := make([]byte, len(obsolete)*8)
+	for n, o := range obsolete {
+		binary.LittleEndian.PutUint32(buf[n*8:], o.id)
+		binary.LittleEndian.PutUint32(buf[n*8+4:], uint32(o.nlists))
+	}
+	return buf
+}
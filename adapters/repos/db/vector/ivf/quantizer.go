@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	compressionNone byte = iota
+	compressionPQ
+	compressionSQ
+
+	pqCentroids = 256
+)
+
+// quantizer encodes the vectors which are stored in the posting lists and
+// compares queries to the encoded vectors. Every generation of the clustering
+// trains its own quantizer, so the codes of a posting list are always
+// compatible with the quantizer of the generation they belong to.
+type quantizer interface {
+	kind() byte
+	encode(vec []float32) []byte
+	distancer(query []float32) (func(code []byte) (float32, error), func())
+	marshal() ([]byte, error)
+}
+
+// rawQuantizer stores the full vectors in the posting lists
+type rawQuantizer struct {
+	provider distancer.Provider
+}
+
+func (q *rawQuantizer) kind() byte {
+	return compressionNone
+}
+
+func (q *rawQuantizer) encode(vec []float32) []byte {
+	return byteSliceFromFloat32Slice(vec, make([]byte, len(vec)*4))
+}
+
+func (q *rawQuantizer) distancer(query []float32) (func(code []byte) (float32, error), func()) {
+	buf := make([]float32, len(query))
+	return func(code []byte) (float32, error) {
+		if len(code) != len(buf)*4 {
+			return 0, fmt.Errorf("posting has %d bytes, expected %d", len(code), len(buf)*4)
+		}
+		return q.provider.SingleDist(query, float32SliceFromByteSlice(code, buf))
+	}, func() {}
+}
+
+func (q *rawQuantizer) marshal() ([]byte, error) {
+	return nil, nil
+}
+
+type pqQuantizer struct {
+	pq *compressionhelpers.ProductQuantizer
+}
+
+func (q *pqQuantizer) kind() byte {
+	return compressionPQ
+}
+
+func (q *pqQuantizer) encode(vec []float32) []byte {
+	return q.pq.Encode(vec)
+}
+
+func (q *pqQuantizer) distancer(query []float32) (func(code []byte) (float32, error), func()) {
+	d := q.pq.NewDistancer(query)
+	return d.Distance, func() { q.pq.ReturnDistancer(d) }
+}
+
+func (q *pqQuantizer) marshal() ([]byte, error) {
+	return q.pq.MarshalCodebook()
+}
+
+type sqQuantizer struct {
+	sq   *compressionhelpers.ScalarQuantizer
+	data compressionhelpers.SQData
+}
+
+func (q *sqQuantizer) kind() byte {
+	return compressionSQ
+}
+
+func (q *sqQuantizer) encode(vec []float32) []byte {
+	return q.sq.Encode(vec)
+}
+
+func (q *sqQuantizer) distancer(query []float32) (func(code []byte) (float32, error), func()) {
+	return q.sq.NewDistancer(query).Distance, func() {}
+}
+
+func (q *sqQuantizer) marshal() ([]byte, error) {
+	buf := make([]byte, 10)
+	binary.LittleEndian.PutUint32(buf[0:4], math.Float32bits(q.data.A))
+	binary.LittleEndian.PutUint32(buf[4:8], math.Float32bits(q.data.B))
+	binary.LittleEndian.PutUint16(buf[8:10], q.data.Dimensions)
+	return buf, nil
+}
+
+// sqRecorder captures the parameters of a scalar quantizer, which are only
+// exposed through the commit logger interface
+type sqRecorder struct {
+	data compressionhelpers.SQData
+}
+
+func (r *sqRecorder) AddPQCompression(compressionhelpers.PQData) error {
+	return errors.New("unexpected pq compression")
+}
+
+func (r *sqRecorder) AddSQCompression(data compressionhelpers.SQData) error {
+	r.data = data
+	return nil
+}
+
+func (r *sqRecorder) AddLowBitSQCompression(compressionhelpers.LowBitSQData) error {
+	return errors.New("unexpected low bit sq compression")
+}
+
+// trainQuantizer fits a quantizer of the given kind to the sample
+func trainQuantizer(kind byte, sample [][]float32, dims, segments int,
+	provider distancer.Provider, logger logrus.FieldLogger,
+) (quantizer, error) {
+	switch kind {
+	case compressionNone:
+		return &rawQuantizer{provider: provider}, nil
+	case compressionPQ:
+		if segments == 0 {
+			segments = common.CalculateOptimalSegments(dims)
+		}
+		if dims%segments != 0 {
+			return nil, fmt.Errorf("pq.segments (%d) must be a divisor of the dimensions (%d)",
+				segments, dims)
+		}
+		pq, err := compressionhelpers.NewProductQuantizer(pqConfig(segments, len(sample)),
+			provider, dims, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "create product quantizer")
+		}
+		if err := pq.Fit(sample); err != nil {
+			return nil, errors.Wrap(err, "fit product quantizer")
+		}
+		return &pqQuantizer{pq: pq}, nil
+	case compressionSQ:
+		sq := compressionhelpers.NewScalarQuantizer(sample, provider)
+		recorder := &sqRecorder{}
+		sq.PersistCompression(recorder)
+		return &sqQuantizer{sq: sq, data: recorder.data}, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %d", kind)
+	}
+}
+
+// restoreQuantizer is the counterpart of quantizer.marshal
+func restoreQuantizer(kind byte, buf []byte, provider distancer.Provider,
+	logger logrus.FieldLogger,
+) (quantizer, error) {
+	switch kind {
+	case compressionNone:
+		return &rawQuantizer{provider: provider}, nil
+	case compressionPQ:
+		segments, err := compressionhelpers.CodebookSegments(buf)
+		if err != nil {
+			return nil, err
+		}
+		pq, err := compressionhelpers.RestoreProductQuantizerFromCodebook(
+			pqConfig(segments, 0), buf, provider, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "restore product quantizer")
+		}
+		return &pqQuantizer{pq: pq}, nil
+	case compressionSQ:
+		if len(buf) != 10 {
+			return nil, fmt.Errorf("corrupt scalar quantizer: %d bytes", len(buf))
+		}
+		data := compressionhelpers.SQData{
+			A:          math.Float32frombits(binary.LittleEndian.Uint32(buf[0:4])),
+			B:          math.Float32frombits(binary.LittleEndian.Uint32(buf[4:8])),
+			Dimensions: binary.LittleEndian.Uint16(buf[8:10]),
+		}
+		sq, err := compressionhelpers.RestoreScalarQuantizer(data.A, data.B, data.Dimensions, provider)
+		if err != nil {
+			return nil, errors.Wrap(err, "restore scalar quantizer")
+		}
+		return &sqQuantizer{sq: sq, data: data}, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %d", kind)
+	}
+}
+
+func pqConfig(segments, trainingLimit int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:       true,
+		Segments:      segments,
+		Centroids:     pqCentroids,
+		TrainingLimit: trainingLimit,
+		Encoder: hnswent.PQEncoder{
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
+
+func byteSliceFromFloat32Slice(vector []float32, slice []byte) []byte {
+	for i := range vector {
+		binary.LittleEndian.PutUint32(slice[i*4:], math.Float32bits(vector[i]))
+	}
+	return slice
+}
+
+func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
+	for i := range slice {
+		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
+	}
+	return slice
+}
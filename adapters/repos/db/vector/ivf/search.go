@@ -0,0 +1,311 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// iterateBatchSize limits how many vectors are read with a single cursor, a
+// cursor blocks flushes of the bucket while it is open
+const iterateBatchSize = 1000
+
+func (i *ivf) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if i.dims.Load() == 0 || k <= 0 {
+		return nil, nil, nil
+	}
+	if allow != nil && allow.IsEmpty() {
+		return nil, nil, nil
+	}
+	if len(vector) != int(i.dims.Load()) {
+		return nil, nil, errors.Errorf("search called with a vector of length %d, the index has %d dimensions",
+			len(vector), i.dims.Load())
+	}
+
+	vector = i.normalized(vector)
+	g := i.currentGeneration()
+	if g == nil {
+		return i.flatSearch(ctx, vector, k, allow)
+	}
+
+	ids, dists, err := i.probe(ctx, g, vector, k, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+	if allow != nil && len(ids) < k {
+		// the probed lists do not contain enough matches for a restrictive
+		// filter, the allowed vectors are few enough to compare all of them
+		return i.flatSearch(ctx, vector, k, allow)
+	}
+	return ids, dists, nil
+}
+
+// probe compares the query to the postings of the nprobe lists with the
+// closest centroids, the best candidates are rescored using the full vectors
+func (i *ivf) probe(ctx context.Context, g *generation, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	nprobe := min(int(i.nprobe.Load()), g.nlists())
+	candidateLimit := max(int(i.rescoreLimit.Load()), k)
+
+	distance, release := g.quantizer.distancer(vector)
+	defer release()
+
+	candidates := priorityqueue.NewMax[any](candidateLimit)
+	seen := make(map[uint64]struct{})
+	for _, list := range g.centroids.NNearest(vector, nprobe) {
+		postings, err := i.postings().MapList(ctx, postingsRowKey(g.id, list))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "read list %d", list)
+		}
+		for _, posting := range postings {
+			if posting.Tombstone {
+				continue
+			}
+			id := binary.BigEndian.Uint64(posting.Key)
+			if allow != nil && !allow.Contains(id) {
+				continue
+			}
+			// a vector can have an outdated posting in another list if it
+			// was updated during a re-clustering
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			dist, err := distance(posting.Value)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "distance to posting %d", id)
+			}
+			insertToHeap(candidates, candidateLimit, id, dist)
+		}
+	}
+
+	heap := priorityqueue.NewMax[any](k)
+	for candidates.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		id := candidates.Pop().ID
+		full, err := i.vectorByKey(idKey(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if full == nil {
+			// deleted during a re-clustering
+			continue
+		}
+		dist, err := i.distancerProvider.SingleDist(vector, full)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+// flatSearch compares the query to every (allowed) vector, it is used before
+// the first clustering and for restrictive filters
+func (i *ivf) flatSearch(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+
+	if allow == nil {
+		err := i.iterateVectors(ctx, func(id uint64, candidate []float32) (bool, error) {
+			dist, err := i.distancerProvider.SingleDist(vector, candidate)
+			if err != nil {
+				return false, err
+			}
+			insertToHeap(heap, k, id, dist)
+			return true, nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		ids, dists := extractHeap(heap)
+		return ids, dists, nil
+	}
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		candidate, err := i.vectorByKey(idKey(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if candidate == nil {
+			continue
+		}
+		dist, err := i.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (i *ivf) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := i.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for n := range ids {
+			if aboveThresh := dist[n] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[n]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[n])
+				resultDist = append(resultDist, dist[n])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	var shouldContinue bool
+	var err error
+	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			i.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+type storedVector struct {
+	id     uint64
+	vector []float32
+}
+
+// iterateVectors calls fn for every stored vector in the order of the ids
+// until fn returns false
+func (i *ivf) iterateVectors(ctx context.Context, fn func(id uint64, vector []float32) (bool, error)) error {
+	var from []byte
+	for {
+		batch, more := i.readVectors(from)
+		for _, v := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			ok, err := fn(v.id, v.vector)
+			if err != nil || !ok {
+				return err
+			}
+		}
+		if !more || len(batch) == 0 {
+			return nil
+		}
+		from = idKey(batch[len(batch)-1].id + 1)
+	}
+}
+
+// readVectors reads up to iterateBatchSize vectors starting at the given key,
+// more is true if there are further vectors
+func (i *ivf) readVectors(from []byte) (batch []storedVector, more bool) {
+	c := i.vectors().Cursor()
+	defer c.Close()
+
+	var k, v []byte
+	if from == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(from)
+	}
+
+	batch = make([]storedVector, 0, iterateBatchSize)
+	for ; k != nil; k, v = c.Next() {
+		if len(batch) == iterateBatchSize {
+			return batch, true
+		}
+		batch = append(batch, storedVector{
+			id:     binary.BigEndian.Uint64(k),
+			vector: float32SliceFromByteSlice(v, make([]float32, len(v)/4)),
+		})
+	}
+	return batch, false
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for n := len(ids) - 1; n >= 0; n-- {
+		item := heap.Pop()
+		ids[n] = item.ID
+		dists[n] = item.Dist
+	}
+	return ids, dists
+}
@@ -96,6 +96,24 @@ func (i *Index) ValidateBeforeInsert(vector []float32) error {
 	return nil
 }
 
+func (i *Index) Multivector() bool {
+	return false
+}
+
+func (i *Index) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	// silently ignore
+	return nil
+}
+
+func (i *Index) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	// silently ignore
+	return nil
+}
+
+func (i *Index) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	return nil
+}
+
 func (i *Index) PostStartup() {
 }
 
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"github.com/pkg/errors"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// ValidateUserConfigUpdate only checks the type of the configs, the single
+// setting of sparse indexes can not be changed.
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	if _, ok := initial.(ent.UserConfig); !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+	if _, ok := updated.(ent.UserConfig); !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Index stores the sparse vectors of a target vector as posting lists in a
+// bucket with the map collection strategy. There is one row per dimension,
+// holding the weights of all documents with a non-zero weight in the
+// dimension, keyed by their doc ids. The bucket should use a block max
+// index, so that searches only read the blocks of long posting lists which
+// can contain competitive documents.
+type Index struct {
+	bucket *lsmkv.Bucket
+}
+
+func New(bucket *lsmkv.Bucket) *Index {
+	return &Index{bucket: bucket}
+}
+
+// Add adds the postings of the vector of the document
+func (i *Index) Add(docID uint64, vector models.SparseVector) error {
+	if len(vector.Indices) != len(vector.Values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values",
+			len(vector.Indices), len(vector.Values))
+	}
+	for j, dim := range vector.Indices {
+		if err := i.bucket.MapSet(DimensionKey(dim),
+			encodePosting(docID, vector.Values[j])); err != nil {
+			return fmt.Errorf("add posting of dimension %d: %w", dim, err)
+		}
+	}
+	return nil
+}
+
+// Delete removes the postings of the previous vector of the document
+func (i *Index) Delete(docID uint64, vector models.SparseVector) error {
+	for _, dim := range vector.Indices {
+		if err := i.bucket.MapDeleteKey(DimensionKey(dim), docIDKey(docID)); err != nil {
+			return fmt.Errorf("delete posting of dimension %d: %w", dim, err)
+		}
+	}
+	return nil
+}
+
+// Search returns the doc ids of the k documents with the highest dot product
+// with the query, together with the dot products, ordered by descending
+// score. Documents sharing no dimension with the query are never returned.
+func (i *Index) Search(ctx context.Context, query models.SparseVector, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if len(query.Indices) != len(query.Values) {
+		return nil, nil, fmt.Errorf("sparse query vector has %d indices but %d values",
+			len(query.Indices), len(query.Values))
+	}
+	if allow != nil && allow.IsEmpty() {
+		return nil, nil, nil
+	}
+
+	keys := make([][]byte, 0, len(query.Indices))
+	dims := make([]int, 0, len(query.Indices))
+	for j, dim := range query.Indices {
+		if query.Values[j] != 0 {
+			keys = append(keys, DimensionKey(dim))
+			dims = append(dims, j)
+		}
+	}
+
+	layers, release, err := i.bucket.DocPointerWithScoreLayers(ctx, keys, 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read postings: %w", err)
+	}
+	defer release()
+
+	lists := make([]*postingList, 0, len(keys))
+	for j, dimLayers := range layers {
+		l, err := newPostingList(dimLayers, query.Values[dims[j]])
+		if err != nil {
+			return nil, nil, fmt.Errorf("read postings of dimension %d: %w",
+				query.Indices[dims[j]], err)
+		}
+		if !l.exhausted() {
+			lists = append(lists, l)
+		}
+	}
+
+	return wand(ctx, lists, k, allow)
+}
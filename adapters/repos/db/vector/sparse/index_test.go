@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func createTestIndex(t *testing.T) *Index {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	t.Cleanup(func() { store.Shutdown(context.Background()) })
+
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(), "sparse",
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection), lsmkv.WithUseBlockMax(true)))
+	return New(store.Bucket("sparse"))
+}
+
+func TestIndex(t *testing.T) {
+	ctx := context.Background()
+	index := createTestIndex(t)
+
+	vectors := map[uint64]models.SparseVector{
+		1: {Indices: []uint32{1, 5, 9}, Values: []float32{0.5, 1, 2}},
+		2: {Indices: []uint32{5}, Values: []float32{3}},
+		3: {Indices: []uint32{2, 9}, Values: []float32{1, 0.5}},
+	}
+	for docID, vector := range vectors {
+		require.Nil(t, index.Add(docID, vector))
+	}
+	query := models.SparseVector{Indices: []uint32{5, 9}, Values: []float32{1, 2}}
+
+	t.Run("search", func(t *testing.T) {
+		ids, scores, err := index.Search(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+		assert.Equal(t, []float32{5, 3, 1}, scores)
+	})
+
+	t.Run("search with limit", func(t *testing.T) {
+		ids, _, err := index.Search(ctx, query, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		ids, _, err := index.Search(ctx, query, 10, helpers.NewAllowList(2, 3))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+	})
+
+	t.Run("search without matching dimensions", func(t *testing.T) {
+		ids, _, err := index.Search(ctx, models.SparseVector{
+			Indices: []uint32{100}, Values: []float32{1},
+		}, 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("search after delete", func(t *testing.T) {
+		require.Nil(t, index.Delete(1, vectors[1]))
+
+		ids, scores, err := index.Search(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3}, ids)
+		assert.Equal(t, []float32{3, 1}, scores)
+	})
+
+	t.Run("mismatching lengths", func(t *testing.T) {
+		err := index.Add(4, models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{1}})
+		assert.EqualError(t, err, "sparse vector has 2 indices but 1 values")
+
+		_, _, err = index.Search(ctx, models.SparseVector{Indices: []uint32{1}}, 10, nil)
+		assert.EqualError(t, err, "sparse query vector has 1 indices but 0 values")
+	})
+}
+
+func TestIndex_BlockMax(t *testing.T) {
+	ctx := context.Background()
+	index := createTestIndex(t)
+
+	// long posting lists, covered by the block max index once flushed
+	for docID := uint64(0); docID < 1000; docID++ {
+		weight := float32(docID%10) + 1
+		if docID == 777 {
+			weight = 50
+		}
+		require.Nil(t, index.Add(docID, models.SparseVector{
+			Indices: []uint32{1, 2}, Values: []float32{weight, -weight},
+		}))
+	}
+	require.Nil(t, index.bucket.FlushMemtable())
+	// newer layers overriding and deleting postings of the segment
+	require.Nil(t, index.Delete(777, models.SparseVector{Indices: []uint32{1, 2}}))
+	require.Nil(t, index.Add(5, models.SparseVector{Indices: []uint32{1}, Values: []float32{20}}))
+
+	t.Run("positive query weight", func(t *testing.T) {
+		ids, scores, err := index.Search(ctx, models.SparseVector{
+			Indices: []uint32{1}, Values: []float32{2},
+		}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{5, 9}, ids)
+		assert.Equal(t, []float32{40, 20}, scores)
+	})
+
+	t.Run("negative query weight", func(t *testing.T) {
+		ids, scores, err := index.Search(ctx, models.SparseVector{
+			Indices: []uint32{2}, Values: []float32{-1},
+		}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{9}, ids)
+		assert.Equal(t, []float32{10}, scores)
+	})
+}
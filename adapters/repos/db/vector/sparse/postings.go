@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// DimensionKey is the row key of the posting list of a dimension. Keys are
+// big endian, so that posting lists are ordered by dimension on disk.
+func DimensionKey(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
+// docIDKey is the map key of a posting. Keys are big endian, so that the
+// postings of a list are ordered by doc id.
+func docIDKey(docID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	return key
+}
+
+// encodePosting encodes the weight in the layout of the postings of the
+// inverted index, as both the frequency and the prop length. The block max
+// index of the segments then bounds the weights of each block from above
+// (max frequency) and below (min prop length), see postingList.
+func encodePosting(docID uint64, weight float32) lsmkv.MapPair {
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(weight))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(weight))
+	return lsmkv.MapPair{Key: docIDKey(docID), Value: value}
+}
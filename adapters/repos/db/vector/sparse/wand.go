@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+)
+
+// postingList is the posting list of a single query dimension, ordered by
+// doc id, with a cursor used during the WAND traversal. The list is split up
+// into the layers of the segments and memtables of the bucket, blocks of the
+// segments are only read once the cursor lands on them.
+type postingList struct {
+	postings *terms.PostingIterator
+
+	// queryWeight is the weight of the dimension in the query
+	queryWeight float32
+	// upperBound is the maximum contribution of any posting of the list to
+	// the score of a document. It is never negative, so that the sum of the
+	// upper bounds of a prefix of lists bounds any subset of these lists.
+	upperBound float32
+}
+
+// newPostingList bounds the contributions of the list by the block max
+// metadata of its layers, without reading them. The weights are stored as
+// frequency and prop length, see encodePosting.
+func newPostingList(layers []terms.PostingLayer, queryWeight float32) (*postingList, error) {
+	postings, err := terms.NewPostingIterator(layers)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &postingList{postings: postings, queryWeight: queryWeight}
+	for _, layer := range layers {
+		for _, block := range layer.Blocks() {
+			bound := block.MaxFrequency
+			if queryWeight < 0 {
+				if block.MinPropLength == math.MaxFloat32 {
+					// the block only contains deletions
+					continue
+				}
+				bound = block.MinPropLength
+			}
+			if contribution := bound * queryWeight; contribution > l.upperBound {
+				l.upperBound = contribution
+			}
+		}
+	}
+	return l, nil
+}
+
+func (l *postingList) exhausted() bool {
+	return l.postings.Exhausted()
+}
+
+func (l *postingList) docID() uint64 {
+	return l.postings.Id()
+}
+
+func (l *postingList) score() float32 {
+	return l.postings.Current().Frequency * l.queryWeight
+}
+
+func (l *postingList) next() error {
+	return l.postings.Next()
+}
+
+// advanceTo moves the cursor to the first posting with a doc id of at least
+// docID, blocks before it are skipped without being read
+func (l *postingList) advanceTo(docID uint64) error {
+	return l.postings.AdvanceTo(docID)
+}
+
+// wand returns the k documents with the highest dot product with the query,
+// ordered by descending score. Lists are traversed with the WAND algorithm:
+// documents whose score can not exceed the k-th best score found so far,
+// according to the upper bounds of the lists they appear in, are skipped
+// without being scored. Documents not contained in a non-nil allow list are
+// never returned.
+func wand(ctx context.Context, lists []*postingList, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	heap := priorityqueue.NewMin[any](k)
+	active := make([]*postingList, 0, len(lists))
+	for _, l := range lists {
+		if !l.exhausted() {
+			active = append(active, l)
+		}
+	}
+
+	for iterations := 0; len(active) > 0; iterations++ {
+		if iterations%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		sort.Slice(active, func(i, j int) bool {
+			return active[i].docID() < active[j].docID()
+		})
+
+		full := heap.Len() == k
+		var threshold float32
+		if full {
+			threshold = heap.Top().Dist
+		}
+
+		pivot := -1
+		var bound float32
+		for i, l := range active {
+			bound += l.upperBound
+			if !full || bound > threshold {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			// no remaining document can make it into the top k
+			break
+		}
+
+		pivotDocID := active[pivot].docID()
+		if active[0].docID() == pivotDocID {
+			// all lists up to the pivot are positioned on the pivot document,
+			// so it is worth to be scored fully
+			var score float32
+			for _, l := range active {
+				if l.exhausted() || l.docID() != pivotDocID {
+					break
+				}
+				score += l.score()
+				if err := l.next(); err != nil {
+					return nil, nil, err
+				}
+			}
+			if allow == nil || allow.Contains(pivotDocID) {
+				if !full {
+					heap.Insert(pivotDocID, score)
+				} else if score > threshold {
+					heap.Pop()
+					heap.Insert(pivotDocID, score)
+				}
+			}
+		} else {
+			// documents before the pivot can not make it into the top k
+			for _, l := range active[:pivot] {
+				if err := l.advanceTo(pivotDocID); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+
+		n := 0
+		for _, l := range active {
+			if !l.exhausted() {
+				active[n] = l
+				n++
+			}
+		}
+		active = active[:n]
+	}
+
+	ids := make([]uint64, heap.Len())
+	scores := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i], scores[i] = item.ID, item.Dist
+	}
+	return ids, scores, nil
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+)
+
+type testDoc struct {
+	dims    []uint32
+	weights []float32
+}
+
+func randomDocs(r *rand.Rand, count, dims, nnz int) []testDoc {
+	docs := make([]testDoc, count)
+	for i := range docs {
+		seen := map[uint32]struct{}{}
+		for len(docs[i].dims) < nnz {
+			dim := uint32(r.Intn(dims))
+			if _, ok := seen[dim]; ok {
+				continue
+			}
+			seen[dim] = struct{}{}
+			docs[i].dims = append(docs[i].dims, dim)
+			docs[i].weights = append(docs[i].weights, r.Float32()*3)
+		}
+	}
+	return docs
+}
+
+func postingListsFor(t *testing.T, docs []testDoc, query testDoc) []*postingList {
+	lists := make([]*postingList, 0, len(query.dims))
+	for q, dim := range query.dims {
+		var postings []terms.DocPointerWithScore
+		for id, doc := range docs {
+			for j, d := range doc.dims {
+				if d == dim {
+					postings = append(postings, terms.DocPointerWithScore{
+						Id: uint64(id), Frequency: doc.weights[j], PropLength: doc.weights[j],
+					})
+				}
+			}
+		}
+		l, err := newPostingList([]terms.PostingLayer{terms.NewDecodedPostingLayer(postings)},
+			query.weights[q])
+		require.Nil(t, err)
+		lists = append(lists, l)
+	}
+	return lists
+}
+
+func bruteForce(docs []testDoc, query testDoc, k int, allow helpers.AllowList) ([]uint64, []float32) {
+	type scored struct {
+		id    uint64
+		score float32
+	}
+	var results []scored
+	for id, doc := range docs {
+		if allow != nil && !allow.Contains(uint64(id)) {
+			continue
+		}
+		var score float32
+		matched := false
+		for q, qdim := range query.dims {
+			for j, d := range doc.dims {
+				if d == qdim {
+					score += query.weights[q] * doc.weights[j]
+					matched = true
+				}
+			}
+		}
+		if matched {
+			results = append(results, scored{uint64(id), score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+	if len(results) > k {
+		results = results[:k]
+	}
+	ids := make([]uint64, len(results))
+	scores := make([]float32, len(results))
+	for i := range results {
+		ids[i], scores[i] = results[i].id, results[i].score
+	}
+	return ids, scores
+}
+
+func TestWand(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	docs := randomDocs(r, 2000, 300, 20)
+
+	for _, k := range []int{1, 10, 100} {
+		for i := 0; i < 20; i++ {
+			query := randomDocs(r, 1, 300, 8)[0]
+
+			ids, scores, err := wand(context.Background(), postingListsFor(t, docs, query), k, nil)
+			require.Nil(t, err)
+
+			_, expectedScores := bruteForce(docs, query, k, nil)
+			require.Len(t, scores, len(expectedScores))
+			for j := range scores {
+				assert.InDelta(t, expectedScores[j], scores[j], 1e-4)
+			}
+			assert.Len(t, ids, len(expectedScores))
+		}
+	}
+}
+
+func TestWand_AllowList(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	docs := randomDocs(r, 1000, 100, 10)
+
+	allow := helpers.NewAllowList()
+	for id := range docs {
+		if id%3 == 0 {
+			allow.Insert(uint64(id))
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		query := randomDocs(r, 1, 100, 5)[0]
+
+		ids, scores, err := wand(context.Background(), postingListsFor(t, docs, query), 10, allow)
+		require.Nil(t, err)
+		for _, id := range ids {
+			assert.True(t, allow.Contains(id))
+		}
+
+		_, expectedScores := bruteForce(docs, query, 10, allow)
+		require.Len(t, scores, len(expectedScores))
+		for j := range scores {
+			assert.InDelta(t, expectedScores[j], scores[j], 1e-4)
+		}
+	}
+}
+
+func TestWand_NegativeWeights(t *testing.T) {
+	docs := []testDoc{
+		{dims: []uint32{1, 2}, weights: []float32{1, -2}},
+		{dims: []uint32{1}, weights: []float32{0.5}},
+		{dims: []uint32{2}, weights: []float32{-1.5}},
+	}
+	query := testDoc{dims: []uint32{1, 2}, weights: []float32{1, 1}}
+
+	ids, scores, err := wand(context.Background(), postingListsFor(t, docs, query), 3, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 0, 2}, ids)
+	assert.Equal(t, []float32{0.5, -1, -1.5}, scores)
+}
+
+func TestWand_CanceledContext(t *testing.T) {
+	docs := randomDocs(rand.New(rand.NewSource(1)), 10, 10, 2)
+	query := testDoc{dims: []uint32{1}, weights: []float32{1}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err := wand(ctx, postingListsFor(t, docs, query), 3, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -52,4 +52,11 @@ type VectorIndex interface {
 	DistancerProvider() distancer.Provider
 	QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer
 	Stats() (common.IndexStats, error)
+	// Multivector returns true if every object is represented by a list of
+	// vectors. Such indexes are searched by passing the concatenated query
+	// vectors to SearchByVector and SearchByVectorDistance.
+	Multivector() bool
+	AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error
+	AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error
+	ValidateMultiBeforeInsert(vectors [][]float32) error
 }
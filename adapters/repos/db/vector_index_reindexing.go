@@ -0,0 +1,426 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+)
+
+// reindexingIndex wraps the live vector index of a target vector while it is
+// rebuilt with a new configuration. Reads are served by the live index, every
+// write and delete is also forwarded to the shadow index that is being built.
+// Once the shadow is swapped in, the wrapper only delegates to the new index,
+// so that callers still holding a reference keep working.
+type reindexingIndex struct {
+	// guards live and shadow, the write lock is only taken to swap them
+	sync.RWMutex
+
+	live   VectorIndex
+	shadow *shadowIndex
+
+	// reindexing is true until the new index has replaced the live one.
+	// It is still true if the shadow was discarded after a failure, as the
+	// live index does not match the configuration of the class anymore.
+	reindexing bool
+
+	// path of the reindex marker, it is part of the backup of the shard
+	markerPath string
+}
+
+func newReindexingIndex(live VectorIndex, markerPath string) *reindexingIndex {
+	return &reindexingIndex{
+		live:       live,
+		reindexing: true,
+		markerPath: markerPath,
+	}
+}
+
+// shadowIndex is a vector index built next to the live index of a shard, with
+// its files in its own directory and its buckets in its own store.
+type shadowIndex struct {
+	index VectorIndex
+	store *lsmkv.Store
+	dir   string
+
+	sync.Mutex
+	// ids deleted while the shadow is filled from the object store. A vector
+	// read from the object store before it was deleted must not be added back,
+	// deleted is nil once the fill is complete.
+	deleted map[uint64]struct{}
+	// first error returned by the shadow index for a forwarded write. It does
+	// not fail the write on the live index, but fails the reindex.
+	err error
+}
+
+func (s *shadowIndex) fail(err error) {
+	if err == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+func (s *shadowIndex) failure() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.err
+}
+
+func (s *shadowIndex) delete(ids ...uint64) {
+	s.Lock()
+	if s.deleted != nil {
+		for _, id := range ids {
+			s.deleted[id] = struct{}{}
+		}
+	}
+	s.Unlock()
+
+	s.fail(s.index.Delete(ids...))
+}
+
+func (s *shadowIndex) isDeleted(id uint64) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.deleted[id]
+	return ok
+}
+
+// completeFill deletes again the ids deleted during the fill, in case their
+// vectors were added concurrently, and stops tracking deletes.
+func (s *shadowIndex) completeFill() error {
+	s.Lock()
+	ids := make([]uint64, 0, len(s.deleted))
+	for id := range s.deleted {
+		ids = append(ids, id)
+	}
+	s.deleted = nil
+	s.Unlock()
+
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.index.Delete(ids...)
+}
+
+// close flushes and shuts down the shadow index and its store, its files are
+// kept on disk.
+func (s *shadowIndex) close(ctx context.Context) error {
+	if err := s.index.Flush(); err != nil {
+		return errors.Wrap(err, "flush shadow vector index")
+	}
+	if err := s.index.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "shut down shadow vector index")
+	}
+	if err := s.store.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "shut down shadow store")
+	}
+	return nil
+}
+
+// discard closes the shadow index and removes its files.
+func (s *shadowIndex) discard(ctx context.Context) error {
+	if err := s.close(ctx); err != nil {
+		return err
+	}
+	return os.RemoveAll(s.dir)
+}
+
+// setShadow replaces the shadow index, discarding the previous one if any.
+func (r *reindexingIndex) setShadow(ctx context.Context, shadow *shadowIndex) error {
+	r.Lock()
+	defer r.Unlock()
+
+	var err error
+	if r.shadow != nil {
+		err = r.shadow.discard(ctx)
+	}
+	r.shadow = shadow
+	return err
+}
+
+func (r *reindexingIndex) Dump(labels ...string) {
+	r.RLock()
+	defer r.RUnlock()
+
+	r.live.Dump(labels...)
+}
+
+func (r *reindexingIndex) Add(ctx context.Context, id uint64, vector []float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if err := r.live.Add(ctx, id, vector); err != nil {
+		return err
+	}
+	if r.shadow != nil {
+		r.shadow.fail(r.shadow.index.Add(ctx, id, vector))
+	}
+	return nil
+}
+
+func (r *reindexingIndex) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if err := r.live.AddBatch(ctx, ids, vectors); err != nil {
+		return err
+	}
+	if r.shadow != nil {
+		r.shadow.fail(r.shadow.index.AddBatch(ctx, ids, vectors))
+	}
+	return nil
+}
+
+func (r *reindexingIndex) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.AddMulti(ctx, docID, vectors)
+}
+
+func (r *reindexingIndex) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.AddMultiBatch(ctx, docIDs, vectors)
+}
+
+func (r *reindexingIndex) Delete(ids ...uint64) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if err := r.live.Delete(ids...); err != nil {
+		return err
+	}
+	if r.shadow != nil {
+		r.shadow.delete(ids...)
+	}
+	return nil
+}
+
+// DeleteFromShadow forwards the deletion of vectors that were not indexed
+// by the live index yet, see [shadowedIndexer].
+func (r *reindexingIndex) DeleteFromShadow(ids ...uint64) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.shadow != nil {
+		r.shadow.delete(ids...)
+	}
+}
+
+func (r *reindexingIndex) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.SearchByVector(ctx, vector, k, allow)
+}
+
+func (r *reindexingIndex) SearchByVectorDistance(ctx context.Context, vector []float32,
+	dist float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.SearchByVectorDistance(ctx, vector, dist, maxLimit, allow)
+}
+
+// UpdateUserConfig applies the update to the shadow index, as the class
+// config already describes the index being built. The live index keeps its
+// previous config.
+func (r *reindexingIndex) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if !r.reindexing {
+		return r.live.UpdateUserConfig(updated, callback)
+	}
+	if r.shadow != nil {
+		return r.shadow.index.UpdateUserConfig(updated, callback)
+	}
+	// the update is picked up when the reindex is resumed
+	callback()
+	return nil
+}
+
+func (r *reindexingIndex) Drop(ctx context.Context) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.shadow != nil {
+		if err := r.shadow.discard(ctx); err != nil {
+			return fmt.Errorf("drop shadow vector index: %w", err)
+		}
+		r.shadow = nil
+	}
+	if err := os.Remove(r.markerPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove reindex marker: %w", err)
+	}
+	return r.live.Drop(ctx)
+}
+
+func (r *reindexingIndex) Shutdown(ctx context.Context) error {
+	r.Lock()
+	defer r.Unlock()
+
+	// the shadow is rebuilt from scratch on the next startup
+	if r.shadow != nil {
+		if err := r.shadow.close(ctx); err != nil {
+			return err
+		}
+		r.shadow = nil
+	}
+	return r.live.Shutdown(ctx)
+}
+
+func (r *reindexingIndex) Flush() error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.shadow != nil {
+		r.shadow.fail(r.shadow.index.Flush())
+	}
+	return r.live.Flush()
+}
+
+func (r *reindexingIndex) SwitchCommitLogs(ctx context.Context) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.SwitchCommitLogs(ctx)
+}
+
+// ListFiles lists the files of the live index. The marker is included, so
+// that a restored shard loads the live index with its own config and
+// resumes the reindex.
+func (r *reindexingIndex) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	files, err := r.live.ListFiles(ctx, basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(r.markerPath); err == nil {
+		relPath, err := filepath.Rel(basePath, r.markerPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		files = append(files, relPath)
+	}
+	return files, nil
+}
+
+func (r *reindexingIndex) PostStartup() {
+	r.RLock()
+	defer r.RUnlock()
+
+	r.live.PostStartup()
+}
+
+func (r *reindexingIndex) Compressed() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.Compressed()
+}
+
+func (r *reindexingIndex) ValidateBeforeInsert(vector []float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.ValidateBeforeInsert(vector)
+}
+
+func (r *reindexingIndex) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.ValidateMultiBeforeInsert(vectors)
+}
+
+func (r *reindexingIndex) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.DistanceBetweenVectors(x, y)
+}
+
+func (r *reindexingIndex) ContainsNode(id uint64) bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.ContainsNode(id)
+}
+
+func (r *reindexingIndex) AlreadyIndexed() uint64 {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.AlreadyIndexed()
+}
+
+func (r *reindexingIndex) Iterate(fn func(id uint64) bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	r.live.Iterate(fn)
+}
+
+func (r *reindexingIndex) DistancerProvider() distancer.Provider {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.DistancerProvider()
+}
+
+func (r *reindexingIndex) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.QueryVectorDistancer(queryVector)
+}
+
+func (r *reindexingIndex) Stats() (common.IndexStats, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.Stats()
+}
+
+func (r *reindexingIndex) Multivector() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.live.Multivector()
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestVectorIndexReindex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	newRepo := func(t *testing.T) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushDirtyAfter:  60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       100,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+		require.Nil(t, err)
+		return repo
+	}
+
+	class := &models.Class{
+		Class:               "ReindexClass",
+		InvertedIndexConfig: invertedConfig(),
+		VectorIndexType:     "flat",
+		VectorIndexConfig:   flatent.NewDefaultUserConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: schema.DataTypeText.PropString(),
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo := newRepo(t)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	r := rand.New(rand.NewSource(7))
+	ids := make([]strfmt.UUID, 500)
+	vectors := make([][]float32, len(ids))
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+		vectors[i] = make([]float32, 8)
+		for j := range vectors[i] {
+			vectors[i][j] = r.Float32()
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: ids[i], Class: class.Class},
+			vectors[i], nil, nil, 0))
+	}
+	require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[17], time.Now(), nil, "", 0))
+
+	searchFirst := func(t *testing.T, repo *DB, vector []float32) strfmt.UUID {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 1},
+		}, []string{""}, [][]float32{vector})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		return res[0].ID
+	}
+
+	forShard := func(repo *DB, fn func(shard ShardLike)) {
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(_ string, shard ShardLike) error {
+			fn(shard)
+			return nil
+		})
+	}
+
+	t.Run("reindex flat into hnsw", func(t *testing.T) {
+		hnswConfig := hnswent.NewDefaultUserConfig()
+		require.Nil(t, migrator.ReindexVectorIndex(ctx, class.Class, "", hnswConfig))
+		class.VectorIndexType = "hnsw"
+		class.VectorIndexConfig = hnswConfig
+
+		// objects written while the shadow index is built must be searchable
+		// after the swap
+		require.Nil(t, repo.PutObject(ctx, &models.Object{ID: ids[0], Class: class.Class},
+			vectors[0], nil, nil, 0))
+
+		require.Eventually(t, func() bool {
+			done := true
+			forShard(repo, func(shard ShardLike) {
+				done = done && len(shard.VectorReindexStatuses()) == 0
+			})
+			return done
+		}, 30*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("shard serves the new index", func(t *testing.T) {
+		forShard(repo, func(shard ShardLike) {
+			stats, err := shard.VectorIndex().Stats()
+			require.Nil(t, err)
+			assert.Equal(t, common.IndexTypeHNSW, string(stats.IndexType()))
+		})
+
+		for _, i := range []int{0, 123, 499} {
+			assert.Equal(t, ids[i], searchFirst(t, repo, vectors[i]))
+		}
+		assert.NotEqual(t, ids[17], searchFirst(t, repo, vectors[17]))
+	})
+
+	t.Run("no reindex leftovers on disk", func(t *testing.T) {
+		matches, err := filepath.Glob(filepath.Join(dirName, "*", "*", "*.reindex*"))
+		require.Nil(t, err)
+		assert.Empty(t, matches)
+	})
+
+	t.Run("new index is loaded after restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+
+		repo = newRepo(t)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		defer repo.Shutdown(ctx)
+
+		forShard(repo, func(shard ShardLike) {
+			stats, err := shard.VectorIndex().Stats()
+			require.Nil(t, err)
+			assert.Equal(t, common.IndexTypeHNSW, string(stats.IndexType()))
+		})
+		for _, i := range []int{0, 123, 499} {
+			assert.Equal(t, ids[i], searchFirst(t, repo, vectors[i]))
+		}
+	})
+}
@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestFilteredVectorSearchExplain(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "ExplainVectorSearch"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:     "number",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		vec := make([]float32, 8)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+	for i := 0; i < 500; i++ {
+		obj := &models.Object{
+			Class:      className,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Properties: map[string]interface{}{"number": int64(i % 10)},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, randomVector(), nil, nil, 0))
+	}
+
+	filter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(className),
+				Property: "number",
+			},
+			Value: &filters.Value{
+				Value: 3,
+				Type:  schema.DataTypeInt,
+			},
+		},
+	}
+	query := randomVector()
+
+	search := func(t *testing.T, filter *filters.LocalFilter, addl additional.Properties) []*additional.VectorSearchExplain {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:            className,
+			Pagination:           &filters.Pagination{Limit: 10},
+			Filters:              filter,
+			AdditionalProperties: addl,
+		}, []string{""}, [][]float32{query})
+		require.Nil(t, err)
+		require.Len(t, res, 10)
+
+		explanations := make([]*additional.VectorSearchExplain, len(res))
+		for i := range res {
+			explain, _ := res[i].AdditionalProperties["explainVectorSearch"].(*additional.VectorSearchExplain)
+			explanations[i] = explain
+		}
+		return explanations
+	}
+
+	t.Run("without explanation", func(t *testing.T) {
+		for _, explain := range search(t, filter, additional.Properties{}) {
+			assert.Nil(t, explain)
+		}
+	})
+
+	t.Run("index decides", func(t *testing.T) {
+		for _, explain := range search(t, filter, additional.Properties{ExplainVectorSearch: true}) {
+			require.NotNil(t, explain)
+			assert.NotEmpty(t, explain.Shard)
+			assert.Equal(t, 50, explain.AllowListSize)
+			// far below the flat search cutoff
+			assert.Equal(t, enthnsw.FilterStrategyFlat, explain.FilterStrategy)
+			assert.Equal(t, int64(50), explain.DistanceComputations)
+		}
+	})
+
+	t.Run("overridden by the query", func(t *testing.T) {
+		for _, strategy := range []string{enthnsw.FilterStrategySweeping, enthnsw.FilterStrategyAcorn} {
+			explanations := search(t, filter, additional.Properties{
+				ExplainVectorSearch: true,
+				FilterStrategy:      strategy,
+			})
+			for _, explain := range explanations {
+				require.NotNil(t, explain)
+				assert.Equal(t, 50, explain.AllowListSize)
+				assert.Equal(t, strategy, explain.FilterStrategy)
+				assert.Positive(t, explain.NodesVisited)
+			}
+		}
+	})
+
+	t.Run("unfiltered", func(t *testing.T) {
+		for _, explain := range search(t, nil, additional.Properties{ExplainVectorSearch: true}) {
+			require.NotNil(t, explain)
+			assert.Equal(t, -1, explain.AllowListSize)
+			assert.Empty(t, explain.FilterStrategy)
+			assert.Positive(t, explain.NodesVisited)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewClusterAsyncReplicationPauseParams creates a new ClusterAsyncReplicationPauseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewClusterAsyncReplicationPauseParams() *ClusterAsyncReplicationPauseParams {
+	return &ClusterAsyncReplicationPauseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClusterAsyncReplicationPauseParamsWithTimeout creates a new ClusterAsyncReplicationPauseParams object
+// with the ability to set a timeout on a request.
+func NewClusterAsyncReplicationPauseParamsWithTimeout(timeout time.Duration) *ClusterAsyncReplicationPauseParams {
+	return &ClusterAsyncReplicationPauseParams{
+		timeout: timeout,
+	}
+}
+
+// NewClusterAsyncReplicationPauseParamsWithContext creates a new ClusterAsyncReplicationPauseParams object
+// with the ability to set a context for a request.
+func NewClusterAsyncReplicationPauseParamsWithContext(ctx context.Context) *ClusterAsyncReplicationPauseParams {
+	return &ClusterAsyncReplicationPauseParams{
+		Context: ctx,
+	}
+}
+
+// NewClusterAsyncReplicationPauseParamsWithHTTPClient creates a new ClusterAsyncReplicationPauseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewClusterAsyncReplicationPauseParamsWithHTTPClient(client *http.Client) *ClusterAsyncReplicationPauseParams {
+	return &ClusterAsyncReplicationPauseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ClusterAsyncReplicationPauseParams contains all the parameters to send to the API endpoint
+
+	for the cluster async replication pause operation.
+
+	Typically these are written to a http.Request.
+*/
+type ClusterAsyncReplicationPauseParams struct {
+
+	/* ClassName.
+
+	   The name of the collection.
+	*/
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cluster async replication pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationPauseParams) WithDefaults() *ClusterAsyncReplicationPauseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cluster async replication pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationPauseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) WithTimeout(timeout time.Duration) *ClusterAsyncReplicationPauseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) WithContext(ctx context.Context) *ClusterAsyncReplicationPauseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) WithHTTPClient(client *http.Client) *ClusterAsyncReplicationPauseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) WithClassName(className string) *ClusterAsyncReplicationPauseParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the cluster async replication pause params
+func (o *ClusterAsyncReplicationPauseParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClusterAsyncReplicationPauseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
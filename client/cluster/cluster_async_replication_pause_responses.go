@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationPauseReader is a Reader for the ClusterAsyncReplicationPause structure.
+type ClusterAsyncReplicationPauseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterAsyncReplicationPauseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterAsyncReplicationPauseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterAsyncReplicationPauseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterAsyncReplicationPauseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterAsyncReplicationPauseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterAsyncReplicationPauseUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterAsyncReplicationPauseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterAsyncReplicationPauseOK creates a ClusterAsyncReplicationPauseOK with default headers values
+func NewClusterAsyncReplicationPauseOK() *ClusterAsyncReplicationPauseOK {
+	return &ClusterAsyncReplicationPauseOK{}
+}
+
+/*
+ClusterAsyncReplicationPauseOK describes a response with status code 200, with default header values.
+
+Async replication successfully paused
+*/
+type ClusterAsyncReplicationPauseOK struct {
+	Payload *models.ReplicationConfig
+}
+
+// IsSuccess returns true when this cluster async replication pause o k response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster async replication pause o k response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause o k response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication pause o k response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication pause o k response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster async replication pause o k response
+func (o *ClusterAsyncReplicationPauseOK) Code() int {
+	return 200
+}
+
+func (o *ClusterAsyncReplicationPauseOK) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseOK) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseOK) GetPayload() *models.ReplicationConfig {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationPauseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationConfig)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationPauseUnauthorized creates a ClusterAsyncReplicationPauseUnauthorized with default headers values
+func NewClusterAsyncReplicationPauseUnauthorized() *ClusterAsyncReplicationPauseUnauthorized {
+	return &ClusterAsyncReplicationPauseUnauthorized{}
+}
+
+/*
+ClusterAsyncReplicationPauseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterAsyncReplicationPauseUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster async replication pause unauthorized response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication pause unauthorized response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause unauthorized response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication pause unauthorized response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication pause unauthorized response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster async replication pause unauthorized response
+func (o *ClusterAsyncReplicationPauseUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterAsyncReplicationPauseUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationPauseUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationPauseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterAsyncReplicationPauseForbidden creates a ClusterAsyncReplicationPauseForbidden with default headers values
+func NewClusterAsyncReplicationPauseForbidden() *ClusterAsyncReplicationPauseForbidden {
+	return &ClusterAsyncReplicationPauseForbidden{}
+}
+
+/*
+ClusterAsyncReplicationPauseForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterAsyncReplicationPauseForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication pause forbidden response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication pause forbidden response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause forbidden response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication pause forbidden response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication pause forbidden response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster async replication pause forbidden response
+func (o *ClusterAsyncReplicationPauseForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterAsyncReplicationPauseForbidden) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseForbidden) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationPauseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationPauseNotFound creates a ClusterAsyncReplicationPauseNotFound with default headers values
+func NewClusterAsyncReplicationPauseNotFound() *ClusterAsyncReplicationPauseNotFound {
+	return &ClusterAsyncReplicationPauseNotFound{}
+}
+
+/*
+ClusterAsyncReplicationPauseNotFound describes a response with status code 404, with default header values.
+
+Collection not found.
+*/
+type ClusterAsyncReplicationPauseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication pause not found response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication pause not found response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause not found response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication pause not found response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication pause not found response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster async replication pause not found response
+func (o *ClusterAsyncReplicationPauseNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterAsyncReplicationPauseNotFound) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseNotFound) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationPauseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationPauseUnprocessableEntity creates a ClusterAsyncReplicationPauseUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationPauseUnprocessableEntity() *ClusterAsyncReplicationPauseUnprocessableEntity {
+	return &ClusterAsyncReplicationPauseUnprocessableEntity{}
+}
+
+/*
+ClusterAsyncReplicationPauseUnprocessableEntity describes a response with status code 422, with default header values.
+
+Async replication is disabled for the collection.
+*/
+type ClusterAsyncReplicationPauseUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication pause unprocessable entity response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication pause unprocessable entity response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause unprocessable entity response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication pause unprocessable entity response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication pause unprocessable entity response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster async replication pause unprocessable entity response
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationPauseUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationPauseInternalServerError creates a ClusterAsyncReplicationPauseInternalServerError with default headers values
+func NewClusterAsyncReplicationPauseInternalServerError() *ClusterAsyncReplicationPauseInternalServerError {
+	return &ClusterAsyncReplicationPauseInternalServerError{}
+}
+
+/*
+ClusterAsyncReplicationPauseInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterAsyncReplicationPauseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication pause internal server error response has a 2xx status code
+func (o *ClusterAsyncReplicationPauseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication pause internal server error response has a 3xx status code
+func (o *ClusterAsyncReplicationPauseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication pause internal server error response has a 4xx status code
+func (o *ClusterAsyncReplicationPauseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication pause internal server error response has a 5xx status code
+func (o *ClusterAsyncReplicationPauseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster async replication pause internal server error response a status code equal to that given
+func (o *ClusterAsyncReplicationPauseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster async replication pause internal server error response
+func (o *ClusterAsyncReplicationPauseInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterAsyncReplicationPauseInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/pause][%d] clusterAsyncReplicationPauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationPauseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationPauseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
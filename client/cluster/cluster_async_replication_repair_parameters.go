@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewClusterAsyncReplicationRepairParams creates a new ClusterAsyncReplicationRepairParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewClusterAsyncReplicationRepairParams() *ClusterAsyncReplicationRepairParams {
+	return &ClusterAsyncReplicationRepairParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClusterAsyncReplicationRepairParamsWithTimeout creates a new ClusterAsyncReplicationRepairParams object
+// with the ability to set a timeout on a request.
+func NewClusterAsyncReplicationRepairParamsWithTimeout(timeout time.Duration) *ClusterAsyncReplicationRepairParams {
+	return &ClusterAsyncReplicationRepairParams{
+		timeout: timeout,
+	}
+}
+
+// NewClusterAsyncReplicationRepairParamsWithContext creates a new ClusterAsyncReplicationRepairParams object
+// with the ability to set a context for a request.
+func NewClusterAsyncReplicationRepairParamsWithContext(ctx context.Context) *ClusterAsyncReplicationRepairParams {
+	return &ClusterAsyncReplicationRepairParams{
+		Context: ctx,
+	}
+}
+
+// NewClusterAsyncReplicationRepairParamsWithHTTPClient creates a new ClusterAsyncReplicationRepairParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewClusterAsyncReplicationRepairParamsWithHTTPClient(client *http.Client) *ClusterAsyncReplicationRepairParams {
+	return &ClusterAsyncReplicationRepairParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ClusterAsyncReplicationRepairParams contains all the parameters to send to the API endpoint
+
+	for the cluster async replication repair operation.
+
+	Typically these are written to a http.Request.
+*/
+type ClusterAsyncReplicationRepairParams struct {
+
+	/* ClassName.
+
+	   The name of the collection.
+	*/
+	ClassName string
+
+	/* Shard.
+
+	   The name of the shard to repair. All shards are repaired if not set.
+	*/
+	Shard *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cluster async replication repair params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationRepairParams) WithDefaults() *ClusterAsyncReplicationRepairParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cluster async replication repair params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationRepairParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) WithTimeout(timeout time.Duration) *ClusterAsyncReplicationRepairParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) WithContext(ctx context.Context) *ClusterAsyncReplicationRepairParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) WithHTTPClient(client *http.Client) *ClusterAsyncReplicationRepairParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) WithClassName(className string) *ClusterAsyncReplicationRepairParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WithShard adds the shard to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) WithShard(shard *string) *ClusterAsyncReplicationRepairParams {
+	o.SetShard(shard)
+	return o
+}
+
+// SetShard adds the shard to the cluster async replication repair params
+func (o *ClusterAsyncReplicationRepairParams) SetShard(shard *string) {
+	o.Shard = shard
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClusterAsyncReplicationRepairParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if o.Shard != nil {
+
+		// query param shard
+		var qrShard string
+
+		if o.Shard != nil {
+			qrShard = *o.Shard
+		}
+		qShard := qrShard
+		if qShard != "" {
+
+			if err := r.SetQueryParam("shard", qShard); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
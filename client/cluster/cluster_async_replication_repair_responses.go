@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationRepairReader is a Reader for the ClusterAsyncReplicationRepair structure.
+type ClusterAsyncReplicationRepairReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterAsyncReplicationRepairReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterAsyncReplicationRepairOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterAsyncReplicationRepairUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterAsyncReplicationRepairForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterAsyncReplicationRepairNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterAsyncReplicationRepairUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterAsyncReplicationRepairInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterAsyncReplicationRepairOK creates a ClusterAsyncReplicationRepairOK with default headers values
+func NewClusterAsyncReplicationRepairOK() *ClusterAsyncReplicationRepairOK {
+	return &ClusterAsyncReplicationRepairOK{}
+}
+
+/*
+ClusterAsyncReplicationRepairOK describes a response with status code 200, with default header values.
+
+Repair of the shards successfully started
+*/
+type ClusterAsyncReplicationRepairOK struct {
+	Payload *models.AsyncReplicationRepairResponse
+}
+
+// IsSuccess returns true when this cluster async replication repair o k response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster async replication repair o k response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair o k response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication repair o k response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication repair o k response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster async replication repair o k response
+func (o *ClusterAsyncReplicationRepairOK) Code() int {
+	return 200
+}
+
+func (o *ClusterAsyncReplicationRepairOK) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairOK) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairOK) GetPayload() *models.AsyncReplicationRepairResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationRepairOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AsyncReplicationRepairResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationRepairUnauthorized creates a ClusterAsyncReplicationRepairUnauthorized with default headers values
+func NewClusterAsyncReplicationRepairUnauthorized() *ClusterAsyncReplicationRepairUnauthorized {
+	return &ClusterAsyncReplicationRepairUnauthorized{}
+}
+
+/*
+ClusterAsyncReplicationRepairUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterAsyncReplicationRepairUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster async replication repair unauthorized response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication repair unauthorized response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair unauthorized response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication repair unauthorized response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication repair unauthorized response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster async replication repair unauthorized response
+func (o *ClusterAsyncReplicationRepairUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterAsyncReplicationRepairUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationRepairUnauthorized) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationRepairUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterAsyncReplicationRepairForbidden creates a ClusterAsyncReplicationRepairForbidden with default headers values
+func NewClusterAsyncReplicationRepairForbidden() *ClusterAsyncReplicationRepairForbidden {
+	return &ClusterAsyncReplicationRepairForbidden{}
+}
+
+/*
+ClusterAsyncReplicationRepairForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterAsyncReplicationRepairForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication repair forbidden response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication repair forbidden response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair forbidden response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication repair forbidden response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication repair forbidden response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster async replication repair forbidden response
+func (o *ClusterAsyncReplicationRepairForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterAsyncReplicationRepairForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairForbidden) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationRepairForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationRepairNotFound creates a ClusterAsyncReplicationRepairNotFound with default headers values
+func NewClusterAsyncReplicationRepairNotFound() *ClusterAsyncReplicationRepairNotFound {
+	return &ClusterAsyncReplicationRepairNotFound{}
+}
+
+/*
+ClusterAsyncReplicationRepairNotFound describes a response with status code 404, with default header values.
+
+Collection or shard not found.
+*/
+type ClusterAsyncReplicationRepairNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication repair not found response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication repair not found response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair not found response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication repair not found response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication repair not found response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster async replication repair not found response
+func (o *ClusterAsyncReplicationRepairNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterAsyncReplicationRepairNotFound) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairNotFound) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationRepairNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationRepairUnprocessableEntity creates a ClusterAsyncReplicationRepairUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationRepairUnprocessableEntity() *ClusterAsyncReplicationRepairUnprocessableEntity {
+	return &ClusterAsyncReplicationRepairUnprocessableEntity{}
+}
+
+/*
+ClusterAsyncReplicationRepairUnprocessableEntity describes a response with status code 422, with default header values.
+
+Async replication is disabled for the collection.
+*/
+type ClusterAsyncReplicationRepairUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication repair unprocessable entity response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication repair unprocessable entity response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair unprocessable entity response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication repair unprocessable entity response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication repair unprocessable entity response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster async replication repair unprocessable entity response
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationRepairUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationRepairInternalServerError creates a ClusterAsyncReplicationRepairInternalServerError with default headers values
+func NewClusterAsyncReplicationRepairInternalServerError() *ClusterAsyncReplicationRepairInternalServerError {
+	return &ClusterAsyncReplicationRepairInternalServerError{}
+}
+
+/*
+ClusterAsyncReplicationRepairInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterAsyncReplicationRepairInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication repair internal server error response has a 2xx status code
+func (o *ClusterAsyncReplicationRepairInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication repair internal server error response has a 3xx status code
+func (o *ClusterAsyncReplicationRepairInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication repair internal server error response has a 4xx status code
+func (o *ClusterAsyncReplicationRepairInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication repair internal server error response has a 5xx status code
+func (o *ClusterAsyncReplicationRepairInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster async replication repair internal server error response a status code equal to that given
+func (o *ClusterAsyncReplicationRepairInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster async replication repair internal server error response
+func (o *ClusterAsyncReplicationRepairInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterAsyncReplicationRepairInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairInternalServerError) String() string {
+	return fmt.Sprintf("[POST /cluster/async-replication/{className}/repair][%d] clusterAsyncReplicationRepairInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationRepairInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationRepairInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
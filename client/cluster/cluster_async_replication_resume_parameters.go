@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewClusterAsyncReplicationResumeParams creates a new ClusterAsyncReplicationResumeParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewClusterAsyncReplicationResumeParams() *ClusterAsyncReplicationResumeParams {
+	return &ClusterAsyncReplicationResumeParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClusterAsyncReplicationResumeParamsWithTimeout creates a new ClusterAsyncReplicationResumeParams object
+// with the ability to set a timeout on a request.
+func NewClusterAsyncReplicationResumeParamsWithTimeout(timeout time.Duration) *ClusterAsyncReplicationResumeParams {
+	return &ClusterAsyncReplicationResumeParams{
+		timeout: timeout,
+	}
+}
+
+// NewClusterAsyncReplicationResumeParamsWithContext creates a new ClusterAsyncReplicationResumeParams object
+// with the ability to set a context for a request.
+func NewClusterAsyncReplicationResumeParamsWithContext(ctx context.Context) *ClusterAsyncReplicationResumeParams {
+	return &ClusterAsyncReplicationResumeParams{
+		Context: ctx,
+	}
+}
+
+// NewClusterAsyncReplicationResumeParamsWithHTTPClient creates a new ClusterAsyncReplicationResumeParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewClusterAsyncReplicationResumeParamsWithHTTPClient(client *http.Client) *ClusterAsyncReplicationResumeParams {
+	return &ClusterAsyncReplicationResumeParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ClusterAsyncReplicationResumeParams contains all the parameters to send to the API endpoint
+
+	for the cluster async replication resume operation.
+
+	Typically these are written to a http.Request.
+*/
+type ClusterAsyncReplicationResumeParams struct {
+
+	/* ClassName.
+
+	   The name of the collection.
+	*/
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cluster async replication resume params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationResumeParams) WithDefaults() *ClusterAsyncReplicationResumeParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cluster async replication resume params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterAsyncReplicationResumeParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) WithTimeout(timeout time.Duration) *ClusterAsyncReplicationResumeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) WithContext(ctx context.Context) *ClusterAsyncReplicationResumeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) WithHTTPClient(client *http.Client) *ClusterAsyncReplicationResumeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) WithClassName(className string) *ClusterAsyncReplicationResumeParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the cluster async replication resume params
+func (o *ClusterAsyncReplicationResumeParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClusterAsyncReplicationResumeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
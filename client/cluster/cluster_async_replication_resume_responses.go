@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterAsyncReplicationResumeReader is a Reader for the ClusterAsyncReplicationResume structure.
+type ClusterAsyncReplicationResumeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterAsyncReplicationResumeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterAsyncReplicationResumeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterAsyncReplicationResumeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterAsyncReplicationResumeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterAsyncReplicationResumeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterAsyncReplicationResumeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterAsyncReplicationResumeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterAsyncReplicationResumeOK creates a ClusterAsyncReplicationResumeOK with default headers values
+func NewClusterAsyncReplicationResumeOK() *ClusterAsyncReplicationResumeOK {
+	return &ClusterAsyncReplicationResumeOK{}
+}
+
+/*
+ClusterAsyncReplicationResumeOK describes a response with status code 200, with default header values.
+
+Async replication successfully resumed
+*/
+type ClusterAsyncReplicationResumeOK struct {
+	Payload *models.ReplicationConfig
+}
+
+// IsSuccess returns true when this cluster async replication resume o k response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster async replication resume o k response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume o k response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication resume o k response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication resume o k response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster async replication resume o k response
+func (o *ClusterAsyncReplicationResumeOK) Code() int {
+	return 200
+}
+
+func (o *ClusterAsyncReplicationResumeOK) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeOK) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeOK) GetPayload() *models.ReplicationConfig {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationResumeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationConfig)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationResumeUnauthorized creates a ClusterAsyncReplicationResumeUnauthorized with default headers values
+func NewClusterAsyncReplicationResumeUnauthorized() *ClusterAsyncReplicationResumeUnauthorized {
+	return &ClusterAsyncReplicationResumeUnauthorized{}
+}
+
+/*
+ClusterAsyncReplicationResumeUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterAsyncReplicationResumeUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster async replication resume unauthorized response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication resume unauthorized response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume unauthorized response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication resume unauthorized response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication resume unauthorized response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster async replication resume unauthorized response
+func (o *ClusterAsyncReplicationResumeUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterAsyncReplicationResumeUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationResumeUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeUnauthorized ", 401)
+}
+
+func (o *ClusterAsyncReplicationResumeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterAsyncReplicationResumeForbidden creates a ClusterAsyncReplicationResumeForbidden with default headers values
+func NewClusterAsyncReplicationResumeForbidden() *ClusterAsyncReplicationResumeForbidden {
+	return &ClusterAsyncReplicationResumeForbidden{}
+}
+
+/*
+ClusterAsyncReplicationResumeForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterAsyncReplicationResumeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication resume forbidden response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication resume forbidden response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume forbidden response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication resume forbidden response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication resume forbidden response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster async replication resume forbidden response
+func (o *ClusterAsyncReplicationResumeForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterAsyncReplicationResumeForbidden) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeForbidden) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationResumeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationResumeNotFound creates a ClusterAsyncReplicationResumeNotFound with default headers values
+func NewClusterAsyncReplicationResumeNotFound() *ClusterAsyncReplicationResumeNotFound {
+	return &ClusterAsyncReplicationResumeNotFound{}
+}
+
+/*
+ClusterAsyncReplicationResumeNotFound describes a response with status code 404, with default header values.
+
+Collection not found.
+*/
+type ClusterAsyncReplicationResumeNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication resume not found response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication resume not found response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume not found response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication resume not found response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication resume not found response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster async replication resume not found response
+func (o *ClusterAsyncReplicationResumeNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterAsyncReplicationResumeNotFound) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeNotFound) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationResumeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationResumeUnprocessableEntity creates a ClusterAsyncReplicationResumeUnprocessableEntity with default headers values
+func NewClusterAsyncReplicationResumeUnprocessableEntity() *ClusterAsyncReplicationResumeUnprocessableEntity {
+	return &ClusterAsyncReplicationResumeUnprocessableEntity{}
+}
+
+/*
+ClusterAsyncReplicationResumeUnprocessableEntity describes a response with status code 422, with default header values.
+
+Async replication is disabled for the collection.
+*/
+type ClusterAsyncReplicationResumeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication resume unprocessable entity response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication resume unprocessable entity response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume unprocessable entity response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster async replication resume unprocessable entity response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster async replication resume unprocessable entity response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster async replication resume unprocessable entity response
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationResumeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterAsyncReplicationResumeInternalServerError creates a ClusterAsyncReplicationResumeInternalServerError with default headers values
+func NewClusterAsyncReplicationResumeInternalServerError() *ClusterAsyncReplicationResumeInternalServerError {
+	return &ClusterAsyncReplicationResumeInternalServerError{}
+}
+
+/*
+ClusterAsyncReplicationResumeInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterAsyncReplicationResumeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster async replication resume internal server error response has a 2xx status code
+func (o *ClusterAsyncReplicationResumeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster async replication resume internal server error response has a 3xx status code
+func (o *ClusterAsyncReplicationResumeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster async replication resume internal server error response has a 4xx status code
+func (o *ClusterAsyncReplicationResumeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster async replication resume internal server error response has a 5xx status code
+func (o *ClusterAsyncReplicationResumeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster async replication resume internal server error response a status code equal to that given
+func (o *ClusterAsyncReplicationResumeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster async replication resume internal server error response
+func (o *ClusterAsyncReplicationResumeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterAsyncReplicationResumeInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /cluster/async-replication/{className}/resume][%d] clusterAsyncReplicationResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterAsyncReplicationResumeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterAsyncReplicationResumeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -41,11 +41,187 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ClusterAsyncReplicationPause(params *ClusterAsyncReplicationPauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationPauseOK, error)
+
+	ClusterAsyncReplicationRepair(params *ClusterAsyncReplicationRepairParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationRepairOK, error)
+
+	ClusterAsyncReplicationResume(params *ClusterAsyncReplicationResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationResumeOK, error)
+
+	ClusterDrainNode(params *ClusterDrainNodeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterDrainNodeOK, error)
+
 	ClusterGetStatistics(params *ClusterGetStatisticsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetStatisticsOK, error)
 
+	ClusterRebalance(params *ClusterRebalanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRebalanceOK, error)
+
+	ClusterReplicasMove(params *ClusterReplicasMoveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterReplicasMoveOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ClusterAsyncReplicationPause pauses async replication of a collection
+
+Stops comparing and repairing the replicas of the collection in the background, e.g. to reduce load during an incident. Changes are still tracked, so replicas are repaired once async replication is resumed. Manual repairs are still possible.
+*/
+func (a *Client) ClusterAsyncReplicationPause(params *ClusterAsyncReplicationPauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationPauseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterAsyncReplicationPauseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.asyncReplication.pause",
+		Method:             "PUT",
+		PathPattern:        "/cluster/async-replication/{className}/pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterAsyncReplicationPauseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterAsyncReplicationPauseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.asyncReplication.pause: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterAsyncReplicationRepair repairs the replicas of the shards of a collection
+
+Compares the replicas of each shard of the collection, or of the given shard only, and propagates the objects missing or outdated on any of them, until all replicas hold the same objects. The repair runs in the background, the request returns once it is started. Its progress is reported per replica by the async replication status in the verbose output of the nodes API.
+*/
+func (a *Client) ClusterAsyncReplicationRepair(params *ClusterAsyncReplicationRepairParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationRepairOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterAsyncReplicationRepairParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.asyncReplication.repair",
+		Method:             "POST",
+		PathPattern:        "/cluster/async-replication/{className}/repair",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterAsyncReplicationRepairReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterAsyncReplicationRepairOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.asyncReplication.repair: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterAsyncReplicationResume resumes async replication of a collection
+
+Resumes comparing and repairing the replicas of the collection in the background after it was paused.
+*/
+func (a *Client) ClusterAsyncReplicationResume(params *ClusterAsyncReplicationResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterAsyncReplicationResumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterAsyncReplicationResumeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.asyncReplication.resume",
+		Method:             "PUT",
+		PathPattern:        "/cluster/async-replication/{className}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterAsyncReplicationResumeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterAsyncReplicationResumeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.asyncReplication.resume: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterDrainNode moves all shard replicas off a node
+
+Moves every shard replica held by the node to other storage nodes. Like a replica move, each target is added to the replicas of the shard and synced with async replication before the node is removed from the replicas and deletes its local copy, so every collection with replicas on the node needs async replication. Replicas of inactive tenants are skipped and listed in the response. Once no replica is left, the node can be removed from the cluster.
+*/
+func (a *Client) ClusterDrainNode(params *ClusterDrainNodeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterDrainNodeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterDrainNodeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.drain.node",
+		Method:             "POST",
+		PathPattern:        "/cluster/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterDrainNodeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterDrainNodeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.drain.node: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ClusterGetStatistics sees raft cluster statistics
 
@@ -87,6 +263,88 @@ func (a *Client) ClusterGetStatistics(params *ClusterGetStatisticsParams, authIn
 	panic(msg)
 }
 
+/*
+ClusterRebalance evens out the number of objects stored by nodes
+
+Proposes shard replica moves from the nodes storing the most objects, as reported by the nodes endpoint, to the nodes storing the fewest. Unless a dry run is requested, the moves are executed one after another. Only replicas of collections with async replication enabled are moved.
+*/
+func (a *Client) ClusterRebalance(params *ClusterRebalanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRebalanceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterRebalanceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.rebalance",
+		Method:             "POST",
+		PathPattern:        "/cluster/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterRebalanceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterRebalanceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.rebalance: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterReplicasMove moves or copy a shard replica to another node
+
+Copies the replica of a shard from the source node to the target node and adds the target node to the replicas of the shard. Unless only a copy is requested, the source replica is removed afterwards. Writes made while the replica is copied are propagated by async replication, which must be enabled for the collection to move a replica.
+*/
+func (a *Client) ClusterReplicasMove(params *ClusterReplicasMoveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterReplicasMoveOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterReplicasMoveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.replicas.move",
+		Method:             "POST",
+		PathPattern:        "/cluster/replicas/move",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterReplicasMoveReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterReplicasMoveOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.replicas.move: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewClusterDrainNodeParams creates a new ClusterDrainNodeParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewClusterDrainNodeParams() *ClusterDrainNodeParams {
+	return &ClusterDrainNodeParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClusterDrainNodeParamsWithTimeout creates a new ClusterDrainNodeParams object
+// with the ability to set a timeout on a request.
+func NewClusterDrainNodeParamsWithTimeout(timeout time.Duration) *ClusterDrainNodeParams {
+	return &ClusterDrainNodeParams{
+		timeout: timeout,
+	}
+}
+
+// NewClusterDrainNodeParamsWithContext creates a new ClusterDrainNodeParams object
+// with the ability to set a context for a request.
+func NewClusterDrainNodeParamsWithContext(ctx context.Context) *ClusterDrainNodeParams {
+	return &ClusterDrainNodeParams{
+		Context: ctx,
+	}
+}
+
+// NewClusterDrainNodeParamsWithHTTPClient creates a new ClusterDrainNodeParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewClusterDrainNodeParamsWithHTTPClient(client *http.Client) *ClusterDrainNodeParams {
+	return &ClusterDrainNodeParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ClusterDrainNodeParams contains all the parameters to send to the API endpoint
+
+	for the cluster drain node operation.
+
+	Typically these are written to a http.Request.
+*/
+type ClusterDrainNodeParams struct {
+
+	/* NodeName.
+
+	   The name of the node to drain.
+	*/
+	NodeName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cluster drain node params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterDrainNodeParams) WithDefaults() *ClusterDrainNodeParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cluster drain node params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ClusterDrainNodeParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cluster drain node params
+func (o *ClusterDrainNodeParams) WithTimeout(timeout time.Duration) *ClusterDrainNodeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cluster drain node params
+func (o *ClusterDrainNodeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cluster drain node params
+func (o *ClusterDrainNodeParams) WithContext(ctx context.Context) *ClusterDrainNodeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cluster drain node params
+func (o *ClusterDrainNodeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cluster drain node params
+func (o *ClusterDrainNodeParams) WithHTTPClient(client *http.Client) *ClusterDrainNodeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cluster drain node params
+func (o *ClusterDrainNodeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNodeName adds the nodeName to the cluster drain node params
+func (o *ClusterDrainNodeParams) WithNodeName(nodeName string) *ClusterDrainNodeParams {
+	o.SetNodeName(nodeName)
+	return o
+}
+
+// SetNodeName adds the nodeName to the cluster drain node params
+func (o *ClusterDrainNodeParams) SetNodeName(nodeName string) {
+	o.NodeName = nodeName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClusterDrainNodeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param nodeName
+	if err := r.SetPathParam("nodeName", o.NodeName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
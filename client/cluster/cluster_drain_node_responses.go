@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterDrainNodeReader is a Reader for the ClusterDrainNode structure.
+type ClusterDrainNodeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterDrainNodeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterDrainNodeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterDrainNodeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterDrainNodeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterDrainNodeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterDrainNodeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterDrainNodeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterDrainNodeOK creates a ClusterDrainNodeOK with default headers values
+func NewClusterDrainNodeOK() *ClusterDrainNodeOK {
+	return &ClusterDrainNodeOK{}
+}
+
+/*
+ClusterDrainNodeOK describes a response with status code 200, with default header values.
+
+Node successfully drained
+*/
+type ClusterDrainNodeOK struct {
+	Payload *models.NodeDrainResponse
+}
+
+// IsSuccess returns true when this cluster drain node o k response has a 2xx status code
+func (o *ClusterDrainNodeOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster drain node o k response has a 3xx status code
+func (o *ClusterDrainNodeOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node o k response has a 4xx status code
+func (o *ClusterDrainNodeOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster drain node o k response has a 5xx status code
+func (o *ClusterDrainNodeOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster drain node o k response a status code equal to that given
+func (o *ClusterDrainNodeOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster drain node o k response
+func (o *ClusterDrainNodeOK) Code() int {
+	return 200
+}
+
+func (o *ClusterDrainNodeOK) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterDrainNodeOK) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterDrainNodeOK) GetPayload() *models.NodeDrainResponse {
+	return o.Payload
+}
+
+func (o *ClusterDrainNodeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodeDrainResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterDrainNodeUnauthorized creates a ClusterDrainNodeUnauthorized with default headers values
+func NewClusterDrainNodeUnauthorized() *ClusterDrainNodeUnauthorized {
+	return &ClusterDrainNodeUnauthorized{}
+}
+
+/*
+ClusterDrainNodeUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterDrainNodeUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster drain node unauthorized response has a 2xx status code
+func (o *ClusterDrainNodeUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster drain node unauthorized response has a 3xx status code
+func (o *ClusterDrainNodeUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node unauthorized response has a 4xx status code
+func (o *ClusterDrainNodeUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster drain node unauthorized response has a 5xx status code
+func (o *ClusterDrainNodeUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster drain node unauthorized response a status code equal to that given
+func (o *ClusterDrainNodeUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster drain node unauthorized response
+func (o *ClusterDrainNodeUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterDrainNodeUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeUnauthorized ", 401)
+}
+
+func (o *ClusterDrainNodeUnauthorized) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeUnauthorized ", 401)
+}
+
+func (o *ClusterDrainNodeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterDrainNodeForbidden creates a ClusterDrainNodeForbidden with default headers values
+func NewClusterDrainNodeForbidden() *ClusterDrainNodeForbidden {
+	return &ClusterDrainNodeForbidden{}
+}
+
+/*
+ClusterDrainNodeForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterDrainNodeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster drain node forbidden response has a 2xx status code
+func (o *ClusterDrainNodeForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster drain node forbidden response has a 3xx status code
+func (o *ClusterDrainNodeForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node forbidden response has a 4xx status code
+func (o *ClusterDrainNodeForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster drain node forbidden response has a 5xx status code
+func (o *ClusterDrainNodeForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster drain node forbidden response a status code equal to that given
+func (o *ClusterDrainNodeForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster drain node forbidden response
+func (o *ClusterDrainNodeForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterDrainNodeForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterDrainNodeForbidden) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterDrainNodeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterDrainNodeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterDrainNodeNotFound creates a ClusterDrainNodeNotFound with default headers values
+func NewClusterDrainNodeNotFound() *ClusterDrainNodeNotFound {
+	return &ClusterDrainNodeNotFound{}
+}
+
+/*
+ClusterDrainNodeNotFound describes a response with status code 404, with default header values.
+
+Node not found.
+*/
+type ClusterDrainNodeNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster drain node not found response has a 2xx status code
+func (o *ClusterDrainNodeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster drain node not found response has a 3xx status code
+func (o *ClusterDrainNodeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node not found response has a 4xx status code
+func (o *ClusterDrainNodeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster drain node not found response has a 5xx status code
+func (o *ClusterDrainNodeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster drain node not found response a status code equal to that given
+func (o *ClusterDrainNodeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster drain node not found response
+func (o *ClusterDrainNodeNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterDrainNodeNotFound) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterDrainNodeNotFound) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterDrainNodeNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterDrainNodeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterDrainNodeUnprocessableEntity creates a ClusterDrainNodeUnprocessableEntity with default headers values
+func NewClusterDrainNodeUnprocessableEntity() *ClusterDrainNodeUnprocessableEntity {
+	return &ClusterDrainNodeUnprocessableEntity{}
+}
+
+/*
+ClusterDrainNodeUnprocessableEntity describes a response with status code 422, with default header values.
+
+The node cannot be drained, e.g. because no other node is left to hold its replicas or a collection with replicas on the node does not have async replication enabled.
+*/
+type ClusterDrainNodeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster drain node unprocessable entity response has a 2xx status code
+func (o *ClusterDrainNodeUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster drain node unprocessable entity response has a 3xx status code
+func (o *ClusterDrainNodeUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node unprocessable entity response has a 4xx status code
+func (o *ClusterDrainNodeUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster drain node unprocessable entity response has a 5xx status code
+func (o *ClusterDrainNodeUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster drain node unprocessable entity response a status code equal to that given
+func (o *ClusterDrainNodeUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster drain node unprocessable entity response
+func (o *ClusterDrainNodeUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterDrainNodeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterDrainNodeUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterDrainNodeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterDrainNodeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterDrainNodeInternalServerError creates a ClusterDrainNodeInternalServerError with default headers values
+func NewClusterDrainNodeInternalServerError() *ClusterDrainNodeInternalServerError {
+	return &ClusterDrainNodeInternalServerError{}
+}
+
+/*
+ClusterDrainNodeInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterDrainNodeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster drain node internal server error response has a 2xx status code
+func (o *ClusterDrainNodeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster drain node internal server error response has a 3xx status code
+func (o *ClusterDrainNodeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster drain node internal server error response has a 4xx status code
+func (o *ClusterDrainNodeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster drain node internal server error response has a 5xx status code
+func (o *ClusterDrainNodeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster drain node internal server error response a status code equal to that given
+func (o *ClusterDrainNodeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster drain node internal server error response
+func (o *ClusterDrainNodeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterDrainNodeInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterDrainNodeInternalServerError) String() string {
+	return fmt.Sprintf("[POST /cluster/nodes/{nodeName}/drain][%d] clusterDrainNodeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterDrainNodeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterDrainNodeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
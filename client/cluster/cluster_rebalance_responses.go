@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterRebalanceReader is a Reader for the ClusterRebalance structure.
+type ClusterRebalanceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterRebalanceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterRebalanceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterRebalanceUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterRebalanceForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterRebalanceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterRebalanceUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterRebalanceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterRebalanceOK creates a ClusterRebalanceOK with default headers values
+func NewClusterRebalanceOK() *ClusterRebalanceOK {
+	return &ClusterRebalanceOK{}
+}
+
+/*
+ClusterRebalanceOK describes a response with status code 200, with default header values.
+
+The proposed or executed moves
+*/
+type ClusterRebalanceOK struct {
+	Payload *models.ClusterRebalanceResponse
+}
+
+// IsSuccess returns true when this cluster rebalance o k response has a 2xx status code
+func (o *ClusterRebalanceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster rebalance o k response has a 3xx status code
+func (o *ClusterRebalanceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance o k response has a 4xx status code
+func (o *ClusterRebalanceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster rebalance o k response has a 5xx status code
+func (o *ClusterRebalanceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster rebalance o k response a status code equal to that given
+func (o *ClusterRebalanceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster rebalance o k response
+func (o *ClusterRebalanceOK) Code() int {
+	return 200
+}
+
+func (o *ClusterRebalanceOK) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterRebalanceOK) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterRebalanceOK) GetPayload() *models.ClusterRebalanceResponse {
+	return o.Payload
+}
+
+func (o *ClusterRebalanceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterRebalanceResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterRebalanceUnauthorized creates a ClusterRebalanceUnauthorized with default headers values
+func NewClusterRebalanceUnauthorized() *ClusterRebalanceUnauthorized {
+	return &ClusterRebalanceUnauthorized{}
+}
+
+/*
+ClusterRebalanceUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterRebalanceUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster rebalance unauthorized response has a 2xx status code
+func (o *ClusterRebalanceUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster rebalance unauthorized response has a 3xx status code
+func (o *ClusterRebalanceUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance unauthorized response has a 4xx status code
+func (o *ClusterRebalanceUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster rebalance unauthorized response has a 5xx status code
+func (o *ClusterRebalanceUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster rebalance unauthorized response a status code equal to that given
+func (o *ClusterRebalanceUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster rebalance unauthorized response
+func (o *ClusterRebalanceUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterRebalanceUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceUnauthorized ", 401)
+}
+
+func (o *ClusterRebalanceUnauthorized) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceUnauthorized ", 401)
+}
+
+func (o *ClusterRebalanceUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterRebalanceForbidden creates a ClusterRebalanceForbidden with default headers values
+func NewClusterRebalanceForbidden() *ClusterRebalanceForbidden {
+	return &ClusterRebalanceForbidden{}
+}
+
+/*
+ClusterRebalanceForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterRebalanceForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster rebalance forbidden response has a 2xx status code
+func (o *ClusterRebalanceForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster rebalance forbidden response has a 3xx status code
+func (o *ClusterRebalanceForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance forbidden response has a 4xx status code
+func (o *ClusterRebalanceForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster rebalance forbidden response has a 5xx status code
+func (o *ClusterRebalanceForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster rebalance forbidden response a status code equal to that given
+func (o *ClusterRebalanceForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster rebalance forbidden response
+func (o *ClusterRebalanceForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterRebalanceForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterRebalanceForbidden) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterRebalanceForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterRebalanceForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterRebalanceNotFound creates a ClusterRebalanceNotFound with default headers values
+func NewClusterRebalanceNotFound() *ClusterRebalanceNotFound {
+	return &ClusterRebalanceNotFound{}
+}
+
+/*
+ClusterRebalanceNotFound describes a response with status code 404, with default header values.
+
+Collection or node not found.
+*/
+type ClusterRebalanceNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster rebalance not found response has a 2xx status code
+func (o *ClusterRebalanceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster rebalance not found response has a 3xx status code
+func (o *ClusterRebalanceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance not found response has a 4xx status code
+func (o *ClusterRebalanceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster rebalance not found response has a 5xx status code
+func (o *ClusterRebalanceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster rebalance not found response a status code equal to that given
+func (o *ClusterRebalanceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster rebalance not found response
+func (o *ClusterRebalanceNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterRebalanceNotFound) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterRebalanceNotFound) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterRebalanceNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterRebalanceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterRebalanceUnprocessableEntity creates a ClusterRebalanceUnprocessableEntity with default headers values
+func NewClusterRebalanceUnprocessableEntity() *ClusterRebalanceUnprocessableEntity {
+	return &ClusterRebalanceUnprocessableEntity{}
+}
+
+/*
+ClusterRebalanceUnprocessableEntity describes a response with status code 422, with default header values.
+
+A move cannot be executed.
+*/
+type ClusterRebalanceUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster rebalance unprocessable entity response has a 2xx status code
+func (o *ClusterRebalanceUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster rebalance unprocessable entity response has a 3xx status code
+func (o *ClusterRebalanceUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance unprocessable entity response has a 4xx status code
+func (o *ClusterRebalanceUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster rebalance unprocessable entity response has a 5xx status code
+func (o *ClusterRebalanceUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster rebalance unprocessable entity response a status code equal to that given
+func (o *ClusterRebalanceUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster rebalance unprocessable entity response
+func (o *ClusterRebalanceUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterRebalanceUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterRebalanceUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterRebalanceUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterRebalanceUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterRebalanceInternalServerError creates a ClusterRebalanceInternalServerError with default headers values
+func NewClusterRebalanceInternalServerError() *ClusterRebalanceInternalServerError {
+	return &ClusterRebalanceInternalServerError{}
+}
+
+/*
+ClusterRebalanceInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterRebalanceInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster rebalance internal server error response has a 2xx status code
+func (o *ClusterRebalanceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster rebalance internal server error response has a 3xx status code
+func (o *ClusterRebalanceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster rebalance internal server error response has a 4xx status code
+func (o *ClusterRebalanceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster rebalance internal server error response has a 5xx status code
+func (o *ClusterRebalanceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster rebalance internal server error response a status code equal to that given
+func (o *ClusterRebalanceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster rebalance internal server error response
+func (o *ClusterRebalanceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterRebalanceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterRebalanceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /cluster/rebalance][%d] clusterRebalanceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterRebalanceInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterRebalanceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
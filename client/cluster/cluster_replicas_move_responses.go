@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterReplicasMoveReader is a Reader for the ClusterReplicasMove structure.
+type ClusterReplicasMoveReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterReplicasMoveReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterReplicasMoveOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterReplicasMoveUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterReplicasMoveForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClusterReplicasMoveNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewClusterReplicasMoveUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterReplicasMoveInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterReplicasMoveOK creates a ClusterReplicasMoveOK with default headers values
+func NewClusterReplicasMoveOK() *ClusterReplicasMoveOK {
+	return &ClusterReplicasMoveOK{}
+}
+
+/*
+ClusterReplicasMoveOK describes a response with status code 200, with default header values.
+
+Replica successfully moved or copied
+*/
+type ClusterReplicasMoveOK struct {
+	Payload *models.ReplicaMoveResponse
+}
+
+// IsSuccess returns true when this cluster replicas move o k response has a 2xx status code
+func (o *ClusterReplicasMoveOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster replicas move o k response has a 3xx status code
+func (o *ClusterReplicasMoveOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move o k response has a 4xx status code
+func (o *ClusterReplicasMoveOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster replicas move o k response has a 5xx status code
+func (o *ClusterReplicasMoveOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster replicas move o k response a status code equal to that given
+func (o *ClusterReplicasMoveOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster replicas move o k response
+func (o *ClusterReplicasMoveOK) Code() int {
+	return 200
+}
+
+func (o *ClusterReplicasMoveOK) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterReplicasMoveOK) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveOK  %+v", 200, o.Payload)
+}
+
+func (o *ClusterReplicasMoveOK) GetPayload() *models.ReplicaMoveResponse {
+	return o.Payload
+}
+
+func (o *ClusterReplicasMoveOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicaMoveResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterReplicasMoveUnauthorized creates a ClusterReplicasMoveUnauthorized with default headers values
+func NewClusterReplicasMoveUnauthorized() *ClusterReplicasMoveUnauthorized {
+	return &ClusterReplicasMoveUnauthorized{}
+}
+
+/*
+ClusterReplicasMoveUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterReplicasMoveUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster replicas move unauthorized response has a 2xx status code
+func (o *ClusterReplicasMoveUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster replicas move unauthorized response has a 3xx status code
+func (o *ClusterReplicasMoveUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move unauthorized response has a 4xx status code
+func (o *ClusterReplicasMoveUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster replicas move unauthorized response has a 5xx status code
+func (o *ClusterReplicasMoveUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster replicas move unauthorized response a status code equal to that given
+func (o *ClusterReplicasMoveUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster replicas move unauthorized response
+func (o *ClusterReplicasMoveUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterReplicasMoveUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveUnauthorized ", 401)
+}
+
+func (o *ClusterReplicasMoveUnauthorized) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveUnauthorized ", 401)
+}
+
+func (o *ClusterReplicasMoveUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterReplicasMoveForbidden creates a ClusterReplicasMoveForbidden with default headers values
+func NewClusterReplicasMoveForbidden() *ClusterReplicasMoveForbidden {
+	return &ClusterReplicasMoveForbidden{}
+}
+
+/*
+ClusterReplicasMoveForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterReplicasMoveForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster replicas move forbidden response has a 2xx status code
+func (o *ClusterReplicasMoveForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster replicas move forbidden response has a 3xx status code
+func (o *ClusterReplicasMoveForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move forbidden response has a 4xx status code
+func (o *ClusterReplicasMoveForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster replicas move forbidden response has a 5xx status code
+func (o *ClusterReplicasMoveForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster replicas move forbidden response a status code equal to that given
+func (o *ClusterReplicasMoveForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster replicas move forbidden response
+func (o *ClusterReplicasMoveForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterReplicasMoveForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterReplicasMoveForbidden) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterReplicasMoveForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterReplicasMoveForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterReplicasMoveNotFound creates a ClusterReplicasMoveNotFound with default headers values
+func NewClusterReplicasMoveNotFound() *ClusterReplicasMoveNotFound {
+	return &ClusterReplicasMoveNotFound{}
+}
+
+/*
+ClusterReplicasMoveNotFound describes a response with status code 404, with default header values.
+
+Collection or node not found.
+*/
+type ClusterReplicasMoveNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster replicas move not found response has a 2xx status code
+func (o *ClusterReplicasMoveNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster replicas move not found response has a 3xx status code
+func (o *ClusterReplicasMoveNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move not found response has a 4xx status code
+func (o *ClusterReplicasMoveNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster replicas move not found response has a 5xx status code
+func (o *ClusterReplicasMoveNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster replicas move not found response a status code equal to that given
+func (o *ClusterReplicasMoveNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cluster replicas move not found response
+func (o *ClusterReplicasMoveNotFound) Code() int {
+	return 404
+}
+
+func (o *ClusterReplicasMoveNotFound) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterReplicasMoveNotFound) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ClusterReplicasMoveNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterReplicasMoveNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterReplicasMoveUnprocessableEntity creates a ClusterReplicasMoveUnprocessableEntity with default headers values
+func NewClusterReplicasMoveUnprocessableEntity() *ClusterReplicasMoveUnprocessableEntity {
+	return &ClusterReplicasMoveUnprocessableEntity{}
+}
+
+/*
+ClusterReplicasMoveUnprocessableEntity describes a response with status code 422, with default header values.
+
+The replica cannot be moved, e.g. because the target node already holds it.
+*/
+type ClusterReplicasMoveUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster replicas move unprocessable entity response has a 2xx status code
+func (o *ClusterReplicasMoveUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster replicas move unprocessable entity response has a 3xx status code
+func (o *ClusterReplicasMoveUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move unprocessable entity response has a 4xx status code
+func (o *ClusterReplicasMoveUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster replicas move unprocessable entity response has a 5xx status code
+func (o *ClusterReplicasMoveUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster replicas move unprocessable entity response a status code equal to that given
+func (o *ClusterReplicasMoveUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the cluster replicas move unprocessable entity response
+func (o *ClusterReplicasMoveUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ClusterReplicasMoveUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterReplicasMoveUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ClusterReplicasMoveUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterReplicasMoveUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterReplicasMoveInternalServerError creates a ClusterReplicasMoveInternalServerError with default headers values
+func NewClusterReplicasMoveInternalServerError() *ClusterReplicasMoveInternalServerError {
+	return &ClusterReplicasMoveInternalServerError{}
+}
+
+/*
+ClusterReplicasMoveInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterReplicasMoveInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster replicas move internal server error response has a 2xx status code
+func (o *ClusterReplicasMoveInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster replicas move internal server error response has a 3xx status code
+func (o *ClusterReplicasMoveInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster replicas move internal server error response has a 4xx status code
+func (o *ClusterReplicasMoveInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster replicas move internal server error response has a 5xx status code
+func (o *ClusterReplicasMoveInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster replicas move internal server error response a status code equal to that given
+func (o *ClusterReplicasMoveInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster replicas move internal server error response
+func (o *ClusterReplicasMoveInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterReplicasMoveInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterReplicasMoveInternalServerError) String() string {
+	return fmt.Sprintf("[POST /cluster/replicas/move][%d] clusterReplicasMoveInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterReplicasMoveInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterReplicasMoveInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
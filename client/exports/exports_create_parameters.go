@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewExportsCreateParams creates a new ExportsCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewExportsCreateParams() *ExportsCreateParams {
+	return &ExportsCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportsCreateParamsWithTimeout creates a new ExportsCreateParams object
+// with the ability to set a timeout on a request.
+func NewExportsCreateParamsWithTimeout(timeout time.Duration) *ExportsCreateParams {
+	return &ExportsCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewExportsCreateParamsWithContext creates a new ExportsCreateParams object
+// with the ability to set a context for a request.
+func NewExportsCreateParamsWithContext(ctx context.Context) *ExportsCreateParams {
+	return &ExportsCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewExportsCreateParamsWithHTTPClient creates a new ExportsCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewExportsCreateParamsWithHTTPClient(client *http.Client) *ExportsCreateParams {
+	return &ExportsCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ExportsCreateParams contains all the parameters to send to the API endpoint
+
+	for the exports create operation.
+
+	Typically these are written to a http.Request.
+*/
+type ExportsCreateParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	*/
+	Backend string
+
+	// Body.
+	Body *models.ExportCreateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the exports create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportsCreateParams) WithDefaults() *ExportsCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the exports create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportsCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the exports create params
+func (o *ExportsCreateParams) WithTimeout(timeout time.Duration) *ExportsCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the exports create params
+func (o *ExportsCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the exports create params
+func (o *ExportsCreateParams) WithContext(ctx context.Context) *ExportsCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the exports create params
+func (o *ExportsCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the exports create params
+func (o *ExportsCreateParams) WithHTTPClient(client *http.Client) *ExportsCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the exports create params
+func (o *ExportsCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the exports create params
+func (o *ExportsCreateParams) WithBackend(backend string) *ExportsCreateParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the exports create params
+func (o *ExportsCreateParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithBody adds the body to the exports create params
+func (o *ExportsCreateParams) WithBody(body *models.ExportCreateRequest) *ExportsCreateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the exports create params
+func (o *ExportsCreateParams) SetBody(body *models.ExportCreateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsCreateReader is a Reader for the ExportsCreate structure.
+type ExportsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportsCreateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewExportsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewExportsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewExportsCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewExportsCreateOK creates a ExportsCreateOK with default headers values
+func NewExportsCreateOK() *ExportsCreateOK {
+	return &ExportsCreateOK{}
+}
+
+/*
+ExportsCreateOK describes a response with status code 200, with default header values.
+
+Export successfully started.
+*/
+type ExportsCreateOK struct {
+	Payload *models.ExportStatusResponse
+}
+
+// IsSuccess returns true when this exports create o k response has a 2xx status code
+func (o *ExportsCreateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this exports create o k response has a 3xx status code
+func (o *ExportsCreateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports create o k response has a 4xx status code
+func (o *ExportsCreateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this exports create o k response has a 5xx status code
+func (o *ExportsCreateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports create o k response a status code equal to that given
+func (o *ExportsCreateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the exports create o k response
+func (o *ExportsCreateOK) Code() int {
+	return 200
+}
+
+func (o *ExportsCreateOK) Error() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportsCreateOK) String() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportsCreateOK) GetPayload() *models.ExportStatusResponse {
+	return o.Payload
+}
+
+func (o *ExportsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ExportStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsCreateUnauthorized creates a ExportsCreateUnauthorized with default headers values
+func NewExportsCreateUnauthorized() *ExportsCreateUnauthorized {
+	return &ExportsCreateUnauthorized{}
+}
+
+/*
+ExportsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ExportsCreateUnauthorized struct {
+}
+
+// IsSuccess returns true when this exports create unauthorized response has a 2xx status code
+func (o *ExportsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports create unauthorized response has a 3xx status code
+func (o *ExportsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports create unauthorized response has a 4xx status code
+func (o *ExportsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports create unauthorized response has a 5xx status code
+func (o *ExportsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports create unauthorized response a status code equal to that given
+func (o *ExportsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the exports create unauthorized response
+func (o *ExportsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ExportsCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateUnauthorized ", 401)
+}
+
+func (o *ExportsCreateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateUnauthorized ", 401)
+}
+
+func (o *ExportsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewExportsCreateForbidden creates a ExportsCreateForbidden with default headers values
+func NewExportsCreateForbidden() *ExportsCreateForbidden {
+	return &ExportsCreateForbidden{}
+}
+
+/*
+ExportsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ExportsCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports create forbidden response has a 2xx status code
+func (o *ExportsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports create forbidden response has a 3xx status code
+func (o *ExportsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports create forbidden response has a 4xx status code
+func (o *ExportsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports create forbidden response has a 5xx status code
+func (o *ExportsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports create forbidden response a status code equal to that given
+func (o *ExportsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the exports create forbidden response
+func (o *ExportsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *ExportsCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportsCreateForbidden) String() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportsCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsCreateUnprocessableEntity creates a ExportsCreateUnprocessableEntity with default headers values
+func NewExportsCreateUnprocessableEntity() *ExportsCreateUnprocessableEntity {
+	return &ExportsCreateUnprocessableEntity{}
+}
+
+/*
+ExportsCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid export request.
+*/
+type ExportsCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports create unprocessable entity response has a 2xx status code
+func (o *ExportsCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports create unprocessable entity response has a 3xx status code
+func (o *ExportsCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports create unprocessable entity response has a 4xx status code
+func (o *ExportsCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports create unprocessable entity response has a 5xx status code
+func (o *ExportsCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports create unprocessable entity response a status code equal to that given
+func (o *ExportsCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the exports create unprocessable entity response
+func (o *ExportsCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ExportsCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ExportsCreateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ExportsCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsCreateInternalServerError creates a ExportsCreateInternalServerError with default headers values
+func NewExportsCreateInternalServerError() *ExportsCreateInternalServerError {
+	return &ExportsCreateInternalServerError{}
+}
+
+/*
+ExportsCreateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ExportsCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports create internal server error response has a 2xx status code
+func (o *ExportsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports create internal server error response has a 3xx status code
+func (o *ExportsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports create internal server error response has a 4xx status code
+func (o *ExportsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this exports create internal server error response has a 5xx status code
+func (o *ExportsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this exports create internal server error response a status code equal to that given
+func (o *ExportsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the exports create internal server error response
+func (o *ExportsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ExportsCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportsCreateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /export/{backend}][%d] exportsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportsCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
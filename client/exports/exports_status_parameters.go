@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewExportsStatusParams creates a new ExportsStatusParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewExportsStatusParams() *ExportsStatusParams {
+	return &ExportsStatusParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportsStatusParamsWithTimeout creates a new ExportsStatusParams object
+// with the ability to set a timeout on a request.
+func NewExportsStatusParamsWithTimeout(timeout time.Duration) *ExportsStatusParams {
+	return &ExportsStatusParams{
+		timeout: timeout,
+	}
+}
+
+// NewExportsStatusParamsWithContext creates a new ExportsStatusParams object
+// with the ability to set a context for a request.
+func NewExportsStatusParamsWithContext(ctx context.Context) *ExportsStatusParams {
+	return &ExportsStatusParams{
+		Context: ctx,
+	}
+}
+
+// NewExportsStatusParamsWithHTTPClient creates a new ExportsStatusParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewExportsStatusParamsWithHTTPClient(client *http.Client) *ExportsStatusParams {
+	return &ExportsStatusParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ExportsStatusParams contains all the parameters to send to the API endpoint
+
+	for the exports status operation.
+
+	Typically these are written to a http.Request.
+*/
+type ExportsStatusParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	*/
+	Backend string
+
+	/* Bucket.
+
+	   Name of the bucket, container, volume, etc
+	*/
+	Bucket *string
+
+	/* ID.
+
+	   The ID of the export.
+	*/
+	ID string
+
+	/* Path.
+
+	   Path or key prefix within the bucket
+	*/
+	Path *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the exports status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportsStatusParams) WithDefaults() *ExportsStatusParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the exports status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportsStatusParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the exports status params
+func (o *ExportsStatusParams) WithTimeout(timeout time.Duration) *ExportsStatusParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the exports status params
+func (o *ExportsStatusParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the exports status params
+func (o *ExportsStatusParams) WithContext(ctx context.Context) *ExportsStatusParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the exports status params
+func (o *ExportsStatusParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the exports status params
+func (o *ExportsStatusParams) WithHTTPClient(client *http.Client) *ExportsStatusParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the exports status params
+func (o *ExportsStatusParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the exports status params
+func (o *ExportsStatusParams) WithBackend(backend string) *ExportsStatusParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the exports status params
+func (o *ExportsStatusParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithBucket adds the bucket to the exports status params
+func (o *ExportsStatusParams) WithBucket(bucket *string) *ExportsStatusParams {
+	o.SetBucket(bucket)
+	return o
+}
+
+// SetBucket adds the bucket to the exports status params
+func (o *ExportsStatusParams) SetBucket(bucket *string) {
+	o.Bucket = bucket
+}
+
+// WithID adds the id to the exports status params
+func (o *ExportsStatusParams) WithID(id string) *ExportsStatusParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the exports status params
+func (o *ExportsStatusParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithPath adds the path to the exports status params
+func (o *ExportsStatusParams) WithPath(path *string) *ExportsStatusParams {
+	o.SetPath(path)
+	return o
+}
+
+// SetPath adds the path to the exports status params
+func (o *ExportsStatusParams) SetPath(path *string) {
+	o.Path = path
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportsStatusParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+
+	if o.Bucket != nil {
+
+		// query param bucket
+		var qrBucket string
+
+		if o.Bucket != nil {
+			qrBucket = *o.Bucket
+		}
+		qBucket := qrBucket
+		if qBucket != "" {
+
+			if err := r.SetQueryParam("bucket", qBucket); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Path != nil {
+
+		// query param path
+		var qrPath string
+
+		if o.Path != nil {
+			qrPath = *o.Path
+		}
+		qPath := qrPath
+		if qPath != "" {
+
+			if err := r.SetQueryParam("path", qPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package exports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportsStatusReader is a Reader for the ExportsStatus structure.
+type ExportsStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportsStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportsStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewExportsStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewExportsStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportsStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewExportsStatusUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewExportsStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewExportsStatusOK creates a ExportsStatusOK with default headers values
+func NewExportsStatusOK() *ExportsStatusOK {
+	return &ExportsStatusOK{}
+}
+
+/*
+ExportsStatusOK describes a response with status code 200, with default header values.
+
+Export status successfully returned.
+*/
+type ExportsStatusOK struct {
+	Payload *models.ExportStatusResponse
+}
+
+// IsSuccess returns true when this exports status o k response has a 2xx status code
+func (o *ExportsStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this exports status o k response has a 3xx status code
+func (o *ExportsStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status o k response has a 4xx status code
+func (o *ExportsStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this exports status o k response has a 5xx status code
+func (o *ExportsStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports status o k response a status code equal to that given
+func (o *ExportsStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the exports status o k response
+func (o *ExportsStatusOK) Code() int {
+	return 200
+}
+
+func (o *ExportsStatusOK) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportsStatusOK) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportsStatusOK) GetPayload() *models.ExportStatusResponse {
+	return o.Payload
+}
+
+func (o *ExportsStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ExportStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsStatusUnauthorized creates a ExportsStatusUnauthorized with default headers values
+func NewExportsStatusUnauthorized() *ExportsStatusUnauthorized {
+	return &ExportsStatusUnauthorized{}
+}
+
+/*
+ExportsStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ExportsStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this exports status unauthorized response has a 2xx status code
+func (o *ExportsStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports status unauthorized response has a 3xx status code
+func (o *ExportsStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status unauthorized response has a 4xx status code
+func (o *ExportsStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports status unauthorized response has a 5xx status code
+func (o *ExportsStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports status unauthorized response a status code equal to that given
+func (o *ExportsStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the exports status unauthorized response
+func (o *ExportsStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ExportsStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusUnauthorized ", 401)
+}
+
+func (o *ExportsStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusUnauthorized ", 401)
+}
+
+func (o *ExportsStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewExportsStatusForbidden creates a ExportsStatusForbidden with default headers values
+func NewExportsStatusForbidden() *ExportsStatusForbidden {
+	return &ExportsStatusForbidden{}
+}
+
+/*
+ExportsStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ExportsStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports status forbidden response has a 2xx status code
+func (o *ExportsStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports status forbidden response has a 3xx status code
+func (o *ExportsStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status forbidden response has a 4xx status code
+func (o *ExportsStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports status forbidden response has a 5xx status code
+func (o *ExportsStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports status forbidden response a status code equal to that given
+func (o *ExportsStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the exports status forbidden response
+func (o *ExportsStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *ExportsStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportsStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportsStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsStatusNotFound creates a ExportsStatusNotFound with default headers values
+func NewExportsStatusNotFound() *ExportsStatusNotFound {
+	return &ExportsStatusNotFound{}
+}
+
+/*
+ExportsStatusNotFound describes a response with status code 404, with default header values.
+
+Not Found - Export does not exist
+*/
+type ExportsStatusNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports status not found response has a 2xx status code
+func (o *ExportsStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports status not found response has a 3xx status code
+func (o *ExportsStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status not found response has a 4xx status code
+func (o *ExportsStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports status not found response has a 5xx status code
+func (o *ExportsStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports status not found response a status code equal to that given
+func (o *ExportsStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the exports status not found response
+func (o *ExportsStatusNotFound) Code() int {
+	return 404
+}
+
+func (o *ExportsStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportsStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportsStatusNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsStatusUnprocessableEntity creates a ExportsStatusUnprocessableEntity with default headers values
+func NewExportsStatusUnprocessableEntity() *ExportsStatusUnprocessableEntity {
+	return &ExportsStatusUnprocessableEntity{}
+}
+
+/*
+ExportsStatusUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid export status request.
+*/
+type ExportsStatusUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports status unprocessable entity response has a 2xx status code
+func (o *ExportsStatusUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports status unprocessable entity response has a 3xx status code
+func (o *ExportsStatusUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status unprocessable entity response has a 4xx status code
+func (o *ExportsStatusUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this exports status unprocessable entity response has a 5xx status code
+func (o *ExportsStatusUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this exports status unprocessable entity response a status code equal to that given
+func (o *ExportsStatusUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the exports status unprocessable entity response
+func (o *ExportsStatusUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ExportsStatusUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ExportsStatusUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ExportsStatusUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsStatusUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportsStatusInternalServerError creates a ExportsStatusInternalServerError with default headers values
+func NewExportsStatusInternalServerError() *ExportsStatusInternalServerError {
+	return &ExportsStatusInternalServerError{}
+}
+
+/*
+ExportsStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ExportsStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this exports status internal server error response has a 2xx status code
+func (o *ExportsStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this exports status internal server error response has a 3xx status code
+func (o *ExportsStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this exports status internal server error response has a 4xx status code
+func (o *ExportsStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this exports status internal server error response has a 5xx status code
+func (o *ExportsStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this exports status internal server error response a status code equal to that given
+func (o *ExportsStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the exports status internal server error response
+func (o *ExportsStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ExportsStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportsStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /export/{backend}/{id}][%d] exportsStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ExportsStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExportsStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
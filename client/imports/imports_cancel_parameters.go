@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewImportsCancelParams creates a new ImportsCancelParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewImportsCancelParams() *ImportsCancelParams {
+	return &ImportsCancelParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportsCancelParamsWithTimeout creates a new ImportsCancelParams object
+// with the ability to set a timeout on a request.
+func NewImportsCancelParamsWithTimeout(timeout time.Duration) *ImportsCancelParams {
+	return &ImportsCancelParams{
+		timeout: timeout,
+	}
+}
+
+// NewImportsCancelParamsWithContext creates a new ImportsCancelParams object
+// with the ability to set a context for a request.
+func NewImportsCancelParamsWithContext(ctx context.Context) *ImportsCancelParams {
+	return &ImportsCancelParams{
+		Context: ctx,
+	}
+}
+
+// NewImportsCancelParamsWithHTTPClient creates a new ImportsCancelParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewImportsCancelParamsWithHTTPClient(client *http.Client) *ImportsCancelParams {
+	return &ImportsCancelParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ImportsCancelParams contains all the parameters to send to the API endpoint
+
+	for the imports cancel operation.
+
+	Typically these are written to a http.Request.
+*/
+type ImportsCancelParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	*/
+	Backend string
+
+	/* ID.
+
+	   The ID of the import.
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the imports cancel params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsCancelParams) WithDefaults() *ImportsCancelParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the imports cancel params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsCancelParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the imports cancel params
+func (o *ImportsCancelParams) WithTimeout(timeout time.Duration) *ImportsCancelParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the imports cancel params
+func (o *ImportsCancelParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the imports cancel params
+func (o *ImportsCancelParams) WithContext(ctx context.Context) *ImportsCancelParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the imports cancel params
+func (o *ImportsCancelParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the imports cancel params
+func (o *ImportsCancelParams) WithHTTPClient(client *http.Client) *ImportsCancelParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the imports cancel params
+func (o *ImportsCancelParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the imports cancel params
+func (o *ImportsCancelParams) WithBackend(backend string) *ImportsCancelParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the imports cancel params
+func (o *ImportsCancelParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithID adds the id to the imports cancel params
+func (o *ImportsCancelParams) WithID(id string) *ImportsCancelParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the imports cancel params
+func (o *ImportsCancelParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportsCancelParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
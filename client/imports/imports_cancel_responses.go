@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCancelReader is a Reader for the ImportsCancel structure.
+type ImportsCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportsCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewImportsCancelNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewImportsCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewImportsCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewImportsCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewImportsCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewImportsCancelNoContent creates a ImportsCancelNoContent with default headers values
+func NewImportsCancelNoContent() *ImportsCancelNoContent {
+	return &ImportsCancelNoContent{}
+}
+
+/*
+ImportsCancelNoContent describes a response with status code 204, with default header values.
+
+Successfully canceled.
+*/
+type ImportsCancelNoContent struct {
+}
+
+// IsSuccess returns true when this imports cancel no content response has a 2xx status code
+func (o *ImportsCancelNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this imports cancel no content response has a 3xx status code
+func (o *ImportsCancelNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports cancel no content response has a 4xx status code
+func (o *ImportsCancelNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports cancel no content response has a 5xx status code
+func (o *ImportsCancelNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports cancel no content response a status code equal to that given
+func (o *ImportsCancelNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the imports cancel no content response
+func (o *ImportsCancelNoContent) Code() int {
+	return 204
+}
+
+func (o *ImportsCancelNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelNoContent ", 204)
+}
+
+func (o *ImportsCancelNoContent) String() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelNoContent ", 204)
+}
+
+func (o *ImportsCancelNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImportsCancelUnauthorized creates a ImportsCancelUnauthorized with default headers values
+func NewImportsCancelUnauthorized() *ImportsCancelUnauthorized {
+	return &ImportsCancelUnauthorized{}
+}
+
+/*
+ImportsCancelUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ImportsCancelUnauthorized struct {
+}
+
+// IsSuccess returns true when this imports cancel unauthorized response has a 2xx status code
+func (o *ImportsCancelUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports cancel unauthorized response has a 3xx status code
+func (o *ImportsCancelUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports cancel unauthorized response has a 4xx status code
+func (o *ImportsCancelUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports cancel unauthorized response has a 5xx status code
+func (o *ImportsCancelUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports cancel unauthorized response a status code equal to that given
+func (o *ImportsCancelUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the imports cancel unauthorized response
+func (o *ImportsCancelUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ImportsCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelUnauthorized ", 401)
+}
+
+func (o *ImportsCancelUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelUnauthorized ", 401)
+}
+
+func (o *ImportsCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImportsCancelForbidden creates a ImportsCancelForbidden with default headers values
+func NewImportsCancelForbidden() *ImportsCancelForbidden {
+	return &ImportsCancelForbidden{}
+}
+
+/*
+ImportsCancelForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ImportsCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports cancel forbidden response has a 2xx status code
+func (o *ImportsCancelForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports cancel forbidden response has a 3xx status code
+func (o *ImportsCancelForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports cancel forbidden response has a 4xx status code
+func (o *ImportsCancelForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports cancel forbidden response has a 5xx status code
+func (o *ImportsCancelForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports cancel forbidden response a status code equal to that given
+func (o *ImportsCancelForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the imports cancel forbidden response
+func (o *ImportsCancelForbidden) Code() int {
+	return 403
+}
+
+func (o *ImportsCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsCancelForbidden) String() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsCancelNotFound creates a ImportsCancelNotFound with default headers values
+func NewImportsCancelNotFound() *ImportsCancelNotFound {
+	return &ImportsCancelNotFound{}
+}
+
+/*
+ImportsCancelNotFound describes a response with status code 404, with default header values.
+
+Not Found - Import is not running
+*/
+type ImportsCancelNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports cancel not found response has a 2xx status code
+func (o *ImportsCancelNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports cancel not found response has a 3xx status code
+func (o *ImportsCancelNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports cancel not found response has a 4xx status code
+func (o *ImportsCancelNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports cancel not found response has a 5xx status code
+func (o *ImportsCancelNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports cancel not found response a status code equal to that given
+func (o *ImportsCancelNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the imports cancel not found response
+func (o *ImportsCancelNotFound) Code() int {
+	return 404
+}
+
+func (o *ImportsCancelNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportsCancelNotFound) String() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportsCancelNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsCancelInternalServerError creates a ImportsCancelInternalServerError with default headers values
+func NewImportsCancelInternalServerError() *ImportsCancelInternalServerError {
+	return &ImportsCancelInternalServerError{}
+}
+
+/*
+ImportsCancelInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ImportsCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports cancel internal server error response has a 2xx status code
+func (o *ImportsCancelInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports cancel internal server error response has a 3xx status code
+func (o *ImportsCancelInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports cancel internal server error response has a 4xx status code
+func (o *ImportsCancelInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports cancel internal server error response has a 5xx status code
+func (o *ImportsCancelInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this imports cancel internal server error response a status code equal to that given
+func (o *ImportsCancelInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the imports cancel internal server error response
+func (o *ImportsCancelInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ImportsCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsCancelInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /import/{backend}/{id}][%d] importsCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
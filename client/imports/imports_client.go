@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new imports API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for imports API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ImportsCancel(params *ImportsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCancelNoContent, error)
+
+	ImportsCreate(params *ImportsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCreateOK, error)
+
+	ImportsStatus(params *ImportsStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsStatusOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ImportsCancel cancels an import
+
+Cancels a running import. It can be resumed by starting it again.
+*/
+func (a *Client) ImportsCancel(params *ImportsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/import/{backend}/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsCreate starts or resume an import
+
+Import objects from JSONL or Parquet files on a backup backend, e.g. the files written by an export. The import runs in the background and ingests the objects in batches. Rows which cannot be imported are reported in the status of the import. Starting an import with the ID of a failed or canceled import resumes it after the last processed rows.
+*/
+func (a *Client) ImportsCreate(params *ImportsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.create",
+		Method:             "POST",
+		PathPattern:        "/import/{backend}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsStatus gets import status
+
+Returns the progress of an import and the errors of rows which could not be imported.
+*/
+func (a *Client) ImportsStatus(params *ImportsStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.status",
+		Method:             "GET",
+		PathPattern:        "/import/{backend}/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewImportsCreateParams creates a new ImportsCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewImportsCreateParams() *ImportsCreateParams {
+	return &ImportsCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportsCreateParamsWithTimeout creates a new ImportsCreateParams object
+// with the ability to set a timeout on a request.
+func NewImportsCreateParamsWithTimeout(timeout time.Duration) *ImportsCreateParams {
+	return &ImportsCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewImportsCreateParamsWithContext creates a new ImportsCreateParams object
+// with the ability to set a context for a request.
+func NewImportsCreateParamsWithContext(ctx context.Context) *ImportsCreateParams {
+	return &ImportsCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewImportsCreateParamsWithHTTPClient creates a new ImportsCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewImportsCreateParamsWithHTTPClient(client *http.Client) *ImportsCreateParams {
+	return &ImportsCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ImportsCreateParams contains all the parameters to send to the API endpoint
+
+	for the imports create operation.
+
+	Typically these are written to a http.Request.
+*/
+type ImportsCreateParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	*/
+	Backend string
+
+	// Body.
+	Body *models.ImportCreateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the imports create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsCreateParams) WithDefaults() *ImportsCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the imports create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the imports create params
+func (o *ImportsCreateParams) WithTimeout(timeout time.Duration) *ImportsCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the imports create params
+func (o *ImportsCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the imports create params
+func (o *ImportsCreateParams) WithContext(ctx context.Context) *ImportsCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the imports create params
+func (o *ImportsCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the imports create params
+func (o *ImportsCreateParams) WithHTTPClient(client *http.Client) *ImportsCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the imports create params
+func (o *ImportsCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the imports create params
+func (o *ImportsCreateParams) WithBackend(backend string) *ImportsCreateParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the imports create params
+func (o *ImportsCreateParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithBody adds the body to the imports create params
+func (o *ImportsCreateParams) WithBody(body *models.ImportCreateRequest) *ImportsCreateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the imports create params
+func (o *ImportsCreateParams) SetBody(body *models.ImportCreateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
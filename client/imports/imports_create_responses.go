@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsCreateReader is a Reader for the ImportsCreate structure.
+type ImportsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewImportsCreateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewImportsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewImportsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewImportsCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewImportsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewImportsCreateOK creates a ImportsCreateOK with default headers values
+func NewImportsCreateOK() *ImportsCreateOK {
+	return &ImportsCreateOK{}
+}
+
+/*
+ImportsCreateOK describes a response with status code 200, with default header values.
+
+Import successfully started.
+*/
+type ImportsCreateOK struct {
+	Payload *models.ImportStatusResponse
+}
+
+// IsSuccess returns true when this imports create o k response has a 2xx status code
+func (o *ImportsCreateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this imports create o k response has a 3xx status code
+func (o *ImportsCreateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports create o k response has a 4xx status code
+func (o *ImportsCreateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports create o k response has a 5xx status code
+func (o *ImportsCreateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports create o k response a status code equal to that given
+func (o *ImportsCreateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the imports create o k response
+func (o *ImportsCreateOK) Code() int {
+	return 200
+}
+
+func (o *ImportsCreateOK) Error() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportsCreateOK) String() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportsCreateOK) GetPayload() *models.ImportStatusResponse {
+	return o.Payload
+}
+
+func (o *ImportsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImportStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsCreateUnauthorized creates a ImportsCreateUnauthorized with default headers values
+func NewImportsCreateUnauthorized() *ImportsCreateUnauthorized {
+	return &ImportsCreateUnauthorized{}
+}
+
+/*
+ImportsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ImportsCreateUnauthorized struct {
+}
+
+// IsSuccess returns true when this imports create unauthorized response has a 2xx status code
+func (o *ImportsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports create unauthorized response has a 3xx status code
+func (o *ImportsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports create unauthorized response has a 4xx status code
+func (o *ImportsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports create unauthorized response has a 5xx status code
+func (o *ImportsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports create unauthorized response a status code equal to that given
+func (o *ImportsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the imports create unauthorized response
+func (o *ImportsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ImportsCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateUnauthorized ", 401)
+}
+
+func (o *ImportsCreateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateUnauthorized ", 401)
+}
+
+func (o *ImportsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImportsCreateForbidden creates a ImportsCreateForbidden with default headers values
+func NewImportsCreateForbidden() *ImportsCreateForbidden {
+	return &ImportsCreateForbidden{}
+}
+
+/*
+ImportsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ImportsCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports create forbidden response has a 2xx status code
+func (o *ImportsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports create forbidden response has a 3xx status code
+func (o *ImportsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports create forbidden response has a 4xx status code
+func (o *ImportsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports create forbidden response has a 5xx status code
+func (o *ImportsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports create forbidden response a status code equal to that given
+func (o *ImportsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the imports create forbidden response
+func (o *ImportsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *ImportsCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsCreateForbidden) String() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsCreateUnprocessableEntity creates a ImportsCreateUnprocessableEntity with default headers values
+func NewImportsCreateUnprocessableEntity() *ImportsCreateUnprocessableEntity {
+	return &ImportsCreateUnprocessableEntity{}
+}
+
+/*
+ImportsCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid import request.
+*/
+type ImportsCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports create unprocessable entity response has a 2xx status code
+func (o *ImportsCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports create unprocessable entity response has a 3xx status code
+func (o *ImportsCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports create unprocessable entity response has a 4xx status code
+func (o *ImportsCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports create unprocessable entity response has a 5xx status code
+func (o *ImportsCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports create unprocessable entity response a status code equal to that given
+func (o *ImportsCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the imports create unprocessable entity response
+func (o *ImportsCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ImportsCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ImportsCreateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ImportsCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsCreateInternalServerError creates a ImportsCreateInternalServerError with default headers values
+func NewImportsCreateInternalServerError() *ImportsCreateInternalServerError {
+	return &ImportsCreateInternalServerError{}
+}
+
+/*
+ImportsCreateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ImportsCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports create internal server error response has a 2xx status code
+func (o *ImportsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports create internal server error response has a 3xx status code
+func (o *ImportsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports create internal server error response has a 4xx status code
+func (o *ImportsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports create internal server error response has a 5xx status code
+func (o *ImportsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this imports create internal server error response a status code equal to that given
+func (o *ImportsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the imports create internal server error response
+func (o *ImportsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ImportsCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsCreateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /import/{backend}][%d] importsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewImportsStatusParams creates a new ImportsStatusParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewImportsStatusParams() *ImportsStatusParams {
+	return &ImportsStatusParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportsStatusParamsWithTimeout creates a new ImportsStatusParams object
+// with the ability to set a timeout on a request.
+func NewImportsStatusParamsWithTimeout(timeout time.Duration) *ImportsStatusParams {
+	return &ImportsStatusParams{
+		timeout: timeout,
+	}
+}
+
+// NewImportsStatusParamsWithContext creates a new ImportsStatusParams object
+// with the ability to set a context for a request.
+func NewImportsStatusParamsWithContext(ctx context.Context) *ImportsStatusParams {
+	return &ImportsStatusParams{
+		Context: ctx,
+	}
+}
+
+// NewImportsStatusParamsWithHTTPClient creates a new ImportsStatusParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewImportsStatusParamsWithHTTPClient(client *http.Client) *ImportsStatusParams {
+	return &ImportsStatusParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ImportsStatusParams contains all the parameters to send to the API endpoint
+
+	for the imports status operation.
+
+	Typically these are written to a http.Request.
+*/
+type ImportsStatusParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	*/
+	Backend string
+
+	/* Bucket.
+
+	   Name of the bucket, container, volume, etc
+	*/
+	Bucket *string
+
+	/* ID.
+
+	   The ID of the import.
+	*/
+	ID string
+
+	/* Path.
+
+	   Path or key prefix within the bucket
+	*/
+	Path *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the imports status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsStatusParams) WithDefaults() *ImportsStatusParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the imports status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportsStatusParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the imports status params
+func (o *ImportsStatusParams) WithTimeout(timeout time.Duration) *ImportsStatusParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the imports status params
+func (o *ImportsStatusParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the imports status params
+func (o *ImportsStatusParams) WithContext(ctx context.Context) *ImportsStatusParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the imports status params
+func (o *ImportsStatusParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the imports status params
+func (o *ImportsStatusParams) WithHTTPClient(client *http.Client) *ImportsStatusParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the imports status params
+func (o *ImportsStatusParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the imports status params
+func (o *ImportsStatusParams) WithBackend(backend string) *ImportsStatusParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the imports status params
+func (o *ImportsStatusParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithBucket adds the bucket to the imports status params
+func (o *ImportsStatusParams) WithBucket(bucket *string) *ImportsStatusParams {
+	o.SetBucket(bucket)
+	return o
+}
+
+// SetBucket adds the bucket to the imports status params
+func (o *ImportsStatusParams) SetBucket(bucket *string) {
+	o.Bucket = bucket
+}
+
+// WithID adds the id to the imports status params
+func (o *ImportsStatusParams) WithID(id string) *ImportsStatusParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the imports status params
+func (o *ImportsStatusParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithPath adds the path to the imports status params
+func (o *ImportsStatusParams) WithPath(path *string) *ImportsStatusParams {
+	o.SetPath(path)
+	return o
+}
+
+// SetPath adds the path to the imports status params
+func (o *ImportsStatusParams) SetPath(path *string) {
+	o.Path = path
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportsStatusParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+
+	if o.Bucket != nil {
+
+		// query param bucket
+		var qrBucket string
+
+		if o.Bucket != nil {
+			qrBucket = *o.Bucket
+		}
+		qBucket := qrBucket
+		if qBucket != "" {
+
+			if err := r.SetQueryParam("bucket", qBucket); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Path != nil {
+
+		// query param path
+		var qrPath string
+
+		if o.Path != nil {
+			qrPath = *o.Path
+		}
+		qPath := qrPath
+		if qPath != "" {
+
+			if err := r.SetQueryParam("path", qPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package imports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ImportsStatusReader is a Reader for the ImportsStatus structure.
+type ImportsStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportsStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewImportsStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewImportsStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewImportsStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewImportsStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewImportsStatusUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewImportsStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewImportsStatusOK creates a ImportsStatusOK with default headers values
+func NewImportsStatusOK() *ImportsStatusOK {
+	return &ImportsStatusOK{}
+}
+
+/*
+ImportsStatusOK describes a response with status code 200, with default header values.
+
+Import status successfully returned.
+*/
+type ImportsStatusOK struct {
+	Payload *models.ImportStatusResponse
+}
+
+// IsSuccess returns true when this imports status o k response has a 2xx status code
+func (o *ImportsStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this imports status o k response has a 3xx status code
+func (o *ImportsStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status o k response has a 4xx status code
+func (o *ImportsStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports status o k response has a 5xx status code
+func (o *ImportsStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports status o k response a status code equal to that given
+func (o *ImportsStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the imports status o k response
+func (o *ImportsStatusOK) Code() int {
+	return 200
+}
+
+func (o *ImportsStatusOK) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportsStatusOK) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportsStatusOK) GetPayload() *models.ImportStatusResponse {
+	return o.Payload
+}
+
+func (o *ImportsStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImportStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsStatusUnauthorized creates a ImportsStatusUnauthorized with default headers values
+func NewImportsStatusUnauthorized() *ImportsStatusUnauthorized {
+	return &ImportsStatusUnauthorized{}
+}
+
+/*
+ImportsStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ImportsStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this imports status unauthorized response has a 2xx status code
+func (o *ImportsStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports status unauthorized response has a 3xx status code
+func (o *ImportsStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status unauthorized response has a 4xx status code
+func (o *ImportsStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports status unauthorized response has a 5xx status code
+func (o *ImportsStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports status unauthorized response a status code equal to that given
+func (o *ImportsStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the imports status unauthorized response
+func (o *ImportsStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ImportsStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusUnauthorized ", 401)
+}
+
+func (o *ImportsStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusUnauthorized ", 401)
+}
+
+func (o *ImportsStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewImportsStatusForbidden creates a ImportsStatusForbidden with default headers values
+func NewImportsStatusForbidden() *ImportsStatusForbidden {
+	return &ImportsStatusForbidden{}
+}
+
+/*
+ImportsStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ImportsStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports status forbidden response has a 2xx status code
+func (o *ImportsStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports status forbidden response has a 3xx status code
+func (o *ImportsStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status forbidden response has a 4xx status code
+func (o *ImportsStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports status forbidden response has a 5xx status code
+func (o *ImportsStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports status forbidden response a status code equal to that given
+func (o *ImportsStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the imports status forbidden response
+func (o *ImportsStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *ImportsStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ImportsStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsStatusNotFound creates a ImportsStatusNotFound with default headers values
+func NewImportsStatusNotFound() *ImportsStatusNotFound {
+	return &ImportsStatusNotFound{}
+}
+
+/*
+ImportsStatusNotFound describes a response with status code 404, with default header values.
+
+Not Found - Import does not exist
+*/
+type ImportsStatusNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports status not found response has a 2xx status code
+func (o *ImportsStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports status not found response has a 3xx status code
+func (o *ImportsStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status not found response has a 4xx status code
+func (o *ImportsStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports status not found response has a 5xx status code
+func (o *ImportsStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports status not found response a status code equal to that given
+func (o *ImportsStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the imports status not found response
+func (o *ImportsStatusNotFound) Code() int {
+	return 404
+}
+
+func (o *ImportsStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportsStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportsStatusNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsStatusUnprocessableEntity creates a ImportsStatusUnprocessableEntity with default headers values
+func NewImportsStatusUnprocessableEntity() *ImportsStatusUnprocessableEntity {
+	return &ImportsStatusUnprocessableEntity{}
+}
+
+/*
+ImportsStatusUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid import status request.
+*/
+type ImportsStatusUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports status unprocessable entity response has a 2xx status code
+func (o *ImportsStatusUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports status unprocessable entity response has a 3xx status code
+func (o *ImportsStatusUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status unprocessable entity response has a 4xx status code
+func (o *ImportsStatusUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this imports status unprocessable entity response has a 5xx status code
+func (o *ImportsStatusUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this imports status unprocessable entity response a status code equal to that given
+func (o *ImportsStatusUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the imports status unprocessable entity response
+func (o *ImportsStatusUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ImportsStatusUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ImportsStatusUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ImportsStatusUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsStatusUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportsStatusInternalServerError creates a ImportsStatusInternalServerError with default headers values
+func NewImportsStatusInternalServerError() *ImportsStatusInternalServerError {
+	return &ImportsStatusInternalServerError{}
+}
+
+/*
+ImportsStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ImportsStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this imports status internal server error response has a 2xx status code
+func (o *ImportsStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this imports status internal server error response has a 3xx status code
+func (o *ImportsStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this imports status internal server error response has a 4xx status code
+func (o *ImportsStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this imports status internal server error response has a 5xx status code
+func (o *ImportsStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this imports status internal server error response a status code equal to that given
+func (o *ImportsStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the imports status internal server error response
+func (o *ImportsStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ImportsStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /import/{backend}/{id}][%d] importsStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ImportsStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ImportsStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -51,12 +51,16 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsReshard(params *SchemaObjectsReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReshardOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaObjectsVectorsReindex(params *SchemaObjectsVectorsReindexParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsReindexOK, error)
+
 	TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -273,6 +277,47 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsReshard changes the number of shards of a collection
+
+Split the shards of a collection without multi-tenancy into the requested number of shards while it keeps serving reads and writes. The objects which move to the new shards are copied in the background, all changes made to them meanwhile are mirrored. Once the copy has caught up, the new sharding state is committed and the moved objects are deleted from their previous shards. The number of shards can only be increased, up to the number of virtual shards of the collection.
+*/
+func (a *Client) SchemaObjectsReshard(params *SchemaObjectsReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReshardOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReshardParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.reshard",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/reshard",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReshardReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReshardOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reshard: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of an object class
 
@@ -396,6 +441,47 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaObjectsVectorsReindex rebuilds the vector index of a collection with a new configuration
+
+Rebuild the vector index of a collection, or of one of its named vectors, with a new configuration, including settings that cannot be updated in place and the index type. A new index is built from the stored objects in the background of every shard while the current index keeps serving queries and writes. Once a shard has caught up, the new index atomically replaces the old one. The progress is reported by the nodes endpoint.
+*/
+func (a *Client) SchemaObjectsVectorsReindex(params *SchemaObjectsVectorsReindexParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsReindexOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsReindexParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.reindex",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/vectors/reindex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsReindexReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsReindexOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.reindex: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantExists checks whether a tenant exists
 
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewSchemaObjectsReshardParams creates a new SchemaObjectsReshardParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsReshardParams() *SchemaObjectsReshardParams {
+	return &SchemaObjectsReshardParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsReshardParamsWithTimeout creates a new SchemaObjectsReshardParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsReshardParamsWithTimeout(timeout time.Duration) *SchemaObjectsReshardParams {
+	return &SchemaObjectsReshardParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsReshardParamsWithContext creates a new SchemaObjectsReshardParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsReshardParamsWithContext(ctx context.Context) *SchemaObjectsReshardParams {
+	return &SchemaObjectsReshardParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsReshardParamsWithHTTPClient creates a new SchemaObjectsReshardParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsReshardParamsWithHTTPClient(client *http.Client) *SchemaObjectsReshardParams {
+	return &SchemaObjectsReshardParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsReshardParams contains all the parameters to send to the API endpoint
+
+	for the schema objects reshard operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsReshardParams struct {
+
+	// Body.
+	Body *models.ReshardRequest
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects reshard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsReshardParams) WithDefaults() *SchemaObjectsReshardParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects reshard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsReshardParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) WithTimeout(timeout time.Duration) *SchemaObjectsReshardParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) WithContext(ctx context.Context) *SchemaObjectsReshardParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) WithHTTPClient(client *http.Client) *SchemaObjectsReshardParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) WithBody(body *models.ReshardRequest) *SchemaObjectsReshardParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) SetBody(body *models.ReshardRequest) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) WithClassName(className string) *SchemaObjectsReshardParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects reshard params
+func (o *SchemaObjectsReshardParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsReshardParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsReshardReader is a Reader for the SchemaObjectsReshard structure.
+type SchemaObjectsReshardReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsReshardReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsReshardOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsReshardUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsReshardForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsReshardNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsReshardUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsReshardInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsReshardOK creates a SchemaObjectsReshardOK with default headers values
+func NewSchemaObjectsReshardOK() *SchemaObjectsReshardOK {
+	return &SchemaObjectsReshardOK{}
+}
+
+/*
+SchemaObjectsReshardOK describes a response with status code 200, with default header values.
+
+The collection was resharded
+*/
+type SchemaObjectsReshardOK struct {
+	Payload *models.ReshardResponse
+}
+
+// IsSuccess returns true when this schema objects reshard o k response has a 2xx status code
+func (o *SchemaObjectsReshardOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects reshard o k response has a 3xx status code
+func (o *SchemaObjectsReshardOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard o k response has a 4xx status code
+func (o *SchemaObjectsReshardOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects reshard o k response has a 5xx status code
+func (o *SchemaObjectsReshardOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects reshard o k response a status code equal to that given
+func (o *SchemaObjectsReshardOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects reshard o k response
+func (o *SchemaObjectsReshardOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsReshardOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsReshardOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsReshardOK) GetPayload() *models.ReshardResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReshardOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReshardResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReshardUnauthorized creates a SchemaObjectsReshardUnauthorized with default headers values
+func NewSchemaObjectsReshardUnauthorized() *SchemaObjectsReshardUnauthorized {
+	return &SchemaObjectsReshardUnauthorized{}
+}
+
+/*
+SchemaObjectsReshardUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsReshardUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects reshard unauthorized response has a 2xx status code
+func (o *SchemaObjectsReshardUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects reshard unauthorized response has a 3xx status code
+func (o *SchemaObjectsReshardUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard unauthorized response has a 4xx status code
+func (o *SchemaObjectsReshardUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects reshard unauthorized response has a 5xx status code
+func (o *SchemaObjectsReshardUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects reshard unauthorized response a status code equal to that given
+func (o *SchemaObjectsReshardUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects reshard unauthorized response
+func (o *SchemaObjectsReshardUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsReshardUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsReshardUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsReshardUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsReshardForbidden creates a SchemaObjectsReshardForbidden with default headers values
+func NewSchemaObjectsReshardForbidden() *SchemaObjectsReshardForbidden {
+	return &SchemaObjectsReshardForbidden{}
+}
+
+/*
+SchemaObjectsReshardForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsReshardForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects reshard forbidden response has a 2xx status code
+func (o *SchemaObjectsReshardForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects reshard forbidden response has a 3xx status code
+func (o *SchemaObjectsReshardForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard forbidden response has a 4xx status code
+func (o *SchemaObjectsReshardForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects reshard forbidden response has a 5xx status code
+func (o *SchemaObjectsReshardForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects reshard forbidden response a status code equal to that given
+func (o *SchemaObjectsReshardForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects reshard forbidden response
+func (o *SchemaObjectsReshardForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsReshardForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsReshardForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsReshardForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReshardForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReshardNotFound creates a SchemaObjectsReshardNotFound with default headers values
+func NewSchemaObjectsReshardNotFound() *SchemaObjectsReshardNotFound {
+	return &SchemaObjectsReshardNotFound{}
+}
+
+/*
+SchemaObjectsReshardNotFound describes a response with status code 404, with default header values.
+
+Class to be resharded does not exist
+*/
+type SchemaObjectsReshardNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects reshard not found response has a 2xx status code
+func (o *SchemaObjectsReshardNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects reshard not found response has a 3xx status code
+func (o *SchemaObjectsReshardNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard not found response has a 4xx status code
+func (o *SchemaObjectsReshardNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects reshard not found response has a 5xx status code
+func (o *SchemaObjectsReshardNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects reshard not found response a status code equal to that given
+func (o *SchemaObjectsReshardNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects reshard not found response
+func (o *SchemaObjectsReshardNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsReshardNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsReshardNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsReshardNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReshardNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReshardUnprocessableEntity creates a SchemaObjectsReshardUnprocessableEntity with default headers values
+func NewSchemaObjectsReshardUnprocessableEntity() *SchemaObjectsReshardUnprocessableEntity {
+	return &SchemaObjectsReshardUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsReshardUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid reshard attempt
+*/
+type SchemaObjectsReshardUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects reshard unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsReshardUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects reshard unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsReshardUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsReshardUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects reshard unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsReshardUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects reshard unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsReshardUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects reshard unprocessable entity response
+func (o *SchemaObjectsReshardUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsReshardUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsReshardUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsReshardUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReshardUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReshardInternalServerError creates a SchemaObjectsReshardInternalServerError with default headers values
+func NewSchemaObjectsReshardInternalServerError() *SchemaObjectsReshardInternalServerError {
+	return &SchemaObjectsReshardInternalServerError{}
+}
+
+/*
+SchemaObjectsReshardInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsReshardInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects reshard internal server error response has a 2xx status code
+func (o *SchemaObjectsReshardInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects reshard internal server error response has a 3xx status code
+func (o *SchemaObjectsReshardInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects reshard internal server error response has a 4xx status code
+func (o *SchemaObjectsReshardInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects reshard internal server error response has a 5xx status code
+func (o *SchemaObjectsReshardInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects reshard internal server error response a status code equal to that given
+func (o *SchemaObjectsReshardInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects reshard internal server error response
+func (o *SchemaObjectsReshardInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsReshardInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsReshardInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/reshard][%d] schemaObjectsReshardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsReshardInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReshardInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
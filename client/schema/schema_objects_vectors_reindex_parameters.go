@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewSchemaObjectsVectorsReindexParams creates a new SchemaObjectsVectorsReindexParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsVectorsReindexParams() *SchemaObjectsVectorsReindexParams {
+	return &SchemaObjectsVectorsReindexParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsVectorsReindexParamsWithTimeout creates a new SchemaObjectsVectorsReindexParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsVectorsReindexParamsWithTimeout(timeout time.Duration) *SchemaObjectsVectorsReindexParams {
+	return &SchemaObjectsVectorsReindexParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsVectorsReindexParamsWithContext creates a new SchemaObjectsVectorsReindexParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsVectorsReindexParamsWithContext(ctx context.Context) *SchemaObjectsVectorsReindexParams {
+	return &SchemaObjectsVectorsReindexParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsVectorsReindexParamsWithHTTPClient creates a new SchemaObjectsVectorsReindexParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsVectorsReindexParamsWithHTTPClient(client *http.Client) *SchemaObjectsVectorsReindexParams {
+	return &SchemaObjectsVectorsReindexParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsVectorsReindexParams contains all the parameters to send to the API endpoint
+
+	for the schema objects vectors reindex operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsVectorsReindexParams struct {
+
+	// Body.
+	Body *models.VectorIndexReindexRequest
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects vectors reindex params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorsReindexParams) WithDefaults() *SchemaObjectsVectorsReindexParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects vectors reindex params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorsReindexParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) WithTimeout(timeout time.Duration) *SchemaObjectsVectorsReindexParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) WithContext(ctx context.Context) *SchemaObjectsVectorsReindexParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) WithHTTPClient(client *http.Client) *SchemaObjectsVectorsReindexParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) WithBody(body *models.VectorIndexReindexRequest) *SchemaObjectsVectorsReindexParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) SetBody(body *models.VectorIndexReindexRequest) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) WithClassName(className string) *SchemaObjectsVectorsReindexParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects vectors reindex params
+func (o *SchemaObjectsVectorsReindexParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsVectorsReindexParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
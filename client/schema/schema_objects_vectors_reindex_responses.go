@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorsReindexReader is a Reader for the SchemaObjectsVectorsReindex structure.
+type SchemaObjectsVectorsReindexReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorsReindexReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorsReindexOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorsReindexUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorsReindexForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorsReindexNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorsReindexUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorsReindexInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorsReindexOK creates a SchemaObjectsVectorsReindexOK with default headers values
+func NewSchemaObjectsVectorsReindexOK() *SchemaObjectsVectorsReindexOK {
+	return &SchemaObjectsVectorsReindexOK{}
+}
+
+/*
+SchemaObjectsVectorsReindexOK describes a response with status code 200, with default header values.
+
+The rebuild was started, the class has the new vector index configuration
+*/
+type SchemaObjectsVectorsReindexOK struct {
+	Payload *models.Class
+}
+
+// IsSuccess returns true when this schema objects vectors reindex o k response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vectors reindex o k response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex o k response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors reindex o k response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors reindex o k response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vectors reindex o k response
+func (o *SchemaObjectsVectorsReindexOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorsReindexOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexOK) GetPayload() *models.Class {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsReindexOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Class)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsReindexUnauthorized creates a SchemaObjectsVectorsReindexUnauthorized with default headers values
+func NewSchemaObjectsVectorsReindexUnauthorized() *SchemaObjectsVectorsReindexUnauthorized {
+	return &SchemaObjectsVectorsReindexUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorsReindexUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorsReindexUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vectors reindex unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors reindex unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors reindex unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors reindex unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vectors reindex unauthorized response
+func (o *SchemaObjectsVectorsReindexUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorsReindexUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsReindexUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsReindexUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsReindexForbidden creates a SchemaObjectsVectorsReindexForbidden with default headers values
+func NewSchemaObjectsVectorsReindexForbidden() *SchemaObjectsVectorsReindexForbidden {
+	return &SchemaObjectsVectorsReindexForbidden{}
+}
+
+/*
+SchemaObjectsVectorsReindexForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorsReindexForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors reindex forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors reindex forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors reindex forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors reindex forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vectors reindex forbidden response
+func (o *SchemaObjectsVectorsReindexForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorsReindexForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsReindexForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsReindexNotFound creates a SchemaObjectsVectorsReindexNotFound with default headers values
+func NewSchemaObjectsVectorsReindexNotFound() *SchemaObjectsVectorsReindexNotFound {
+	return &SchemaObjectsVectorsReindexNotFound{}
+}
+
+/*
+SchemaObjectsVectorsReindexNotFound describes a response with status code 404, with default header values.
+
+Class to be reindexed does not exist
+*/
+type SchemaObjectsVectorsReindexNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors reindex not found response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors reindex not found response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex not found response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors reindex not found response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors reindex not found response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vectors reindex not found response
+func (o *SchemaObjectsVectorsReindexNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorsReindexNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsReindexNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsReindexUnprocessableEntity creates a SchemaObjectsVectorsReindexUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorsReindexUnprocessableEntity() *SchemaObjectsVectorsReindexUnprocessableEntity {
+	return &SchemaObjectsVectorsReindexUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorsReindexUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid reindex attempt
+*/
+type SchemaObjectsVectorsReindexUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors reindex unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors reindex unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors reindex unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors reindex unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vectors reindex unprocessable entity response
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsReindexUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsReindexInternalServerError creates a SchemaObjectsVectorsReindexInternalServerError with default headers values
+func NewSchemaObjectsVectorsReindexInternalServerError() *SchemaObjectsVectorsReindexInternalServerError {
+	return &SchemaObjectsVectorsReindexInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorsReindexInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorsReindexInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors reindex internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorsReindexInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors reindex internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorsReindexInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors reindex internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorsReindexInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors reindex internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorsReindexInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vectors reindex internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorsReindexInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vectors reindex internal server error response
+func (o *SchemaObjectsVectorsReindexInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorsReindexInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/reindex][%d] schemaObjectsVectorsReindexInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsReindexInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsReindexInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
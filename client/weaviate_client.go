@@ -26,7 +26,9 @@ import (
 	"github.com/weaviate/weaviate/client/batch"
 	"github.com/weaviate/weaviate/client/classifications"
 	"github.com/weaviate/weaviate/client/cluster"
+	"github.com/weaviate/weaviate/client/exports"
 	"github.com/weaviate/weaviate/client/graphql"
+	"github.com/weaviate/weaviate/client/imports"
 	"github.com/weaviate/weaviate/client/meta"
 	"github.com/weaviate/weaviate/client/nodes"
 	"github.com/weaviate/weaviate/client/objects"
@@ -82,7 +84,9 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 	cli.Batch = batch.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
 	cli.Cluster = cluster.New(transport, formats)
+	cli.Exports = exports.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
+	cli.Imports = imports.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
@@ -143,8 +147,12 @@ type Weaviate struct {
 
 	Cluster cluster.ClientService
 
+	Exports exports.ClientService
+
 	Graphql graphql.ClientService
 
+	Imports imports.ClientService
+
 	Meta meta.ClientService
 
 	Nodes nodes.ClientService
@@ -168,7 +176,9 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Batch.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
 	c.Cluster.SetTransport(transport)
+	c.Exports.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
+	c.Imports.SetTransport(transport)
 	c.Meta.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
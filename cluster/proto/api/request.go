@@ -25,6 +25,10 @@ type AddClassRequest struct {
 type UpdateClassRequest struct {
 	Class *models.Class
 	State *sharding.State
+	// ReindexTargetVector is set when the update rebuilds the vector index
+	// of the given target vector ("" for the legacy vector) with a new
+	// config, instead of updating it in place.
+	ReindexTargetVector *string `json:",omitempty"`
 }
 
 type AddPropertyRequest struct {
@@ -94,3 +98,26 @@ type QueryShardingStateResponse struct {
 	State   *sharding.State
 	Version uint64
 }
+
+type UpsertRolesPermissionsRequest struct {
+	Roles map[string][]*models.Permission
+}
+
+type DeleteRolesRequest struct {
+	Roles []string
+}
+
+type RemovePermissionsRequest struct {
+	Role        string
+	Permissions []*models.Permission
+}
+
+type AddRolesForUserRequest struct {
+	User  string
+	Roles []string
+}
+
+type RevokeRolesForUserRequest struct {
+	User  string
+	Roles []string
+}
@@ -63,6 +63,26 @@ func (s *Raft) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.
 	return s.Execute(ctx, command)
 }
 
+// ReindexVectorIndex updates the class with a new vector index config for the
+// target vector and rebuilds the index, the settings that are immutable in
+// an update can be changed.
+func (s *Raft) ReindexVectorIndex(ctx context.Context, cls *models.Class, targetVector string) (uint64, error) {
+	if cls == nil || cls.Class == "" {
+		return 0, fmt.Errorf("nil class or empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.UpdateClassRequest{Class: cls, ReindexTargetVector: &targetVector}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+		Class:      cls.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) DeleteClass(ctx context.Context, name string) (uint64, error) {
 	command := &cmd.ApplyRequest{
 		Type:  cmd.ApplyRequest_TYPE_DELETE_CLASS,
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/rbac"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func (s *Raft) UpsertRolesPermissions(ctx context.Context, roles map[string][]*models.Permission) error {
+	if len(roles) == 0 {
+		return fmt.Errorf("no roles to upsert: %w", rbac.ErrBadRequest)
+	}
+	for name := range roles {
+		if name == "" {
+			return fmt.Errorf("empty role name: %w", rbac.ErrBadRequest)
+		}
+	}
+	return s.executeRBAC(ctx, cmd.ApplyRequest_TYPE_UPSERT_ROLES_PERMISSIONS,
+		&cmd.UpsertRolesPermissionsRequest{Roles: roles})
+}
+
+func (s *Raft) DeleteRoles(ctx context.Context, names ...string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("no roles to delete: %w", rbac.ErrBadRequest)
+	}
+	return s.executeRBAC(ctx, cmd.ApplyRequest_TYPE_DELETE_ROLES,
+		&cmd.DeleteRolesRequest{Roles: names})
+}
+
+func (s *Raft) RemovePermissions(ctx context.Context, role string, permissions []*models.Permission) error {
+	if role == "" {
+		return fmt.Errorf("empty role name: %w", rbac.ErrBadRequest)
+	}
+	return s.executeRBAC(ctx, cmd.ApplyRequest_TYPE_REMOVE_PERMISSIONS,
+		&cmd.RemovePermissionsRequest{Role: role, Permissions: permissions})
+}
+
+func (s *Raft) AddRolesForUser(ctx context.Context, user string, roles []string) error {
+	if user == "" {
+		return fmt.Errorf("empty user: %w", rbac.ErrBadRequest)
+	}
+	return s.executeRBAC(ctx, cmd.ApplyRequest_TYPE_ADD_ROLES_FOR_USER,
+		&cmd.AddRolesForUserRequest{User: user, Roles: roles})
+}
+
+func (s *Raft) RevokeRolesForUser(ctx context.Context, user string, roles []string) error {
+	if user == "" {
+		return fmt.Errorf("empty user: %w", rbac.ErrBadRequest)
+	}
+	return s.executeRBAC(ctx, cmd.ApplyRequest_TYPE_REVOKE_ROLES_FOR_USER,
+		&cmd.RevokeRolesForUserRequest{User: user, Roles: roles})
+}
+
+// GetRoles reads the roles from the local store, it's served without contacting the leader
+func (s *Raft) GetRoles(names ...string) map[string][]*models.Permission {
+	return s.store.rbacManager.GetRoles(names...)
+}
+
+// GetRolesForUser reads the roles assigned to user from the local store
+func (s *Raft) GetRolesForUser(user string) map[string][]*models.Permission {
+	return s.store.rbacManager.GetRolesForUser(user)
+}
+
+// GetUsersForRole reads the users a role is assigned to from the local store
+func (s *Raft) GetUsersForRole(role string) []string {
+	return s.store.rbacManager.GetUsersForRole(role)
+}
+
+func (s *Raft) executeRBAC(ctx context.Context, typ cmd.ApplyRequest_Type, req interface{}) error {
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       typ,
+		SubCommand: subCommand,
+	}
+	_, err = s.Execute(ctx, command)
+	return err
+}
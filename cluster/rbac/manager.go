@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrRoleNotFound = errors.New("role not found")
+)
+
+// Manager holds the RBAC state (roles, their permissions and the roles
+// assigned to each user) which is replicated through RAFT. Every mutation is
+// applied by the FSM in the same order on every node, therefore all methods
+// mutating the state must stay deterministic.
+type Manager struct {
+	sync.RWMutex
+	// roles maps a role name to its permissions
+	roles map[string][]*models.Permission
+	// users maps a user (or API key) id to the set of roles assigned to it
+	users map[string]map[string]struct{}
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		roles: make(map[string][]*models.Permission),
+		users: make(map[string]map[string]struct{}),
+	}
+}
+
+func (m *Manager) UpsertRolesPermissions(c *command.ApplyRequest) error {
+	req := &command.UpsertRolesPermissionsRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for name, permissions := range req.Roles {
+		existing := m.roles[name]
+		for _, p := range permissions {
+			if p == nil || containsPermission(existing, p) {
+				continue
+			}
+			existing = append(existing, p)
+		}
+		if existing == nil {
+			existing = []*models.Permission{}
+		}
+		m.roles[name] = existing
+	}
+	return nil
+}
+
+func (m *Manager) DeleteRoles(c *command.ApplyRequest) error {
+	req := &command.DeleteRolesRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, name := range req.Roles {
+		delete(m.roles, name)
+		for user, roles := range m.users {
+			delete(roles, name)
+			if len(roles) == 0 {
+				delete(m.users, user)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manager) RemovePermissions(c *command.ApplyRequest) error {
+	req := &command.RemovePermissionsRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	existing, ok := m.roles[req.Role]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrRoleNotFound, req.Role)
+	}
+
+	kept := make([]*models.Permission, 0, len(existing))
+	for _, p := range existing {
+		if !containsPermission(req.Permissions, p) {
+			kept = append(kept, p)
+		}
+	}
+	m.roles[req.Role] = kept
+	return nil
+}
+
+func (m *Manager) AddRolesForUser(c *command.ApplyRequest) error {
+	req := &command.AddRolesForUserRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.User == "" {
+		return fmt.Errorf("%w: empty user", ErrBadRequest)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, name := range req.Roles {
+		if _, ok := m.roles[name]; !ok {
+			return fmt.Errorf("%w: %q", ErrRoleNotFound, name)
+		}
+	}
+
+	assigned, ok := m.users[req.User]
+	if !ok {
+		assigned = make(map[string]struct{}, len(req.Roles))
+		m.users[req.User] = assigned
+	}
+	for _, name := range req.Roles {
+		assigned[name] = struct{}{}
+	}
+	return nil
+}
+
+func (m *Manager) RevokeRolesForUser(c *command.ApplyRequest) error {
+	req := &command.RevokeRolesForUserRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	assigned, ok := m.users[req.User]
+	if !ok {
+		return nil
+	}
+	for _, name := range req.Roles {
+		delete(assigned, name)
+	}
+	if len(assigned) == 0 {
+		delete(m.users, req.User)
+	}
+	return nil
+}
+
+// GetRoles returns the permissions of the requested roles. If no names are
+// passed all roles are returned. Unknown roles are skipped.
+func (m *Manager) GetRoles(names ...string) map[string][]*models.Permission {
+	m.RLock()
+	defer m.RUnlock()
+
+	if len(names) == 0 {
+		out := make(map[string][]*models.Permission, len(m.roles))
+		for name, permissions := range m.roles {
+			out[name] = copyPermissions(permissions)
+		}
+		return out
+	}
+
+	out := make(map[string][]*models.Permission, len(names))
+	for _, name := range names {
+		if permissions, ok := m.roles[name]; ok {
+			out[name] = copyPermissions(permissions)
+		}
+	}
+	return out
+}
+
+// GetRolesForUser returns the roles assigned to user along with their permissions
+func (m *Manager) GetRolesForUser(user string) map[string][]*models.Permission {
+	m.RLock()
+	defer m.RUnlock()
+
+	assigned := m.users[user]
+	out := make(map[string][]*models.Permission, len(assigned))
+	for name := range assigned {
+		out[name] = copyPermissions(m.roles[name])
+	}
+	return out
+}
+
+// GetUsersForRole returns the sorted list of users the role is assigned to
+func (m *Manager) GetUsersForRole(role string) []string {
+	m.RLock()
+	defer m.RUnlock()
+
+	users := []string{}
+	for user, roles := range m.users {
+		if _, ok := roles[role]; ok {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+	return users
+}
+
+type snapshot struct {
+	Roles map[string][]*models.Permission `json:"roles"`
+	Users map[string][]string             `json:"users"`
+}
+
+// Snapshot returns the serialized RBAC state to be embedded into the RAFT snapshot
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	snap := snapshot{
+		Roles: m.roles,
+		Users: make(map[string][]string, len(m.users)),
+	}
+	for user, roles := range m.users {
+		names := make([]string, 0, len(roles))
+		for name := range roles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		snap.Users[user] = names
+	}
+	return json.Marshal(&snap)
+}
+
+// Restore replaces the current state with the one serialized in data. An
+// empty data resets the state, which is the case for snapshots written
+// before RBAC existed.
+func (m *Manager) Restore(data []byte) error {
+	snap := snapshot{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return fmt.Errorf("restore rbac snapshot: %w", err)
+		}
+	}
+
+	roles := make(map[string][]*models.Permission, len(snap.Roles))
+	for name, permissions := range snap.Roles {
+		if permissions == nil {
+			permissions = []*models.Permission{}
+		}
+		roles[name] = permissions
+	}
+	users := make(map[string]map[string]struct{}, len(snap.Users))
+	for user, names := range snap.Users {
+		assigned := make(map[string]struct{}, len(names))
+		for _, name := range names {
+			assigned[name] = struct{}{}
+		}
+		users[user] = assigned
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.roles = roles
+	m.users = users
+	return nil
+}
+
+func copyPermissions(in []*models.Permission) []*models.Permission {
+	out := make([]*models.Permission, len(in))
+	for i, p := range in {
+		cp := *p
+		out[i] = &cp
+	}
+	return out
+}
+
+func containsPermission(list []*models.Permission, p *models.Permission) bool {
+	for _, candidate := range list {
+		if candidate != nil && EqualPermissions(candidate, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualPermissions reports whether both permissions grant the same action on
+// the same resources. Unset resource fields are equivalent to "*".
+func EqualPermissions(a, b *models.Permission) bool {
+	return valueOrAll(a.Action) == valueOrAll(b.Action) &&
+		valueOrAll(a.Collection) == valueOrAll(b.Collection) &&
+		valueOrAll(a.Tenant) == valueOrAll(b.Tenant) &&
+		valueOrAll(a.Object) == valueOrAll(b.Object) &&
+		valueOrAll(a.Role) == valueOrAll(b.Role)
+}
+
+func valueOrAll(s *string) string {
+	if s == nil || *s == "" {
+		return "*"
+	}
+	return *s
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func applyRequest(t *testing.T, req interface{}) *command.ApplyRequest {
+	t.Helper()
+	sub, err := json.Marshal(req)
+	require.Nil(t, err)
+	return &command.ApplyRequest{SubCommand: sub}
+}
+
+func permission(action, collection string) *models.Permission {
+	return &models.Permission{Action: &action, Collection: &collection}
+}
+
+func TestManager(t *testing.T) {
+	m := NewManager()
+	readFoo := permission(models.PermissionActionReadCollections, "Foo")
+	readBar := permission(models.PermissionActionReadCollections, "Bar")
+
+	require.Nil(t, m.UpsertRolesPermissions(applyRequest(t, &command.UpsertRolesPermissionsRequest{
+		Roles: map[string][]*models.Permission{
+			"reader": {readFoo, readBar},
+			"empty":  nil,
+		},
+	})))
+	// upserting an existing permission doesn't duplicate it
+	require.Nil(t, m.UpsertRolesPermissions(applyRequest(t, &command.UpsertRolesPermissionsRequest{
+		Roles: map[string][]*models.Permission{"reader": {readFoo}},
+	})))
+	assert.Len(t, m.GetRoles("reader")["reader"], 2)
+	assert.Len(t, m.GetRoles(), 2)
+	assert.Empty(t, m.GetRoles("unknown"))
+
+	t.Run("assign roles", func(t *testing.T) {
+		err := m.AddRolesForUser(applyRequest(t, &command.AddRolesForUserRequest{User: "alice", Roles: []string{"unknown"}}))
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+
+		require.Nil(t, m.AddRolesForUser(applyRequest(t, &command.AddRolesForUserRequest{User: "alice", Roles: []string{"reader", "empty"}})))
+		require.Nil(t, m.AddRolesForUser(applyRequest(t, &command.AddRolesForUserRequest{User: "bob", Roles: []string{"reader"}})))
+		assert.Len(t, m.GetRolesForUser("alice"), 2)
+		assert.Equal(t, []string{"alice", "bob"}, m.GetUsersForRole("reader"))
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		data, err := m.Snapshot()
+		require.Nil(t, err)
+
+		restored := NewManager()
+		require.Nil(t, restored.Restore(data))
+		assert.Equal(t, m.GetRoles(), restored.GetRoles())
+		assert.Equal(t, m.GetRolesForUser("alice"), restored.GetRolesForUser("alice"))
+
+		require.Nil(t, restored.Restore(nil))
+		assert.Empty(t, restored.GetRoles())
+	})
+
+	t.Run("remove permissions", func(t *testing.T) {
+		err := m.RemovePermissions(applyRequest(t, &command.RemovePermissionsRequest{Role: "unknown"}))
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+
+		require.Nil(t, m.RemovePermissions(applyRequest(t, &command.RemovePermissionsRequest{
+			Role: "reader", Permissions: []*models.Permission{readBar},
+		})))
+		permissions := m.GetRoles("reader")["reader"]
+		require.Len(t, permissions, 1)
+		assert.True(t, EqualPermissions(readFoo, permissions[0]))
+	})
+
+	t.Run("revoke and delete roles", func(t *testing.T) {
+		require.Nil(t, m.RevokeRolesForUser(applyRequest(t, &command.RevokeRolesForUserRequest{User: "bob", Roles: []string{"reader"}})))
+		assert.Equal(t, []string{"alice"}, m.GetUsersForRole("reader"))
+
+		require.Nil(t, m.DeleteRoles(applyRequest(t, &command.DeleteRolesRequest{Roles: []string{"reader"}})))
+		assert.Empty(t, m.GetUsersForRole("reader"))
+		assert.Len(t, m.GetRolesForUser("alice"), 1)
+	})
+}
+
+func TestEqualPermissions(t *testing.T) {
+	all := "*"
+	action := models.PermissionActionManageCluster
+	assert.True(t, EqualPermissions(&models.Permission{Action: &action}, &models.Permission{Action: &action, Collection: &all}))
+	assert.False(t, EqualPermissions(permission(action, "Foo"), permission(action, "Bar")))
+}
@@ -17,12 +17,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/exp/metadata"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 	gproto "google.golang.org/protobuf/proto"
 )
 
@@ -223,19 +225,36 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		// Ensure that if non-default values for properties is stored in raft we fix them before processing an update to
 		// avoid triggering diff on properties and therefore discarding a legitimate update.
 		migratePropertiesIfNecessary(&meta.Class)
-		u, err := s.parser.ParseClassUpdate(&meta.Class, req.Class)
+		current := &meta.Class
+		if req.ReindexTargetVector != nil {
+			base, err := s.reindexBaseClass(cmd.SubCommand, current, *req.ReindexTargetVector)
+			if err != nil {
+				return fmt.Errorf("%w :reindex vector index: %w", ErrBadRequest, err)
+			}
+			current = base
+		}
+		u, err := s.parser.ParseClassUpdate(current, req.Class)
 		if err != nil {
 			return fmt.Errorf("%w :parse class update: %w", ErrBadRequest, err)
 		}
+		meta.Class.VectorIndexType = u.VectorIndexType
 		meta.Class.VectorIndexConfig = u.VectorIndexConfig
 		meta.Class.InvertedIndexConfig = u.InvertedIndexConfig
 		meta.Class.VectorConfig = u.VectorConfig
 		meta.Class.ReplicationConfig = u.ReplicationConfig
+		meta.Class.ObjectTTLConfig = u.ObjectTTLConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
 		meta.Class.Description = u.Description
 		meta.ClassVersion = cmd.Version
 		if req.State != nil {
 			meta.Sharding = *req.State
+			// resharding changes the shard count
+			cfg, ok := meta.Class.ShardingConfig.(shardingConfig.Config)
+			if ok && cfg.DesiredCount != req.State.Config.DesiredCount {
+				cfg.DesiredCount = req.State.Config.DesiredCount
+				cfg.ActualCount = req.State.Config.ActualCount
+				meta.Class.ShardingConfig = cfg
+			}
 		}
 		return nil
 	}
@@ -251,6 +270,46 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 	)
 }
 
+// reindexBaseClass returns a copy of the class where the vector index of the
+// target vector has the type and config of the update. Validating the update
+// against it allows to change the settings that are immutable otherwise, as
+// the vector index is rebuilt from scratch.
+func (s *SchemaManager) reindexBaseClass(subCommand []byte, class *models.Class, targetVector string) (*models.Class, error) {
+	// the update is parsed in place by ParseClassUpdate, a copy is needed to
+	// get the parsed vector index config
+	req := command.UpdateClassRequest{}
+	if err := json.Unmarshal(subCommand, &req); err != nil {
+		return nil, err
+	}
+	if err := s.parser.ParseClass(req.Class); err != nil {
+		return nil, err
+	}
+
+	base := *class
+	if targetVector == "" {
+		if len(class.VectorConfig) > 0 {
+			return nil, fmt.Errorf("class %q has named vectors, a target vector is required", class.Class)
+		}
+		base.VectorIndexType = req.Class.VectorIndexType
+		base.VectorIndexConfig = req.Class.VectorIndexConfig
+		return &base, nil
+	}
+
+	current, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("target vector %q not found", targetVector)
+	}
+	updated, ok := req.Class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("missing config for target vector %q", targetVector)
+	}
+	current.VectorIndexType = updated.VectorIndexType
+	current.VectorIndexConfig = updated.VectorIndexConfig
+	base.VectorConfig = maps.Clone(class.VectorConfig)
+	base.VectorConfig[targetVector] = current
+	return &base, nil
+}
+
 func (s *SchemaManager) DeleteClass(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
 	var hasFrozen bool
 	tenants, err := s.schema.getTenants(cmd.Class, nil)
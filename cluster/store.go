@@ -30,6 +30,7 @@ import (
 	raftbolt "github.com/hashicorp/raft-boltdb/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/log"
+	"github.com/weaviate/weaviate/cluster/rbac"
 	"github.com/weaviate/weaviate/cluster/resolver"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
@@ -187,6 +188,8 @@ type Store struct {
 	// schemaManager is responsible for applying changes committed by RAFT to the schema representation & querying the
 	// schema
 	schemaManager *schema.SchemaManager
+	// rbacManager is responsible for applying changes committed by RAFT to the roles and their assignments
+	rbacManager *rbac.Manager
 	// lastAppliedIndexToDB represents the index of the last applied command when the store is opened.
 	lastAppliedIndexToDB atomic.Uint64
 	// / lastAppliedIndex index of latest update to the store
@@ -226,6 +229,7 @@ func NewFSM(cfg Config) Store {
 		applyTimeout:  time.Second * 20,
 		raftResolver:  raftResolver,
 		schemaManager: schemaManager,
+		rbacManager:   rbac.NewManager(),
 	}
 }
 
@@ -754,6 +758,7 @@ func (st *Store) recoverSingleNode(force bool) error {
 		applyTimeout:  st.applyTimeout,
 		snapshotStore: st.snapshotStore,
 		schemaManager: st.schemaManager,
+		rbacManager:   st.rbacManager,
 		logStore:      st.logStore,
 		logCache:      st.logCache,
 	}, st.logCache,
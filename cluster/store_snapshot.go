@@ -12,6 +12,8 @@
 package cluster
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -21,6 +23,10 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 )
 
+// snapshotRBACKey is the top level key of the snapshot document holding the RBAC state. The rest of the document is
+// the schema snapshot, which keeps snapshots readable by versions that predate RBAC.
+const snapshotRBACKey = "rbac"
+
 // Snapshot returns an FSMSnapshot used to: support log compaction, to
 // restore the FSM to a previous state, or to bring out-of-date followers up
 // to a recent log index.
@@ -35,7 +41,11 @@ import (
 // be implemented to allow for concurrent updates while a snapshot is happening.
 func (st *Store) Snapshot() (raft.FSMSnapshot, error) {
 	st.log.Info("persisting snapshot")
-	return st.schemaManager.Snapshot(), nil
+	rbacState, err := st.rbacManager.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot rbac: %w", err)
+	}
+	return &storeSnapshot{schema: st.schemaManager.Snapshot(), rbac: rbacState}, nil
 }
 
 // Restore is used to restore an FSM from a snapshot. It is not called
@@ -50,12 +60,25 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}()
 
-		if err := st.schemaManager.Restore(rc, st.cfg.Parser); err != nil {
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("read snapshot: %w", err)
+		}
+		if err := st.schemaManager.Restore(io.NopCloser(bytes.NewReader(data)), st.cfg.Parser); err != nil {
 			st.log.WithError(err).Error("restoring schema from snapshot")
 			return fmt.Errorf("restore schema from snapshot: %w", err)
 		}
 		st.log.Info("successfully restored schema from snapshot")
 
+		var doc map[string]json.RawMessage
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("restore rbac from snapshot: %w", err)
+		}
+		if err := st.rbacManager.Restore(doc[snapshotRBACKey]); err != nil {
+			st.log.WithError(err).Error("restoring rbac from snapshot")
+			return fmt.Errorf("restore rbac from snapshot: %w", err)
+		}
+
 		if st.cfg.MetadataOnlyVoters {
 			return nil
 		}
@@ -87,3 +110,43 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 	wg.Wait()
 	return err
 }
+
+// storeSnapshot combines the schema snapshot with the RBAC state captured at snapshot time into a single document.
+type storeSnapshot struct {
+	schema raft.FSMSnapshot
+	rbac   []byte
+}
+
+func (s *storeSnapshot) Persist(sink raft.SnapshotSink) error {
+	buf := &bufferedSink{SnapshotSink: sink}
+	if err := s.schema.Persist(buf); err != nil {
+		sink.Cancel()
+		return err
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("decode schema snapshot: %w", err)
+	}
+	doc[snapshotRBACKey] = s.rbac
+	if err := json.NewEncoder(sink).Encode(doc); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("encode: %w", err)
+	}
+	return sink.Close()
+}
+
+func (s *storeSnapshot) Release() {
+	s.schema.Release()
+}
+
+// bufferedSink collects everything written to it in memory instead of the underlying sink, closing it is a no-op.
+type bufferedSink struct {
+	raft.SnapshotSink
+	bytes.Buffer
+}
+
+func (b *bufferedSink) Write(p []byte) (int, error) { return b.Buffer.Write(p) }
+
+func (b *bufferedSink) Close() error { return nil }
@@ -45,6 +45,12 @@ func TestStoreApply(t *testing.T) {
 	}
 
 	cls := &models.Class{Class: "C1", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}}
+	reindexCls := &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		VectorIndexType:    "flat",
+	}
+	missingTargetVector := "missing"
 	ss := &sharding.State{Physical: map[string]sharding.Physical{"T1": {
 		Name:           "T1",
 		BelongsToNodes: []string{"THIS"},
@@ -259,6 +265,50 @@ func TestStoreApply(t *testing.T) {
 				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
 			},
 		},
+		{
+			name: "UpdateClass/Reindex",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+				cmd.UpdateClassRequest{Class: reindexCls, ReindexTargetVector: new(string)},
+				nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				// the update is validated against the class with the new index type
+				m.parser.On("ParseClassUpdate", mock.MatchedBy(func(c *models.Class) bool {
+					return c.VectorIndexType == "flat"
+				}), mock.Anything).Return(mock.Anything, nil)
+				m.indexer.On("UpdateClass", mock.MatchedBy(func(req cmd.UpdateClassRequest) bool {
+					return req.ReindexTargetVector != nil && *req.ReindexTargetVector == ""
+				})).Return(nil)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil || class.VectorIndexType != "flat" {
+					return fmt.Errorf("class was not updated")
+				}
+				return nil
+			},
+		},
+		{
+			name: "UpdateClass/ReindexTargetVectorNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+				cmd.UpdateClassRequest{Class: reindexCls, ReindexTargetVector: &missingTargetVector},
+				nil)},
+			resp: Response{Error: schema.ErrBadRequest},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+		},
 		{
 			name: "DeleteClass/Success",
 			req: raft.Log{Data: cmdAsBytes("C1",
@@ -37,6 +37,14 @@ type Properties struct {
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
 
+	// ExplainVectorSearch returns how the vector index executed the search,
+	// see VectorSearchExplain
+	ExplainVectorSearch bool `json:"explainVectorSearch"`
+
+	// FilterStrategy overrides the filter strategy of the vector index for
+	// a single query. Empty means the index decides.
+	FilterStrategy string `json:"filterStrategy"`
+
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
 	NoProps bool `json:"noProps"`
@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// VectorSearchExplain describes how a shard executed a vector search. It is
+// attached to every object that shard returned, so it helps to understand
+// why a (filtered) vector search is slow.
+type VectorSearchExplain struct {
+	Shard string `json:"shard"`
+
+	// AllowListSize is the number of objects matching the filter, it is -1
+	// for unfiltered searches
+	AllowListSize int `json:"allowListSize"`
+
+	// FilterStrategy is the strategy chosen by the vector index to apply the
+	// filter, it is empty for unfiltered searches
+	FilterStrategy string `json:"filterStrategy,omitempty"`
+
+	NodesVisited         int64 `json:"nodesVisited"`
+	DistanceComputations int64 `json:"distanceComputations"`
+	RescoredCandidates   int64 `json:"rescoredCandidates"`
+}
@@ -35,6 +35,12 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+
+	// BaseID is the id of the backup an incremental backup was based on
+	BaseID string `json:"baseId,omitempty"`
+	// Dependencies lists all backups holding files referenced by an
+	// incremental backup, starting with its base.
+	Dependencies []string `json:"dependencies,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +230,20 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// FileInfos maps the relative path of immutable files (LSM segments,
+	// HNSW commit logs) to their metadata. Files referencing another backup
+	// are not part of Files and must be restored from that backup.
+	FileInfos map[string]FileInfo `json:"fileInfos,omitempty"`
+}
+
+// FileInfo describes a backed up file
+type FileInfo struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	// BackupID is the backup storing the file's content.
+	// It is empty if the file is stored in the backup it is described in.
+	BackupID string `json:"backupId,omitempty"`
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -259,6 +279,7 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	BaseID        string            `json:"baseId,omitempty"` // base of an incremental backup
 }
 
 // List all existing classes in d
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changefeed
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ErrTruncated is returned when resuming from a position whose following
+// changes are no longer retained
+var ErrTruncated = errors.New("change feed position is no longer retained")
+
+type Operation uint8
+
+const (
+	OperationInsert Operation = iota + 1
+	OperationUpdate
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationInsert:
+		return "insert"
+	case OperationUpdate:
+		return "update"
+	case OperationDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(o))
+	}
+}
+
+// Change is a single object mutation recorded in the change feed of a shard
+type Change struct {
+	// Sequence is the position of the change in the feed of its shard, it
+	// increases strictly monotonically
+	Sequence  uint64
+	Operation Operation
+	UUID      strfmt.UUID
+	// Timestamp is the last update time of the object in ms, or the deletion
+	// time for deletes
+	Timestamp int64
+	// Object is the object as written, it is nil for deletes and if the
+	// object was not requested
+	Object *storobj.Object
+}
+
+// ShardPosition is the sequence of the last consumed change of a shard.
+// Sequences are assigned by each replica of a shard independently, so they
+// are only meaningful for the node they were read from.
+type ShardPosition struct {
+	Node     string `json:"node"`
+	Sequence uint64 `json:"seq"`
+}
+
+// Position holds the position of the last consumed change per shard
+type Position map[string]ShardPosition
+
+// Token encodes the position as an opaque resume token
+func (p Position) Token() string {
+	b, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// PositionFromToken decodes a resume token created by Position.Token, an
+// empty token is the position before the oldest retained change
+func PositionFromToken(token string) (Position, error) {
+	p := Position{}
+	if token == "" {
+		return p, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume token: %w", err)
+	}
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid resume token: %w", err)
+	}
+	if p == nil {
+		// a null token decodes to a nil map, the position is updated in place
+		return Position{}, nil
+	}
+	return p, nil
+}
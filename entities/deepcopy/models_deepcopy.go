@@ -66,6 +66,7 @@ func Prop(p *models.Property) *models.Property {
 		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
 		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
+		IndexPositions:    ptrBoolCopy(p.IndexPositions),
 	}
 }
 
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AsyncReplicationRepairResponse The shards whose replicas are repaired in the background
+//
+// swagger:model AsyncReplicationRepairResponse
+type AsyncReplicationRepairResponse struct {
+
+	// The name of the collection.
+	Class string `json:"class,omitempty"`
+
+	// The names of the shards whose repair was started.
+	Shards []string `json:"shards"`
+}
+
+// Validate validates this async replication repair response
+func (m *AsyncReplicationRepairResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this async replication repair response based on context it is used
+func (m *AsyncReplicationRepairResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AsyncReplicationRepairResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AsyncReplicationRepairResponse) UnmarshalBinary(b []byte) error {
+	var res AsyncReplicationRepairResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
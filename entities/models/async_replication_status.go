@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AsyncReplicationStatus The state of async replication of a shard replica, as seen by the node holding it
+//
+// swagger:model AsyncReplicationStatus
+type AsyncReplicationStatus struct {
+
+	// The hosts of the replicas compared in the last hashbeat.
+	LastComparedHosts []string `json:"lastComparedHosts"`
+
+	// The error of the last hashbeat, if it failed.
+	LastError string `json:"lastError,omitempty"`
+
+	// The time of the last hashbeat, in milliseconds since epoch UTC. 0 if there was none yet.
+	LastHashbeatUnixMillis int64 `json:"lastHashbeatUnixMillis"`
+
+	// The time of the last hashbeat which compared all replicas without errors, in milliseconds since epoch UTC. 0 if there was none yet.
+	LastSyncUnixMillis int64 `json:"lastSyncUnixMillis"`
+
+	// The number of objects propagated to other replicas since the shard was loaded.
+	ObjectsPropagated int64 `json:"objectsPropagated"`
+
+	// The error of the last repair, if it failed.
+	RepairError string `json:"repairError,omitempty"`
+
+	// The time the last repair finished, in milliseconds since epoch UTC.
+	RepairFinishedUnixMillis int64 `json:"repairFinishedUnixMillis,omitempty"`
+
+	// The time the last repair started, in milliseconds since epoch UTC.
+	RepairStartedUnixMillis int64 `json:"repairStartedUnixMillis,omitempty"`
+
+	// The state of the last repair of the shard started on this replica. Empty if there was none since the shard was loaded.
+	// Enum: [RUNNING SUCCEEDED FAILED]
+	RepairStatus string `json:"repairStatus,omitempty"`
+
+	// Whether the replica is still building its hashtree, compares itself with the other replicas or is paused.
+	// Enum: [INITIALIZING ACTIVE PAUSED]
+	Status string `json:"status,omitempty"`
+
+	// The result of the last hashbeat per replica which differed from this one.
+	Targets []*AsyncReplicationTargetStatus `json:"targets"`
+}
+
+// Validate validates this async replication status
+func (m *AsyncReplicationStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRepairStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var asyncReplicationStatusTypeRepairStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","SUCCEEDED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		asyncReplicationStatusTypeRepairStatusPropEnum = append(asyncReplicationStatusTypeRepairStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AsyncReplicationStatusRepairStatusRUNNING captures enum value "RUNNING"
+	AsyncReplicationStatusRepairStatusRUNNING string = "RUNNING"
+
+	// AsyncReplicationStatusRepairStatusSUCCEEDED captures enum value "SUCCEEDED"
+	AsyncReplicationStatusRepairStatusSUCCEEDED string = "SUCCEEDED"
+
+	// AsyncReplicationStatusRepairStatusFAILED captures enum value "FAILED"
+	AsyncReplicationStatusRepairStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *AsyncReplicationStatus) validateRepairStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, asyncReplicationStatusTypeRepairStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AsyncReplicationStatus) validateRepairStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.RepairStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRepairStatusEnum("repairStatus", "body", m.RepairStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var asyncReplicationStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["INITIALIZING","ACTIVE","PAUSED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		asyncReplicationStatusTypeStatusPropEnum = append(asyncReplicationStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AsyncReplicationStatusStatusINITIALIZING captures enum value "INITIALIZING"
+	AsyncReplicationStatusStatusINITIALIZING string = "INITIALIZING"
+
+	// AsyncReplicationStatusStatusACTIVE captures enum value "ACTIVE"
+	AsyncReplicationStatusStatusACTIVE string = "ACTIVE"
+
+	// AsyncReplicationStatusStatusPAUSED captures enum value "PAUSED"
+	AsyncReplicationStatusStatusPAUSED string = "PAUSED"
+)
+
+// prop value enum
+func (m *AsyncReplicationStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, asyncReplicationStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AsyncReplicationStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AsyncReplicationStatus) validateTargets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Targets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Targets); i++ {
+		if swag.IsZero(m.Targets[i]) { // not required
+			continue
+		}
+
+		if m.Targets[i] != nil {
+			if err := m.Targets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("targets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this async replication status based on the context it is used
+func (m *AsyncReplicationStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTargets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AsyncReplicationStatus) contextValidateTargets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Targets); i++ {
+
+		if m.Targets[i] != nil {
+			if err := m.Targets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("targets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AsyncReplicationStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AsyncReplicationStatus) UnmarshalBinary(b []byte) error {
+	var res AsyncReplicationStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
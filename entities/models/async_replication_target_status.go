@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AsyncReplicationTargetStatus The result of the last hashbeat comparing a shard replica with another one
+//
+// swagger:model AsyncReplicationTargetStatus
+type AsyncReplicationTargetStatus struct {
+
+	// The number of hashtree leaf ranges which differed between the replicas.
+	Differences int64 `json:"differences"`
+
+	// The reason the replicas could not be compared or repaired, if any.
+	Error string `json:"error,omitempty"`
+
+	// The host of the other replica.
+	Host string `json:"host"`
+
+	// The number of local objects in the differing ranges.
+	LocalObjects int64 `json:"localObjects"`
+
+	// The number of objects propagated to the other replica.
+	ObjectsPropagated int64 `json:"objectsPropagated"`
+
+	// The number of objects of the other replica in the differing ranges.
+	RemoteObjects int64 `json:"remoteObjects"`
+}
+
+// Validate validates this async replication target status
+func (m *AsyncReplicationTargetStatus) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this async replication target status based on context it is used
+func (m *AsyncReplicationTargetStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AsyncReplicationTargetStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AsyncReplicationTargetStatus) UnmarshalBinary(b []byte) error {
+	var res AsyncReplicationTargetStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
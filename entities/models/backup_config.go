@@ -51,6 +51,9 @@ type BackupConfig struct {
 	// name of the endpoint, e.g. s3.amazonaws.com
 	Endpoint string `json:"Endpoint,omitempty"`
 
+	// ID of a successful backup on the same backend. If set, LSM segments and HNSW commit logs which did not change since that backup are not uploaded again, they are restored from the backup chain instead.
+	IncrementalBaseBackupID string `json:"IncrementalBaseBackupId,omitempty"`
+
 	// Path or key within the bucket
 	Path string `json:"Path,omitempty"`
 }
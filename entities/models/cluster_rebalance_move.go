@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ClusterRebalanceMove A shard replica move to rebalance the cluster
+//
+// swagger:model ClusterRebalanceMove
+type ClusterRebalanceMove struct {
+
+	// The name of the collection.
+	Class string `json:"class,omitempty"`
+
+	// The number of objects of the replica.
+	ObjectCount int64 `json:"objectCount,omitempty"`
+
+	// The name of the shard.
+	Shard string `json:"shard,omitempty"`
+
+	// The node the replica is moved from.
+	SourceNode string `json:"sourceNode,omitempty"`
+
+	// The node the replica is moved to.
+	TargetNode string `json:"targetNode,omitempty"`
+}
+
+// Validate validates this cluster rebalance move
+func (m *ClusterRebalanceMove) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this cluster rebalance move based on context it is used
+func (m *ClusterRebalanceMove) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterRebalanceMove) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterRebalanceMove) UnmarshalBinary(b []byte) error {
+	var res ClusterRebalanceMove
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
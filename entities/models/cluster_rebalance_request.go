@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ClusterRebalanceRequest Request to even out the number of objects stored by nodes
+//
+// swagger:model ClusterRebalanceRequest
+type ClusterRebalanceRequest struct {
+
+	// Only propose the moves without executing them.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// The maximum number of replicas to move. Defaults to 10.
+	MaxMoves int64 `json:"maxMoves,omitempty"`
+}
+
+// Validate validates this cluster rebalance request
+func (m *ClusterRebalanceRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this cluster rebalance request based on context it is used
+func (m *ClusterRebalanceRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterRebalanceRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterRebalanceRequest) UnmarshalBinary(b []byte) error {
+	var res ClusterRebalanceRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ExportStatusResponse Status of an export
+//
+// swagger:model ExportStatusResponse
+type ExportStatusResponse struct {
+
+	// Backup backend name the export is written to e.g. filesystem, gcs, s3.
+	Backend string `json:"backend,omitempty"`
+
+	// Name of the exported collection
+	Collection string `json:"collection,omitempty"`
+
+	// Time the export completed
+	// Format: date-time
+	CompletedAt strfmt.DateTime `json:"completedAt,omitempty"`
+
+	// Error message if the export failed
+	Error string `json:"error,omitempty"`
+
+	// File format of the exported data
+	Format string `json:"format,omitempty"`
+
+	// The ID of the export
+	ID string `json:"id,omitempty"`
+
+	// Destination path of the exported files
+	Path string `json:"path,omitempty"`
+
+	// Data files of the exported shards
+	Shards []*ExportStatusResponseShardsItems0 `json:"shards"`
+
+	// Time the export was started
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"startedAt,omitempty"`
+
+	// phase of the export
+	// Enum: [STARTED TRANSFERRING SUCCESS FAILED]
+	Status *string `json:"status,omitempty"`
+
+	// Exported tenant, empty if all tenants are exported
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this export status response
+func (m *ExportStatusResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShards(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ExportStatusResponse) validateCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completedAt", "body", "date-time", m.CompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ExportStatusResponse) validateShards(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shards) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Shards); i++ {
+		if swag.IsZero(m.Shards[i]) { // not required
+			continue
+		}
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ExportStatusResponse) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var exportStatusResponseTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["STARTED","TRANSFERRING","SUCCESS","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		exportStatusResponseTypeStatusPropEnum = append(exportStatusResponseTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ExportStatusResponseStatusSTARTED captures enum value "STARTED"
+	ExportStatusResponseStatusSTARTED string = "STARTED"
+
+	// ExportStatusResponseStatusTRANSFERRING captures enum value "TRANSFERRING"
+	ExportStatusResponseStatusTRANSFERRING string = "TRANSFERRING"
+
+	// ExportStatusResponseStatusSUCCESS captures enum value "SUCCESS"
+	ExportStatusResponseStatusSUCCESS string = "SUCCESS"
+
+	// ExportStatusResponseStatusFAILED captures enum value "FAILED"
+	ExportStatusResponseStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ExportStatusResponse) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, exportStatusResponseTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ExportStatusResponse) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this export status response based on the context it is used
+func (m *ExportStatusResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateShards(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ExportStatusResponse) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Shards); i++ {
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ExportStatusResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ExportStatusResponse) UnmarshalBinary(b []byte) error {
+	var res ExportStatusResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// ExportStatusResponseShardsItems0 export status response shards items0
+//
+// swagger:model ExportStatusResponseShardsItems0
+type ExportStatusResponseShardsItems0 struct {
+
+	// Key of the data file relative to the export
+	Key string `json:"key,omitempty"`
+
+	// Name of the shard
+	Name string `json:"name,omitempty"`
+
+	// Number of exported objects
+	Objects int64 `json:"objects,omitempty"`
+}
+
+// Validate validates this export status response shards items0
+func (m *ExportStatusResponseShardsItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this export status response shards items0 based on context it is used
+func (m *ExportStatusResponseShardsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ExportStatusResponseShardsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ExportStatusResponseShardsItems0) UnmarshalBinary(b []byte) error {
+	var res ExportStatusResponseShardsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportCreateRequest Request body for importing files from a backup backend
+//
+// swagger:model ImportCreateRequest
+type ImportCreateRequest struct {
+
+	// Number of objects ingested at once
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// Collection to import the objects into, overrides the collection of the records
+	Collection string `json:"collection,omitempty"`
+
+	// Custom location of the files
+	Config *ExportConfig `json:"config,omitempty"`
+
+	// Files to import relative to the source. All data files of the export are imported if not set.
+	Files []string `json:"files"`
+
+	// File format of the files. The format of the export or the file extensions are used if not set.
+	// Enum: [jsonl parquet]
+	Format string `json:"format,omitempty"`
+
+	// The ID of the import. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed. Starting an import with the ID of a failed or canceled import resumes it.
+	ID string `json:"id,omitempty"`
+
+	// Directory of the files on the backend, usually the ID of an export
+	Source string `json:"source,omitempty"`
+
+	// Tenant to import the objects into, overrides the tenant of the records
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this import create request
+func (m *ImportCreateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportCreateRequest) validateConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.Config) { // not required
+		return nil
+	}
+
+	if m.Config != nil {
+		if err := m.Config.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("config")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("config")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var importCreateRequestTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["jsonl","parquet"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importCreateRequestTypeFormatPropEnum = append(importCreateRequestTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// ImportCreateRequestFormatJsonl captures enum value "jsonl"
+	ImportCreateRequestFormatJsonl string = "jsonl"
+
+	// ImportCreateRequestFormatParquet captures enum value "parquet"
+	ImportCreateRequestFormatParquet string = "parquet"
+)
+
+// prop value enum
+func (m *ImportCreateRequest) validateFormatEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, importCreateRequestTypeFormatPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportCreateRequest) validateFormat(formats strfmt.Registry) error {
+	if swag.IsZero(m.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateFormatEnum("format", "body", m.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this import create request based on the context it is used
+func (m *ImportCreateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportCreateRequest) contextValidateConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Config != nil {
+		if err := m.Config.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("config")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("config")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportCreateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportCreateRequest) UnmarshalBinary(b []byte) error {
+	var res ImportCreateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
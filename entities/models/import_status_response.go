@@ -0,0 +1,404 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportStatusResponse Status of an import
+//
+// swagger:model ImportStatusResponse
+type ImportStatusResponse struct {
+
+	// Backup backend name the files are read from e.g. filesystem, gcs, s3.
+	Backend string `json:"backend,omitempty"`
+
+	// Collection the objects are imported into, empty if the collection of the records is used
+	Collection string `json:"collection,omitempty"`
+
+	// Time the import completed
+	// Format: date-time
+	CompletedAt strfmt.DateTime `json:"completedAt,omitempty"`
+
+	// Error message if the import failed
+	Error string `json:"error,omitempty"`
+
+	// Errors of single rows, at most 1000 errors are reported
+	Errors []*ImportStatusResponseErrorsItems0 `json:"errors"`
+
+	// Progress of the imported files
+	Files []*ImportStatusResponseFilesItems0 `json:"files"`
+
+	// The ID of the import
+	ID string `json:"id,omitempty"`
+
+	// Directory of the files on the backend
+	Source string `json:"source,omitempty"`
+
+	// Time the import was started or resumed
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"startedAt,omitempty"`
+
+	// phase of the import
+	// Enum: [STARTED TRANSFERRING SUCCESS FAILED CANCELED]
+	Status *string `json:"status,omitempty"`
+
+	// Tenant the objects are imported into, empty if the tenant of the records is used
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this import status response
+func (m *ImportStatusResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFiles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportStatusResponse) validateCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completedAt", "body", "date-time", m.CompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportStatusResponse) validateErrors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Errors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Errors); i++ {
+		if swag.IsZero(m.Errors[i]) { // not required
+			continue
+		}
+
+		if m.Errors[i] != nil {
+			if err := m.Errors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ImportStatusResponse) validateFiles(formats strfmt.Registry) error {
+	if swag.IsZero(m.Files) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Files); i++ {
+		if swag.IsZero(m.Files[i]) { // not required
+			continue
+		}
+
+		if m.Files[i] != nil {
+			if err := m.Files[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ImportStatusResponse) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var importStatusResponseTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["STARTED","TRANSFERRING","SUCCESS","FAILED","CANCELED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importStatusResponseTypeStatusPropEnum = append(importStatusResponseTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ImportStatusResponseStatusSTARTED captures enum value "STARTED"
+	ImportStatusResponseStatusSTARTED string = "STARTED"
+
+	// ImportStatusResponseStatusTRANSFERRING captures enum value "TRANSFERRING"
+	ImportStatusResponseStatusTRANSFERRING string = "TRANSFERRING"
+
+	// ImportStatusResponseStatusSUCCESS captures enum value "SUCCESS"
+	ImportStatusResponseStatusSUCCESS string = "SUCCESS"
+
+	// ImportStatusResponseStatusFAILED captures enum value "FAILED"
+	ImportStatusResponseStatusFAILED string = "FAILED"
+
+	// ImportStatusResponseStatusCANCELED captures enum value "CANCELED"
+	ImportStatusResponseStatusCANCELED string = "CANCELED"
+)
+
+// prop value enum
+func (m *ImportStatusResponse) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, importStatusResponseTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportStatusResponse) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this import status response based on the context it is used
+func (m *ImportStatusResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateErrors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFiles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportStatusResponse) contextValidateErrors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Errors); i++ {
+
+		if m.Errors[i] != nil {
+			if err := m.Errors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ImportStatusResponse) contextValidateFiles(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Files); i++ {
+
+		if m.Files[i] != nil {
+			if err := m.Files[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportStatusResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportStatusResponse) UnmarshalBinary(b []byte) error {
+	var res ImportStatusResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// ImportStatusResponseErrorsItems0 import status response errors items0
+//
+// swagger:model ImportStatusResponseErrorsItems0
+type ImportStatusResponseErrorsItems0 struct {
+
+	// Reason the row could not be imported
+	Error string `json:"error,omitempty"`
+
+	// ID of the object, if the row could be parsed
+	ID string `json:"id,omitempty"`
+
+	// Key of the file of the row
+	Key string `json:"key,omitempty"`
+
+	// One based line of the row in JSONL files
+	Line int64 `json:"line,omitempty"`
+
+	// Zero based index of the row in the file
+	Row int64 `json:"row,omitempty"`
+}
+
+// Validate validates this import status response errors items0
+func (m *ImportStatusResponseErrorsItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this import status response errors items0 based on context it is used
+func (m *ImportStatusResponseErrorsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportStatusResponseErrorsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportStatusResponseErrorsItems0) UnmarshalBinary(b []byte) error {
+	var res ImportStatusResponseErrorsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// ImportStatusResponseFilesItems0 import status response files items0
+//
+// swagger:model ImportStatusResponseFilesItems0
+type ImportStatusResponseFilesItems0 struct {
+
+	// Whether all rows of the file were processed
+	Done bool `json:"done,omitempty"`
+
+	// Number of rows which could not be imported
+	Failed int64 `json:"failed,omitempty"`
+
+	// File format of the file
+	Format string `json:"format,omitempty"`
+
+	// Number of imported objects
+	Imported int64 `json:"imported,omitempty"`
+
+	// Key of the file relative to the source
+	Key string `json:"key,omitempty"`
+
+	// Number of rows processed, a resumed import continues after them
+	Rows int64 `json:"rows,omitempty"`
+}
+
+// Validate validates this import status response files items0
+func (m *ImportStatusResponseFilesItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this import status response files items0 based on context it is used
+func (m *ImportStatusResponseFilesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportStatusResponseFilesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportStatusResponseFilesItems0) UnmarshalBinary(b []byte) error {
+	var res ImportStatusResponseFilesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodeDrainSkippedShard A shard replica which was not moved off a drained node
+//
+// swagger:model NodeDrainSkippedShard
+type NodeDrainSkippedShard struct {
+
+	// The name of the collection.
+	Class string `json:"class,omitempty"`
+
+	// Why the replica was not moved.
+	Reason string `json:"reason,omitempty"`
+
+	// The name of the shard.
+	Shard string `json:"shard,omitempty"`
+}
+
+// Validate validates this node drain skipped shard
+func (m *NodeDrainSkippedShard) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this node drain skipped shard based on context it is used
+func (m *NodeDrainSkippedShard) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeDrainSkippedShard) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeDrainSkippedShard) UnmarshalBinary(b []byte) error {
+	var res NodeDrainSkippedShard
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -18,7 +18,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -28,6 +30,9 @@ import (
 // swagger:model NodeShardStatus
 type NodeShardStatus struct {
 
+	// The state of async replication of the shard replica. Not set if async replication is disabled.
+	AsyncReplicationStatus *AsyncReplicationStatus `json:"asyncReplicationStatus,omitempty"`
+
 	// The name of shard's class.
 	Class string `json:"class"`
 
@@ -48,15 +53,125 @@ type NodeShardStatus struct {
 
 	// The length of the vector indexing queue.
 	VectorQueueLength int64 `json:"vectorQueueLength"`
+
+	// The vector indexes of the shard that are being rebuilt online.
+	VectorReindexes []*VectorReindexStatus `json:"vectorReindexes"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAsyncReplicationStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorReindexes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateAsyncReplicationStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.AsyncReplicationStatus) { // not required
+		return nil
+	}
+
+	if m.AsyncReplicationStatus != nil {
+		if err := m.AsyncReplicationStatus.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("asyncReplicationStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("asyncReplicationStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorReindexes(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorReindexes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorReindexes); i++ {
+		if swag.IsZero(m.VectorReindexes[i]) { // not required
+			continue
+		}
+
+		if m.VectorReindexes[i] != nil {
+			if err := m.VectorReindexes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorReindexes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorReindexes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAsyncReplicationStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVectorReindexes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateAsyncReplicationStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AsyncReplicationStatus != nil {
+		if err := m.AsyncReplicationStatus.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("asyncReplicationStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("asyncReplicationStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorReindexes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorReindexes); i++ {
+
+		if m.VectorReindexes[i] != nil {
+			if err := m.VectorReindexes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorReindexes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorReindexes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ObjectTTLConfig Configure the automatic deletion of expired objects
+//
+// swagger:model ObjectTtlConfig
+type ObjectTTLConfig struct {
+
+	// The age in seconds after which an object expires.
+	DefaultTTL int64 `json:"defaultTtl,omitempty"`
+
+	// The timestamp the age of an object is determined from. Either `_creationTimeUnix`, `_lastUpdateTimeUnix` or the name of a filterable date property (default: `_creationTimeUnix`).
+	DeleteOn string `json:"deleteOn,omitempty"`
+
+	// Delete objects once they are older than the default TTL (default: false).
+	Enabled bool `json:"enabled"`
+}
+
+// Validate validates this object Ttl config
+func (m *ObjectTTLConfig) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this object Ttl config based on context it is used
+func (m *ObjectTTLConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ObjectTTLConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ObjectTTLConfig) UnmarshalBinary(b []byte) error {
+	var res ObjectTTLConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -44,6 +44,9 @@ type Property struct {
 	// (Deprecated). Whether to include this property in the inverted index. If `false`, this property cannot be used in `where` filters, `bm25` or `hybrid` search. <br/><br/>Unrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Whether to store the positions of the terms of this property in addition to the searchable index. Enables phrase (`"exact phrase"`) and proximity (`term NEAR/3 term`) matching in bm25 and hybrid search. Applicable only to properties of data type text and text[] with `indexSearchable` enabled. Defaults to false.
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.
 	IndexRangeFilters *bool `json:"indexRangeFilters,omitempty"`
 
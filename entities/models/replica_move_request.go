@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicaMoveRequest Request to move or copy a shard replica to another node
+//
+// swagger:model ReplicaMoveRequest
+type ReplicaMoveRequest struct {
+
+	// The name of the collection.
+	Class string `json:"class,omitempty"`
+
+	// Keep the replica on the source node.
+	Copy bool `json:"copy,omitempty"`
+
+	// The name of the shard.
+	Shard string `json:"shard,omitempty"`
+
+	// The node holding the replica.
+	SourceNode string `json:"sourceNode,omitempty"`
+
+	// The node to copy the replica to.
+	TargetNode string `json:"targetNode,omitempty"`
+}
+
+// Validate validates this replica move request
+func (m *ReplicaMoveRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this replica move request based on context it is used
+func (m *ReplicaMoveRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicaMoveRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicaMoveRequest) UnmarshalBinary(b []byte) error {
+	var res ReplicaMoveRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicaMoveResponse The replicas of a shard after moving or copying one
+//
+// swagger:model ReplicaMoveResponse
+type ReplicaMoveResponse struct {
+
+	// The name of the collection.
+	Class string `json:"class,omitempty"`
+
+	// The nodes holding a replica of the shard.
+	Nodes []string `json:"nodes"`
+
+	// The name of the shard.
+	Shard string `json:"shard,omitempty"`
+}
+
+// Validate validates this replica move response
+func (m *ReplicaMoveResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this replica move response based on context it is used
+func (m *ReplicaMoveResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicaMoveResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicaMoveResponse) UnmarshalBinary(b []byte) error {
+	var res ReplicaMoveResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -34,6 +34,9 @@ type ReplicationConfig struct {
 	// Enable asynchronous replication (default: false).
 	AsyncEnabled bool `json:"asyncEnabled"`
 
+	// Pause asynchronous replication. Replicas are not compared and repaired until it is resumed, but changes are still tracked (default: false).
+	AsyncPaused bool `json:"asyncPaused,omitempty"`
+
 	// Conflict resolution strategy for deleted objects.
 	// Enum: [NoAutomatedResolution DeleteOnConflict TimeBasedResolution]
 	DeletionStrategy string `json:"deletionStrategy,omitempty"`
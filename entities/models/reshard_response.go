@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReshardResponse The result of resharding a collection.
+//
+// swagger:model ReshardResponse
+type ReshardResponse struct {
+
+	// The name of the resharded collection.
+	Class string `json:"class,omitempty"`
+
+	// The names of the shards added by resharding.
+	NewShards []string `json:"newShards"`
+
+	// The number of shards of the collection.
+	ShardCount int64 `json:"shardCount,omitempty"`
+}
+
+// Validate validates this reshard response
+func (m *ReshardResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this reshard response based on context it is used
+func (m *ReshardResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReshardResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReshardResponse) UnmarshalBinary(b []byte) error {
+	var res ReshardResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// VectorIndexReindexRequest Request body to rebuild the vector index of a collection with a new configuration.
+//
+// swagger:model VectorIndexReindexRequest
+type VectorIndexReindexRequest struct {
+
+	// Name of the target vector whose index is rebuilt. Leave empty for collections without named vectors.
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// Vector-index config of the rebuilt index, that is specific to the type of index selected in vectorIndexType
+	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
+
+	// Name of the vector index to build, eg. (HNSW). Defaults to the current index type.
+	VectorIndexType string `json:"vectorIndexType,omitempty"`
+}
+
+// Validate validates this vector index reindex request
+func (m *VectorIndexReindexRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this vector index reindex request based on context it is used
+func (m *VectorIndexReindexRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorIndexReindexRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorIndexReindexRequest) UnmarshalBinary(b []byte) error {
+	var res VectorIndexReindexRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
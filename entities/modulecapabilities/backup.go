@@ -52,3 +52,10 @@ type BackupBackend interface {
 	Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error)
 }
+
+// BackupDeleter is implemented by backup backends which can remove backups,
+// e.g. to enforce the retention of scheduled backups
+type BackupDeleter interface {
+	// DeleteBackup removes all objects stored for backupID
+	DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error
+}
@@ -124,6 +124,9 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to false. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date."
 	IndexRangeFilters bool `json:"indexRangeFilters,omitempty"`
 
+	// Optional. Should the positions of the terms be stored in addition to the searchable index. Defaults to false. Enables phrase and proximity matching in bm25 and hybrid search. Applicable only to properties of data type text and text[].
+	IndexPositions bool `json:"indexPositions,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig map[string]interface{} `json:"moduleConfig,omitempty"`
 
@@ -248,6 +251,9 @@ func PropertyFromModel(m models.Property) Property {
 	} else {
 		p.IndexRangeFilters = false
 	}
+	if m.IndexPositions != nil {
+		p.IndexPositions = *m.IndexPositions
+	}
 	if v, ok := m.ModuleConfig.(map[string]interface{}); ok {
 		p.ModuleConfig = v
 	}
@@ -279,6 +285,10 @@ func PropertyToModel(p Property) models.Property {
 	m.IndexSearchable = &indexSearchable
 	indexRangeFilters := p.IndexRangeFilters
 	m.IndexRangeFilters = &indexRangeFilters
+	if p.IndexPositions {
+		indexPositions := p.IndexPositions
+		m.IndexPositions = &indexPositions
+	}
 	m.ModuleConfig = p.ModuleConfig
 	m.Name = p.Name
 	m.Tokenization = p.Tokenization
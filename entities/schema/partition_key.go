@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// IsPartitionKeyDataType returns true if a class can be sharded by a
+// property of the given data type.
+func IsPartitionKeyDataType(dt DataType) bool {
+	switch dt {
+	case DataTypeText, DataTypeInt, DataTypeUUID:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartitionKeyValue returns the canonical form of a partition key value.
+// It is hashed to find the shard of an object, so it must be the same
+// whether the value comes from a stored object, a request or a filter.
+func PartitionKeyValue(dt DataType, value interface{}) (string, error) {
+	switch dt {
+	case DataTypeText:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case DataTypeUUID:
+		switch v := value.(type) {
+		case uuid.UUID:
+			return v.String(), nil
+		case strfmt.UUID:
+			return partitionKeyUUID(v.String())
+		case string:
+			return partitionKeyUUID(v)
+		}
+	case DataTypeInt:
+		switch v := value.(type) {
+		case int:
+			return strconv.FormatInt(int64(v), 10), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			if v == float64(int64(v)) {
+				return strconv.FormatInt(int64(v), 10), nil
+			}
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				return strconv.FormatInt(i, 10), nil
+			}
+		}
+	default:
+		return "", fmt.Errorf("data type %q can't be used as partition key", dt)
+	}
+	return "", fmt.Errorf("invalid %s partition key value %v", dt, value)
+}
+
+func partitionKeyUUID(in string) (string, error) {
+	id, err := uuid.Parse(in)
+	if err != nil {
+		return "", fmt.Errorf("invalid uuid partition key value %q", in)
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionKeyValue(t *testing.T) {
+	id := "6A8b1bC8-3c2e-4a4e-8d8e-4b1f0c6e1a2b"
+	canonicalID := "6a8b1bc8-3c2e-4a4e-8d8e-4b1f0c6e1a2b"
+
+	tests := []struct {
+		name     string
+		dataType DataType
+		value    interface{}
+		expected string
+		err      bool
+	}{
+		{name: "text", dataType: DataTypeText, value: "Acme Corp", expected: "Acme Corp"},
+		{name: "text from int", dataType: DataTypeText, value: 7, err: true},
+		{name: "int", dataType: DataTypeInt, value: 7, expected: "7"},
+		{name: "int64", dataType: DataTypeInt, value: int64(-7), expected: "-7"},
+		{name: "float64", dataType: DataTypeInt, value: float64(7), expected: "7"},
+		{name: "json number", dataType: DataTypeInt, value: json.Number("7"), expected: "7"},
+		{name: "fraction", dataType: DataTypeInt, value: 7.5, err: true},
+		{name: "uuid string", dataType: DataTypeUUID, value: id, expected: canonicalID},
+		{name: "strfmt uuid", dataType: DataTypeUUID, value: strfmt.UUID(id), expected: canonicalID},
+		{name: "parsed uuid", dataType: DataTypeUUID, value: uuid.MustParse(id), expected: canonicalID},
+		{name: "invalid uuid", dataType: DataTypeUUID, value: "not-a-uuid", err: true},
+		{name: "unsupported type", dataType: DataTypeNumber, value: 7.5, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := PartitionKeyValue(test.dataType, test.value)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
@@ -30,6 +30,7 @@ type Result struct {
 	Vector               []float32
 	Vectors              models.Vectors
 	MultiVectors         map[string][][]float32
+	SparseVectors        map[string]models.SparseVector
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -74,11 +75,30 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 	if includeVector {
 		t.Vector = r.Vector
 		t.Vectors = r.Vectors
+		t.MultiVectors = r.modelMultiVectors()
+		if len(r.SparseVectors) > 0 {
+			t.SparseVectors = r.SparseVectors
+		}
 	}
 
 	return t
 }
 
+func (r Result) modelMultiVectors() models.MultiVectors {
+	if len(r.MultiVectors) == 0 {
+		return nil
+	}
+	out := make(models.MultiVectors, len(r.MultiVectors))
+	for targetVector, vectors := range r.MultiVectors {
+		multiVector := make(models.MultiVector, len(vectors))
+		for i := range vectors {
+			multiVector[i] = vectors[i]
+		}
+		out[targetVector] = multiVector
+	}
+	return out
+}
+
 func (rs Results) Objects() []*models.Object {
 	return rs.ObjectsWithVector(true)
 }
@@ -29,11 +29,31 @@ type NearVector struct {
 	TargetVectors []string    `json:"targetVectors"`
 }
 
+// MaxFuzziness is the maximum edit distance of fuzzy keyword matching
+const MaxFuzziness = 2
+
+// KeywordRankingTypeSparse ranks objects by the dot product of their sparse
+// vectors with a sparse query vector instead of BM25
+const KeywordRankingTypeSparse = "sparse"
+
 type KeywordRanking struct {
 	Type                   string   `json:"type"`
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Fuzziness is the maximum edit distance between a query term and the
+	// terms of the index it matches, 0 disables fuzzy matching
+	Fuzziness int `json:"fuzziness"`
+	// FuzzyPrefixLength is the number of leading characters a term of the
+	// index has to share with a query term to match it fuzzily. It limits
+	// the part of the term dictionary which is scanned, 0 scans all of it.
+	FuzzyPrefixLength int `json:"fuzzyPrefixLength"`
+	// Prefix additionally matches the terms of the index starting with a
+	// query term
+	Prefix bool `json:"prefix"`
+	// SparseVector and TargetVector are only set for the sparse type
+	SparseVector *models.SparseVector `json:"sparseVector,omitempty"`
+	TargetVector string               `json:"targetVector,omitempty"`
 }
 
 // Indicates whether property should be indexed
@@ -110,6 +130,14 @@ type HybridSearch struct {
 	WithDistance     bool        `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// Fuzziness, FuzzyPrefixLength and Prefix are passed to the keyword search
+	Fuzziness         int  `json:"fuzziness"`
+	FuzzyPrefixLength int  `json:"fuzzyPrefixLength"`
+	Prefix            bool `json:"prefix"`
+	// SparseVector replaces the BM25 search of the query with a search of
+	// the sparse target vector SparseTargetVector
+	SparseVector       *models.SparseVector `json:"sparseVector"`
+	SparseTargetVector string               `json:"sparseTargetVector"`
 }
 
 type NearObject struct {
@@ -55,7 +55,9 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 	DocID             uint64
-	Vectors           map[string][]float32 `json:"vectors"`
+	Vectors           map[string][]float32           `json:"vectors"`
+	MultiVectors      map[string][][]float32         `json:"multivectors"`
+	SparseVectors     map[string]models.SparseVector `json:"sparsevectors"`
 }
 
 func New(docID uint64) *Object {
@@ -91,6 +93,8 @@ func FromObject(object *models.Object, vector []float32, vectors models.Vectors)
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 		Vectors:           vecs,
+		MultiVectors:      asMultiVectors(object.MultiVectors),
+		SparseVectors:     object.SparseVectors,
 	}
 }
 
@@ -204,6 +208,18 @@ func FromBinaryOptional(data []byte,
 				ko.Object.Vectors[vecName] = vec
 			}
 		}
+
+		multiVectors, err := unmarshalMultiVectors(&rw)
+		if err != nil {
+			return nil, err
+		}
+		ko.MultiVectors = multiVectors
+
+		sparseVectors, err := unmarshalSparseVectors(&rw)
+		if err != nil {
+			return nil, err
+		}
+		ko.SparseVectors = sparseVectors
 	}
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
@@ -404,6 +420,16 @@ func (ko *Object) ExplainScore() string {
 	return ""
 }
 
+func (ko *Object) VectorSearchExplain() *additional.VectorSearchExplain {
+	props := ko.AdditionalProperties()
+	if props != nil {
+		if explain, ok := props["explainVectorSearch"].(*additional.VectorSearchExplain); ok {
+			return explain
+		}
+	}
+	return nil
+}
+
 func (ko *Object) ID() strfmt.UUID {
 	return ko.Object.ID
 }
@@ -491,19 +517,26 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.ExplainVectorSearch {
+			if explain := ko.VectorSearchExplain(); explain != nil {
+				additionalProperties["explainVectorSearch"] = explain
+			}
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
 	}
 
 	return &search.Result{
-		ID:        ko.ID(),
-		DocID:     &ko.DocID,
-		ClassName: ko.Class().String(),
-		Schema:    ko.Properties(),
-		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors),
-		Dims:      ko.VectorLen,
+		ID:            ko.ID(),
+		DocID:         &ko.DocID,
+		ClassName:     ko.Class().String(),
+		Schema:        ko.Properties(),
+		Vector:        ko.Vector,
+		Vectors:       ko.asVectors(ko.Vectors),
+		MultiVectors:  ko.MultiVectors,
+		SparseVectors: ko.SparseVectors,
+		Dims:          ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -527,6 +560,21 @@ func (ko *Object) asVectors(in map[string][]float32) models.Vectors {
 	return nil
 }
 
+func asMultiVectors(in models.MultiVectors) map[string][][]float32 {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string][][]float32, len(in))
+	for targetVector, multiVector := range in {
+		vectors := make([][]float32, len(multiVector))
+		for i := range multiVector {
+			vectors[i] = multiVector[i]
+		}
+		out[targetVector] = vectors
+	}
+	return out
+}
+
 func (ko *Object) SearchResultWithDist(addl additional.Properties, dist float32) search.Result {
 	res := ko.SearchResult(addl, "")
 	res.Dist = dist
@@ -654,6 +702,12 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n          | []byte        | packed target vectors offsets map { name : offset_in_bytes }
 // 4          | uint32        | length of target vectors segment (in bytes)
 // n          | uint16+[]byte | target vectors segment: sequence of vec_length + vec (uint16 + []byte), (uint16 + []byte) ...
+// 4          | uint32        | length of packed multi vectors offsets (in bytes)
+// n          | []byte        | packed multi vectors offsets map { name : offset_in_bytes }
+// 4          | uint32        | length of multi vectors segment (in bytes)
+// n          | uint32+[]byte | multi vectors segment: sequence of vec_count + vec_count * (uint16 + []byte) ...
+// 4          | uint32        | length of sparse vectors segment (in bytes)
+// n          | []byte        | sparse vectors segment: sequence of name_length + name + nnz + indices + values (uint16 + []byte + uint32 + nnz*uint32 + nnz*float32) ...
 
 const (
 	maxVectorLength               int = math.MaxUint16
@@ -663,6 +717,9 @@ const (
 	maxVectorWeightsLength        int = math.MaxUint32
 	maxTargetVectorsSegmentLength int = math.MaxUint32
 	maxTargetVectorsOffsetsLength int = math.MaxUint32
+	maxMultiVectorsSegmentLength  int = math.MaxUint32
+	maxMultiVectorsOffsetsLength  int = math.MaxUint32
+	maxSparseVectorsSegmentLength int = math.MaxUint32
 )
 
 func (ko *Object) MarshalBinary() ([]byte, error) {
@@ -755,6 +812,59 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		targetVectorsOffsetsLength = uint32(len(targetVectorsOffsets))
 	}
 
+	var multiVectorsOffsets []byte
+	var multiVectorsOffsetsLength uint32
+	var multiVectorsSegmentLength int
+
+	multiVectorsOffsetOrder := make([]string, 0, len(ko.MultiVectors))
+	if len(ko.MultiVectors) > 0 {
+		offsetsMap := map[string]uint32{}
+		for name, vecs := range ko.MultiVectors {
+			offsetsMap[name] = uint32(multiVectorsSegmentLength)
+			multiVectorsSegmentLength += 4 // 4 for number of vectors
+			for _, vec := range vecs {
+				if len(vec) > maxVectorLength {
+					return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", len(vec), maxVectorLength)
+				}
+				multiVectorsSegmentLength += 2 + 4*len(vec) // 2 for vec length + vec bytes
+			}
+
+			if multiVectorsSegmentLength > maxMultiVectorsSegmentLength {
+				return nil,
+					fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)",
+						"multiVectorsSegmentLength", multiVectorsSegmentLength, maxMultiVectorsSegmentLength)
+			}
+
+			multiVectorsOffsetOrder = append(multiVectorsOffsetOrder, name)
+		}
+
+		multiVectorsOffsets, err = msgpack.Marshal(offsetsMap)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal multi vectors offsets: %w", err)
+		}
+		if len(multiVectorsOffsets) > maxMultiVectorsOffsetsLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "multiVectorsOffsets", len(multiVectorsOffsets), maxMultiVectorsOffsetsLength)
+		}
+		multiVectorsOffsetsLength = uint32(len(multiVectorsOffsets))
+	}
+
+	var sparseVectorsSegmentLength int
+	for name, vec := range ko.SparseVectors {
+		if len(name) > maxClassNameLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectorName", len(name), maxClassNameLength)
+		}
+		if len(vec.Indices) != len(vec.Values) {
+			return nil, fmt.Errorf("could not marshal sparse vector %q: %d indices but %d values",
+				name, len(vec.Indices), len(vec.Values))
+		}
+		sparseVectorsSegmentLength += 2 + len(name) + 4 + 8*len(vec.Indices)
+		if sparseVectorsSegmentLength > maxSparseVectorsSegmentLength {
+			return nil,
+				fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)",
+					"sparseVectorsSegmentLength", sparseVectorsSegmentLength, maxSparseVectorsSegmentLength)
+		}
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -762,7 +872,10 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + metaLength +
 		4 + vectorWeightsLength +
 		4 + targetVectorsOffsetsLength +
-		4 + uint32(targetVectorsSegmentLength)
+		4 + uint32(targetVectorsSegmentLength) +
+		4 + multiVectorsOffsetsLength +
+		4 + uint32(multiVectorsSegmentLength) +
+		4 + uint32(sparseVectorsSegmentLength)
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -823,6 +936,42 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	rw.WriteUint32(multiVectorsOffsetsLength)
+	if multiVectorsOffsetsLength > 0 {
+		err = rw.CopyBytesToBuffer(multiVectorsOffsets)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy multiVectorsOffsets")
+		}
+	}
+
+	rw.WriteUint32(uint32(multiVectorsSegmentLength))
+	for _, name := range multiVectorsOffsetOrder {
+		vecs := ko.MultiVectors[name]
+		rw.WriteUint32(uint32(len(vecs)))
+		for _, vec := range vecs {
+			vecLen := len(vec)
+			rw.WriteUint16(uint16(vecLen))
+			for j := 0; j < vecLen; j++ {
+				rw.WriteUint32(math.Float32bits(vec[j]))
+			}
+		}
+	}
+
+	rw.WriteUint32(uint32(sparseVectorsSegmentLength))
+	for name, vec := range ko.SparseVectors {
+		rw.WriteUint16(uint16(len(name)))
+		if err := rw.CopyBytesToBuffer([]byte(name)); err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparse vector name")
+		}
+		rw.WriteUint32(uint32(len(vec.Indices)))
+		for _, index := range vec.Indices {
+			rw.WriteUint32(index)
+		}
+		for _, value := range vec.Values {
+			rw.WriteUint32(math.Float32bits(value))
+		}
+	}
+
 	return byteBuffer, nil
 }
 
@@ -1014,6 +1163,18 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	}
 	ko.Vectors = vectors
 
+	multiVectors, err := unmarshalMultiVectors(&rw)
+	if err != nil {
+		return err
+	}
+	ko.MultiVectors = multiVectors
+
+	sparseVectors, err := unmarshalSparseVectors(&rw)
+	if err != nil {
+		return err
+	}
+	ko.SparseVectors = sparseVectors
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -1058,6 +1219,139 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 	return nil, nil
 }
 
+func unmarshalMultiVectors(rw *byteops.ReadWriter) (map[string][][]float32, error) {
+	// objects written before multi vector support end right after the target
+	// vectors segment
+	if rw.Position < uint64(len(rw.Buffer)) {
+		multiVectorsOffsets := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+		multiVectorsSegmentLength := rw.ReadUint32()
+		pos := rw.Position
+
+		if len(multiVectorsOffsets) > 0 {
+			var mvOffsets map[string]uint32
+			if err := msgpack.Unmarshal(multiVectorsOffsets, &mvOffsets); err != nil {
+				return nil, fmt.Errorf("Could not unmarshal multi vectors offset: %w", err)
+			}
+
+			multiVectors := map[string][][]float32{}
+			for name, offset := range mvOffsets {
+				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
+				multiVectors[name] = readMultiVector(rw)
+			}
+
+			rw.MoveBufferToAbsolutePosition(pos + uint64(multiVectorsSegmentLength))
+			return multiVectors, nil
+		}
+	}
+	return nil, nil
+}
+
+func unmarshalSparseVectors(rw *byteops.ReadWriter) (map[string]models.SparseVector, error) {
+	// objects written before sparse vector support end right after the multi
+	// vectors segment
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+
+	segmentLength := uint64(rw.ReadUint32())
+	if segmentLength == 0 {
+		return nil, nil
+	}
+	end := rw.Position + segmentLength
+	if end > uint64(len(rw.Buffer)) {
+		return nil, fmt.Errorf("sparse vectors segment exceeds object length")
+	}
+
+	sparseVectors := map[string]models.SparseVector{}
+	for rw.Position < end {
+		name := string(rw.ReadBytesFromBuffer(uint64(rw.ReadUint16())))
+		nnz := rw.ReadUint32()
+		vec := models.SparseVector{
+			Indices: make([]uint32, nnz),
+			Values:  make([]float32, nnz),
+		}
+		for i := range vec.Indices {
+			vec.Indices[i] = rw.ReadUint32()
+		}
+		for i := range vec.Values {
+			vec.Values[i] = math.Float32frombits(rw.ReadUint32())
+		}
+		sparseVectors[name] = vec
+	}
+	return sparseVectors, nil
+}
+
+func readMultiVector(rw *byteops.ReadWriter) [][]float32 {
+	vecCount := rw.ReadUint32()
+	vecs := make([][]float32, vecCount)
+	for i := range vecs {
+		vecLen := rw.ReadUint16()
+		vec := make([]float32, vecLen)
+		for j := uint16(0); j < vecLen; j++ {
+			vec[j] = math.Float32frombits(rw.ReadUint32())
+		}
+		vecs[i] = vec
+	}
+	return vecs
+}
+
+// MultiVectorFromBinary returns the multi vector of the given target vector
+// without unmarshalling the rest of the object
+func MultiVectorFromBinary(in []byte, targetVector string) ([][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	startPos := uint64(1 + 8 + 1 + 16 + 8 + 8) // elements at the start
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(startPos))
+
+	vectorLength := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(vectorLength * 4)
+
+	classnameLength := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(classnameLength)
+
+	schemaLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(schemaLength)
+
+	metaLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(metaLength)
+
+	vectorWeightsLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(vectorWeightsLength)
+
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, errors.Errorf("multi vector not found for target vector: %s", targetVector)
+	}
+	targetVectorsOffsetsLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(targetVectorsOffsetsLength)
+	targetVectorsSegmentLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(targetVectorsSegmentLength)
+
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, errors.Errorf("multi vector not found for target vector: %s", targetVector)
+	}
+	multiVectorsOffsets := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+	pos := rw.Position + 4 // skip segment length
+	var mvOffsets map[string]uint32
+	if len(multiVectorsOffsets) > 0 {
+		if err := msgpack.Unmarshal(multiVectorsOffsets, &mvOffsets); err != nil {
+			return nil, fmt.Errorf("Could not unmarshal multi vectors offset: %w", err)
+		}
+	}
+	offset, ok := mvOffsets[targetVector]
+	if !ok {
+		return nil, errors.Errorf("multi vector not found for target vector: %s", targetVector)
+	}
+	rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
+	return readMultiVector(&rw), nil
+}
+
 func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
 	if len(in) == 0 {
 		return nil, nil
@@ -1194,6 +1488,21 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 				additionalProperties["group"] = &group
 			}
 		}
+
+		if prop, ok := additionalProperties["explainVectorSearch"]; ok {
+			if explainMap, ok := prop.(map[string]interface{}); ok {
+				marshalled, err := json.Marshal(explainMap)
+				if err != nil {
+					return err
+				}
+				var explain additional.VectorSearchExplain
+				err = json.Unmarshal(marshalled, &explain)
+				if err != nil {
+					return err
+				}
+				additionalProperties["explainVectorSearch"] = &explain
+			}
+		}
 	}
 
 	var vectorWeights interface{}
@@ -1227,11 +1536,27 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectors(ko.Vectors),
+		MultiVectors:      deepCopyMultiVectors(ko.MultiVectors),
+		SparseVectors:     deepCopySparseVectors(ko.SparseVectors),
 	}
 
 	return o
 }
 
+// AddVectorSearchExplain attaches the explanation of a shard's vector search
+// to all objects found by it.
+func AddVectorSearchExplain(objs []*Object, explain *additional.VectorSearchExplain) {
+	if explain == nil {
+		return
+	}
+	for i := range objs {
+		if objs[i].Object.Additional == nil {
+			objs[i].Object.Additional = models.AdditionalProperties{}
+		}
+		objs[i].Object.Additional["explainVectorSearch"] = explain
+	}
+}
+
 func AddOwnership(objs []*Object, node, shard string) {
 	for i := range objs {
 		objs[i].BelongsToNode = node
@@ -1253,6 +1578,34 @@ func deepCopyVectors[V []float32 | models.Vector](orig map[string]V) map[string]
 	return out
 }
 
+func deepCopyMultiVectors(orig map[string][][]float32) map[string][][]float32 {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string][][]float32, len(orig))
+	for key, vecs := range orig {
+		copied := make([][]float32, len(vecs))
+		for i := range vecs {
+			copied[i] = deepCopyVector(vecs[i])
+		}
+		out[key] = copied
+	}
+	return out
+}
+
+func deepCopySparseVectors(orig map[string]models.SparseVector) map[string]models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]models.SparseVector, len(orig))
+	for key, vec := range orig {
+		indices := make([]uint32, len(vec.Indices))
+		copy(indices, vec.Indices)
+		out[key] = models.SparseVector{Indices: indices, Values: deepCopyVector(vec.Values)}
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
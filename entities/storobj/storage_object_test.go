@@ -290,6 +290,45 @@ func TestNewStorageObject(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithVectorSearchExplain(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+		},
+		[]float32{1, 2, 0.7},
+		nil,
+	)
+	before.DocID = 7
+	explain := &additional.VectorSearchExplain{
+		Shard:                "abc",
+		AllowListSize:        120,
+		FilterStrategy:       "acorn",
+		NodesVisited:         30,
+		DistanceComputations: 400,
+		RescoredCandidates:   10,
+	}
+	AddVectorSearchExplain([]*Object{before}, explain)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+	assert.Equal(t, explain, after.VectorSearchExplain())
+
+	t.Run("only returned if requested", func(t *testing.T) {
+		res := after.SearchResult(additional.Properties{}, "")
+		assert.Nil(t, res.AdditionalProperties["explainVectorSearch"])
+
+		res = after.SearchResult(additional.Properties{ExplainVectorSearch: true}, "")
+		assert.Equal(t, explain, res.AdditionalProperties["explainVectorSearch"])
+	})
+}
+
 func TestStorageArrayObjectMarshalling(t *testing.T) {
 	before := FromObject(
 		&models.Object{
@@ -747,6 +786,147 @@ func TestVectorFromBinary(t *testing.T) {
 	assert.Equal(t, vector3, outVector3)
 }
 
+func TestMultiVectorMarshalling(t *testing.T) {
+	colbert := [][]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			MultiVectors: models.MultiVectors{
+				"colbert": {colbert[0], colbert[1], colbert[2]},
+			},
+		},
+		nil,
+		models.Vectors{
+			"vector1": []float32{1, 2},
+		},
+	)
+	before.DocID = 7
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, colbert, after.MultiVectors["colbert"])
+		assert.Equal(t, []float32{1, 2}, after.Vectors["vector1"])
+	})
+
+	t.Run("unmarshal optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"colbert"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, colbert, after.MultiVectors["colbert"])
+	})
+
+	t.Run("extract multi vector only", func(t *testing.T) {
+		out, err := MultiVectorFromBinary(asBinary, "colbert")
+		require.Nil(t, err)
+		assert.Equal(t, colbert, out)
+
+		_, err = MultiVectorFromBinary(asBinary, "missing")
+		require.NotNil(t, err)
+	})
+
+	t.Run("objects without multi vectors section", func(t *testing.T) {
+		before.MultiVectors = nil
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+		// strip the trailing, empty multi and sparse vectors sections to mimic
+		// objects written by previous versions
+		legacy := asBinary[:len(asBinary)-12]
+
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Nil(t, after.MultiVectors)
+		assert.Equal(t, []float32{1, 2}, after.Vectors["vector1"])
+
+		_, err = MultiVectorFromBinary(legacy, "colbert")
+		require.NotNil(t, err)
+	})
+}
+
+func TestSparseVectorMarshalling(t *testing.T) {
+	splade := models.SparseVector{
+		Indices: []uint32{3, 1017, 29000},
+		Values:  []float32{0.5, 1.25, 0.125},
+	}
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			MultiVectors: models.MultiVectors{
+				"colbert": {{1, 2}, {3, 4}},
+			},
+			SparseVectors: models.SparseVectors{
+				"splade": splade,
+				"empty":  {Indices: []uint32{}, Values: []float32{}},
+			},
+		},
+		nil,
+		models.Vectors{
+			"vector1": []float32{1, 2},
+		},
+	)
+	before.DocID = 7
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, splade, after.SparseVectors["splade"])
+		assert.Len(t, after.SparseVectors["empty"].Indices, 0)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}}, after.MultiVectors["colbert"])
+		assert.Equal(t, []float32{1, 2}, after.Vectors["vector1"])
+	})
+
+	t.Run("unmarshal optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"splade"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, splade, after.SparseVectors["splade"])
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		copied := before.DeepCopyDangerous()
+		copied.SparseVectors["splade"].Values[0] = 7
+		assert.Equal(t, float32(0.5), before.SparseVectors["splade"].Values[0])
+	})
+
+	t.Run("mismatching indices and values", func(t *testing.T) {
+		invalid := before.DeepCopyDangerous()
+		invalid.SparseVectors["splade"] = models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{1}}
+		_, err := invalid.MarshalBinary()
+		require.NotNil(t, err)
+	})
+
+	t.Run("objects without sparse vectors section", func(t *testing.T) {
+		legacyObj := before.DeepCopyDangerous()
+		legacyObj.SparseVectors = nil
+		asBinary, err := legacyObj.MarshalBinary()
+		require.Nil(t, err)
+		// strip the trailing, empty sparse vectors section to mimic objects
+		// written by previous versions
+		legacy := asBinary[:len(asBinary)-4]
+
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Nil(t, after.SparseVectors)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}}, after.MultiVectors["colbert"])
+	})
+}
+
 func TestStorageInvalidObjectMarshalling(t *testing.T) {
 	t.Run("invalid className", func(t *testing.T) {
 		invalidClassName := make([]byte, maxClassNameLength+1)
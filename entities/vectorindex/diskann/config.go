@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultMaxDegree           = 64
+	DefaultBuildSearchListSize = 128
+	DefaultSearchListSize      = 100
+	DefaultAlpha               = 1.2
+	DefaultBeamWidth           = 4
+	DefaultFlatSearchCutoff    = 40000
+	DefaultPQSegments          = 0 // indicates "let Weaviate pick"
+	DefaultPQTrainingLimit     = 100000
+
+	// Fail validation if those criteria are not met
+	MinimumMaxDegree       = 8
+	MaximumMaxDegree       = 512
+	MinimumSearchListSize  = 1
+	MinimumBeamWidth       = 1
+	MinimumAlpha           = 1.0
+	MinimumPQTrainingLimit = 1000
+)
+
+// PQConfig configures the product quantization of the vectors kept in memory.
+// The codebook is trained once TrainingLimit vectors have been indexed, until
+// then the index navigates using the uncompressed vectors.
+type PQConfig struct {
+	Segments      int `json:"segments"`
+	TrainingLimit int `json:"trainingLimit"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance            string   `json:"distance"`
+	MaxDegree           int      `json:"maxDegree"`
+	BuildSearchListSize int      `json:"buildSearchListSize"`
+	SearchListSize      int      `json:"searchListSize"`
+	Alpha               float64  `json:"alpha"`
+	BeamWidth           int      `json:"beamWidth"`
+	FlatSearchCutoff    int      `json:"flatSearchCutoff"`
+	PQ                  PQConfig `json:"pq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorIndexCommon.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.BuildSearchListSize = DefaultBuildSearchListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.Alpha = DefaultAlpha
+	u.BeamWidth = DefaultBeamWidth
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ.Segments = DefaultPQSegments
+	u.PQ.TrainingLimit = DefaultPQTrainingLimit
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "buildSearchListSize", func(v int) {
+		uc.BuildSearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "beamWidth", func(v int) {
+		uc.BeamWidth = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if pqConfig, ok := asMap["pq"].(map[string]interface{}); ok {
+		if err := vectorIndexCommon.OptionalIntFromMap(pqConfig, "segments", func(v int) {
+			uc.PQ.Segments = v
+		}); err != nil {
+			return uc, err
+		}
+
+		if err := vectorIndexCommon.OptionalIntFromMap(pqConfig, "trainingLimit", func(v int) {
+			uc.PQ.TrainingLimit = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.MaxDegree < MinimumMaxDegree || u.MaxDegree > MaximumMaxDegree {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"maxDegree must be between %d and %d", MinimumMaxDegree, MaximumMaxDegree))
+	}
+
+	if u.BuildSearchListSize < u.MaxDegree {
+		errMsgs = append(errMsgs, "buildSearchListSize must not be smaller than maxDegree")
+	}
+
+	if u.SearchListSize < MinimumSearchListSize {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"searchListSize must be a positive integer with a minimum of %d", MinimumSearchListSize))
+	}
+
+	if u.Alpha < MinimumAlpha {
+		errMsgs = append(errMsgs, fmt.Sprintf("alpha must be at least %v", MinimumAlpha))
+	}
+
+	if u.BeamWidth < MinimumBeamWidth {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"beamWidth must be a positive integer with a minimum of %d", MinimumBeamWidth))
+	}
+
+	if u.PQ.Segments < 0 {
+		errMsgs = append(errMsgs, "pq.segments must not be negative")
+	}
+
+	if u.PQ.TrainingLimit < MinimumPQTrainingLimit {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"pq.trainingLimit must be a positive integer with a minimum of %d", MinimumPQTrainingLimit))
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid diskann config: %s", strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func optionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return fmt.Errorf("json.Number to float64 for %q: %w", name, err)
+		}
+		setFn(asFloat)
+	case float64:
+		setFn(typed)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:            common.DefaultDistanceMetric,
+				MaxDegree:           DefaultMaxDegree,
+				BuildSearchListSize: DefaultBuildSearchListSize,
+				SearchListSize:      DefaultSearchListSize,
+				Alpha:               DefaultAlpha,
+				BeamWidth:           DefaultBeamWidth,
+				FlatSearchCutoff:    DefaultFlatSearchCutoff,
+				PQ: PQConfig{
+					Segments:      DefaultPQSegments,
+					TrainingLimit: DefaultPQTrainingLimit,
+				},
+			},
+		},
+		{
+			name: "all values specified",
+			input: map[string]interface{}{
+				"distance":            "l2-squared",
+				"maxDegree":           float64(32),
+				"buildSearchListSize": float64(64),
+				"searchListSize":      json.Number("50"),
+				"alpha":               json.Number("1.4"),
+				"beamWidth":           float64(8),
+				"flatSearchCutoff":    float64(1000),
+				"pq": map[string]interface{}{
+					"segments":      float64(96),
+					"trainingLimit": float64(50000),
+				},
+			},
+			expected: UserConfig{
+				Distance:            common.DistanceL2Squared,
+				MaxDegree:           32,
+				BuildSearchListSize: 64,
+				SearchListSize:      50,
+				Alpha:               1.4,
+				BeamWidth:           8,
+				FlatSearchCutoff:    1000,
+				PQ: PQConfig{
+					Segments:      96,
+					TrainingLimit: 50000,
+				},
+			},
+		},
+		{
+			name: "maxDegree too small",
+			input: map[string]interface{}{
+				"maxDegree": float64(2),
+			},
+			expectErr:    true,
+			expectErrMsg: "maxDegree must be between 8 and 512",
+		},
+		{
+			name: "build search list smaller than max degree",
+			input: map[string]interface{}{
+				"maxDegree":           float64(64),
+				"buildSearchListSize": float64(32),
+			},
+			expectErr:    true,
+			expectErrMsg: "buildSearchListSize must not be smaller than maxDegree",
+		},
+		{
+			name: "alpha below 1",
+			input: map[string]interface{}{
+				"alpha": float64(0.5),
+			},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name: "training limit too small",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"trainingLimit": float64(10),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq.trainingLimit must be a positive integer with a minimum of 1000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
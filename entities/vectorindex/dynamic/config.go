@@ -16,19 +16,33 @@ import (
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 const (
-	DefaultThreshold = 10_000
+	DefaultThreshold          = 10_000
+	DefaultDowngradeThreshold = 0 // indicates "never downgrade"
+	DefaultUpgradeTo          = UpgradeToHNSW
+
+	// UpgradeToHNSW and UpgradeToDiskANN are the index types the flat index
+	// can be upgraded to once the threshold is reached
+	UpgradeToHNSW    = "hnsw"
+	UpgradeToDiskANN = "diskann"
 )
 
 type UserConfig struct {
-	Distance  string          `json:"distance"`
-	Threshold uint64          `json:"threshold"`
-	HnswUC    hnsw.UserConfig `json:"hnsw"`
-	FlatUC    flat.UserConfig `json:"flat"`
+	Distance  string `json:"distance"`
+	Threshold uint64 `json:"threshold"`
+	// DowngradeThreshold switches an upgraded index back to flat once it
+	// holds fewer vectors. It has to be lower than Threshold, so that an index
+	// close to the threshold does not switch back and forth.
+	DowngradeThreshold uint64             `json:"downgradeThreshold"`
+	UpgradeTo          string             `json:"upgradeTo"`
+	HnswUC             hnsw.UserConfig    `json:"hnsw"`
+	FlatUC             flat.UserConfig    `json:"flat"`
+	DiskANNUC          diskann.UserConfig `json:"diskann"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -41,12 +55,24 @@ func (u UserConfig) DistanceName() string {
 	return u.Distance
 }
 
+// UpgradeTarget returns the index type the flat index is upgraded to, configs
+// which were stored before the option existed always upgrade to hnsw
+func (u UserConfig) UpgradeTarget() string {
+	if u.UpgradeTo == "" {
+		return DefaultUpgradeTo
+	}
+	return u.UpgradeTo
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.Threshold = DefaultThreshold
+	u.DowngradeThreshold = DefaultDowngradeThreshold
 	u.Distance = common.DefaultDistanceMetric
+	u.UpgradeTo = DefaultUpgradeTo
 	u.HnswUC = hnsw.NewDefaultUserConfig()
 	u.FlatUC = flat.NewDefaultUserConfig()
+	u.DiskANNUC = diskann.NewDefaultUserConfig()
 }
 
 func NewDefaultUserConfig() UserConfig {
@@ -82,6 +108,40 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := common.OptionalIntFromMap(asMap, "downgradeThreshold", func(v int) {
+		uc.DowngradeThreshold = uint64(v)
+	}); err != nil {
+		return uc, err
+	}
+	if uc.DowngradeThreshold >= uc.Threshold && uc.DowngradeThreshold != 0 {
+		return uc, fmt.Errorf("downgradeThreshold (%d) must be lower than threshold (%d)",
+			uc.DowngradeThreshold, uc.Threshold)
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "upgradeTo", func(v string) {
+		uc.UpgradeTo = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.UpgradeTo != UpgradeToHNSW && uc.UpgradeTo != UpgradeToDiskANN {
+		return uc, fmt.Errorf("invalid upgradeTo %q, must be one of %q or %q",
+			uc.UpgradeTo, UpgradeToHNSW, UpgradeToDiskANN)
+	}
+
+	diskannConfig, ok := asMap["diskann"]
+	if ok && diskannConfig != nil {
+		diskannUC, err := diskann.ParseAndValidateConfig(diskannConfig)
+		if err != nil {
+			return uc, err
+		}
+
+		castedDiskANNUC, ok := diskannUC.(diskann.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid diskann configuration")
+		}
+		uc.DiskANNUC = castedDiskANNUC
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig)
@@ -93,6 +153,9 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		if !ok {
 			return uc, fmt.Errorf("invalid hnsw configuration")
 		}
+		if castedHnswUC.Multivector.Enabled {
+			return uc, fmt.Errorf("multivector is not supported by the dynamic index")
+		}
 		uc.HnswUC = castedHnswUC
 	}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -37,6 +38,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				DiskANNUC: diskann.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -63,6 +66,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Enabled:       hnsw.DefaultSQEnabled,
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
+						Bits:          hnsw.DefaultSQBits,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
@@ -80,9 +84,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Cache:        flat.DefaultVectorCache,
 					},
 					SQ: flat.CompressionUserConfig{
-						Enabled:      flat.DefaultCompressionEnabled,
-						RescoreLimit: flat.DefaultCompressionRescore,
-						Cache:        flat.DefaultVectorCache,
+						Enabled:       flat.DefaultCompressionEnabled,
+						RescoreLimit:  flat.DefaultCompressionRescore,
+						Cache:         flat.DefaultVectorCache,
+						Bits:          flat.DefaultSQBits,
+						TrainingLimit: flat.DefaultSQTrainingLimit,
 					},
 				},
 			},
@@ -95,6 +101,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: 100,
+				UpgradeTo: DefaultUpgradeTo,
+				DiskANNUC: diskann.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -121,6 +129,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Enabled:       hnsw.DefaultSQEnabled,
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
+						Bits:          hnsw.DefaultSQBits,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
@@ -138,9 +147,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Cache:        flat.DefaultVectorCache,
 					},
 					SQ: flat.CompressionUserConfig{
-						Enabled:      flat.DefaultCompressionEnabled,
-						RescoreLimit: flat.DefaultCompressionRescore,
-						Cache:        flat.DefaultVectorCache,
+						Enabled:       flat.DefaultCompressionEnabled,
+						RescoreLimit:  flat.DefaultCompressionRescore,
+						Cache:         flat.DefaultVectorCache,
+						Bits:          flat.DefaultSQBits,
+						TrainingLimit: flat.DefaultSQTrainingLimit,
 					},
 				},
 			},
@@ -174,6 +185,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				DiskANNUC: diskann.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: 11,
 					MaxConnections:         12,
@@ -199,6 +212,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Enabled:       hnsw.DefaultSQEnabled,
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
+						Bits:          hnsw.DefaultSQBits,
 					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
 				},
@@ -216,9 +230,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Cache:        flat.DefaultVectorCache,
 					},
 					SQ: flat.CompressionUserConfig{
-						Enabled:      flat.DefaultCompressionEnabled,
-						RescoreLimit: flat.DefaultCompressionRescore,
-						Cache:        flat.DefaultVectorCache,
+						Enabled:       flat.DefaultCompressionEnabled,
+						RescoreLimit:  flat.DefaultCompressionRescore,
+						Cache:         flat.DefaultVectorCache,
+						Bits:          flat.DefaultSQBits,
+						TrainingLimit: flat.DefaultSQTrainingLimit,
 					},
 				},
 			},
@@ -239,6 +255,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				DiskANNUC: diskann.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -265,6 +283,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Enabled:       hnsw.DefaultSQEnabled,
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
+						Bits:          hnsw.DefaultSQBits,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
@@ -282,9 +301,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						Cache:        true,
 					},
 					SQ: flat.CompressionUserConfig{
-						Enabled:      flat.DefaultCompressionEnabled,
-						RescoreLimit: flat.DefaultCompressionRescore,
-						Cache:        flat.DefaultVectorCache,
+						Enabled:       flat.DefaultCompressionEnabled,
+						RescoreLimit:  flat.DefaultCompressionRescore,
+						Cache:         flat.DefaultVectorCache,
+						Bits:          flat.DefaultSQBits,
+						TrainingLimit: flat.DefaultSQTrainingLimit,
 					},
 				},
 			},
@@ -305,6 +326,18 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "multivector enabled with hnsw returns error",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"multivector": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "multivector is not supported by the dynamic index",
+		},
 	}
 
 	for _, test := range tests {
@@ -321,3 +354,81 @@ func Test_DynamicUserConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_DynamicUserConfigUpgradeTo(t *testing.T) {
+	t.Run("diskann with custom settings", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"upgradeTo": "diskann",
+			"diskann": map[string]interface{}{
+				"maxDegree":      float64(32),
+				"searchListSize": float64(50),
+			},
+		})
+		require.Nil(t, err)
+
+		expected := diskann.NewDefaultUserConfig()
+		expected.MaxDegree = 32
+		expected.SearchListSize = 50
+		assert.Equal(t, UpgradeToDiskANN, cfg.(UserConfig).UpgradeTo)
+		assert.Equal(t, expected, cfg.(UserConfig).DiskANNUC)
+	})
+
+	t.Run("invalid upgrade target", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"upgradeTo": "flat",
+		})
+		assert.ErrorContains(t, err, `invalid upgradeTo "flat"`)
+	})
+
+	t.Run("invalid diskann config", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"upgradeTo": "diskann",
+			"diskann": map[string]interface{}{
+				"maxDegree": float64(4),
+			},
+		})
+		assert.ErrorContains(t, err, "invalid diskann config")
+	})
+}
+
+func Test_DynamicUserConfigDowngradeThreshold(t *testing.T) {
+	t.Run("downgrade and upgrade thresholds", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold":          float64(10_000),
+			"downgradeThreshold": float64(5_000),
+			"flat": map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			"hnsw": map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		uc := cfg.(UserConfig)
+		assert.Equal(t, uint64(10_000), uc.Threshold)
+		assert.Equal(t, uint64(5_000), uc.DowngradeThreshold)
+		// each side keeps its own compression
+		assert.True(t, uc.FlatUC.BQ.Enabled)
+		assert.False(t, uc.FlatUC.PQ.Enabled)
+		assert.True(t, uc.HnswUC.PQ.Enabled)
+	})
+
+	t.Run("never downgrade by default", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), cfg.(UserConfig).DowngradeThreshold)
+	})
+
+	t.Run("downgrade threshold must be lower than the threshold", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold":          float64(1_000),
+			"downgradeThreshold": float64(1_000),
+		})
+		assert.ErrorContains(t, err, "downgradeThreshold (1000) must be lower than threshold (1000)")
+	})
+}
@@ -24,12 +24,18 @@ const (
 	DefaultVectorCacheMaxObjects = 1e12
 	DefaultCompressionEnabled    = false
 	DefaultCompressionRescore    = -1 // indicates "let Weaviate pick"
+	DefaultSQBits                = 8
+	DefaultSQTrainingLimit       = 100000
 )
 
 type CompressionUserConfig struct {
 	Enabled      bool `json:"enabled"`
 	RescoreLimit int  `json:"rescoreLimit"`
 	Cache        bool `json:"cache"`
+	Rotation     bool `json:"rotation"`
+	// Bits and TrainingLimit are only used by SQ
+	Bits          int `json:"bits,omitempty"`
+	TrainingLimit int `json:"trainingLimit,omitempty"`
 }
 
 type UserConfig struct {
@@ -38,6 +44,10 @@ type UserConfig struct {
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	// IndexDimensions limits the BQ codes to the first n dimensions of every
+	// vector, results are rescored with the full vectors. Uncompressed flat
+	// indexes always compare full vectors. 0 uses all dimensions.
+	IndexDimensions int `json:"indexDimensions"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -62,6 +72,8 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.SQ.Bits = DefaultSQBits
+	u.SQ.TrainingLimit = DefaultSQTrainingLimit
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -95,6 +107,15 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "indexDimensions", func(v int) {
+		uc.IndexDimensions = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.IndexDimensions < 0 {
+		return uc, errors.New("indexDimensions must be a positive integer or 0 to use all dimensions")
+	}
+
 	return uc, nil
 }
 
@@ -118,6 +139,12 @@ func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
 		}); err != nil {
 			return err
 		}
+
+		if err := vectorindexcommon.OptionalBoolFromMap(configMap, "rotation", func(v bool) {
+			cuc.Rotation = v
+		}); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -150,6 +177,9 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		if err != nil {
 			return err
 		}
+		if err := parseSQMap(sqConfigValue, &uc.SQ); err != nil {
+			return err
+		}
 	}
 
 	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
@@ -168,14 +198,47 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		return errors.New("cannot enable multiple quantization methods at the same time")
 	}
 
-	// TODO: remove once PQ and SQ are supported
+	// TODO: remove once PQ is supported
 	if uc.PQ.Enabled {
 		return errors.New("PQ is not currently supported for flat indices")
 	}
 	if uc.SQ.Enabled {
-		return errors.New("SQ is not currently supported for flat indices")
+		return validateSQ(uc.SQ)
+	}
+
+	return nil
+}
+
+func parseSQMap(in interface{}, cuc *CompressionUserConfig) error {
+	configMap, ok := in.(map[string]interface{})
+	if !ok {
+		return nil
 	}
 
+	if err := vectorindexcommon.OptionalIntFromMap(configMap, "bits", func(v int) {
+		cuc.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	return vectorindexcommon.OptionalIntFromMap(configMap, "trainingLimit", func(v int) {
+		cuc.TrainingLimit = v
+	})
+}
+
+func validateSQ(sq CompressionUserConfig) error {
+	switch sq.Bits {
+	case 2, 4, 8:
+	default:
+		return fmt.Errorf("sq.bits must be one of 2, 4 or 8, got %d", sq.Bits)
+	}
+	if sq.TrainingLimit <= 0 {
+		return fmt.Errorf("sq.trainingLimit must be a positive integer, got %d", sq.TrainingLimit)
+	}
+	// TODO: remove once the SQ codes can be cached
+	if sq.Cache {
+		return errors.New("SQ cache is not currently supported for flat indices")
+	}
 	return nil
 }
 
@@ -46,9 +46,11 @@ func Test_FlatUserConfig(t *testing.T) {
 					Cache:        DefaultVectorCache,
 				},
 				SQ: CompressionUserConfig{
-					Enabled:      DefaultCompressionEnabled,
-					RescoreLimit: DefaultCompressionRescore,
-					Cache:        DefaultVectorCache,
+					Enabled:       DefaultCompressionEnabled,
+					RescoreLimit:  DefaultCompressionRescore,
+					Cache:         DefaultVectorCache,
+					Bits:          DefaultSQBits,
+					TrainingLimit: DefaultSQTrainingLimit,
 				},
 			},
 		},
@@ -77,9 +79,11 @@ func Test_FlatUserConfig(t *testing.T) {
 					Cache:        true,
 				},
 				SQ: CompressionUserConfig{
-					Enabled:      DefaultCompressionEnabled,
-					RescoreLimit: DefaultCompressionRescore,
-					Cache:        DefaultVectorCache,
+					Enabled:       DefaultCompressionEnabled,
+					RescoreLimit:  DefaultCompressionRescore,
+					Cache:         DefaultVectorCache,
+					Bits:          DefaultSQBits,
+					TrainingLimit: DefaultSQTrainingLimit,
 				},
 			},
 		},
@@ -89,13 +93,56 @@ func Test_FlatUserConfig(t *testing.T) {
 				"vectorCacheMaxObjects": float64(100),
 				"distance":              "cosine",
 				"sq": map[string]interface{}{
-					"enabled":      true,
-					"rescoreLimit": float64(20),
-					"cache":        true,
+					"enabled":       true,
+					"rescoreLimit":  float64(20),
+					"bits":          float64(4),
+					"trainingLimit": float64(1000),
+					"rotation":      true,
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: 100,
+				Distance:              common.DefaultDistanceMetric,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:       true,
+					RescoreLimit:  20,
+					Rotation:      true,
+					Bits:          4,
+					TrainingLimit: 1000,
+				},
+			},
+		},
+		{
+			name: "sq with invalid bits",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "sq.bits must be one of 2, 4 or 8, got 3",
+		},
+		{
+			name: "sq with cache",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled": true,
+					"cache":   true,
 				},
 			},
 			expectErr:    true,
-			expectErrMsg: "SQ is not currently supported for flat indices",
+			expectErrMsg: "SQ cache is not currently supported for flat indices",
 		},
 		{
 			name: "pq enabled",
@@ -130,6 +177,45 @@ func Test_FlatUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
+		{
+			name: "bq with index dimensions",
+			input: map[string]interface{}{
+				"indexDimensions": float64(256),
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      true,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:       DefaultCompressionEnabled,
+					RescoreLimit:  DefaultCompressionRescore,
+					Cache:         DefaultVectorCache,
+					Bits:          DefaultSQBits,
+					TrainingLimit: DefaultSQTrainingLimit,
+				},
+				IndexDimensions: 256,
+			},
+		},
+		{
+			name: "negative index dimensions",
+			input: map[string]interface{}{
+				"indexDimensions": float64(-1),
+			},
+			expectErr:    true,
+			expectErrMsg: "indexDimensions must be a positive integer",
+		},
 	}
 
 	for _, test := range tests {
@@ -14,11 +14,14 @@ package hnsw
 import "github.com/weaviate/weaviate/entities/vectorindex/common"
 
 const (
-	DefaultBQEnabled = false
+	DefaultBQEnabled  = false
+	DefaultBQRotation = false
 )
 
 type BQConfig struct {
 	Enabled bool `json:"enabled"`
+	// Rotation applies a random rotation to the vectors before quantization
+	Rotation bool `json:"rotation"`
 }
 
 func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
@@ -38,5 +41,11 @@ func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
 		return err
 	}
 
+	if err := common.OptionalBoolFromMap(bqConfigMap, "rotation", func(v bool) {
+		bq.Rotation = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
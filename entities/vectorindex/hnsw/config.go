@@ -33,6 +33,9 @@ const (
 
 	FilterStrategySweeping = "sweeping"
 	FilterStrategyAcorn    = "acorn"
+	// FilterStrategyFlat can only be set per query and forces a brute force
+	// search over the objects matching the filter
+	FilterStrategyFlat = "flat"
 
 	DefaultFilterStrategy = FilterStrategySweeping
 
@@ -43,21 +46,27 @@ const (
 
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
-	BQ                     BQConfig `json:"bq"`
-	SQ                     SQConfig `json:"sq"`
-	FilterStrategy         string   `json:"filterStrategy"`
+	Skip                   bool              `json:"skip"`
+	CleanupIntervalSeconds int               `json:"cleanupIntervalSeconds"`
+	MaxConnections         int               `json:"maxConnections"`
+	EFConstruction         int               `json:"efConstruction"`
+	EF                     int               `json:"ef"`
+	DynamicEFMin           int               `json:"dynamicEfMin"`
+	DynamicEFMax           int               `json:"dynamicEfMax"`
+	DynamicEFFactor        int               `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int               `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int               `json:"flatSearchCutoff"`
+	Distance               string            `json:"distance"`
+	PQ                     PQConfig          `json:"pq"`
+	BQ                     BQConfig          `json:"bq"`
+	SQ                     SQConfig          `json:"sq"`
+	FilterStrategy         string            `json:"filterStrategy"`
+	Multivector            MultivectorConfig `json:"multivector"`
+	// IndexDimensions limits the index to the first n dimensions of every
+	// vector, results are rescored with the full vectors. This is meant for
+	// embedding models trained with Matryoshka representation learning. 0
+	// indexes all dimensions.
+	IndexDimensions int `json:"indexDimensions"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -95,12 +104,15 @@ func (u *UserConfig) SetDefaults() {
 		},
 	}
 	u.BQ = BQConfig{
-		Enabled: DefaultBQEnabled,
+		Enabled:  DefaultBQEnabled,
+		Rotation: DefaultBQRotation,
 	}
 	u.SQ = SQConfig{
 		Enabled:       DefaultSQEnabled,
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
+		Bits:          DefaultSQBits,
+		Rotation:      DefaultSQRotation,
 	}
 	u.FilterStrategy = DefaultFilterStrategy
 }
@@ -204,6 +216,16 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseMultivectorMap(asMap, &uc.Multivector); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "indexDimensions", func(v int) {
+		uc.IndexDimensions = v
+	}); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -227,6 +249,14 @@ func (u *UserConfig) validate() error {
 		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
 	}
 
+	if u.IndexDimensions < 0 {
+		errMsgs = append(errMsgs, "indexDimensions must be a positive integer or 0 to index all dimensions")
+	}
+
+	if err := u.SQ.validate(); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -246,6 +276,18 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
 
+	if u.Multivector.Enabled {
+		if err := u.Multivector.validate(); err != nil {
+			return fmt.Errorf("invalid hnsw config: %w", err)
+		}
+		if enabled > 0 {
+			return fmt.Errorf("invalid hnsw config: compression is not supported for multivector indexes")
+		}
+		if u.IndexDimensions > 0 {
+			return fmt.Errorf("invalid hnsw config: indexDimensions is not supported for multivector indexes")
+		}
+	}
+
 	return nil
 }
 
@@ -254,3 +296,15 @@ func NewDefaultUserConfig() UserConfig {
 	uc.SetDefaults()
 	return uc
 }
+
+// ValidateQueryFilterStrategy checks a filter strategy that overrides the
+// configured one for a single query. An empty strategy means no override.
+func ValidateQueryFilterStrategy(strategy string) error {
+	switch strategy {
+	case "", FilterStrategySweeping, FilterStrategyAcorn, FilterStrategyFlat:
+		return nil
+	default:
+		return fmt.Errorf("filterStrategy must be one of '%s', '%s' or '%s', got '%s'",
+			FilterStrategySweeping, FilterStrategyAcorn, FilterStrategyFlat, strategy)
+	}
+}
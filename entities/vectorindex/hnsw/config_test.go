@@ -61,6 +61,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -97,6 +98,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -144,6 +146,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -191,6 +194,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -239,6 +243,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -284,6 +289,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -338,6 +344,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -391,6 +398,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -464,6 +472,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -548,6 +557,7 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
@@ -593,10 +603,104 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       true,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: DefaultFilterStrategy,
 			},
 		},
+		{
+			name: "with 4 bit sq and rotation",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled":  true,
+					"bits":     float64(4),
+					"rotation": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       false,
+					Segments:      0,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       true,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          4,
+					Rotation:      true,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+			},
+		},
+		{
+			name: "with bq rotation",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled":  true,
+					"rotation": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       false,
+					Segments:      0,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				BQ: BQConfig{
+					Enabled:  true,
+					Rotation: true,
+				},
+				SQ: SQConfig{
+					Enabled:       false,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+			},
+		},
+		{
+			name: "with unsupported sq bits",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: sq.bits must be one of 2, 4 or 8, got 3",
+		},
 		{
 			name: "with invalid compression",
 			input: map[string]interface{}{
@@ -653,10 +757,135 @@ func Test_UserConfig(t *testing.T) {
 					Enabled:       DefaultSQEnabled,
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
 				},
 				FilterStrategy: FilterStrategyAcorn,
 			},
 		},
+		{
+			name: "multivector enabled, default aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: MultivectorAggregationMaxSim,
+				},
+			},
+		},
+		{
+			name: "multivector with invalid aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "avg",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid multivector aggregation \"avg\"",
+		},
+		{
+			name: "multivector with compression",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "compression is not supported for multivector indexes",
+		},
+		{
+			name: "with index dimensions",
+			input: map[string]interface{}{
+				"indexDimensions": float64(256),
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+					Bits:          DefaultSQBits,
+				},
+				FilterStrategy:  DefaultFilterStrategy,
+				IndexDimensions: 256,
+			},
+		},
+		{
+			name: "with negative index dimensions",
+			input: map[string]interface{}{
+				"indexDimensions": float64(-4),
+			},
+			expectErr:    true,
+			expectErrMsg: "indexDimensions must be a positive integer",
+		},
+		{
+			name: "multivector with index dimensions",
+			input: map[string]interface{}{
+				"indexDimensions": float64(256),
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "indexDimensions is not supported for multivector indexes",
+		},
 	}
 
 	for _, test := range tests {
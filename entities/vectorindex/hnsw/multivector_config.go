@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	MultivectorAggregationMaxSim  = "maxSim"
+	DefaultMultivectorAggregation = MultivectorAggregationMaxSim
+)
+
+// MultivectorConfig turns the index into a late interaction index: every
+// object holds a list of token vectors and is scored against the list of
+// query token vectors using the aggregation function
+type MultivectorConfig struct {
+	Enabled     bool   `json:"enabled"`
+	Aggregation string `json:"aggregation"`
+}
+
+func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConfig) error {
+	multivectorConfigValue, ok := in["multivector"]
+	if !ok {
+		return nil
+	}
+
+	multivectorConfigMap, ok := multivectorConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(multivectorConfigMap, "enabled", func(v bool) {
+		multivector.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalStringFromMap(multivectorConfigMap, "aggregation", func(v string) {
+		multivector.Aggregation = v
+	}); err != nil {
+		return err
+	}
+
+	if multivector.Enabled && multivector.Aggregation == "" {
+		multivector.Aggregation = DefaultMultivectorAggregation
+	}
+
+	return nil
+}
+
+func (m MultivectorConfig) validate() error {
+	if m.Aggregation != MultivectorAggregationMaxSim {
+		return fmt.Errorf("invalid multivector aggregation %q, only %q is supported",
+			m.Aggregation, MultivectorAggregationMaxSim)
+	}
+	return nil
+}
@@ -11,18 +11,37 @@
 
 package hnsw
 
-import "github.com/weaviate/weaviate/entities/vectorindex/common"
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
 
 const (
 	DefaultSQEnabled       = false
 	DefaultSQTrainingLimit = 100000
 	DefaultSQRescoreLimit  = 20
+	DefaultSQBits          = 8
+	DefaultSQRotation      = false
 )
 
 type SQConfig struct {
 	Enabled       bool `json:"enabled"`
 	TrainingLimit int  `json:"trainingLimit"`
 	RescoreLimit  int  `json:"rescoreLimit"`
+	// Bits per dimension, 8 (default), 4 or 2
+	Bits int `json:"bits"`
+	// Rotation applies a random rotation to the vectors before quantization
+	Rotation bool `json:"rotation"`
+}
+
+func (sq SQConfig) validate() error {
+	switch sq.Bits {
+	case 2, 4, 8:
+		return nil
+	default:
+		return fmt.Errorf("sq.bits must be one of 2, 4 or 8, got %d", sq.Bits)
+	}
 }
 
 func parseSQMap(in map[string]interface{}, sq *SQConfig) error {
@@ -54,5 +73,17 @@ func parseSQMap(in map[string]interface{}, sq *SQConfig) error {
 		return err
 	}
 
+	if err := common.OptionalIntFromMap(sqConfigMap, "bits", func(v int) {
+		sq.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalBoolFromMap(sqConfigMap, "rotation", func(v bool) {
+		sq.Rotation = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultNLists             = 0 // indicates "let Weaviate pick"
+	DefaultNProbe             = 8
+	DefaultTrainingLimit      = 10000
+	DefaultReclusterThreshold = 1.0
+	DefaultRescoreLimit       = 100
+	DefaultPQEnabled          = false
+	DefaultPQSegments         = 0 // indicates "let Weaviate pick"
+	DefaultSQEnabled          = false
+
+	// Fail validation if those criteria are not met
+	MaximumNLists             = 65536
+	MinimumNProbe             = 1
+	MinimumTrainingLimit      = 100
+	MinimumReclusterThreshold = 0.1
+)
+
+// PQConfig enables product quantization of the vectors in the posting lists
+type PQConfig struct {
+	Enabled  bool `json:"enabled"`
+	Segments int  `json:"segments"`
+}
+
+// SQConfig enables scalar quantization of the vectors in the posting lists
+type SQConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance           string   `json:"distance"`
+	NLists             int      `json:"nlists"`
+	NProbe             int      `json:"nprobe"`
+	TrainingLimit      int      `json:"trainingLimit"`
+	ReclusterThreshold float64  `json:"reclusterThreshold"`
+	RescoreLimit       int      `json:"rescoreLimit"`
+	PQ                 PQConfig `json:"pq"`
+	SQ                 SQConfig `json:"sq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "ivf"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorIndexCommon.DefaultDistanceMetric
+	u.NLists = DefaultNLists
+	u.NProbe = DefaultNProbe
+	u.TrainingLimit = DefaultTrainingLimit
+	u.ReclusterThreshold = DefaultReclusterThreshold
+	u.RescoreLimit = DefaultRescoreLimit
+	u.PQ.Enabled = DefaultPQEnabled
+	u.PQ.Segments = DefaultPQSegments
+	u.SQ.Enabled = DefaultSQEnabled
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "nlists", func(v int) {
+		uc.NLists = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "nprobe", func(v int) {
+		uc.NProbe = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		uc.TrainingLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalFloatFromMap(asMap, "reclusterThreshold", func(v float64) {
+		uc.ReclusterThreshold = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "rescoreLimit", func(v int) {
+		uc.RescoreLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if pqConfig, ok := asMap["pq"].(map[string]interface{}); ok {
+		if err := vectorIndexCommon.OptionalBoolFromMap(pqConfig, "enabled", func(v bool) {
+			uc.PQ.Enabled = v
+		}); err != nil {
+			return uc, err
+		}
+
+		if err := vectorIndexCommon.OptionalIntFromMap(pqConfig, "segments", func(v int) {
+			uc.PQ.Segments = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	if sqConfig, ok := asMap["sq"].(map[string]interface{}); ok {
+		if err := vectorIndexCommon.OptionalBoolFromMap(sqConfig, "enabled", func(v bool) {
+			uc.SQ.Enabled = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.NLists < 0 || u.NLists > MaximumNLists {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"nlists must be between 0 (automatic) and %d", MaximumNLists))
+	}
+
+	if u.NProbe < MinimumNProbe {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"nprobe must be a positive integer with a minimum of %d", MinimumNProbe))
+	}
+
+	if u.TrainingLimit < MinimumTrainingLimit {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"trainingLimit must be a positive integer with a minimum of %d", MinimumTrainingLimit))
+	}
+
+	if u.NLists > u.TrainingLimit {
+		errMsgs = append(errMsgs, "nlists must not be larger than trainingLimit")
+	}
+
+	if u.ReclusterThreshold < MinimumReclusterThreshold {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"reclusterThreshold must be at least %v", MinimumReclusterThreshold))
+	}
+
+	if u.RescoreLimit < 0 {
+		errMsgs = append(errMsgs, "rescoreLimit must not be negative")
+	}
+
+	if u.PQ.Enabled && u.SQ.Enabled {
+		errMsgs = append(errMsgs, "cannot enable multiple quantization methods at the same time")
+	}
+
+	if u.PQ.Segments < 0 {
+		errMsgs = append(errMsgs, "pq.segments must not be negative")
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid ivf config: %s", strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func optionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return fmt.Errorf("json.Number to float64 for %q: %w", name, err)
+		}
+		setFn(asFloat)
+	case float64:
+		setFn(typed)
+	}
+	return nil
+}
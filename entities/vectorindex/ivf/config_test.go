@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_IVFUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:           common.DefaultDistanceMetric,
+				NLists:             DefaultNLists,
+				NProbe:             DefaultNProbe,
+				TrainingLimit:      DefaultTrainingLimit,
+				ReclusterThreshold: DefaultReclusterThreshold,
+				RescoreLimit:       DefaultRescoreLimit,
+				PQ: PQConfig{
+					Enabled:  DefaultPQEnabled,
+					Segments: DefaultPQSegments,
+				},
+				SQ: SQConfig{
+					Enabled: DefaultSQEnabled,
+				},
+			},
+		},
+		{
+			name: "all values specified",
+			input: map[string]interface{}{
+				"distance":           "l2-squared",
+				"nlists":             float64(256),
+				"nprobe":             json.Number("16"),
+				"trainingLimit":      float64(50000),
+				"reclusterThreshold": json.Number("0.5"),
+				"rescoreLimit":       float64(200),
+				"pq": map[string]interface{}{
+					"enabled":  true,
+					"segments": float64(32),
+				},
+			},
+			expected: UserConfig{
+				Distance:           common.DistanceL2Squared,
+				NLists:             256,
+				NProbe:             16,
+				TrainingLimit:      50000,
+				ReclusterThreshold: 0.5,
+				RescoreLimit:       200,
+				PQ: PQConfig{
+					Enabled:  true,
+					Segments: 32,
+				},
+			},
+		},
+		{
+			name: "sq enabled",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				Distance:           common.DefaultDistanceMetric,
+				NLists:             DefaultNLists,
+				NProbe:             DefaultNProbe,
+				TrainingLimit:      DefaultTrainingLimit,
+				ReclusterThreshold: DefaultReclusterThreshold,
+				RescoreLimit:       DefaultRescoreLimit,
+				SQ: SQConfig{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			name: "nprobe too small",
+			input: map[string]interface{}{
+				"nprobe": float64(0),
+			},
+			expectErr:    true,
+			expectErrMsg: "nprobe must be a positive integer with a minimum of 1",
+		},
+		{
+			name: "nlists larger than training limit",
+			input: map[string]interface{}{
+				"nlists":        float64(2000),
+				"trainingLimit": float64(1000),
+			},
+			expectErr:    true,
+			expectErrMsg: "nlists must not be larger than trainingLimit",
+		},
+		{
+			name: "training limit too small",
+			input: map[string]interface{}{
+				"trainingLimit": float64(10),
+			},
+			expectErr:    true,
+			expectErrMsg: "trainingLimit must be a positive integer with a minimum of 100",
+		},
+		{
+			name: "pq and sq both enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": true},
+				"sq": map[string]interface{}{"enabled": true},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SparseUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: UserConfig{Distance: DefaultDistance},
+		},
+		{
+			name:     "empty config",
+			input:    map[string]interface{}{},
+			expected: UserConfig{Distance: DefaultDistance},
+		},
+		{
+			name: "dot distance",
+			input: map[string]interface{}{
+				"distance": "dot",
+			},
+			expected: UserConfig{Distance: "dot"},
+		},
+		{
+			name: "unsupported distance",
+			input: map[string]interface{}{
+				"distance": "cosine",
+			},
+			expectErr:    true,
+			expectErrMsg: "distance \"cosine\" is not supported",
+		},
+		{
+			name:         "invalid input",
+			input:        "sparse",
+			expectErr:    true,
+			expectErrMsg: "input must be a non-nil map",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangeFeedOperation int32
+
+const (
+	ChangeFeedOperation_CHANGE_FEED_OPERATION_UNSPECIFIED ChangeFeedOperation = 0
+	ChangeFeedOperation_CHANGE_FEED_OPERATION_INSERT      ChangeFeedOperation = 1
+	ChangeFeedOperation_CHANGE_FEED_OPERATION_UPDATE      ChangeFeedOperation = 2
+	ChangeFeedOperation_CHANGE_FEED_OPERATION_DELETE      ChangeFeedOperation = 3
+)
+
+// Enum value maps for ChangeFeedOperation.
+var (
+	ChangeFeedOperation_name = map[int32]string{
+		0: "CHANGE_FEED_OPERATION_UNSPECIFIED",
+		1: "CHANGE_FEED_OPERATION_INSERT",
+		2: "CHANGE_FEED_OPERATION_UPDATE",
+		3: "CHANGE_FEED_OPERATION_DELETE",
+	}
+	ChangeFeedOperation_value = map[string]int32{
+		"CHANGE_FEED_OPERATION_UNSPECIFIED": 0,
+		"CHANGE_FEED_OPERATION_INSERT":      1,
+		"CHANGE_FEED_OPERATION_UPDATE":      2,
+		"CHANGE_FEED_OPERATION_DELETE":      3,
+	}
+)
+
+func (x ChangeFeedOperation) Enum() *ChangeFeedOperation {
+	p := new(ChangeFeedOperation)
+	*p = x
+	return p
+}
+
+func (x ChangeFeedOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeFeedOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_change_feed_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeFeedOperation) Type() protoreflect.EnumType {
+	return &file_v1_change_feed_proto_enumTypes[0]
+}
+
+func (x ChangeFeedOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeFeedOperation.Descriptor instead.
+func (ChangeFeedOperation) EnumDescriptor() ([]byte, []int) {
+	return file_v1_change_feed_proto_rawDescGZIP(), []int{0}
+}
+
+type ChangeFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// restricts the feed to the shard of a single tenant, required for multi-tenant collections
+	Tenant *string `protobuf:"bytes,2,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// resume_token of the last consumed reply, the feed starts at the oldest retained change if not set
+	ResumeToken *string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
+	// include the object as written in insert and update changes
+	IncludeObject bool `protobuf:"varint,4,opt,name=include_object,json=includeObject,proto3" json:"include_object,omitempty"`
+}
+
+func (x *ChangeFeedRequest) Reset() {
+	*x = ChangeFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_change_feed_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFeedRequest) ProtoMessage() {}
+
+func (x *ChangeFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_change_feed_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFeedRequest.ProtoReflect.Descriptor instead.
+func (*ChangeFeedRequest) Descriptor() ([]byte, []int) {
+	return file_v1_change_feed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeFeedRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangeFeedRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ChangeFeedRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ChangeFeedRequest) GetIncludeObject() bool {
+	if x != nil {
+		return x.IncludeObject
+	}
+	return false
+}
+
+type ChangeFeedReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation  ChangeFeedOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=weaviate.v1.ChangeFeedOperation" json:"operation,omitempty"`
+	Uuid       string              `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Collection string              `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant     *string             `protobuf:"bytes,4,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	Shard      string              `protobuf:"bytes,5,opt,name=shard,proto3" json:"shard,omitempty"`
+	// position of the change in the feed of its shard
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// last update time of the object, or deletion time for deletes, in ms since epoch
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// JSON encoded object, only set if requested and not a delete
+	Object []byte `protobuf:"bytes,8,opt,name=object,proto3,oneof" json:"object,omitempty"`
+	// resumes the feed after this change
+	ResumeToken string `protobuf:"bytes,9,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *ChangeFeedReply) Reset() {
+	*x = ChangeFeedReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_change_feed_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeFeedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFeedReply) ProtoMessage() {}
+
+func (x *ChangeFeedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_change_feed_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFeedReply.ProtoReflect.Descriptor instead.
+func (*ChangeFeedReply) Descriptor() ([]byte, []int) {
+	return file_v1_change_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeFeedReply) GetOperation() ChangeFeedOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return ChangeFeedOperation_CHANGE_FEED_OPERATION_UNSPECIFIED
+}
+
+func (x *ChangeFeedReply) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ChangeFeedReply) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangeFeedReply) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ChangeFeedReply) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *ChangeFeedReply) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ChangeFeedReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChangeFeedReply) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *ChangeFeedReply) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_v1_change_feed_proto protoreflect.FileDescriptor
+
+var file_v1_change_feed_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xc8, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2a, 0xa2, 0x01, 0x0a,
+	0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46,
+	0x45, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a,
+	0x1c, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x42, 0x74, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_change_feed_proto_rawDescOnce sync.Once
+	file_v1_change_feed_proto_rawDescData = file_v1_change_feed_proto_rawDesc
+)
+
+func file_v1_change_feed_proto_rawDescGZIP() []byte {
+	file_v1_change_feed_proto_rawDescOnce.Do(func() {
+		file_v1_change_feed_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_change_feed_proto_rawDescData)
+	})
+	return file_v1_change_feed_proto_rawDescData
+}
+
+var file_v1_change_feed_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_change_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_change_feed_proto_goTypes = []interface{}{
+	(ChangeFeedOperation)(0),  // 0: weaviate.v1.ChangeFeedOperation
+	(*ChangeFeedRequest)(nil), // 1: weaviate.v1.ChangeFeedRequest
+	(*ChangeFeedReply)(nil),   // 2: weaviate.v1.ChangeFeedReply
+}
+var file_v1_change_feed_proto_depIdxs = []int32{
+	0, // 0: weaviate.v1.ChangeFeedReply.operation:type_name -> weaviate.v1.ChangeFeedOperation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v1_change_feed_proto_init() }
+func file_v1_change_feed_proto_init() {
+	if File_v1_change_feed_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_change_feed_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_change_feed_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeFeedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_change_feed_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_v1_change_feed_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_change_feed_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_change_feed_proto_goTypes,
+		DependencyIndexes: file_v1_change_feed_proto_depIdxs,
+		EnumInfos:         file_v1_change_feed_proto_enumTypes,
+		MessageInfos:      file_v1_change_feed_proto_msgTypes,
+	}.Build()
+	File_v1_change_feed_proto = out.File
+	file_v1_change_feed_proto_rawDesc = nil
+	file_v1_change_feed_proto_goTypes = nil
+	file_v1_change_feed_proto_depIdxs = nil
+}
@@ -21,8 +21,12 @@ const _ = grpc.SupportPackageIsVersion7
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	ChangeFeed(ctx context.Context, in *ChangeFeedRequest, opts ...grpc.CallOption) (Weaviate_ChangeFeedClient, error)
+	ExportCreate(ctx context.Context, in *ExportCreateRequest, opts ...grpc.CallOption) (*ExportReply, error)
+	ExportStatus(ctx context.Context, in *ExportStatusRequest, opts ...grpc.CallOption) (*ExportReply, error)
 }
 
 type weaviateClient struct {
@@ -51,6 +55,37 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviate.v1.Weaviate/BatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateBatchStreamClient{stream}
+	return x, nil
+}
+
+type Weaviate_BatchStreamClient interface {
+	Send(*BatchStreamRequest) error
+	Recv() (*BatchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateBatchStreamClient) Send(m *BatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamClient) Recv() (*BatchStreamReply, error) {
+	m := new(BatchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error) {
 	out := new(BatchDeleteReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchDelete", in, out, opts...)
@@ -69,14 +104,68 @@ func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest,
 	return out, nil
 }
 
+func (c *weaviateClient) ChangeFeed(ctx context.Context, in *ChangeFeedRequest, opts ...grpc.CallOption) (Weaviate_ChangeFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviate.v1.Weaviate/ChangeFeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateChangeFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ChangeFeedClient interface {
+	Recv() (*ChangeFeedReply, error)
+	grpc.ClientStream
+}
+
+type weaviateChangeFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateChangeFeedClient) Recv() (*ChangeFeedReply, error) {
+	m := new(ChangeFeedReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) ExportCreate(ctx context.Context, in *ExportCreateRequest, opts ...grpc.CallOption) (*ExportReply, error) {
+	out := new(ExportReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ExportCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ExportStatus(ctx context.Context, in *ExportStatusRequest, opts ...grpc.CallOption) (*ExportReply, error) {
+	out := new(ExportReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ExportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	BatchStream(Weaviate_BatchStreamServer) error
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	ChangeFeed(*ChangeFeedRequest, Weaviate_ChangeFeedServer) error
+	ExportCreate(context.Context, *ExportCreateRequest) (*ExportReply, error)
+	ExportStatus(context.Context, *ExportStatusRequest) (*ExportReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -90,12 +179,24 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) BatchStream(Weaviate_BatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchStream not implemented")
+}
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
+func (UnimplementedWeaviateServer) ChangeFeed(*ChangeFeedRequest, Weaviate_ChangeFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChangeFeed not implemented")
+}
+func (UnimplementedWeaviateServer) ExportCreate(context.Context, *ExportCreateRequest) (*ExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCreate not implemented")
+}
+func (UnimplementedWeaviateServer) ExportStatus(context.Context, *ExportStatusRequest) (*ExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStatus not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -145,6 +246,32 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).BatchStream(&weaviateBatchStreamServer{stream})
+}
+
+type Weaviate_BatchStreamServer interface {
+	Send(*BatchStreamReply) error
+	Recv() (*BatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateBatchStreamServer) Send(m *BatchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamServer) Recv() (*BatchStreamRequest, error) {
+	m := new(BatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchDeleteRequest)
 	if err := dec(in); err != nil {
@@ -181,6 +308,63 @@ func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_ChangeFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangeFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).ChangeFeed(m, &weaviateChangeFeedServer{stream})
+}
+
+type Weaviate_ChangeFeedServer interface {
+	Send(*ChangeFeedReply) error
+	grpc.ServerStream
+}
+
+type weaviateChangeFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateChangeFeedServer) Send(m *ChangeFeedReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_ExportCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ExportCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ExportCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ExportCreate(ctx, req.(*ExportCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ExportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ExportStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ExportStatus(ctx, req.(*ExportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,7 +388,27 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
 		},
+		{
+			MethodName: "ExportCreate",
+			Handler:    _Weaviate_ExportCreate_Handler,
+		},
+		{
+			MethodName: "ExportStatus",
+			Handler:    _Weaviate_ExportStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchStream",
+			Handler:       _Weaviate_BatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ChangeFeed",
+			Handler:       _Weaviate_ChangeFeed_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
@@ -330,3 +330,33 @@ func (r *reader) Read(p []byte) (n int, err error) {
 	r.count += n
 	return
 }
+
+// DeleteBackup removes all blobs stored under the backup's prefix
+func (a *azureClient) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	containerName := a.config.Container
+	if overrideBucket != "" {
+		containerName = overrideBucket
+	}
+
+	prefix := a.makeObjectName(overridePath, []string{backupID}) + "/"
+	blobs := a.client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	for blobs.More() {
+		page, err := blobs.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs %s", prefix))
+		}
+		if page.Segment == nil {
+			continue
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, containerName, *item.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob %s", *item.Name))
+			}
+		}
+	}
+	return nil
+}
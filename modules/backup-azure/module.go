@@ -112,5 +112,6 @@ func (m *Module) MetaInfo() (map[string]interface{}, error) {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.BackupDeleter(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
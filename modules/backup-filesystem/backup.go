@@ -248,3 +248,14 @@ func (m *Module) createBackupsDir(backupsPath string) error {
 	}
 	return nil
 }
+
+func (m *Module) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	backupPath := m.makeBackupDirPath(m.backupsPath, backupID)
+	if overridePath != "" {
+		backupPath = filepath.Join(overridePath, backupID)
+	}
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup %s", backupPath))
+	}
+	return nil
+}
@@ -135,5 +135,6 @@ func (m *Module) makeBackupDirPath(path, id string) string {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.BackupDeleter(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
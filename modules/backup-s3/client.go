@@ -333,3 +333,26 @@ func (s *s3Client) Read(ctx context.Context, backupID, key, overrideBucket, over
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
+
+// DeleteBackup removes all objects stored under the backup's prefix
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete backup: cannot get client")
+	}
+	prefix := s.makeObjectName(backupID) + "/"
+	if overridePath != "" {
+		prefix = path.Join(overridePath, backupID) + "/"
+	}
+
+	bucket := s.config.Bucket
+	if overrideBucket != "" {
+		bucket = overrideBucket
+	}
+
+	objects := client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	for rerr := range client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		return backup.NewErrInternal(errors.Wrapf(rerr.Err, "delete object %s:%s", bucket, rerr.ObjectName))
+	}
+	return nil
+}
@@ -12,13 +12,16 @@
 package modjinaai
 
 import (
+	"context"
+
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/arguments/nearText"
 )
 
 func (m *JinaAIModule) initNearText() error {
-	// TODO: add support for generic searcher
-	// m.searcher = nearText.NewSearcher(m.vectorizer)
+	m.searcher = &multiVectorSearcher{vectorizer: m.vectorizer}
 	m.graphqlProvider = nearText.New(m.nearTextTransformer)
 	return nil
 }
@@ -31,6 +34,44 @@ func (m *JinaAIModule) VectorSearches() map[string]modulecapabilities.VectorForP
 	return m.searcher.VectorSearches()
 }
 
+// multiVectorSearcher vectorizes nearText queries into a multi vector. Search
+// parameters only carry a single []float32, so the token vectors are
+// concatenated, a multivector index splits them again by its dimensionality.
+type multiVectorSearcher struct {
+	vectorizer interface {
+		Texts(ctx context.Context, input []string, cfg moduletools.ClassConfig) ([][]float32, error)
+	}
+}
+
+func (s *multiVectorSearcher) VectorSearches() map[string]modulecapabilities.VectorForParams {
+	return map[string]modulecapabilities.VectorForParams{
+		"nearText": s.vectorForNearTextParam,
+	}
+}
+
+func (s *multiVectorSearcher) vectorForNearTextParam(ctx context.Context, params interface{},
+	className string, findVectorFn modulecapabilities.FindVectorFn, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	p, ok := params.(*nearText.NearTextParams)
+	if !ok {
+		return nil, errors.Errorf("unexpected nearText params type %T", params)
+	}
+	if p.MoveTo.Force > 0 || p.MoveAwayFrom.Force > 0 {
+		return nil, errors.New("moveTo and moveAwayFrom are not supported for multi vectors")
+	}
+
+	vectors, err := s.vectorizer.Texts(ctx, p.Values, cfg)
+	if err != nil {
+		return nil, errors.Errorf("vectorize keywords: %v", err)
+	}
+
+	var flat []float32
+	for _, vector := range vectors {
+		flat = append(flat, vector...)
+	}
+	return flat, nil
+}
+
 var (
 	_ = modulecapabilities.GraphQLArguments(New())
 	_ = modulecapabilities.Searcher(New())
@@ -14,6 +14,7 @@ package authorization
 import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -26,6 +27,10 @@ type Authorizer interface {
 
 // New Authorizer based on the application-wide config
 func New(cfg config.Config) Authorizer {
+	if cfg.Authorization.Rbac.Enabled {
+		return rbac.New(cfg.Authorization.Rbac)
+	}
+
 	if cfg.Authorization.AdminList.Enabled {
 		return adminlist.New(cfg.Authorization.AdminList)
 	}
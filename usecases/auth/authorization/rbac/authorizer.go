@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const AnonymousPrincipalUsername = "anonymous"
+
+// RolesReader returns the roles assigned to a user together with their
+// permissions
+type RolesReader interface {
+	GetRolesForUser(user string) map[string][]*models.Permission
+}
+
+// Controller manages roles and their assignments. Changes are replicated
+// across the cluster, reads are served from the local state.
+type Controller interface {
+	RolesReader
+	UpsertRolesPermissions(ctx context.Context, roles map[string][]*models.Permission) error
+	DeleteRoles(ctx context.Context, names ...string) error
+	RemovePermissions(ctx context.Context, role string, permissions []*models.Permission) error
+	AddRolesForUser(ctx context.Context, user string, roles []string) error
+	RevokeRolesForUser(ctx context.Context, user string, roles []string) error
+	GetRoles(names ...string) map[string][]*models.Permission
+	GetUsersForRole(role string) []string
+}
+
+// Authorizer grants full access to the configured admins and evaluates the
+// permissions of the roles assigned to every other principal
+type Authorizer struct {
+	admins map[string]struct{}
+
+	sync.RWMutex
+	roles RolesReader
+}
+
+// New Authorizer using role based access control. Until a RolesReader is set
+// only admins are granted access.
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{admins: make(map[string]struct{}, len(cfg.Admins))}
+	for _, admin := range cfg.Admins {
+		a.admins[admin] = struct{}{}
+	}
+	return a
+}
+
+// SetRolesReader sets the source of the role assignments, which is only
+// available once the cluster service has been initialized
+func (a *Authorizer) SetRolesReader(r RolesReader) {
+	a.Lock()
+	defer a.Unlock()
+	a.roles = r
+}
+
+// Authorize succeeds if the principal is an admin or if every resource is
+// covered by a permission of one of its roles for the given verb
+func (a *Authorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	if _, ok := a.admins[principal.Username]; ok {
+		return nil
+	}
+
+	a.RLock()
+	roles := a.roles
+	a.RUnlock()
+	if roles == nil {
+		return errors.NewForbidden(principal, verb, resources...)
+	}
+
+	var policies []policy
+	for _, permissions := range roles.GetRolesForUser(principal.Username) {
+		for _, p := range permissions {
+			translated, err := policiesFromPermission(p)
+			if err != nil {
+				// permissions are validated before being stored
+				continue
+			}
+			policies = append(policies, translated...)
+		}
+	}
+
+	for _, resource := range resources {
+		if !allowed(policies, verb, resource) {
+			return errors.NewForbidden(principal, verb, resources...)
+		}
+	}
+	return nil
+}
+
+func allowed(policies []policy, verb, resource string) bool {
+	for _, p := range policies {
+		if p.allows(verb, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrincipalUsername,
+	}
+}
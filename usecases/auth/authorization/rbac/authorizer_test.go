@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type fakeRolesReader map[string]map[string][]*models.Permission
+
+func (f fakeRolesReader) GetRolesForUser(user string) map[string][]*models.Permission {
+	return f[user]
+}
+
+func permission(action string, collection, tenant *string) *models.Permission {
+	return &models.Permission{Action: &action, Collection: collection, Tenant: tenant}
+}
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	cfg := Config{Enabled: true, Admins: []string{"admin"}}
+	alice := &models.Principal{Username: "alice"}
+
+	t.Run("admins are always allowed", func(t *testing.T) {
+		a := New(cfg)
+		err := a.Authorize(&models.Principal{Username: "admin"}, "D", "collections/Foo")
+		assert.Nil(t, err)
+	})
+
+	t.Run("without roles reader every other user is denied", func(t *testing.T) {
+		a := New(cfg)
+		err := a.Authorize(alice, "R", "collections/Foo")
+		assert.Equal(t, errors.NewForbidden(alice, "R", "collections/Foo"), err)
+	})
+
+	t.Run("a nil principal is treated as anonymous", func(t *testing.T) {
+		a := New(cfg)
+		a.SetRolesReader(fakeRolesReader{})
+		err := a.Authorize(nil, "R", "collections/Foo")
+		assert.Equal(t, errors.NewForbidden(newAnonymousPrincipal(), "R", "collections/Foo"), err)
+	})
+
+	t.Run("permissions of the assigned roles", func(t *testing.T) {
+		a := New(cfg)
+		a.SetRolesReader(fakeRolesReader{
+			"alice": {
+				"reader": {
+					permission(models.PermissionActionReadCollections, strPtr("Foo*"), nil),
+					permission(models.PermissionActionReadObjectsTenant, strPtr("Foo"), strPtr("t1")),
+				},
+			},
+		})
+
+		tests := []struct {
+			name      string
+			verb      string
+			resources []string
+			allowed   bool
+		}{
+			{"matching collection", "R", []string{"collections/FooBar"}, true},
+			{"non matching collection", "R", []string{"collections/Bar"}, false},
+			{"other verb", "U", []string{"collections/Foo"}, false},
+			{"objects of the tenant", "R", []string{"collections/Foo/shards/t1/objects/1"}, true},
+			{"objects of another tenant", "R", []string{"collections/Foo/shards/t2/objects/1"}, false},
+			{"shard of the tenant", "R", []string{"collection/Foo/shards/t1"}, true},
+			{"all resources must be allowed", "R", []string{"collections/Foo", "collections/Bar"}, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := a.Authorize(alice, tt.verb, tt.resources...)
+				if tt.allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, errors.NewForbidden(alice, tt.verb, tt.resources...), err)
+				}
+			})
+		}
+	})
+
+	t.Run("manage cluster and roles", func(t *testing.T) {
+		a := New(cfg)
+		a.SetRolesReader(fakeRolesReader{
+			"alice": {
+				"ops": {
+					permission(models.PermissionActionManageCluster, nil, nil),
+					{Action: strPtr(models.PermissionActionReadRoles), Role: strPtr("ops")},
+				},
+			},
+		})
+		require.Nil(t, a.Authorize(alice, "U", "cluster/nodes"))
+		require.Nil(t, a.Authorize(alice, "R", "roles/ops"))
+		require.NotNil(t, a.Authorize(alice, "R", "roles/admin"))
+		require.NotNil(t, a.Authorize(alice, "C", "roles/ops"))
+	})
+}
+
+func Test_ValidatePermission(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     *models.Permission
+		valid bool
+	}{
+		{"nil permission", nil, false},
+		{"missing action", &models.Permission{}, false},
+		{"unknown action", permission("do_things", nil, nil), false},
+		{"collection action", permission(models.PermissionActionCreateCollections, strPtr("Foo"), nil), true},
+		{"tenant action", permission(models.PermissionActionDeleteTenants, strPtr("Foo"), strPtr("*")), true},
+		{"slash in resource", permission(models.PermissionActionCreateCollections, strPtr("Foo/Bar"), nil), false},
+		{"bad pattern", permission(models.PermissionActionCreateCollections, strPtr("Foo["), nil), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePermission(tt.p)
+			if tt.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import "fmt"
+
+// Config enables role based access control. Admins have full access to every
+// resource and are needed to create the first roles, every other subject is
+// only granted what the roles assigned to it allow.
+type Config struct {
+	Enabled bool     `json:"enabled" yaml:"enabled"`
+	Admins  []string `json:"admins" yaml:"admins"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if len(c.Admins) == 0 {
+		return fmt.Errorf("rbac: at least one admin is required to manage roles")
+	}
+	for _, admin := range c.Admins {
+		if admin == "" {
+			return fmt.Errorf("rbac: admin names must not be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// verbs and resources mirror the ones built by the authorization package,
+// which can't be imported from here as it depends on this package.
+const (
+	verbCreate = "C"
+	verbRead   = "R"
+	verbUpdate = "U"
+	verbDelete = "D"
+
+	all = "*"
+)
+
+var allVerbs = []string{verbCreate, verbRead, verbUpdate, verbDelete}
+
+// policy allows verb on every resource matching the resource pattern
+type policy struct {
+	verb     string
+	resource string
+}
+
+func (p policy) allows(verb, resource string) bool {
+	if p.verb != verb {
+		return false
+	}
+	ok, _ := path.Match(p.resource, resource)
+	return ok
+}
+
+// ValidatePermission makes sure the permission can be translated into
+// policies: the action must be known and every resource field must be either
+// a plain name or a pattern using "*" as wildcard.
+func ValidatePermission(p *models.Permission) error {
+	if p == nil || p.Action == nil {
+		return fmt.Errorf("permission without action")
+	}
+	for _, field := range []*string{p.Collection, p.Tenant, p.Object, p.Role} {
+		if field == nil {
+			continue
+		}
+		if strings.Contains(*field, "/") {
+			return fmt.Errorf("invalid resource %q: must not contain '/'", *field)
+		}
+		if _, err := path.Match(*field, ""); err != nil {
+			return fmt.Errorf("invalid resource pattern %q: %w", *field, err)
+		}
+	}
+	_, err := policiesFromPermission(p)
+	return err
+}
+
+// policiesFromPermission translates a permission into the verbs and resources
+// it allows. Unset resource fields match everything.
+func policiesFromPermission(p *models.Permission) ([]policy, error) {
+	var (
+		action     = valueOrAll(p.Action)
+		collection = valueOrAll(p.Collection)
+		tenant     = valueOrAll(p.Tenant)
+		object     = valueOrAll(p.Object)
+	)
+
+	switch action {
+	case models.PermissionActionManageRoles:
+		return forVerbs(allVerbs, rolesResource(valueOrAll(p.Role))), nil
+	case models.PermissionActionReadRoles:
+		return forVerbs([]string{verbRead}, rolesResource(valueOrAll(p.Role))), nil
+	case models.PermissionActionManageCluster:
+		return forVerbs(allVerbs, "cluster/*"), nil
+	}
+
+	verb, domain, ok := strings.Cut(action, "_")
+	if !ok {
+		return nil, fmt.Errorf("unknown action %q", action)
+	}
+	switch verb {
+	case "create":
+		verb = verbCreate
+	case "read":
+		verb = verbRead
+	case "update":
+		verb = verbUpdate
+	case "delete":
+		verb = verbDelete
+	default:
+		return nil, fmt.Errorf("unknown action %q", action)
+	}
+
+	switch domain {
+	case "collections":
+		return []policy{{verb, collectionsResource(collection)}}, nil
+	case "tenants":
+		return []policy{{verb, shardsResource(collection, tenant)}}, nil
+	case "objects_collection":
+		return objectsPolicies(verb, collection, all, object), nil
+	case "objects_tenant":
+		return objectsPolicies(verb, collection, tenant, object), nil
+	default:
+		return nil, fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// objectsPolicies allows verb on the objects themselves. Batch operations and
+// listings are authorized against the shards holding the objects, reads also
+// require access to the collection definition to be able to query it. As
+// every write verb allows to update the shards, batch deletes additionally
+// require the delete verb on the objects.
+func objectsPolicies(verb, collection, tenant, object string) []policy {
+	policies := []policy{{verb, objectsResource(collection, tenant, object)}}
+	if verb == verbRead {
+		return append(policies,
+			policy{verbRead, shardsResource(collection, tenant)},
+			policy{verbRead, collectionsResource(collection)},
+		)
+	}
+	return append(policies, policy{verbUpdate, shardsResource(collection, tenant)})
+}
+
+func forVerbs(verbs []string, resource string) []policy {
+	policies := make([]policy, len(verbs))
+	for i, verb := range verbs {
+		policies[i] = policy{verb, resource}
+	}
+	return policies
+}
+
+func rolesResource(role string) string {
+	return fmt.Sprintf("roles/%s", role)
+}
+
+func collectionsResource(collection string) string {
+	return fmt.Sprintf("collections/%s", collection)
+}
+
+func shardsResource(collection, shard string) string {
+	return fmt.Sprintf("collection/%s/shards/%s", collection, shard)
+}
+
+func objectsResource(collection, shard, object string) string {
+	return fmt.Sprintf("collections/%s/shards/%s/objects/%s", collection, shard, object)
+}
+
+func valueOrAll(s *string) string {
+	if s == nil || *s == "" {
+		return all
+	}
+	return *s
+}
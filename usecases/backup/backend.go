@@ -151,6 +151,12 @@ func (s *nodeStore) Meta(ctx context.Context, backupID, overrideBucket, override
 	return &result, err
 }
 
+// sibling returns the store of this node's part of another backup
+func (s *nodeStore) sibling(backupID string) nodeStore {
+	node := path.Base(s.backupId) // backupId is "<id>/<node>"
+	return nodeStore{objectStore{backend: s.backend, backupId: backupID + "/" + node, bucket: s.bucket, path: s.path}}
+}
+
 // meta marshals and uploads metadata
 func (s *nodeStore) PutMeta(ctx context.Context, desc *backup.BackupDescriptor, overrideBucket, overridePath string) error {
 	return s.putMeta(ctx, BackupFile, overrideBucket, overridePath, desc)
@@ -160,6 +166,11 @@ type coordStore struct {
 	objectStore
 }
 
+// sibling returns the store of another backup on the same backend
+func (s *coordStore) sibling(backupID string) coordStore {
+	return coordStore{objectStore{backend: s.backend, backupId: backupID, bucket: s.bucket, path: s.path}}
+}
+
 // PutMeta puts coordinator's global metadata into object store
 func (s *coordStore) PutMeta(ctx context.Context, filename string, desc *backup.DistributedBackupDescriptor, overrideBucket, overridePath string) error {
 	return s.putMeta(ctx, filename, overrideBucket, overridePath, desc)
@@ -186,6 +197,8 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	// base maps reusable files of the base backup to their metadata
+	base map[string]backup.FileInfo
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -201,6 +214,7 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		}),
 		setstatus,
 		l,
+		nil,
 	}
 }
 
@@ -209,6 +223,12 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+// withBase makes the uploader skip files which are already stored in base
+func (u *uploader) withBase(base map[string]backup.FileInfo) *uploader {
+	u.base = base
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor, overrideBucket, overridePath string) (err error) {
 	u.setStatus(backup.Transferring)
@@ -415,6 +435,9 @@ func (u *uploader) compress(ctx context.Context,
 			if err := ctx.Err(); err != nil {
 				return err
 			}
+			if err := u.reuseBaseFiles(shard); err != nil {
+				return err
+			}
 			if _, err := zip.WriteShard(ctx, shard); err != nil {
 				return err
 			}
@@ -492,6 +515,11 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, o
 	if err := fw.writeTempFiles(ctx, classTempDir, overrideBucket, overridePath, desc); err != nil {
 		return fmt.Errorf("get files: %w", err)
 	}
+	if fw.compressed {
+		if err := fw.writeBaseFiles(ctx, classTempDir, desc, overrideBucket, overridePath); err != nil {
+			return fmt.Errorf("get files of base backups: %w", err)
+		}
+	}
 
 	if fw.migrator != nil {
 		if err := fw.migrator(classTempDir); err != nil {
@@ -57,7 +57,7 @@ func (b *backupper) Backup(ctx context.Context,
 		Bucket:  overrideBucket,
 		Path:    overridePath,
 	}
-	if _, err := b.backup(store, &req); err != nil {
+	if _, err := b.backup(ctx, store, &req); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
@@ -126,7 +126,7 @@ func (b *backupper) OnStatus(ctx context.Context, req *StatusRequest) (reqState,
 // Moreover it starts a goroutine in the background which waits for the
 // next instruction from the coordinator (second phase).
 // It will start the backup as soon as it receives an ack, or abort otherwise
-func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, error) {
+func (b *backupper) backup(ctx context.Context, store nodeStore, req *Request) (CanCommitResponse, error) {
 	id := req.ID
 	expiration := req.Duration
 	if expiration > _TimeoutShardCommit {
@@ -138,6 +138,14 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		Timeout: expiration,
 	}
 
+	var base map[string]backup.FileInfo
+	if req.BaseID != "" {
+		var err error
+		if base, err = baseFiles(ctx, store, req.BaseID); err != nil {
+			return ret, err
+		}
+	}
+
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir(req.Bucket, req.Path), req.Bucket, req.Path); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -154,7 +162,8 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger).
-			withCompression(newZipConfig(req.Compression))
+			withCompression(newZipConfig(req.Compression)).
+			withBase(base)
 
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
@@ -162,6 +171,7 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseID:        req.BaseID,
 		}
 
 		// the coordinator might want to abort the backup
@@ -177,6 +177,8 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
+		BaseID:        req.BaseID,
+		Dependencies:  req.dependencies,
 	}
 
 	for key := range c.Participants {
@@ -397,6 +399,7 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 					Compression: req.Compression,
 					Bucket:      req.Bucket,
 					Path:        req.Path,
+					BaseID:      req.BaseID,
 				},
 			}
 		}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, expr := range []string{
+		"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *",
+		"* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *",
+	} {
+		_, err := parseCron(expr)
+		assert.Error(t, err, expr)
+	}
+	for _, expr := range []string{
+		"* * * * *", "0 2 * * *", "*/15 1-5 * * mon-fri", "0 0 1,15 jan-jun *", "5/20 * * * 7", "@daily",
+	} {
+		_, err := parseCron(expr)
+		assert.NoError(t, err, expr)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// Thursday
+	from := time.Date(2024, time.February, 29, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.February, 29, 10, 18, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2024, time.March, 1, 2, 0, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2024, time.February, 29, 10, 20, 0, 0, time.UTC)},
+		{"5/20 10 * * *", time.Date(2024, time.February, 29, 10, 25, 0, 0, time.UTC)},
+		{"30 3 * * sun", time.Date(2024, time.March, 3, 3, 30, 0, 0, time.UTC)},
+		{"30 3 * * 7", time.Date(2024, time.March, 3, 3, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// day of month or day of week if both are restricted
+		{"0 0 15 * fri", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.February, 29, 11, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := parseCron(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, c.next(from))
+		})
+	}
+
+	t.Run("never", func(t *testing.T) {
+		c, err := parseCron("0 0 31 2 *")
+		require.NoError(t, err)
+		assert.True(t, c.next(from).IsZero())
+	})
+}
@@ -142,6 +142,10 @@ type BackupRequest struct {
 
 	// Override path (optional) - replaces environement variable for one call
 	Path string
+
+	// BaseID (optional) is the id of a successful backup on the same backend.
+	// Immutable files which did not change since then are not uploaded again.
+	BaseID string
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
@@ -176,7 +180,7 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 			ret.Err = fmt.Sprintf("init uploader: %v", err)
 			return ret
 		}
-		res, err := m.backupper.backup(store, req)
+		res, err := m.backupper.backup(ctx, store, req)
 		if err != nil {
 			ret.Err = err.Error()
 			return ret
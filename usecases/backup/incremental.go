@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// reusableFile reports whether the file at relPath never changes once it
+// has been written. Only such files can be shared by incremental backups.
+func reusableFile(relPath string) bool {
+	name := filepath.Base(relPath)
+	if strings.HasPrefix(name, "segment-") {
+		// the write-ahead log of a memtable keeps growing
+		return !strings.HasSuffix(name, ".wal") && !strings.HasSuffix(name, ".tmp")
+	}
+	return strings.HasSuffix(filepath.Dir(relPath), ".hnsw.commitlog.d")
+}
+
+// baseFiles returns the reusable files recorded in this node's part of the
+// base backup. The returned infos point to the backup storing each file.
+func baseFiles(ctx context.Context, store nodeStore, baseID string) (map[string]backup.FileInfo, error) {
+	base := store.sibling(baseID)
+	meta, err := base.Meta(ctx, baseID, store.bucket, store.path, false)
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return nil, nil // this node did not take part in the base backup
+		}
+		return nil, fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	files := make(map[string]backup.FileInfo, 1024)
+	for _, class := range meta.Classes {
+		for _, shard := range class.Shards {
+			for relPath, info := range shard.FileInfos {
+				if info.BackupID == "" {
+					info.BackupID = baseID
+				}
+				files[relPath] = info
+			}
+		}
+	}
+	return files, nil
+}
+
+// reuseBaseFiles records the metadata of reusable shard files and removes
+// files which did not change since the base backup from sd.Files
+func (u *uploader) reuseBaseFiles(sd *backup.ShardDescriptor) error {
+	files := make([]string, 0, len(sd.Files))
+	for _, relPath := range sd.Files {
+		if !reusableFile(relPath) {
+			files = append(files, relPath)
+			continue
+		}
+		stat, err := os.Stat(filepath.Join(u.backend.SourceDataPath(), relPath))
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", relPath, err)
+		}
+		info := backup.FileInfo{Size: stat.Size(), ModTime: stat.ModTime().UTC()}
+		if base, ok := u.base[relPath]; ok && base.Size == info.Size && base.ModTime.Equal(info.ModTime) {
+			info.BackupID = base.BackupID
+		} else {
+			files = append(files, relPath)
+		}
+		if sd.FileInfos == nil {
+			sd.FileInfos = make(map[string]backup.FileInfo)
+		}
+		sd.FileInfos[relPath] = info
+	}
+	sd.Files = files
+	return nil
+}
+
+// writeBaseFiles fetches the files of an incremental backup which are
+// stored in the backups it depends on
+func (fw *fileWriter) writeBaseFiles(ctx context.Context, classTempDir string,
+	desc *backup.ClassDescriptor, overrideBucket, overridePath string,
+) error {
+	// backup id -> shard name -> relative paths
+	refs := make(map[string]map[string][]string)
+	for _, shard := range desc.Shards {
+		for relPath, info := range shard.FileInfos {
+			if info.BackupID == "" {
+				continue
+			}
+			if refs[info.BackupID] == nil {
+				refs[info.BackupID] = make(map[string][]string)
+			}
+			refs[info.BackupID][shard.Name] = append(refs[info.BackupID][shard.Name], relPath)
+		}
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(fw.logger, ctx)
+	eg.SetLimit(fw.GoPoolSize)
+	for id, shards := range refs {
+		store := fw.backend.sibling(id)
+		meta, err := store.Meta(ctx, id, overrideBucket, overridePath, false)
+		if err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		chunks, err := baseChunks(meta, desc.Name, shards)
+		if err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		for chunk, wanted := range chunks {
+			key := chunkKey(desc.Name, chunk)
+			eg.Go(func() error {
+				uz, w := NewUnzip(classTempDir)
+				uz.withFilter(func(name string) bool {
+					_, ok := wanted[name]
+					delete(wanted, name)
+					return ok
+				})
+				enterrors.GoWrapper(func() {
+					store.Read(ctx, key, overrideBucket, overridePath, w)
+				}, fw.logger)
+				if _, err := uz.ReadChunk(); err != nil {
+					return fmt.Errorf("base backup %q: %s: %w", id, key, err)
+				}
+				if len(wanted) > 0 {
+					return fmt.Errorf("base backup %q: %s: %d files are missing", id, key, len(wanted))
+				}
+				return nil
+			})
+		}
+	}
+	return eg.Wait()
+}
+
+// baseChunks groups the wanted files of shards by the chunk storing them
+func baseChunks(meta *backup.BackupDescriptor, class string, shards map[string][]string,
+) (map[int32]map[string]struct{}, error) {
+	var cdesc *backup.ClassDescriptor
+	for i := range meta.Classes {
+		if meta.Classes[i].Name == class {
+			cdesc = &meta.Classes[i]
+			break
+		}
+	}
+	if cdesc == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	chunks := make(map[int32]map[string]struct{})
+	for _, shard := range cdesc.Shards {
+		paths, ok := shards[shard.Name]
+		if !ok {
+			continue
+		}
+		if chunks[shard.Chunk] == nil {
+			chunks[shard.Chunk] = make(map[string]struct{}, len(paths))
+		}
+		for _, p := range paths {
+			chunks[shard.Chunk][p] = struct{}{}
+		}
+		delete(shards, shard.Name)
+	}
+	for name := range shards {
+		return nil, fmt.Errorf("shard %q of class %q not found", name, class)
+	}
+	return chunks, nil
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestReusableFile(t *testing.T) {
+	assert.True(t, reusableFile("c1/s1/lsm/objects/segment-1700000000.db"))
+	assert.True(t, reusableFile("c1/s1/lsm/objects/segment-1700000000.bloom"))
+	assert.True(t, reusableFile("c1/s1/main.hnsw.commitlog.d/1700000000"))
+	assert.True(t, reusableFile("c1/s1/main.hnsw.commitlog.d/1700000000.condensed"))
+	assert.False(t, reusableFile("c1/s1/lsm/objects/segment-1700000000.wal"))
+	assert.False(t, reusableFile("c1/s1/indexcount"))
+	assert.False(t, reusableFile("c1/s1/proplengths"))
+}
+
+func TestUploaderReuseBaseFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(relPath, content string) os.FileInfo {
+		path := filepath.Join(dir, relPath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), os.ModePerm))
+		stat, err := os.Stat(path)
+		require.NoError(t, err)
+		return stat
+	}
+	var (
+		unchanged = "c1/s1/lsm/objects/segment-1.db"
+		modified  = "c1/s1/main.hnsw.commitlog.d/1"
+		added     = "c1/s1/lsm/objects/segment-2.db"
+		mutable   = "c1/s1/indexcount"
+	)
+	unchangedStat := write(unchanged, "unchanged")
+	write(modified, "modified")
+	write(added, "added")
+	write(mutable, "1")
+
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dir)
+	u := newUploader(nil, nodeStore{objectStore{backend: backend}}, "b2", nil, nil).
+		withBase(map[string]backup.FileInfo{
+			unchanged: {Size: unchangedStat.Size(), ModTime: unchangedStat.ModTime(), BackupID: "b0"},
+			modified:  {Size: 3, ModTime: time.Now(), BackupID: "b1"},
+		})
+
+	sd := &backup.ShardDescriptor{Name: "s1", Files: []string{unchanged, modified, added, mutable}}
+	require.NoError(t, u.reuseBaseFiles(sd))
+
+	assert.Equal(t, []string{modified, added, mutable}, sd.Files)
+	require.Len(t, sd.FileInfos, 3)
+	assert.Equal(t, "b0", sd.FileInfos[unchanged].BackupID)
+	assert.Equal(t, "", sd.FileInfos[modified].BackupID)
+	assert.Equal(t, int64(5), sd.FileInfos[added].Size)
+}
+
+func TestBaseChunks(t *testing.T) {
+	meta := &backup.BackupDescriptor{Classes: []backup.ClassDescriptor{{
+		Name: "C1",
+		Shards: []*backup.ShardDescriptor{
+			{Name: "s1", Chunk: 1}, {Name: "s2", Chunk: 1}, {Name: "s3", Chunk: 2},
+		},
+	}}}
+
+	chunks, err := baseChunks(meta, "C1", map[string][]string{
+		"s1": {"a"}, "s2": {"b"}, "s3": {"c", "d"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]map[string]struct{}{
+		1: {"a": {}, "b": {}},
+		2: {"c": {}, "d": {}},
+	}, chunks)
+
+	_, err = baseChunks(meta, "C2", map[string][]string{"s1": {"a"}})
+	assert.ErrorContains(t, err, `class "C2" not found`)
+	_, err = baseChunks(meta, "C1", map[string][]string{"s4": {"a"}})
+	assert.ErrorContains(t, err, `shard "s4" of class "C1" not found`)
+}
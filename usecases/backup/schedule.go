@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// scheduledIDPrefix identifies backups created by a Schedule
+const scheduledIDPrefix = "scheduled-"
+
+// Schedule periodically creates backups as configured by a cron expression.
+// Only the cluster leader takes backups. Up to IncrementalLimit consecutive
+// backups are incremental, each based on the previous one. Once a backup
+// has been started, scheduled backups beyond the retention are deleted,
+// unless they are still referenced by a retained backup.
+type Schedule struct {
+	scheduler *Scheduler
+	cron      cronSchedule
+	config    config.BackupSchedule
+	logger    logrus.FieldLogger
+	now       func() time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewSchedule validates cfg and returns a schedule which is ready to start
+func NewSchedule(scheduler *Scheduler, cfg config.BackupSchedule, logger logrus.FieldLogger) (*Schedule, error) {
+	cron, err := parseCron(cfg.Cron)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := scheduler.backends.BackupBackend(cfg.Backend); err != nil {
+		return nil, fmt.Errorf("backup schedule: backend %q: %w", cfg.Backend, err)
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = config.DefaultBackupScheduleRetention
+	}
+	return &Schedule{
+		scheduler: scheduler,
+		cron:      cron,
+		config:    cfg,
+		logger:    logger.WithField("action", "backup_schedule"),
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}, nil
+}
+
+// Start runs the schedule in the background until Close is called
+func (s *Schedule) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	enterrors.GoWrapper(func() {
+		defer close(s.done)
+		for {
+			next := s.cron.next(s.now())
+			if next.IsZero() {
+				s.logger.Warn("cron expression never matches, stopping schedule")
+				return
+			}
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			if !s.isLeader() {
+				continue
+			}
+			if err := s.run(ctx, next); err != nil {
+				s.logger.Error(err)
+			}
+		}
+	}, s.logger)
+}
+
+// Close stops the schedule, a backup which has already been started is not
+// affected
+func (s *Schedule) Close() {
+	s.once.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+			<-s.done
+		}
+	})
+}
+
+func (s *Schedule) isLeader() bool {
+	c := s.scheduler.backupper
+	return c.nodeResolver.LeaderID() == c.schema.NodeName()
+}
+
+// run starts a scheduled backup and enforces the retention afterwards
+func (s *Schedule) run(ctx context.Context, at time.Time) error {
+	backend, err := s.scheduler.backends.BackupBackend(s.config.Backend)
+	if err != nil {
+		return fmt.Errorf("backend %q: %w", s.config.Backend, err)
+	}
+	all, err := backend.AllBackups(ctx)
+	if err != nil {
+		return fmt.Errorf("list backups: %w", err)
+	}
+	scheduled := scheduledBackups(all)
+
+	req := &BackupRequest{
+		ID:      scheduledID(at),
+		Backend: s.config.Backend,
+		BaseID:  nextBaseID(scheduled, s.config.IncrementalLimit),
+		Compression: Compression{
+			Level:         DefaultCompression,
+			CPUPercentage: DefaultCPUPercentage,
+			ChunkSize:     DefaultChunkSize,
+		},
+	}
+	if _, err := s.scheduler.backup(ctx, req); err != nil {
+		return fmt.Errorf("start backup %q: %w", req.ID, err)
+	}
+	s.logger.WithField("backup_id", req.ID).WithField("base_id", req.BaseID).
+		Info("scheduled backup started")
+
+	deleter, ok := backend.(modulecapabilities.BackupDeleter)
+	if !ok {
+		s.logger.WithField("backend", s.config.Backend).
+			Warn("backend cannot delete backups, retention is not enforced")
+		return nil
+	}
+	for _, id := range expiredBackups(scheduled, s.config.Retention) {
+		if err := deleter.DeleteBackup(ctx, id, "", ""); err != nil {
+			return fmt.Errorf("delete expired backup %q: %w", id, err)
+		}
+		s.logger.WithField("backup_id", id).Info("expired scheduled backup deleted")
+	}
+	return nil
+}
+
+func scheduledID(at time.Time) string {
+	return scheduledIDPrefix + at.UTC().Format("20060102-150405")
+}
+
+// scheduledBackups returns scheduled backups sorted from oldest to newest
+func scheduledBackups(all []*backup.DistributedBackupDescriptor) []*backup.DistributedBackupDescriptor {
+	scheduled := make([]*backup.DistributedBackupDescriptor, 0, len(all))
+	for _, d := range all {
+		if strings.HasPrefix(d.ID, scheduledIDPrefix) {
+			scheduled = append(scheduled, d)
+		}
+	}
+	sort.Slice(scheduled, func(i, j int) bool {
+		return scheduled[i].StartedAt.Before(scheduled[j].StartedAt)
+	})
+	return scheduled
+}
+
+// nextBaseID returns the base for the next backup or "" if a full backup is
+// due because there is no successful backup yet or the limit of consecutive
+// incremental backups has been reached
+func nextBaseID(scheduled []*backup.DistributedBackupDescriptor, incrementalLimit int) string {
+	if incrementalLimit <= 0 {
+		return ""
+	}
+	var latest *backup.DistributedBackupDescriptor
+	incremental := 0
+	for i := len(scheduled) - 1; i >= 0; i-- {
+		d := scheduled[i]
+		if d.Status != backup.Success {
+			continue
+		}
+		if latest == nil {
+			latest = d
+		}
+		if d.BaseID == "" {
+			break
+		}
+		incremental++
+	}
+	if latest == nil || incremental >= incrementalLimit {
+		return ""
+	}
+	return latest.ID
+}
+
+// expiredBackups returns the ids of all finished backups which are neither
+// among the newest retention successful ones nor a dependency thereof
+func expiredBackups(scheduled []*backup.DistributedBackupDescriptor, retention int) []string {
+	keep := make(map[string]struct{}, 2*retention)
+	retained := 0
+	for i := len(scheduled) - 1; i >= 0 && retained < retention; i-- {
+		if d := scheduled[i]; d.Status == backup.Success {
+			retained++
+			keep[d.ID] = struct{}{}
+			for _, id := range d.Dependencies {
+				keep[id] = struct{}{}
+			}
+		}
+	}
+	var expired []string
+	for _, d := range scheduled {
+		if _, ok := keep[d.ID]; ok || backupNotCompleted(d.Status) {
+			continue
+		}
+		expired = append(expired, d.ID)
+	}
+	return expired
+}
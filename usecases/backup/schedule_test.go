@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestScheduledBackups(t *testing.T) {
+	now := time.Now()
+	all := []*backup.DistributedBackupDescriptor{
+		{ID: "scheduled-2", StartedAt: now.Add(-time.Hour)},
+		{ID: "manual", StartedAt: now.Add(-3 * time.Hour)},
+		{ID: "scheduled-1", StartedAt: now.Add(-2 * time.Hour)},
+	}
+	scheduled := scheduledBackups(all)
+	assert.Len(t, scheduled, 2)
+	assert.Equal(t, "scheduled-1", scheduled[0].ID)
+	assert.Equal(t, "scheduled-2", scheduled[1].ID)
+	assert.Equal(t, "scheduled-20240229-020000", scheduledID(time.Date(2024, time.February, 29, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestNextBaseID(t *testing.T) {
+	full := func(id string) *backup.DistributedBackupDescriptor {
+		return &backup.DistributedBackupDescriptor{ID: id, Status: backup.Success}
+	}
+	incr := func(id, base string) *backup.DistributedBackupDescriptor {
+		return &backup.DistributedBackupDescriptor{ID: id, Status: backup.Success, BaseID: base}
+	}
+	failed := &backup.DistributedBackupDescriptor{ID: "failed", Status: backup.Failed}
+
+	assert.Equal(t, "", nextBaseID(nil, 3), "no backups")
+	assert.Equal(t, "", nextBaseID([]*backup.DistributedBackupDescriptor{failed}, 3), "no successful backup")
+	assert.Equal(t, "", nextBaseID([]*backup.DistributedBackupDescriptor{full("a")}, 0), "incremental disabled")
+	assert.Equal(t, "a", nextBaseID([]*backup.DistributedBackupDescriptor{full("a"), failed}, 3))
+	assert.Equal(t, "c", nextBaseID([]*backup.DistributedBackupDescriptor{
+		full("a"), incr("b", "a"), incr("c", "b"),
+	}, 3))
+	assert.Equal(t, "", nextBaseID([]*backup.DistributedBackupDescriptor{
+		full("a"), incr("b", "a"), incr("c", "b"), incr("d", "c"),
+	}, 3), "limit reached")
+}
+
+func TestExpiredBackups(t *testing.T) {
+	scheduled := []*backup.DistributedBackupDescriptor{
+		{ID: "a", Status: backup.Success},
+		{ID: "b", Status: backup.Success, BaseID: "a", Dependencies: []string{"a"}},
+		{ID: "c", Status: backup.Success},
+		{ID: "d", Status: backup.Failed},
+		{ID: "e", Status: backup.Success, BaseID: "c", Dependencies: []string{"c"}},
+		{ID: "f", Status: backup.Success, BaseID: "e", Dependencies: []string{"e", "c"}},
+		{ID: "g", Status: backup.Transferring},
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e", "f"}, expiredBackups(append(scheduled,
+		&backup.DistributedBackupDescriptor{ID: "h", Status: backup.Success}), 1))
+	assert.ElementsMatch(t, []string{"a", "b", "d"}, expiredBackups(scheduled, 2))
+	// e and c are still referenced by f
+	assert.ElementsMatch(t, []string{"a", "b", "d"}, expiredBackups(scheduled, 1))
+	assert.ElementsMatch(t, []string{"d"}, expiredBackups(scheduled, 10))
+}
@@ -116,6 +116,11 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, authorization.CREATE, authorization.Cluster()); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup starts a backup on behalf of an already authorized caller
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID, req.Bucket, req.Path)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -126,6 +131,10 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	dependencies, err := s.validateBaseBackup(ctx, store, req)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
 
 	if err := store.Initialize(ctx, req.Bucket, req.Path); err != nil {
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
@@ -138,6 +147,9 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		Compression: req.Compression,
 		Bucket:      req.Bucket,
 		Path:        req.Path,
+
+		BaseID:       req.BaseID,
+		dependencies: dependencies,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -380,6 +392,38 @@ func (s *Scheduler) checkIfBackupExists(ctx context.Context, store coordStore, r
 	return nil
 }
 
+// validateBaseBackup makes sure the base of an incremental backup exists and
+// returns the backups the new backup may reference files from
+func (s *Scheduler) validateBaseBackup(ctx context.Context, store coordStore, req *BackupRequest) ([]string, error) {
+	if req.BaseID == "" {
+		return nil, nil
+	}
+	if err := validateID(req.BaseID); err != nil {
+		return nil, fmt.Errorf("base: %w", err)
+	}
+	if req.BaseID == req.ID {
+		return nil, fmt.Errorf("backup %q cannot be its own base", req.ID)
+	}
+	meta, err := successfulBackup(ctx, store.sibling(req.BaseID), req)
+	if err != nil {
+		return nil, fmt.Errorf("incremental backup: %w", err)
+	}
+	return append([]string{meta.ID}, meta.Dependencies...), nil
+}
+
+// successfulBackup returns the meta data of the backup stored in store
+// or an error if it does not exist or did not succeed
+func successfulBackup(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
+	meta, err := store.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("base backup %q at %q: %w", store.backupId, store.HomeDir(req.Bucket, req.Path), err)
+	}
+	if meta.Status != backup.Success {
+		return nil, fmt.Errorf("base backup %q has status %s", store.backupId, meta.Status)
+	}
+	return meta, nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.backend.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -408,6 +452,11 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if err := meta.Validate(); err != nil {
 		return nil, fmt.Errorf("corrupted backup file: %w", err)
 	}
+	for _, id := range meta.Dependencies {
+		if _, err := successfulBackup(ctx, store.sibling(id), req); err != nil {
+			return nil, fmt.Errorf("incremental backup depends on %w", err)
+		}
+	}
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
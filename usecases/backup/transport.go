@@ -54,6 +54,12 @@ type Request struct {
 
 	// Additional path prefix override
 	Path string
+
+	// BaseID is the id of the base backup of an incremental backup
+	BaseID string
+
+	// dependencies of an incremental backup as resolved by the scheduler
+	dependencies []string
 }
 
 type CanCommitResponse struct {
@@ -179,6 +179,7 @@ type unzip struct {
 	gzr        *gzip.Reader
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+	filter     func(name string) bool
 }
 
 func NewUnzip(dst string) (unzip, io.WriteCloser) {
@@ -189,6 +190,11 @@ func NewUnzip(dst string) (unzip, io.WriteCloser) {
 	}, pw
 }
 
+// withFilter restricts ReadChunk to regular files for which keep returns true
+func (u *unzip) withFilter(keep func(name string) bool) {
+	u.filter = keep
+}
+
 func (u *unzip) init() error {
 	if u.gzr != nil {
 		return nil
@@ -242,6 +248,9 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			if u.filter != nil && !u.filter(header.Name) {
+				continue
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
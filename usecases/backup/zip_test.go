@@ -158,6 +158,52 @@ func TestZipConfig(t *testing.T) {
 	}
 }
 
+func TestUnzipWithFilter(t *testing.T) {
+	var (
+		pathNode = "test_data/node1"
+		pathDest = t.TempDir()
+		ctx      = context.Background()
+	)
+	sd, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sd.Files) == 0 {
+		t.Fatal("shard has no files")
+	}
+	wanted := sd.Files[0]
+
+	z, rc := NewZip(pathNode, 0)
+	go func() {
+		if _, err := z.WriteShard(ctx, &sd); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+
+	uz, wc := NewUnzip(pathDest)
+	uz.withFilter(func(name string) bool { return name == wanted })
+	go func() {
+		io.Copy(wc, rc)
+		wc.Close()
+	}()
+	if _, err := uz.ReadChunk(); err != nil {
+		t.Fatalf("unzip: %v", err)
+	}
+	uz.Close()
+
+	var extracted []string
+	filepath.Walk(pathDest, func(fPath string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			extracted = append(extracted, strings.TrimPrefix(fPath, pathDest+string(filepath.Separator)))
+		}
+		return nil
+	})
+	if len(extracted) != 1 || extracted[0] != wanted {
+		t.Errorf("extracted files got=%v want=[%s]", extracted, wanted)
+	}
+}
+
 func getShard(src, shardName string) (sd backup.ShardDescriptor, err error) {
 	sd.Name = shardName
 	err = filepath.Walk(src, func(fPath string, fi os.FileInfo, err error) error {
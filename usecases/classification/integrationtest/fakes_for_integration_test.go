@@ -28,6 +28,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -485,6 +486,18 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) ReadChanges(ctx context.Context, hostName, indexName, shardName string,
+	after uint64, limit int, withObjects bool,
+) ([]changefeed.Change, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) OpenExportObjects(ctx context.Context, hostName, indexName, shardName,
+	exportID string,
+) (sharding.ObjectStream, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) DigestObjects(ctx context.Context,
 	hostName, indexName, shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
@@ -15,17 +15,29 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 )
 
 // Authorization configuration
 type Authorization struct {
 	AdminList adminlist.Config `json:"admin_list" yaml:"admin_list"`
+	Rbac      rbac.Config      `json:"rbac" yaml:"rbac"`
 }
 
 // Validate the Authorization configuration. This only validates at a general
 // level. Validation specific to the individual auth methods should happen
 // inside their respective packages
 func (a Authorization) Validate() error {
+	if a.AdminList.Enabled && a.Rbac.Enabled {
+		return fmt.Errorf("authorization: admin list and rbac cannot be enabled at the same time")
+	}
+
+	if a.Rbac.Enabled {
+		if err := a.Rbac.Validate(); err != nil {
+			return fmt.Errorf("authorization: %s", err)
+		}
+	}
+
 	if a.AdminList.Enabled {
 		if err := a.AdminList.Validate(); err != nil {
 			return fmt.Errorf("authorization: %s", err)
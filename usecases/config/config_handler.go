@@ -90,6 +90,7 @@ type Config struct {
 	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
 	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
 	QueryCrossReferenceDepthLimit       int                      `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
+	QueryTermExpansionScanLimit         int                      `json:"query_term_expansion_scan_limit" yaml:"query_term_expansion_scan_limit"`
 	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
 	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
 	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
@@ -124,6 +125,9 @@ type Config struct {
 	HNSWStartupWaitForVectorCache       bool                     `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
 	HNSWVisitedListPoolMaxSize          int                      `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
 	HNSWFlatSearchConcurrency           int                      `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
+	ObjectsTTLDeleteIntervalSeconds     int                      `json:"objects_ttl_delete_interval_seconds" yaml:"objects_ttl_delete_interval_seconds"`
+	BackupSchedule                      BackupSchedule           `json:"backup_schedule" yaml:"backup_schedule"`
+	VectorRecallMonitoring              VectorRecallMonitoring   `json:"vector_recall_monitoring" yaml:"vector_recall_monitoring"`
 	Sentry                              *entsentry.ConfigOpts    `json:"sentry" yaml:"sentry"`
 	MetadataServer                      MetadataServer           `json:"metadata_server" yaml:"metadata_server"`
 
@@ -209,6 +213,12 @@ type GRPC struct {
 	CertFile   string `json:"certFile" yaml:"certFile"`
 	KeyFile    string `json:"keyFile" yaml:"keyFile"`
 	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
+	// BatchStreamMaxBatchSize is the largest batch size offered to BatchStream
+	// clients when the node is idle
+	BatchStreamMaxBatchSize int `json:"batchStreamMaxBatchSize" yaml:"batchStreamMaxBatchSize"`
+	// BatchStreamMaxQueueSize is the number of vectors waiting in the index
+	// queues at which BatchStream clients are asked to pause
+	BatchStreamMaxQueueSize int `json:"batchStreamMaxQueueSize" yaml:"batchStreamMaxQueueSize"`
 }
 
 type Profiling struct {
@@ -228,6 +238,10 @@ type Persistence struct {
 	LSMSegmentsCleanupIntervalSeconds int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
 	LSMSeparateObjectsCompactions     bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
 	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	// ChangeFeedEnabled records object changes of every shard in a resumable
+	// change feed that can be consumed over gRPC
+	ChangeFeedEnabled   bool          `json:"changeFeedEnabled" yaml:"changeFeedEnabled"`
+	ChangeFeedRetention time.Duration `json:"changeFeedRetention" yaml:"changeFeedRetention"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -244,6 +258,8 @@ const DefaultPersistenceLSMSegmentsCleanupIntervalSeconds = 0
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+const DefaultPersistenceChangeFeedRetention = 24 * time.Hour
+
 // MetadataServer is experimental.
 type MetadataServer struct {
 	// When enabled startup will include a "metadata server"
@@ -262,6 +278,44 @@ const DefaultHNSWVisitedListPoolSize = -1 // unlimited for backward compatibilit
 
 const DefaultHNSWFlatSearchConcurrency = 1 // 1 for backward compatibility
 
+const DefaultObjectsTTLDeleteIntervalSeconds = 60
+
+const (
+	DefaultBackupScheduleRetention        = 7
+	DefaultBackupScheduleIncrementalLimit = 6
+)
+
+// BackupSchedule configures backups which are triggered by the server itself
+type BackupSchedule struct {
+	// Cron expression, scheduled backups are disabled if it is empty
+	Cron    string `json:"cron" yaml:"cron"`
+	Backend string `json:"backend" yaml:"backend"`
+	// Retention is the number of successful scheduled backups to keep
+	Retention int `json:"retention" yaml:"retention"`
+	// IncrementalLimit is the number of consecutive incremental backups
+	// before a full backup is taken, 0 disables incremental backups
+	IncrementalLimit int `json:"incremental_limit" yaml:"incremental_limit"`
+}
+
+const (
+	DefaultVectorRecallMonitoringIntervalSeconds = 600
+	DefaultVectorRecallMonitoringSampleSize      = 10
+	DefaultVectorRecallMonitoringK               = 10
+)
+
+// VectorRecallMonitoring configures the background job which compares the
+// results of the vector indexes with a brute force search over the object
+// store and exports the measured recall as a metric
+type VectorRecallMonitoring struct {
+	Enabled         bool `json:"enabled" yaml:"enabled"`
+	IntervalSeconds int  `json:"interval_seconds" yaml:"interval_seconds"`
+	// SampleSize is the number of stored vectors used as queries per shard
+	// and target vector in every cycle
+	SampleSize int `json:"sample_size" yaml:"sample_size"`
+	// K is the number of nearest neighbors recall is measured at
+	K int `json:"k" yaml:"k"`
+}
+
 func (p Persistence) Validate() error {
 	if p.DataPath == "" {
 		return fmt.Errorf("persistence.dataPath must be set")
@@ -188,6 +188,14 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if entcfg.Enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.Rbac.Enabled = true
+
+		if adminsString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ADMINS"); ok {
+			config.Authorization.Rbac.Admins = strings.Split(adminsString, ",")
+		}
+	}
+
 	config.Profiling.Disabled = entcfg.Enabled(os.Getenv("GO_PROFILING_DISABLE"))
 
 	if !config.Authentication.AnyAuthMethodSelected() {
@@ -232,6 +240,23 @@ func FromEnv(config *Config) error {
 		config.Persistence.HNSWMaxLogSize = DefaultPersistenceHNSWMaxLogSize
 	}
 
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_CHANGE_FEED_ENABLED")) {
+		config.Persistence.ChangeFeedEnabled = true
+	}
+
+	if v := os.Getenv("PERSISTENCE_CHANGE_FEED_RETENTION"); v != "" {
+		retention, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_CHANGE_FEED_RETENTION as time.Duration: %w", err)
+		}
+		if retention < 0 {
+			return fmt.Errorf("PERSISTENCE_CHANGE_FEED_RETENTION must not be negative")
+		}
+		config.Persistence.ChangeFeedRetention = retention
+	} else {
+		config.Persistence.ChangeFeedRetention = DefaultPersistenceChangeFeedRetention
+	}
+
 	if err := parseInt(
 		"HNSW_VISITED_LIST_POOL_MAX_SIZE",
 		DefaultHNSWVisitedListPoolSize,
@@ -249,6 +274,22 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := parsePositiveInt(
+		"OBJECTS_TTL_DELETE_INTERVAL_SECONDS",
+		func(val int) { config.ObjectsTTLDeleteIntervalSeconds = val },
+		DefaultObjectsTTLDeleteIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := config.parseBackupScheduleConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseVectorRecallMonitoringConfig(); err != nil {
+		return err
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -323,6 +364,14 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := parsePositiveInt(
+		"QUERY_TERM_EXPANSION_SCAN_LIMIT",
+		func(val int) { config.QueryTermExpansionScanLimit = val },
+		DefaultQueryTermExpansionScanLimit,
+	); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("MAX_IMPORT_GOROUTINES_FACTOR"); v != "" {
 		asFloat, err := strconv.ParseFloat(v, 64)
 		if err != nil {
@@ -433,6 +482,20 @@ func FromEnv(config *Config) error {
 	); err != nil {
 		return err
 	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_STREAM_MAX_BATCH_SIZE",
+		func(val int) { config.GRPC.BatchStreamMaxBatchSize = val },
+		DefaultGRPCBatchStreamMaxBatchSize,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_STREAM_MAX_QUEUE_SIZE",
+		func(val int) { config.GRPC.BatchStreamMaxQueueSize = val },
+		DefaultGRPCBatchStreamMaxQueueSize,
+	); err != nil {
+		return err
+	}
 	config.GRPC.CertFile = ""
 	if v := os.Getenv("GRPC_CERT_FILE"); v != "" {
 		config.GRPC.CertFile = v
@@ -624,6 +687,54 @@ func (c *Config) parseCORSConfig() error {
 	return nil
 }
 
+func (c *Config) parseBackupScheduleConfig() error {
+	c.BackupSchedule.Cron = os.Getenv("BACKUP_SCHEDULE_CRON")
+	c.BackupSchedule.Backend = os.Getenv("BACKUP_SCHEDULE_BACKEND")
+	if c.BackupSchedule.Cron != "" && c.BackupSchedule.Backend == "" {
+		return fmt.Errorf("BACKUP_SCHEDULE_BACKEND must be set if BACKUP_SCHEDULE_CRON is set")
+	}
+
+	if err := parsePositiveInt(
+		"BACKUP_SCHEDULE_RETENTION",
+		func(val int) { c.BackupSchedule.Retention = val },
+		DefaultBackupScheduleRetention,
+	); err != nil {
+		return err
+	}
+
+	return parseNonNegativeInt(
+		"BACKUP_SCHEDULE_INCREMENTAL_LIMIT",
+		func(val int) { c.BackupSchedule.IncrementalLimit = val },
+		DefaultBackupScheduleIncrementalLimit,
+	)
+}
+
+func (c *Config) parseVectorRecallMonitoringConfig() error {
+	c.VectorRecallMonitoring.Enabled = entcfg.Enabled(os.Getenv("VECTOR_RECALL_MONITORING_ENABLED"))
+
+	if err := parsePositiveInt(
+		"VECTOR_RECALL_MONITORING_INTERVAL_SECONDS",
+		func(val int) { c.VectorRecallMonitoring.IntervalSeconds = val },
+		DefaultVectorRecallMonitoringIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"VECTOR_RECALL_MONITORING_SAMPLE_SIZE",
+		func(val int) { c.VectorRecallMonitoring.SampleSize = val },
+		DefaultVectorRecallMonitoringSampleSize,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"VECTOR_RECALL_MONITORING_K",
+		func(val int) { c.VectorRecallMonitoring.K = val },
+		DefaultVectorRecallMonitoringK,
+	)
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old idle name for flush value
 	if err := parsePositiveInt(
@@ -719,6 +830,9 @@ const (
 	DefaultQueryNestedCrossReferenceLimit = int64(100000)
 	// DefaultQueryCrossReferenceDepthLimit describes the max depth of nested crossrefs in a query
 	DefaultQueryCrossReferenceDepthLimit = 5
+	// DefaultQueryTermExpansionScanLimit describes the max number of terms of a
+	// property scanned for the fuzzy or prefix expansion of a query term
+	DefaultQueryTermExpansionScanLimit = 10000
 )
 
 const (
@@ -729,6 +843,8 @@ const (
 	DefaultMaxConcurrentGetRequests            = 0
 	DefaultGRPCPort                            = 50051
 	DefaultGRPCMaxMsgSize                      = 10 * 1024 * 1024
+	DefaultGRPCBatchStreamMaxBatchSize         = 1000
+	DefaultGRPCBatchStreamMaxQueueSize         = 1_000_000
 	DefaultMinimumReplicationFactor            = 1
 )
 
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -779,6 +780,112 @@ func TestEnvironmentHNSWMaxLogSize(t *testing.T) {
 	}
 }
 
+func TestEnvironmentChangeFeedRetention(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{"Valid", []string{"2h"}, 2 * time.Hour, false},
+		{"Valid: keep all", []string{"0s"}, 0, false},
+		{"not given", []string{}, DefaultPersistenceChangeFeedRetention, false},
+		{"negative", []string{"-1h"}, 0, true},
+		{"not parsable", []string{"one day"}, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_CHANGE_FEED_RETENTION", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.Persistence.ChangeFeedRetention)
+			}
+		})
+	}
+}
+
+func TestEnvironmentBackupSchedule(t *testing.T) {
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    BackupSchedule
+		expectedErr bool
+	}{
+		{"not given", nil, BackupSchedule{
+			Retention: DefaultBackupScheduleRetention, IncrementalLimit: DefaultBackupScheduleIncrementalLimit,
+		}, false},
+		{"Valid", map[string]string{
+			"BACKUP_SCHEDULE_CRON": "0 2 * * *", "BACKUP_SCHEDULE_BACKEND": "s3",
+			"BACKUP_SCHEDULE_RETENTION": "14", "BACKUP_SCHEDULE_INCREMENTAL_LIMIT": "0",
+		}, BackupSchedule{Cron: "0 2 * * *", Backend: "s3", Retention: 14, IncrementalLimit: 0}, false},
+		{"missing backend", map[string]string{"BACKUP_SCHEDULE_CRON": "0 2 * * *"}, BackupSchedule{}, true},
+		{"zero retention", map[string]string{"BACKUP_SCHEDULE_RETENTION": "0"}, BackupSchedule{}, true},
+		{"negative incremental limit", map[string]string{"BACKUP_SCHEDULE_INCREMENTAL_LIMIT": "-1"}, BackupSchedule{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.BackupSchedule)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVectorRecallMonitoring(t *testing.T) {
+	defaults := VectorRecallMonitoring{
+		IntervalSeconds: DefaultVectorRecallMonitoringIntervalSeconds,
+		SampleSize:      DefaultVectorRecallMonitoringSampleSize,
+		K:               DefaultVectorRecallMonitoringK,
+	}
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    VectorRecallMonitoring
+		expectedErr bool
+	}{
+		{"not given", nil, defaults, false},
+		{"Valid", map[string]string{
+			"VECTOR_RECALL_MONITORING_ENABLED": "true", "VECTOR_RECALL_MONITORING_INTERVAL_SECONDS": "60",
+			"VECTOR_RECALL_MONITORING_SAMPLE_SIZE": "20", "VECTOR_RECALL_MONITORING_K": "100",
+		}, VectorRecallMonitoring{Enabled: true, IntervalSeconds: 60, SampleSize: 20, K: 100}, false},
+		{"zero interval", map[string]string{"VECTOR_RECALL_MONITORING_INTERVAL_SECONDS": "0"}, VectorRecallMonitoring{}, true},
+		{"zero sample size", map[string]string{"VECTOR_RECALL_MONITORING_SAMPLE_SIZE": "0"}, VectorRecallMonitoring{}, true},
+		{"invalid k", map[string]string{"VECTOR_RECALL_MONITORING_K": "ten"}, VectorRecallMonitoring{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.VectorRecallMonitoring)
+			}
+		})
+	}
+}
+
 func TestEnvironmentHNSWWaitForPrefill(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -0,0 +1,425 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// MetaFile is the name of the file describing an export. It is stored next
+// to the exported data files.
+const MetaFile = "export_config.json"
+
+const metaTimeout = 20 * time.Minute
+
+var regExpID = regexp.MustCompile("^[a-z0-9_-]+$")
+
+// Source provides a point-in-time view of the objects of a collection
+type Source interface {
+	ExportObjects(ctx context.Context, exportID, class, tenant string,
+		fn func(shard string, obj *storobj.Object) error) error
+}
+
+// BackendProvider resolves the backup backends exports are written to
+type BackendProvider interface {
+	BackupBackend(backend string) (modulecapabilities.BackupBackend, error)
+}
+
+// Request to export a collection
+type Request struct {
+	// ID of the export, it is unique per backend
+	ID string
+	// Backend is the name of the backup backend module to write to
+	Backend string
+	Class   string
+	// Tenant limits the export to a single tenant of the collection
+	Tenant string
+	Format Format
+	// Bucket and Path override the default location of the backend
+	Bucket string
+	Path   string
+}
+
+// Descriptor describes an export and its progress
+type Descriptor struct {
+	ID          string            `json:"id"`
+	Backend     string            `json:"backend"`
+	Class       string            `json:"class"`
+	Tenant      string            `json:"tenant,omitempty"`
+	Format      Format            `json:"format"`
+	Path        string            `json:"path"`
+	Status      backup.Status     `json:"status"`
+	Error       string            `json:"error,omitempty"`
+	StartedAt   time.Time         `json:"startedAt"`
+	CompletedAt time.Time         `json:"completedAt,omitempty"`
+	Shards      []ShardDescriptor `json:"shards,omitempty"`
+}
+
+// ShardDescriptor describes the data file of an exported shard
+type ShardDescriptor struct {
+	Name string `json:"name"`
+	// Key of the data file relative to the export
+	Key     string `json:"key"`
+	Objects int64  `json:"objects"`
+}
+
+// Exporter writes point-in-time exports of collections through backup
+// backends. Exports run in the background, their progress is tracked in the
+// meta file of the export.
+type Exporter struct {
+	logger     logrus.FieldLogger
+	authorizer authorization.Authorizer
+	backends   BackendProvider
+	source     Source
+
+	sync.Mutex
+	// running exports by backend and id
+	running map[string]*Descriptor
+}
+
+func NewExporter(authorizer authorization.Authorizer, backends BackendProvider,
+	source Source, logger logrus.FieldLogger,
+) *Exporter {
+	return &Exporter{
+		logger:     logger,
+		authorizer: authorizer,
+		backends:   backends,
+		source:     source,
+		running:    make(map[string]*Descriptor),
+	}
+}
+
+// Export validates the request and starts the export in the background
+func (e *Exporter) Export(ctx context.Context, principal *models.Principal, req *Request,
+) (*Descriptor, error) {
+	req.Class = schema.UppercaseClassName(req.Class)
+	if err := e.authorizer.Authorize(principal, authorization.READ,
+		authorization.Objects(req.Class, req.Tenant, "")); err != nil {
+		return nil, err
+	}
+	if err := e.authorizer.Authorize(principal, authorization.CREATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+
+	if !regExpID.MatchString(req.ID) {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"invalid export id: '%v' allowed characters are lowercase, 0-9, _, -", req.ID))
+	}
+	if req.Format == "" {
+		req.Format = FormatJSONL
+	}
+	if req.Format != FormatJSONL && req.Format != FormatParquet {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("unknown format %q", req.Format))
+	}
+	backend, err := e.backends.BackupBackend(req.Backend)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"no backup backend %q: %w, did you enable the right module?", req.Backend, err))
+	}
+
+	desc := &Descriptor{
+		ID:        req.ID,
+		Backend:   req.Backend,
+		Class:     req.Class,
+		Tenant:    req.Tenant,
+		Format:    req.Format,
+		Path:      backend.HomeDir(req.ID, req.Bucket, req.Path),
+		Status:    backup.Started,
+		StartedAt: time.Now().UTC(),
+	}
+
+	// reserve the id, the backend is not accessed while holding the lock
+	key := req.Backend + "/" + req.ID
+	e.Lock()
+	if _, ok := e.running[key]; ok {
+		e.Unlock()
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("export %q is already running", req.ID))
+	}
+	e.running[key] = desc
+	e.Unlock()
+
+	if err := e.initBackend(ctx, backend, req, desc); err != nil {
+		e.Lock()
+		delete(e.running, key)
+		e.Unlock()
+		return nil, err
+	}
+	ret := *desc
+
+	enterrors.GoWrapper(func() {
+		err := e.export(context.Background(), backend, req, desc)
+		e.finish(key, backend, req, desc, err)
+	}, e.logger)
+
+	return &ret, nil
+}
+
+// initBackend makes sure the export does not exist yet and writes its
+// initial meta file
+func (e *Exporter) initBackend(ctx context.Context, backend modulecapabilities.BackupBackend,
+	req *Request, desc *Descriptor,
+) error {
+	if _, err := readMeta(ctx, backend, req.ID, req.Bucket, req.Path); err == nil {
+		return backup.NewErrUnprocessable(fmt.Errorf("export %q already exists", req.ID))
+	} else if !errors.As(err, &backup.ErrNotFound{}) {
+		return fmt.Errorf("check if export %q exists: %w", req.ID, err)
+	}
+	if err := backend.Initialize(ctx, req.ID, req.Bucket, req.Path); err != nil {
+		return backup.NewErrUnprocessable(fmt.Errorf("init backend: %w", err))
+	}
+	return putMeta(ctx, backend, req.ID, req.Bucket, req.Path, desc)
+}
+
+// Status returns the progress of a running or the result of a completed
+// export
+func (e *Exporter) Status(ctx context.Context, principal *models.Principal,
+	backendName, id, bucket, path string,
+) (*Descriptor, error) {
+	if err := e.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+
+	e.Lock()
+	if desc, ok := e.running[backendName+"/"+id]; ok {
+		ret := *desc
+		ret.Shards = append([]ShardDescriptor(nil), desc.Shards...)
+		e.Unlock()
+		return &ret, nil
+	}
+	e.Unlock()
+
+	backend, err := e.backends.BackupBackend(backendName)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"no backup backend %q: %w, did you enable the right module?", backendName, err))
+	}
+	desc, err := readMeta(ctx, backend, id, bucket, path)
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return nil, backup.NewErrNotFound(fmt.Errorf("export %q not found", id))
+		}
+		return nil, err
+	}
+	return desc, nil
+}
+
+// export writes one data file per shard
+func (e *Exporter) export(ctx context.Context, backend modulecapabilities.BackupBackend,
+	req *Request, desc *Descriptor,
+) (err error) {
+	var file *shardFile
+	defer func() {
+		if file != nil {
+			if cerr := file.close(); err == nil {
+				err = cerr
+			}
+			e.addShard(desc, file)
+		}
+	}()
+
+	return e.source.ExportObjects(ctx, req.ID, req.Class, req.Tenant,
+		func(shard string, obj *storobj.Object) error {
+			if file == nil || file.name != shard {
+				if file != nil {
+					err := file.close()
+					e.addShard(desc, file)
+					file = nil
+					if err != nil {
+						return err
+					}
+				}
+				key := path.Join(req.Class, shard+req.Format.Ext())
+				f, err := openShardFile(ctx, backend, req, shard, key, e.logger)
+				if err != nil {
+					return err
+				}
+				file = f
+				e.setStatus(desc, backup.Transferring)
+			}
+
+			r, err := NewRecord(obj)
+			if err != nil {
+				return err
+			}
+			return file.write(r)
+		})
+}
+
+func (e *Exporter) finish(key string, backend modulecapabilities.BackupBackend,
+	req *Request, desc *Descriptor, err error,
+) {
+	e.Lock()
+	desc.CompletedAt = time.Now().UTC()
+	desc.Status = backup.Success
+	if err != nil {
+		desc.Status = backup.Failed
+		desc.Error = err.Error()
+	}
+	meta := *desc
+	e.Unlock()
+
+	logOperation(e.logger, "export", req.ID, req.Backend, desc.StartedAt, err)
+	ctx, cancel := context.WithTimeout(context.Background(), metaTimeout)
+	defer cancel()
+	if err := putMeta(ctx, backend, req.ID, req.Bucket, req.Path, &meta); err != nil {
+		e.logger.WithField("action", "export").WithField("export_id", req.ID).Error(err)
+	}
+
+	e.Lock()
+	delete(e.running, key)
+	e.Unlock()
+}
+
+func (e *Exporter) setStatus(desc *Descriptor, status backup.Status) {
+	e.Lock()
+	defer e.Unlock()
+	desc.Status = status
+}
+
+func (e *Exporter) addShard(desc *Descriptor, file *shardFile) {
+	e.Lock()
+	defer e.Unlock()
+	desc.Shards = append(desc.Shards, ShardDescriptor{
+		Name:    file.name,
+		Key:     file.key,
+		Objects: file.objects,
+	})
+}
+
+// shardFile streams the records of a shard to the backend
+type shardFile struct {
+	name    string
+	key     string
+	objects int64
+	pw      *io.PipeWriter
+	w       recordWriter
+	done    chan error
+}
+
+func openShardFile(ctx context.Context, backend modulecapabilities.BackupBackend, req *Request,
+	shard, key string, logger logrus.FieldLogger,
+) (*shardFile, error) {
+	pr, pw := io.Pipe()
+	w, err := newRecordWriter(req.Format, pw)
+	if err != nil {
+		return nil, err
+	}
+	f := &shardFile{name: shard, key: key, pw: pw, w: w, done: make(chan error, 1)}
+	enterrors.GoWrapper(func() {
+		_, err := backend.Write(ctx, req.ID, key, req.Bucket, req.Path, pr)
+		// unblock the writer if the backend stopped reading early
+		pr.CloseWithError(err)
+		f.done <- err
+	}, logger)
+	return f, nil
+}
+
+func (f *shardFile) write(r Record) error {
+	if err := f.w.Write(r); err != nil {
+		return f.abort(fmt.Errorf("write object %s: %w", r.ID, err))
+	}
+	f.objects++
+	return nil
+}
+
+func (f *shardFile) abort(err error) error {
+	f.pw.CloseWithError(err)
+	if werr := <-f.done; werr != nil {
+		return fmt.Errorf("upload %q: %w", f.key, werr)
+	}
+	return err
+}
+
+// close flushes the remaining records and waits for the upload to complete
+func (f *shardFile) close() error {
+	if err := f.w.Close(); err != nil {
+		return f.abort(fmt.Errorf("close %q: %w", f.key, err))
+	}
+	f.pw.Close()
+	if err := <-f.done; err != nil {
+		return fmt.Errorf("upload %q: %w", f.key, err)
+	}
+	return nil
+}
+
+func putMeta(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id, bucket, path string, desc *Descriptor,
+) error {
+	return putJSON(ctx, backend, id, MetaFile, bucket, path, desc)
+}
+
+func readMeta(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id, bucket, path string,
+) (*Descriptor, error) {
+	var desc Descriptor
+	if err := getJSON(ctx, backend, id, MetaFile, bucket, path, &desc); err != nil {
+		return nil, err
+	}
+	return &desc, nil
+}
+
+func putJSON(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id, key, bucket, path string, v interface{},
+) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal %q: %w", key, err)
+	}
+	if err := backend.PutObject(ctx, id, key, bucket, path, b); err != nil {
+		return fmt.Errorf("upload %q: %w", key, err)
+	}
+	return nil
+}
+
+// getJSON downloads and unmarshals key, errors of the backend are returned
+// as is so that callers can check for backup.ErrNotFound
+func getJSON(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id, key, bucket, path string, v interface{},
+) error {
+	b, err := backend.GetObject(ctx, id, key, bucket, path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("unmarshal %q: %w", key, err)
+	}
+	return nil
+}
+
+func logOperation(logger logrus.FieldLogger, name, id, backend string, begin time.Time, err error) {
+	le := logger.WithField("action", name).
+		WithField("export_id", id).WithField("backend", backend).
+		WithField("took", time.Since(begin))
+	if err != nil {
+		le.Error(err)
+	} else {
+		le.Info()
+	}
+}
@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/parquet-go/parquet-go"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+func TestExporter(t *testing.T) {
+	ctx := context.Background()
+	objects := map[string][]*storobj.Object{
+		"shard1": {testObject("00000000-0000-0000-0000-000000000001")},
+		"shard2": {
+			testObject("00000000-0000-0000-0000-000000000002"),
+			testObject("00000000-0000-0000-0000-000000000003"),
+		},
+	}
+
+	for _, format := range []Format{FormatJSONL, FormatParquet} {
+		t.Run(string(format), func(t *testing.T) {
+			backend := newMemBackend()
+			e := newTestExporter(backend, &fakeSource{objects: objects})
+
+			desc, err := e.Export(ctx, nil, &Request{
+				ID: "export-1", Backend: "memory", Class: "article", Format: format,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, "Article", desc.Class)
+			assert.Equal(t, backup.Started, desc.Status)
+
+			desc = waitForExport(t, e, "export-1")
+			require.Equal(t, backup.Success, desc.Status, desc.Error)
+			assert.Equal(t, []ShardDescriptor{
+				{Name: "shard1", Key: "Article/shard1" + format.Ext(), Objects: 1},
+				{Name: "shard2", Key: "Article/shard2" + format.Ext(), Objects: 2},
+			}, desc.Shards)
+
+			records := readRecords(t, format, backend.object("export-1", "Article/shard2"+format.Ext()))
+			require.Len(t, records, 2)
+			expected, err := NewRecord(objects["shard2"][1])
+			require.Nil(t, err)
+			assert.Equal(t, expected, records[1])
+		})
+	}
+
+	t.Run("export already exists", func(t *testing.T) {
+		backend := newMemBackend()
+		e := newTestExporter(backend, &fakeSource{objects: objects})
+		_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		require.Nil(t, err)
+		waitForExport(t, e, "export-1")
+
+		_, err = e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+	})
+
+	t.Run("backend is not accessed while holding the lock", func(t *testing.T) {
+		backend := newMemBackend()
+		started, release := make(chan struct{}), make(chan struct{})
+		backend.initialize = func(id string) error {
+			if id == "export-1" {
+				close(started)
+				<-release
+			}
+			return nil
+		}
+		e := newTestExporter(backend, &fakeSource{objects: objects})
+
+		done := make(chan error)
+		go func() {
+			_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+			done <- err
+		}()
+		<-started
+
+		_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		assert.ErrorAs(t, err, &backup.ErrUnprocessable{}, "id is reserved")
+		_, err = e.Export(ctx, nil, &Request{ID: "export-2", Backend: "memory", Class: "Article"})
+		assert.Nil(t, err)
+		assert.Equal(t, backup.Success, waitForExport(t, e, "export-2").Status)
+
+		close(release)
+		require.Nil(t, <-done)
+		assert.Equal(t, backup.Success, waitForExport(t, e, "export-1").Status)
+	})
+
+	t.Run("backend fails", func(t *testing.T) {
+		backend := newMemBackend()
+		backend.initialize = func(string) error { return errors.New("access denied") }
+		e := newTestExporter(backend, &fakeSource{objects: objects})
+		_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		assert.ErrorContains(t, err, "access denied")
+
+		// the id is released again
+		backend.initialize = nil
+		_, err = e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		assert.Nil(t, err)
+		waitForExport(t, e, "export-1")
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		e := newTestExporter(newMemBackend(), &fakeSource{})
+		for _, req := range []*Request{
+			{ID: "Export", Backend: "memory", Class: "Article"},
+			{ID: "export", Backend: "memory", Class: "Article", Format: "csv"},
+			{ID: "export", Backend: "unknown", Class: "Article"},
+		} {
+			_, err := e.Export(ctx, nil, req)
+			assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+		}
+	})
+
+	t.Run("source fails", func(t *testing.T) {
+		e := newTestExporter(newMemBackend(), &fakeSource{err: errors.New("shard not found")})
+		_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		require.Nil(t, err)
+
+		desc := waitForExport(t, e, "export-1")
+		assert.Equal(t, backup.Failed, desc.Status)
+		assert.Contains(t, desc.Error, "shard not found")
+	})
+
+	t.Run("unknown export", func(t *testing.T) {
+		e := newTestExporter(newMemBackend(), &fakeSource{})
+		_, err := e.Status(ctx, nil, "memory", "export-1", "", "")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		authorizer := mocks.NewMockAuthorizer()
+		authorizer.SetErr(errors.New("forbidden"))
+		logger, _ := test.NewNullLogger()
+		e := NewExporter(authorizer, &memBackends{newMemBackend()}, &fakeSource{}, logger)
+
+		_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article"})
+		assert.EqualError(t, err, "forbidden")
+		_, err = e.Status(ctx, nil, "memory", "export-1", "", "")
+		assert.EqualError(t, err, "forbidden")
+	})
+}
+
+func newTestExporter(backend *memBackend, source Source) *Exporter {
+	logger, _ := test.NewNullLogger()
+	return NewExporter(mocks.NewMockAuthorizer(), &memBackends{backend}, source, logger)
+}
+
+func waitForExport(t *testing.T, e *Exporter, id string) *Descriptor {
+	var desc *Descriptor
+	require.Eventually(t, func() bool {
+		var err error
+		desc, err = e.Status(context.Background(), nil, "memory", id, "", "")
+		require.Nil(t, err)
+		return desc.Status == backup.Success || desc.Status == backup.Failed
+	}, 5*time.Second, 10*time.Millisecond)
+	return desc
+}
+
+func readRecords(t *testing.T, format Format, data []byte) []Record {
+	var records []Record
+	switch format {
+	case FormatJSONL:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		for dec.More() {
+			var r Record
+			require.Nil(t, dec.Decode(&r))
+			records = append(records, r)
+		}
+	case FormatParquet:
+		rows, err := parquet.Read[parquetRecord](bytes.NewReader(data), int64(len(data)))
+		require.Nil(t, err)
+		for _, row := range rows {
+			records = append(records, fromParquetRecord(row))
+		}
+	}
+	return records
+}
+
+func testObject(id string) *storobj.Object {
+	obj := storobj.FromObject(&models.Object{
+		ID:                 strfmt.UUID(id),
+		Class:              "Article",
+		CreationTimeUnix:   1000,
+		LastUpdateTimeUnix: 2000,
+		Properties:         map[string]interface{}{"title": "title " + id, "words": float64(12)},
+	}, []float32{1, 2, 3}, models.Vectors{"summary": []float32{4, 5}})
+	obj.MultiVectors = map[string][][]float32{"colbert": {{1, 2}, {3, 4}}}
+	obj.SparseVectors = map[string]models.SparseVector{"splade": {Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}}}
+	return obj
+}
+
+type fakeSource struct {
+	objects map[string][]*storobj.Object
+	err     error
+}
+
+func (s *fakeSource) ExportObjects(ctx context.Context, exportID, class, tenant string,
+	fn func(shard string, obj *storobj.Object) error,
+) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, shard := range []string{"shard1", "shard2"} {
+		for _, obj := range s.objects[shard] {
+			if err := fn(shard, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type memBackends struct {
+	backend *memBackend
+}
+
+func (b *memBackends) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	if name != b.backend.Name() {
+		return nil, fmt.Errorf("backend %q not found", name)
+	}
+	return b.backend, nil
+}
+
+// memBackend keeps objects in memory
+type memBackend struct {
+	sync.Mutex
+	objects map[string][]byte
+	// initialize is called by Initialize if set
+	initialize func(id string) error
+}
+
+func newMemBackend() *memBackend {
+	return &memBackend{objects: make(map[string][]byte)}
+}
+
+func (b *memBackend) object(id, key string) []byte {
+	b.Lock()
+	defer b.Unlock()
+	return b.objects[path.Join(id, key)]
+}
+
+func (b *memBackend) IsExternal() bool { return true }
+
+func (b *memBackend) Name() string { return "memory" }
+
+func (b *memBackend) HomeDir(id, bucket, path string) string { return "memory://" + id }
+
+func (b *memBackend) AllBackups(context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+	return nil, nil
+}
+
+func (b *memBackend) GetObject(_ context.Context, id, key, _, _ string) ([]byte, error) {
+	if data := b.object(id, key); data != nil {
+		return data, nil
+	}
+	return nil, backup.NewErrNotFound(fmt.Errorf("%s/%s not found", id, key))
+}
+
+func (b *memBackend) WriteToFile(_ context.Context, id, key, dest, _, _ string) error {
+	data := b.object(id, key)
+	if data == nil {
+		return backup.NewErrNotFound(fmt.Errorf("%s/%s not found", id, key))
+	}
+	return os.WriteFile(dest, data, 0o600)
+}
+
+func (b *memBackend) SourceDataPath() string { return "" }
+
+func (b *memBackend) PutObject(_ context.Context, id, key, _, _ string, data []byte) error {
+	b.Lock()
+	defer b.Unlock()
+	b.objects[path.Join(id, key)] = data
+	return nil
+}
+
+func (b *memBackend) Initialize(_ context.Context, id, _, _ string) error {
+	if b.initialize != nil {
+		return b.initialize(id)
+	}
+	return nil
+}
+
+func (b *memBackend) Write(ctx context.Context, id, key, bucket, path string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), b.PutObject(ctx, id, key, bucket, path, data)
+}
+
+func (b *memBackend) Read(_ context.Context, id, key, _, _ string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+	data := b.object(id, key)
+	if data == nil {
+		return 0, backup.NewErrNotFound(fmt.Errorf("%s/%s not found", id, key))
+	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
@@ -0,0 +1,604 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ImportMetaFile is the name of the file tracking the progress of an import.
+// It is stored in the directory of the import on the backend.
+const ImportMetaFile = "import_status.json"
+
+const (
+	DefaultImportBatchSize = 100
+	// maxImportRowErrors limits the number of row errors kept in the status
+	// of an import, all failed rows are counted nonetheless
+	maxImportRowErrors = 1000
+	// importCheckpointInterval is the minimum interval at which the progress
+	// of an import is persisted
+	importCheckpointInterval = 10 * time.Second
+)
+
+// BatchAdder ingests objects in batches
+type BatchAdder interface {
+	AddObjects(ctx context.Context, principal *models.Principal, objects []*models.Object,
+		fields []*string, repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+}
+
+// ImportRequest to import files from a backup backend
+type ImportRequest struct {
+	// ID of the import, it is unique per backend
+	ID      string
+	Backend string
+	// Source is the directory of the files on the backend, usually the id of
+	// an export
+	Source string
+	// Files to import relative to Source. All data files of the export are
+	// imported if not set.
+	Files []string
+	// Format of the files, the format of the export or the file extensions
+	// are used if not set
+	Format Format
+	// Class and Tenant override the collection and tenant of the records
+	Class  string
+	Tenant string
+	// BatchSize is the number of objects ingested at once
+	BatchSize int
+	// Bucket and Path override the default location of the backend
+	Bucket string
+	Path   string
+}
+
+// ImportDescriptor describes an import and its progress
+type ImportDescriptor struct {
+	ID          string        `json:"id"`
+	Backend     string        `json:"backend"`
+	Source      string        `json:"source"`
+	Class       string        `json:"class,omitempty"`
+	Tenant      string        `json:"tenant,omitempty"`
+	BatchSize   int           `json:"batchSize"`
+	Status      backup.Status `json:"status"`
+	Error       string        `json:"error,omitempty"`
+	StartedAt   time.Time     `json:"startedAt"`
+	CompletedAt time.Time     `json:"completedAt,omitempty"`
+	Files       []ImportFile  `json:"files"`
+	// Errors of single rows, at most maxImportRowErrors are kept
+	Errors []RowError `json:"errors,omitempty"`
+}
+
+// ImportFile is the progress of a single file of an import
+type ImportFile struct {
+	Key    string `json:"key"`
+	Format Format `json:"format"`
+	// Rows is the number of rows processed so far, an interrupted import
+	// resumes after them
+	Rows     int64 `json:"rows"`
+	Imported int64 `json:"imported"`
+	Failed   int64 `json:"failed"`
+	Done     bool  `json:"done"`
+}
+
+// RowError is the error of a single row which could not be imported
+type RowError struct {
+	Key string `json:"key"`
+	Row int64  `json:"row"`
+	// Line of the row in line based formats, starting at one
+	Line  int64  `json:"line,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error"`
+}
+
+// Importer ingests Parquet and JSONL files from backup backends, e.g. those
+// written by an export. Imports run in the background and persist their
+// progress regularly, so that a failed, cancelled or interrupted import is
+// resumed when it is started again with the same id.
+type Importer struct {
+	logger     logrus.FieldLogger
+	authorizer authorization.Authorizer
+	backends   BackendProvider
+	batcher    BatchAdder
+	// tmpDir holds Parquet files while they are imported
+	tmpDir string
+
+	sync.Mutex
+	// running imports by backend and id
+	running map[string]*runningImport
+}
+
+type runningImport struct {
+	desc   *ImportDescriptor
+	cancel context.CancelFunc
+}
+
+func NewImporter(authorizer authorization.Authorizer, backends BackendProvider,
+	batcher BatchAdder, tmpDir string, logger logrus.FieldLogger,
+) *Importer {
+	return &Importer{
+		logger:     logger,
+		authorizer: authorizer,
+		backends:   backends,
+		batcher:    batcher,
+		tmpDir:     tmpDir,
+		running:    make(map[string]*runningImport),
+	}
+}
+
+// Import starts a new import, or resumes an import which did not succeed, in
+// the background
+func (im *Importer) Import(ctx context.Context, principal *models.Principal, req *ImportRequest,
+) (*ImportDescriptor, error) {
+	if err := im.authorizer.Authorize(principal, authorization.CREATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	if req.Class != "" {
+		req.Class = schema.UppercaseClassName(req.Class)
+		if err := im.authorizer.Authorize(principal, authorization.UPDATE,
+			authorization.Shards(req.Class, req.Tenant)...); err != nil {
+			return nil, err
+		}
+	}
+
+	if !regExpID.MatchString(req.ID) {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"invalid import id: '%v' allowed characters are lowercase, 0-9, _, -", req.ID))
+	}
+	if req.Source == "" {
+		return nil, backup.NewErrUnprocessable(errors.New("missing source"))
+	}
+	if req.Format != "" && req.Format != FormatJSONL && req.Format != FormatParquet {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("unknown format %q", req.Format))
+	}
+	if req.BatchSize < 0 {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("invalid batch size %d", req.BatchSize))
+	}
+	if req.BatchSize == 0 {
+		req.BatchSize = DefaultImportBatchSize
+	}
+	backend, err := im.backends.BackupBackend(req.Backend)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"no backup backend %q: %w, did you enable the right module?", req.Backend, err))
+	}
+
+	key := req.Backend + "/" + req.ID
+	im.Lock()
+	defer im.Unlock()
+	if _, ok := im.running[key]; ok {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("import %q is already running", req.ID))
+	}
+
+	desc, err := im.resumableImport(ctx, backend, req)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		files, err := im.importFiles(ctx, backend, req)
+		if err != nil {
+			return nil, err
+		}
+		desc = &ImportDescriptor{
+			ID:        req.ID,
+			Backend:   req.Backend,
+			Source:    req.Source,
+			Class:     req.Class,
+			Tenant:    req.Tenant,
+			BatchSize: req.BatchSize,
+			Files:     files,
+		}
+	}
+	desc.Status = backup.Started
+	desc.Error = ""
+	desc.StartedAt = time.Now().UTC()
+	desc.CompletedAt = time.Time{}
+
+	if err := backend.Initialize(ctx, req.ID, req.Bucket, req.Path); err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("init backend: %w", err))
+	}
+	if err := putJSON(ctx, backend, req.ID, ImportMetaFile, req.Bucket, req.Path, desc); err != nil {
+		return nil, err
+	}
+
+	importCtx, cancel := context.WithCancel(context.Background())
+	im.running[key] = &runningImport{desc: desc, cancel: cancel}
+	ret := desc.clone()
+
+	enterrors.GoWrapper(func() {
+		defer cancel()
+		err := im.run(importCtx, principal, backend, req, desc)
+		im.finish(key, backend, req, desc, err)
+	}, im.logger)
+
+	return ret, nil
+}
+
+// resumableImport returns the stored progress of an import with the same id
+// or nil if there is none
+func (im *Importer) resumableImport(ctx context.Context, backend modulecapabilities.BackupBackend,
+	req *ImportRequest,
+) (*ImportDescriptor, error) {
+	var desc ImportDescriptor
+	err := getJSON(ctx, backend, req.ID, ImportMetaFile, req.Bucket, req.Path, &desc)
+	if errors.As(err, &backup.ErrNotFound{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("check if import %q exists: %w", req.ID, err)
+	}
+	if desc.Status == backup.Success {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("import %q already succeeded", req.ID))
+	}
+	if desc.Source != req.Source || desc.Class != req.Class || desc.Tenant != req.Tenant {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"import %q exists with a different source, collection or tenant", req.ID))
+	}
+	return &desc, nil
+}
+
+// importFiles resolves the files to import and their format
+func (im *Importer) importFiles(ctx context.Context, backend modulecapabilities.BackupBackend,
+	req *ImportRequest,
+) ([]ImportFile, error) {
+	keys, format := req.Files, req.Format
+	if len(keys) == 0 {
+		exp, err := readMeta(ctx, backend, req.Source, req.Bucket, req.Path)
+		if err != nil {
+			return nil, backup.NewErrUnprocessable(fmt.Errorf(
+				"no files given and source %q is not an export: %w", req.Source, err))
+		}
+		if exp.Status != backup.Success {
+			return nil, backup.NewErrUnprocessable(fmt.Errorf(
+				"export %q did not succeed: %s", req.Source, exp.Status))
+		}
+		for _, shard := range exp.Shards {
+			keys = append(keys, shard.Key)
+		}
+		if format == "" {
+			format = exp.Format
+		}
+	}
+
+	files := make([]ImportFile, len(keys))
+	for i, key := range keys {
+		f := format
+		if f == "" {
+			switch strings.ToLower(path.Ext(key)) {
+			case FormatJSONL.Ext():
+				f = FormatJSONL
+			case FormatParquet.Ext():
+				f = FormatParquet
+			default:
+				return nil, backup.NewErrUnprocessable(fmt.Errorf(
+					"unknown format of file %q, please set the format", key))
+			}
+		}
+		files[i] = ImportFile{Key: key, Format: f}
+	}
+	return files, nil
+}
+
+// Status returns the progress of an import
+func (im *Importer) Status(ctx context.Context, principal *models.Principal,
+	backendName, id, bucket, path string,
+) (*ImportDescriptor, error) {
+	if err := im.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+
+	im.Lock()
+	if ri, ok := im.running[backendName+"/"+id]; ok {
+		ret := ri.desc.clone()
+		im.Unlock()
+		return ret, nil
+	}
+	im.Unlock()
+
+	backend, err := im.backends.BackupBackend(backendName)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf(
+			"no backup backend %q: %w, did you enable the right module?", backendName, err))
+	}
+	var desc ImportDescriptor
+	if err := getJSON(ctx, backend, id, ImportMetaFile, bucket, path, &desc); err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return nil, backup.NewErrNotFound(fmt.Errorf("import %q not found", id))
+		}
+		return nil, err
+	}
+	return &desc, nil
+}
+
+// Cancel stops a running import, it can be resumed later on
+func (im *Importer) Cancel(ctx context.Context, principal *models.Principal, backendName, id string) error {
+	if err := im.authorizer.Authorize(principal, authorization.DELETE, authorization.Cluster()); err != nil {
+		return err
+	}
+
+	im.Lock()
+	defer im.Unlock()
+	ri, ok := im.running[backendName+"/"+id]
+	if !ok {
+		return backup.NewErrNotFound(fmt.Errorf("import %q is not running", id))
+	}
+	ri.cancel()
+	return nil
+}
+
+func (im *Importer) run(ctx context.Context, principal *models.Principal,
+	backend modulecapabilities.BackupBackend, req *ImportRequest, desc *ImportDescriptor,
+) error {
+	im.setStatus(desc, backup.Transferring)
+	checkpoint := newImportCheckpoint(im, backend, req, desc)
+
+	for i := range desc.Files {
+		if desc.Files[i].Done {
+			continue
+		}
+		if err := im.importFile(ctx, principal, backend, req, desc, i, checkpoint); err != nil {
+			if ctx.Err() != nil {
+				// the import was cancelled, errors of the batch are a result of it
+				return ctx.Err()
+			}
+			return fmt.Errorf("import %q: %w", desc.Files[i].Key, err)
+		}
+	}
+	return nil
+}
+
+func (im *Importer) importFile(ctx context.Context, principal *models.Principal,
+	backend modulecapabilities.BackupBackend, req *ImportRequest, desc *ImportDescriptor,
+	fileIdx int, checkpoint *importCheckpoint,
+) error {
+	im.Lock()
+	file := desc.Files[fileIdx]
+	im.Unlock()
+
+	r, err := im.openFile(ctx, backend, req, file)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	done := false
+	if err := r.Skip(file.Rows); errors.Is(err, io.EOF) {
+		done = true
+	} else if err != nil {
+		return fmt.Errorf("skip %d imported rows: %w", file.Rows, err)
+	}
+
+	batch := make([]*models.Object, 0, desc.BatchSize)
+	// rows and lines of the objects of the batch
+	rows := make([]int64, 0, desc.BatchSize)
+	lines := make([]int64, 0, desc.BatchSize)
+	for !done {
+		batch, rows, lines = batch[:0], rows[:0], lines[:0]
+		var rowErrs []RowError
+		row := file.Rows
+		for len(batch) < desc.BatchSize {
+			rec, err := r.Read()
+			if errors.Is(err, io.EOF) {
+				done = true
+				break
+			}
+			var malformed *malformedRowError
+			if err != nil && !errors.As(err, &malformed) {
+				return fmt.Errorf("read row %d: %w", row, err)
+			}
+			row++
+
+			if err == nil {
+				var obj *models.Object
+				if obj, err = rec.Object(desc.Class, desc.Tenant); err == nil {
+					batch = append(batch, obj)
+					rows = append(rows, row-1)
+					lines = append(lines, r.Line())
+					continue
+				}
+			}
+			rowErrs = append(rowErrs, RowError{
+				Key:   file.Key,
+				Row:   row - 1,
+				Line:  r.Line(),
+				ID:    rec.ID,
+				Error: err.Error(),
+			})
+		}
+
+		imported := int64(0)
+		if len(batch) > 0 {
+			res, err := im.batcher.AddObjects(ctx, principal, batch, nil, nil)
+			if err != nil {
+				return err
+			}
+			for _, bo := range res {
+				if bo.Err != nil {
+					rowErrs = append(rowErrs, RowError{
+						Key:   file.Key,
+						Row:   rows[bo.OriginalIndex],
+						Line:  lines[bo.OriginalIndex],
+						ID:    batch[bo.OriginalIndex].ID.String(),
+						Error: bo.Err.Error(),
+					})
+					continue
+				}
+				imported++
+			}
+		}
+
+		file.Rows = row
+		file.Imported += imported
+		file.Failed += int64(len(rowErrs))
+		file.Done = done
+		im.Lock()
+		desc.Files[fileIdx] = file
+		for _, re := range rowErrs {
+			if len(desc.Errors) >= maxImportRowErrors {
+				break
+			}
+			desc.Errors = append(desc.Errors, re)
+		}
+		im.Unlock()
+		checkpoint.save(ctx, done)
+
+		if err := ctx.Err(); err != nil && !done {
+			return err
+		}
+	}
+	return nil
+}
+
+// openFile opens a reader on the file, Parquet files are downloaded first
+func (im *Importer) openFile(ctx context.Context, backend modulecapabilities.BackupBackend,
+	req *ImportRequest, file ImportFile,
+) (recordReader, error) {
+	switch file.Format {
+	case FormatJSONL:
+		pr, pw := io.Pipe()
+		enterrors.GoWrapper(func() {
+			_, err := backend.Read(ctx, req.Source, file.Key, req.Bucket, req.Path, pw)
+			pw.CloseWithError(err)
+		}, im.logger)
+		return newJSONLReader(pr), nil
+	case FormatParquet:
+		dir, err := os.MkdirTemp(im.tmpDir, "import-")
+		if err != nil {
+			return nil, fmt.Errorf("create temporary directory: %w", err)
+		}
+		dest := filepath.Join(dir, "data.parquet")
+		if err := backend.WriteToFile(ctx, req.Source, file.Key, dest, req.Bucket, req.Path); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("download: %w", err)
+		}
+		r, err := openParquetReader(dest)
+		if err != nil {
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		return &tmpFileReader{recordReader: r, dir: dir}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", file.Format)
+	}
+}
+
+// tmpFileReader removes the downloaded file once it was read
+type tmpFileReader struct {
+	recordReader
+	dir string
+}
+
+func (r *tmpFileReader) Close() error {
+	return errors.Join(r.recordReader.Close(), os.RemoveAll(r.dir))
+}
+
+func (im *Importer) finish(key string, backend modulecapabilities.BackupBackend,
+	req *ImportRequest, desc *ImportDescriptor, err error,
+) {
+	im.Lock()
+	desc.CompletedAt = time.Now().UTC()
+	switch {
+	case err == nil:
+		desc.Status = backup.Success
+	case errors.Is(err, context.Canceled):
+		desc.Status = backup.Cancelled
+	default:
+		desc.Status = backup.Failed
+		desc.Error = err.Error()
+	}
+	meta := desc.clone()
+	im.Unlock()
+
+	le := im.logger.WithField("action", "import").WithField("import_id", req.ID).
+		WithField("backend", req.Backend).WithField("took", time.Since(desc.StartedAt))
+	if err != nil {
+		le.Error(err)
+	} else {
+		le.Info()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), metaTimeout)
+	defer cancel()
+	if err := putJSON(ctx, backend, req.ID, ImportMetaFile, req.Bucket, req.Path, meta); err != nil {
+		le.Error(err)
+	}
+
+	im.Lock()
+	delete(im.running, key)
+	im.Unlock()
+}
+
+func (im *Importer) setStatus(desc *ImportDescriptor, status backup.Status) {
+	im.Lock()
+	defer im.Unlock()
+	desc.Status = status
+}
+
+func (d *ImportDescriptor) clone() *ImportDescriptor {
+	ret := *d
+	ret.Files = append([]ImportFile(nil), d.Files...)
+	ret.Errors = append([]RowError(nil), d.Errors...)
+	return &ret
+}
+
+// importCheckpoint persists the progress of an import at most every
+// importCheckpointInterval
+type importCheckpoint struct {
+	im      *Importer
+	backend modulecapabilities.BackupBackend
+	req     *ImportRequest
+	desc    *ImportDescriptor
+	last    time.Time
+}
+
+func newImportCheckpoint(im *Importer, backend modulecapabilities.BackupBackend,
+	req *ImportRequest, desc *ImportDescriptor,
+) *importCheckpoint {
+	return &importCheckpoint{im: im, backend: backend, req: req, desc: desc, last: time.Now()}
+}
+
+func (c *importCheckpoint) save(ctx context.Context, force bool) {
+	if !force && time.Since(c.last) < importCheckpointInterval {
+		return
+	}
+	c.last = time.Now()
+
+	c.im.Lock()
+	meta := c.desc.clone()
+	c.im.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), metaTimeout)
+	defer cancel()
+	if err := putJSON(ctx, c.backend, c.req.ID, ImportMetaFile, c.req.Bucket, c.req.Path, meta); err != nil {
+		// the import continues, it only resumes from an earlier row
+		c.im.logger.WithField("action", "import").WithField("import_id", c.req.ID).
+			Warnf("save progress: %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestImporter(t *testing.T) {
+	ctx := context.Background()
+	objs := map[string][]*storobj.Object{
+		"shard1": {testObject("00000000-0000-0000-0000-000000000001")},
+		"shard2": {
+			testObject("00000000-0000-0000-0000-000000000002"),
+			testObject("00000000-0000-0000-0000-000000000003"),
+		},
+	}
+
+	for _, format := range []Format{FormatJSONL, FormatParquet} {
+		t.Run("import "+string(format)+" export", func(t *testing.T) {
+			backend := newMemBackend()
+			e := newTestExporter(backend, &fakeSource{objects: objs})
+			_, err := e.Export(ctx, nil, &Request{ID: "export-1", Backend: "memory", Class: "Article", Format: format})
+			require.Nil(t, err)
+			require.Equal(t, backup.Success, waitForExport(t, e, "export-1").Status)
+
+			batcher := &fakeBatcher{}
+			im := newTestImporter(t, backend, batcher)
+			desc, err := im.Import(ctx, nil, &ImportRequest{
+				ID: "import-1", Backend: "memory", Source: "export-1", Class: "Copy", BatchSize: 2,
+			})
+			require.Nil(t, err)
+			require.Len(t, desc.Files, 2)
+			assert.Equal(t, format, desc.Files[0].Format)
+
+			desc = waitForImport(t, im, "import-1")
+			require.Equal(t, backup.Success, desc.Status, desc.Error)
+			assert.Equal(t, []ImportFile{
+				{Key: "Article/shard1" + format.Ext(), Format: format, Rows: 1, Imported: 1, Done: true},
+				{Key: "Article/shard2" + format.Ext(), Format: format, Rows: 2, Imported: 2, Done: true},
+			}, desc.Files)
+
+			imported := batcher.imported()
+			require.Len(t, imported, 3)
+			obj := imported[2]
+			assert.Equal(t, "Copy", obj.Class)
+			assert.Equal(t, "00000000-0000-0000-0000-000000000003", obj.ID.String())
+			assert.Equal(t, models.C11yVector{1, 2, 3}, obj.Vector)
+			assert.Equal(t, models.Vector{4, 5}, obj.Vectors["summary"])
+			assert.Equal(t, models.MultiVector{{1, 2}, {3, 4}}, obj.MultiVectors["colbert"])
+			assert.Equal(t, models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				obj.SparseVectors["splade"])
+			assert.Equal(t, "title 00000000-0000-0000-0000-000000000003",
+				obj.Properties.(map[string]interface{})["title"])
+
+			_, err = im.Import(ctx, nil, &ImportRequest{
+				ID: "import-1", Backend: "memory", Source: "export-1", Class: "Copy",
+			})
+			assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+		})
+	}
+
+	t.Run("row errors", func(t *testing.T) {
+		backend := newMemBackend()
+		backend.PutObject(ctx, "files", "data.jsonl", "", "", []byte(
+			`{"id":"00000000-0000-0000-0000-000000000001","collection":"Article","properties":{"title":"a"}}
+{"id":"00000000-0000-0000-0000-000000000002","collection":"Article","properties":[1]}
+{"id":"00000000-0000-0000-0000-000000000003","collection":"Article","properties":{"title":"c"}}
+{"id":"00000000-0000-0000-0000-000000000004","collection":"Article","properties":{"title":"d"}}
+`))
+		batcher := &fakeBatcher{invalid: "00000000-0000-0000-0000-000000000003"}
+		im := newTestImporter(t, backend, batcher)
+		_, err := im.Import(ctx, nil, &ImportRequest{
+			ID: "import-1", Backend: "memory", Source: "files", Files: []string{"data.jsonl"}, BatchSize: 3,
+		})
+		require.Nil(t, err)
+
+		desc := waitForImport(t, im, "import-1")
+		require.Equal(t, backup.Success, desc.Status, desc.Error)
+		assert.Equal(t, []ImportFile{{Key: "data.jsonl", Format: FormatJSONL, Rows: 4, Imported: 2, Failed: 2, Done: true}},
+			desc.Files)
+		require.Len(t, desc.Errors, 2)
+		assert.Equal(t, int64(1), desc.Errors[0].Row)
+		assert.Equal(t, int64(2), desc.Errors[0].Line)
+		assert.Contains(t, desc.Errors[0].Error, "unmarshal properties")
+		assert.Equal(t, int64(2), desc.Errors[1].Row)
+		assert.Equal(t, int64(3), desc.Errors[1].Line)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000003", desc.Errors[1].ID)
+		assert.Equal(t, "invalid object", desc.Errors[1].Error)
+	})
+
+	t.Run("malformed lines", func(t *testing.T) {
+		backend := newMemBackend()
+		backend.PutObject(ctx, "files", "data.jsonl", "", "", []byte(
+			`{"id":"00000000-0000-0000-0000-000000000001","collection":"Article"}
+{"id":"00000000-0000-0000-0000-000000000002",
+
+{"id":"00000000-0000-0000-0000-000000000003","collection":"Article"}
+not json
+{"id":"00000000-0000-0000-0000-000000000004","collection":"Article"}`))
+		batcher := &fakeBatcher{}
+		im := newTestImporter(t, backend, batcher)
+		_, err := im.Import(ctx, nil, &ImportRequest{
+			ID: "import-1", Backend: "memory", Source: "files", Files: []string{"data.jsonl"}, BatchSize: 2,
+		})
+		require.Nil(t, err)
+
+		desc := waitForImport(t, im, "import-1")
+		require.Equal(t, backup.Success, desc.Status, desc.Error)
+		assert.Equal(t, []ImportFile{{Key: "data.jsonl", Format: FormatJSONL, Rows: 5, Imported: 3, Failed: 2, Done: true}},
+			desc.Files)
+		require.Len(t, desc.Errors, 2)
+		assert.Equal(t, int64(1), desc.Errors[0].Row)
+		assert.Equal(t, int64(2), desc.Errors[0].Line)
+		assert.Contains(t, desc.Errors[0].Error, "malformed row")
+		assert.Equal(t, int64(3), desc.Errors[1].Row)
+		assert.Equal(t, int64(5), desc.Errors[1].Line)
+
+		imported := batcher.imported()
+		require.Len(t, imported, 3)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000004", imported[2].ID.String())
+	})
+
+	t.Run("resume failed import", func(t *testing.T) {
+		backend := newMemBackend()
+		backend.PutObject(ctx, "files", "data.jsonl", "", "", []byte(
+			`{"id":"00000000-0000-0000-0000-000000000001","collection":"Article"}
+{"id":"00000000-0000-0000-0000-000000000002","collection":"Article"}
+{"id":"00000000-0000-0000-0000-000000000003","collection":"Article"}
+`))
+		batcher := &fakeBatcher{failAfter: 1}
+		im := newTestImporter(t, backend, batcher)
+		req := ImportRequest{ID: "import-1", Backend: "memory", Source: "files", Files: []string{"data.jsonl"}, BatchSize: 2}
+		r := req
+		_, err := im.Import(ctx, nil, &r)
+		require.Nil(t, err)
+
+		desc := waitForImport(t, im, "import-1")
+		require.Equal(t, backup.Failed, desc.Status)
+		assert.Equal(t, int64(2), desc.Files[0].Rows)
+		assert.Len(t, batcher.imported(), 2)
+
+		batcher.setFailAfter(0)
+		r = req
+		_, err = im.Import(ctx, nil, &r)
+		require.Nil(t, err)
+
+		desc = waitForImport(t, im, "import-1")
+		require.Equal(t, backup.Success, desc.Status, desc.Error)
+		assert.Equal(t, ImportFile{Key: "data.jsonl", Format: FormatJSONL, Rows: 3, Imported: 3, Done: true}, desc.Files[0])
+		// only the remaining row is imported again
+		require.Len(t, batcher.imported(), 3)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000003", batcher.imported()[2].ID.String())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		backend := newMemBackend()
+		im := newTestImporter(t, backend, &fakeBatcher{})
+		for _, req := range []*ImportRequest{
+			{ID: "Import", Backend: "memory", Source: "export-1"},
+			{ID: "import", Backend: "memory"},
+			{ID: "import", Backend: "memory", Source: "export-1", Format: "csv"},
+			{ID: "import", Backend: "unknown", Source: "export-1"},
+			// not an export
+			{ID: "import", Backend: "memory", Source: "export-1"},
+			{ID: "import", Backend: "memory", Source: "files", Files: []string{"data.csv"}},
+		} {
+			_, err := im.Import(ctx, nil, req)
+			assert.ErrorAs(t, err, &backup.ErrUnprocessable{}, req)
+		}
+	})
+
+	t.Run("status and cancel of unknown import", func(t *testing.T) {
+		im := newTestImporter(t, newMemBackend(), &fakeBatcher{})
+		_, err := im.Status(ctx, nil, "memory", "import-1", "", "")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+		err = im.Cancel(ctx, nil, "memory", "import-1")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	})
+}
+
+func newTestImporter(t *testing.T, backend *memBackend, batcher BatchAdder) *Importer {
+	logger, _ := test.NewNullLogger()
+	return NewImporter(mocks.NewMockAuthorizer(), &memBackends{backend}, batcher, t.TempDir(), logger)
+}
+
+func waitForImport(t *testing.T, im *Importer, id string) *ImportDescriptor {
+	var desc *ImportDescriptor
+	require.Eventually(t, func() bool {
+		var err error
+		desc, err = im.Status(context.Background(), nil, "memory", id, "", "")
+		require.Nil(t, err)
+		switch desc.Status {
+		case backup.Success, backup.Failed, backup.Cancelled:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	return desc
+}
+
+type fakeBatcher struct {
+	sync.Mutex
+	objects []*models.Object
+	// invalid is the id of an object which fails validation
+	invalid string
+	// failAfter fails all batches after the given number of batches
+	failAfter int
+	batches   int
+}
+
+func (b *fakeBatcher) AddObjects(ctx context.Context, principal *models.Principal, objs []*models.Object,
+	fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	b.Lock()
+	defer b.Unlock()
+	if b.failAfter > 0 && b.batches >= b.failAfter {
+		return nil, errors.New("node unavailable")
+	}
+	b.batches++
+
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+		if obj.ID.String() == b.invalid {
+			res[i].Err = fmt.Errorf("invalid object")
+			continue
+		}
+		b.objects = append(b.objects, obj)
+	}
+	return res, nil
+}
+
+func (b *fakeBatcher) imported() []*models.Object {
+	b.Lock()
+	defer b.Unlock()
+	return append([]*models.Object(nil), b.objects...)
+}
+
+func (b *fakeBatcher) setFailAfter(n int) {
+	b.Lock()
+	defer b.Unlock()
+	b.failAfter = n
+}
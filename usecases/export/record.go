@@ -0,0 +1,437 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// Format is the file format of an export
+type Format string
+
+const (
+	FormatJSONL   Format = "jsonl"
+	FormatParquet Format = "parquet"
+)
+
+// Ext returns the file extension of the format
+func (f Format) Ext() string {
+	return "." + string(f)
+}
+
+// Record is a single exported object. JSONL files contain one record per
+// line, Parquet files one record per row.
+type Record struct {
+	ID                 string          `json:"id"`
+	Collection         string          `json:"collection"`
+	Tenant             string          `json:"tenant,omitempty"`
+	CreationTimeUnix   int64           `json:"creationTimeUnix"`
+	LastUpdateTimeUnix int64           `json:"lastUpdateTimeUnix"`
+	Properties         json.RawMessage `json:"properties,omitempty"`
+	Vector             []float32       `json:"vector,omitempty"`
+	Vectors            []NamedVector   `json:"vectors,omitempty"`
+}
+
+// NamedVector is a named vector of a record, it is set to either a regular,
+// a multi or a sparse vector
+type NamedVector struct {
+	Name         string               `json:"name"`
+	Vector       []float32            `json:"vector,omitempty"`
+	MultiVector  [][]float32          `json:"multiVector,omitempty"`
+	SparseVector *models.SparseVector `json:"sparseVector,omitempty"`
+}
+
+// NewRecord converts a stored object to a record. Named vectors are sorted
+// by name.
+func NewRecord(obj *storobj.Object) (Record, error) {
+	r := Record{
+		ID:                 obj.ID().String(),
+		Collection:         obj.Class().String(),
+		Tenant:             obj.Object.Tenant,
+		CreationTimeUnix:   obj.CreationTimeUnix(),
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix(),
+		Vector:             obj.Vector,
+	}
+	if props := obj.Properties(); props != nil {
+		b, err := json.Marshal(props)
+		if err != nil {
+			return r, fmt.Errorf("marshal properties of object %s: %w", r.ID, err)
+		}
+		r.Properties = b
+	}
+	for name, vec := range obj.Vectors {
+		r.Vectors = append(r.Vectors, NamedVector{Name: name, Vector: vec})
+	}
+	for name, vecs := range obj.MultiVectors {
+		r.Vectors = append(r.Vectors, NamedVector{Name: name, MultiVector: vecs})
+	}
+	for name, vec := range obj.SparseVectors {
+		vec := vec
+		r.Vectors = append(r.Vectors, NamedVector{Name: name, SparseVector: &vec})
+	}
+	sort.Slice(r.Vectors, func(i, j int) bool {
+		return r.Vectors[i].Name < r.Vectors[j].Name
+	})
+	return r, nil
+}
+
+// Object converts the record to an object. class and tenant override the
+// collection and tenant of the record if set.
+func (r Record) Object(class, tenant string) (*models.Object, error) {
+	obj := &models.Object{
+		ID:                 strfmt.UUID(r.ID),
+		Class:              r.Collection,
+		Tenant:             r.Tenant,
+		CreationTimeUnix:   r.CreationTimeUnix,
+		LastUpdateTimeUnix: r.LastUpdateTimeUnix,
+	}
+	if class != "" {
+		obj.Class = class
+	}
+	if tenant != "" {
+		obj.Tenant = tenant
+	}
+	if len(r.Properties) > 0 {
+		// numbers are decoded as json.Number just like in REST requests, so
+		// that they are validated against the data type of the property
+		dec := json.NewDecoder(bytes.NewReader(r.Properties))
+		dec.UseNumber()
+		var props map[string]interface{}
+		if err := dec.Decode(&props); err != nil {
+			return nil, fmt.Errorf("unmarshal properties: %w", err)
+		}
+		obj.Properties = props
+	}
+	if len(r.Vector) > 0 {
+		obj.Vector = r.Vector
+	}
+	for _, v := range r.Vectors {
+		if v.SparseVector != nil {
+			if obj.SparseVectors == nil {
+				obj.SparseVectors = models.SparseVectors{}
+			}
+			obj.SparseVectors[v.Name] = *v.SparseVector
+			continue
+		}
+		if len(v.MultiVector) > 0 {
+			if obj.MultiVectors == nil {
+				obj.MultiVectors = models.MultiVectors{}
+			}
+			mv := make(models.MultiVector, len(v.MultiVector))
+			for i, vec := range v.MultiVector {
+				mv[i] = vec
+			}
+			obj.MultiVectors[v.Name] = mv
+			continue
+		}
+		if obj.Vectors == nil {
+			obj.Vectors = models.Vectors{}
+		}
+		obj.Vectors[v.Name] = v.Vector
+	}
+	return obj, nil
+}
+
+// recordWriter encodes records into a file of a specific format
+type recordWriter interface {
+	Write(r Record) error
+	// Close flushes buffered records, it does not close the underlying writer
+	Close() error
+}
+
+func newRecordWriter(format Format, w io.Writer) (recordWriter, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLWriter(w), nil
+	case FormatParquet:
+		return newParquetWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type jsonlWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	buf := bufio.NewWriter(w)
+	return &jsonlWriter{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+func (w *jsonlWriter) Write(r Record) error {
+	// Encode terminates every record with a newline
+	return w.enc.Encode(r)
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.buf.Flush()
+}
+
+// parquetRecord is the row layout of Parquet files. Properties are stored as
+// a JSON column as their types differ between collections. Multi vectors
+// need a wrapper type as Parquet cannot represent nested lists directly,
+// sparse vectors are stored as a list of indices and a list of values.
+type parquetRecord struct {
+	ID                 string               `parquet:"id"`
+	Collection         string               `parquet:"collection"`
+	Tenant             string               `parquet:"tenant,optional"`
+	CreationTimeUnix   int64                `parquet:"creation_time_unix"`
+	LastUpdateTimeUnix int64                `parquet:"last_update_time_unix"`
+	Properties         []byte               `parquet:"properties,json"`
+	Vector             []float32            `parquet:"vector,list"`
+	Vectors            []parquetNamedVector `parquet:"vectors,list"`
+}
+
+type parquetNamedVector struct {
+	Name          string          `parquet:"name"`
+	Vector        []float32       `parquet:"vector,list"`
+	MultiVector   []parquetVector `parquet:"multi_vector,list"`
+	SparseIndices []uint32        `parquet:"sparse_indices,list"`
+	SparseValues  []float32       `parquet:"sparse_values,list"`
+}
+
+type parquetVector struct {
+	Values []float32 `parquet:"values,list"`
+}
+
+func toParquetRecord(r Record) parquetRecord {
+	p := parquetRecord{
+		ID:                 r.ID,
+		Collection:         r.Collection,
+		Tenant:             r.Tenant,
+		CreationTimeUnix:   r.CreationTimeUnix,
+		LastUpdateTimeUnix: r.LastUpdateTimeUnix,
+		Properties:         r.Properties,
+		Vector:             r.Vector,
+		Vectors:            make([]parquetNamedVector, len(r.Vectors)),
+	}
+	for i, v := range r.Vectors {
+		p.Vectors[i] = parquetNamedVector{Name: v.Name, Vector: v.Vector}
+		for _, values := range v.MultiVector {
+			p.Vectors[i].MultiVector = append(p.Vectors[i].MultiVector, parquetVector{Values: values})
+		}
+		if v.SparseVector != nil {
+			p.Vectors[i].SparseIndices = v.SparseVector.Indices
+			p.Vectors[i].SparseValues = v.SparseVector.Values
+		}
+	}
+	return p
+}
+
+func fromParquetRecord(p parquetRecord) Record {
+	r := Record{
+		ID:                 p.ID,
+		Collection:         p.Collection,
+		Tenant:             p.Tenant,
+		CreationTimeUnix:   p.CreationTimeUnix,
+		LastUpdateTimeUnix: p.LastUpdateTimeUnix,
+		Properties:         p.Properties,
+		Vector:             p.Vector,
+	}
+	if len(r.Vector) == 0 {
+		r.Vector = nil
+	}
+	for _, v := range p.Vectors {
+		nv := NamedVector{Name: v.Name}
+		if len(v.Vector) > 0 {
+			nv.Vector = v.Vector
+		}
+		for _, values := range v.MultiVector {
+			nv.MultiVector = append(nv.MultiVector, values.Values)
+		}
+		if len(v.SparseIndices) > 0 {
+			nv.SparseVector = &models.SparseVector{Indices: v.SparseIndices, Values: v.SparseValues}
+		}
+		r.Vectors = append(r.Vectors, nv)
+	}
+	return r
+}
+
+type parquetWriter struct {
+	w   *parquet.GenericWriter[parquetRecord]
+	row []parquetRecord
+}
+
+func newParquetWriter(w io.Writer) *parquetWriter {
+	return &parquetWriter{
+		w:   parquet.NewGenericWriter[parquetRecord](w),
+		row: make([]parquetRecord, 1),
+	}
+}
+
+func (w *parquetWriter) Write(r Record) error {
+	w.row[0] = toParquetRecord(r)
+	_, err := w.w.Write(w.row)
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.w.Close()
+}
+
+// recordReader decodes the records of a file of a specific format
+type recordReader interface {
+	// Read returns io.EOF after the last record. A record which can't be
+	// decoded is reported as *malformedRowError, reading continues with the
+	// next record.
+	Read() (Record, error)
+	// Skip discards the next n records
+	Skip(n int64) error
+	// Line returns the line of the last record read, zero for formats which
+	// are not line based
+	Line() int64
+	Close() error
+}
+
+// malformedRowError is the error of a single record which can't be decoded
+type malformedRowError struct {
+	err error
+}
+
+func (e *malformedRowError) Error() string {
+	return fmt.Sprintf("malformed row: %v", e.err)
+}
+
+func (e *malformedRowError) Unwrap() error {
+	return e.err
+}
+
+// jsonlReader reads one record per line, empty lines are ignored. Every line
+// is decoded on its own, so that a malformed line only fails its record.
+type jsonlReader struct {
+	rc   io.ReadCloser
+	r    *bufio.Reader
+	line int64
+}
+
+func newJSONLReader(rc io.ReadCloser) *jsonlReader {
+	return &jsonlReader{rc: rc, r: bufio.NewReader(rc)}
+}
+
+// next returns the next line which is not empty
+func (r *jsonlReader) next() ([]byte, error) {
+	for {
+		b, err := r.r.ReadBytes('\n')
+		if len(b) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		if b = bytes.TrimSpace(b); len(b) > 0 {
+			return b, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *jsonlReader) Read() (Record, error) {
+	b, err := r.next()
+	if err != nil {
+		return Record{}, err
+	}
+	var rec Record
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return Record{}, &malformedRowError{err: err}
+	}
+	return rec, nil
+}
+
+func (r *jsonlReader) Skip(n int64) error {
+	for i := int64(0); i < n; i++ {
+		if _, err := r.next(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *jsonlReader) Line() int64 {
+	return r.line
+}
+
+func (r *jsonlReader) Close() error {
+	return r.rc.Close()
+}
+
+// parquetReader reads records from a local file, Parquet files cannot be
+// streamed as their metadata is stored at the end of the file
+type parquetReader struct {
+	f   *os.File
+	r   *parquet.GenericReader[parquetRecord]
+	row []parquetRecord
+}
+
+func openParquetReader(path string) (*parquetReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	pf, err := parquet.OpenFile(f, info.Size())
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("open parquet file: %w", err)
+	}
+	return &parquetReader{
+		f:   f,
+		r:   parquet.NewGenericReader[parquetRecord](pf),
+		row: make([]parquetRecord, 1),
+	}, nil
+}
+
+func (r *parquetReader) Read() (Record, error) {
+	// the reader reuses the memory of the rows it is passed
+	r.row[0] = parquetRecord{}
+	n, err := r.r.Read(r.row)
+	if n == 1 {
+		return fromParquetRecord(r.row[0]), nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return Record{}, err
+}
+
+func (r *parquetReader) Skip(n int64) error {
+	if n >= r.r.NumRows() {
+		return io.EOF
+	}
+	return r.r.SeekToRow(n)
+}
+
+func (r *parquetReader) Line() int64 {
+	return 0
+}
+
+func (r *parquetReader) Close() error {
+	return errors.Join(r.r.Close(), r.f.Close())
+}
@@ -23,9 +23,12 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -355,20 +358,29 @@ func (p *Provider) addVectorToObject(object *models.Object,
 		}
 	}
 	if cfg.TargetVector() == "" {
+		// multi vectors are only supported for named vectors
 		object.Vector = vector
-		// TODO: add support for multi vectors
-		// object.Vector = vector
+		return
+	}
+	if multiVector != nil {
+		if object.MultiVectors == nil {
+			object.MultiVectors = models.MultiVectors{}
+		}
+		object.MultiVectors[cfg.TargetVector()] = asModelMultiVector(multiVector)
 		return
 	}
 	if object.Vectors == nil {
 		object.Vectors = models.Vectors{}
 	}
 	object.Vectors[cfg.TargetVector()] = vector
-	// TODO: add support for multi vectors
-	// if object.MultiVectors == nil {
-	// 	object.MultiVectors = models.Vectors{}
-	// }
-	// object.MultiVectors[cfg.TargetVector()] = multiVectors
+}
+
+func asModelMultiVector(in [][]float32) models.MultiVector {
+	out := make(models.MultiVector, len(in))
+	for i, vector := range in {
+		out[i] = vector
+	}
+	return out
 }
 
 func (p *Provider) vectorizeOne(ctx context.Context, object *models.Object, class *models.Class,
@@ -471,7 +483,8 @@ func (p *Provider) shouldVectorizeObject(object *models.Object, cfg moduletools.
 	targetVectorExists := false
 	p.lockGuard(func() {
 		vec, ok := object.Vectors[cfg.TargetVector()]
-		targetVectorExists = ok && len(vec) > 0
+		multiVec, multiOk := object.MultiVectors[cfg.TargetVector()]
+		targetVectorExists = (ok && len(vec) > 0) || (multiOk && len(multiVec) > 0)
 	})
 	return !targetVectorExists
 }
@@ -536,7 +549,10 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	hnswConfig, okHnsw := vectorIndexConfig.(hnsw.UserConfig)
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
 	_, okDynamic := vectorIndexConfig.(dynamic.UserConfig)
-	if !(okHnsw || okFlat || okDynamic) {
+	_, okDiskANN := vectorIndexConfig.(diskann.UserConfig)
+	_, okIVF := vectorIndexConfig.(ivf.UserConfig)
+	_, okSparse := vectorIndexConfig.(sparse.UserConfig)
+	if !(okHnsw || okFlat || okDynamic || okDiskANN || okIVF || okSparse) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
@@ -99,6 +99,8 @@ type PrometheusMetrics struct {
 	VectorSegmentsSum                  *prometheus.GaugeVec
 	VectorDimensionsSumByVector        *prometheus.GaugeVec
 	VectorSegmentsSumByVector          *prometheus.GaugeVec
+	VectorIndexRecall                  *prometheus.GaugeVec
+	VectorIndexRecallDurations         *prometheus.SummaryVec
 
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
@@ -276,6 +278,8 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.VectorIndexMaintenanceDurations.DeletePartialMatch(labels)
 	pm.VectorIndexDurations.DeletePartialMatch(labels)
 	pm.VectorIndexSize.DeletePartialMatch(labels)
+	pm.VectorIndexRecall.DeletePartialMatch(labels)
+	pm.VectorIndexRecallDurations.DeletePartialMatch(labels)
 	pm.StartupProgress.DeletePartialMatch(labels)
 	pm.StartupDurations.DeletePartialMatch(labels)
 	pm.StartupDiskIO.DeletePartialMatch(labels)
@@ -554,6 +558,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_segments_sum_by_vector",
 			Help: "Total segments in a shard for target vector if quantization enabled",
 		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexRecall: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_recall",
+			Help: "Recall@k of the vector index measured against a brute force search for a sample of stored vectors",
+		}, []string{"class_name", "shard_name", "target_vector", "k"}),
+		VectorIndexRecallDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "vector_index_recall_durations_ms",
+			Help: "Duration of a vector index recall measurement",
+		}, []string{"class_name", "shard_name", "target_vector"}),
 
 		// Startup metrics
 		StartupProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
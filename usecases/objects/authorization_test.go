@@ -238,8 +238,8 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				&additional.ReplicationProperties{},
 				"",
 			},
-			expectedVerb:      authorization.UPDATE,
-			expectedResources: authorization.Shards("", ""),
+			expectedVerb:      authorization.DELETE,
+			expectedResources: []string{authorization.Objects("", "", "")},
 		},
 		{
 			methodName: "DeleteObjectsFromGRPC",
@@ -248,8 +248,8 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				&additional.ReplicationProperties{},
 				"",
 			},
-			expectedVerb:      authorization.UPDATE,
-			expectedResources: authorization.Shards("", ""),
+			expectedVerb:      authorization.DELETE,
+			expectedResources: []string{authorization.Objects("", "", "")},
 		},
 	}
 
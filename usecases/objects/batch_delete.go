@@ -36,8 +36,7 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	if match != nil {
 		class = match.Class
 	}
-	err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(class, tenant)...)
-	if err != nil {
+	if err := b.authorizeDelete(principal, class, tenant); err != nil {
 		return nil, err
 	}
 
@@ -62,8 +61,7 @@ func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *mod
 	params BatchDeleteParams,
 	repl *additional.ReplicationProperties, tenant string,
 ) (BatchDeleteResult, error) {
-	err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(params.ClassName.String(), tenant)...)
-	if err != nil {
+	if err := b.authorizeDelete(principal, params.ClassName.String(), tenant); err != nil {
 		return BatchDeleteResult{}, err
 	}
 
@@ -80,6 +78,17 @@ func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *mod
 	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
 }
 
+// authorizeDelete requires the permission to delete the objects matched in
+// the class. Updating the shards is not sufficient, as it is granted to
+// create or update objects in batches as well.
+func (b *BatchManager) authorizeDelete(principal *models.Principal, class, tenant string) error {
+	if err := b.authorizer.Authorize(principal, authorization.DELETE,
+		authorization.Objects(class, tenant, "")); err != nil {
+		return err
+	}
+	return b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(class, tenant)...)
+}
+
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, deletionTimeUnixMilli *int64, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
@@ -17,11 +17,14 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/entities/verbosity"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -186,3 +189,42 @@ func ptBool(b bool) *bool {
 func ptString(s string) *string {
 	return &s
 }
+
+type fakeRolesReader map[string]map[string][]*models.Permission
+
+func (f fakeRolesReader) GetRolesForUser(user string) map[string][]*models.Permission {
+	return f[user]
+}
+
+func Test_BatchDelete_RBAC(t *testing.T) {
+	action := func(a string) *models.Permission {
+		return &models.Permission{Action: &a, Collection: ptString("Foo")}
+	}
+	authorizer := rbac.New(rbac.Config{Enabled: true})
+	authorizer.SetRolesReader(fakeRolesReader{
+		"creator": {"creator": {action(models.PermissionActionCreateObjectsCollection)}},
+		"deleter": {"deleter": {action(models.PermissionActionDeleteObjectsCollection)}},
+	})
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("BatchDeleteObjects", mock.Anything).Return(BatchDeleteResult{}, nil)
+	manager := NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+		&fakeSchemaManager{}, &config.WeaviateConfig{}, logger, authorizer, nil)
+	match := &models.BatchDeleteMatch{Class: "Foo"}
+
+	t.Run("creating objects does not allow to delete them", func(t *testing.T) {
+		principal := &models.Principal{Username: "creator"}
+		_, err := manager.DeleteObjects(context.Background(), principal, match, nil, nil, nil, nil, "")
+		assert.ErrorAs(t, err, &autherrs.Forbidden{})
+		_, err = manager.DeleteObjectsFromGRPC(context.Background(), principal,
+			BatchDeleteParams{ClassName: "Foo"}, nil, "")
+		assert.ErrorAs(t, err, &autherrs.Forbidden{})
+	})
+
+	t.Run("deleting objects is allowed", func(t *testing.T) {
+		principal := &models.Principal{Username: "deleter"}
+		_, err := manager.DeleteObjectsFromGRPC(context.Background(), principal,
+			BatchDeleteParams{ClassName: "Foo"}, nil, "")
+		assert.Nil(t, err)
+	})
+}
@@ -34,6 +34,8 @@ type MergeDocument struct {
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
 	Vectors              models.Vectors              `json:"vectors"`
+	MultiVectors         models.MultiVectors         `json:"multiVectors,omitempty"`
+	SparseVectors        models.SparseVectors        `json:"sparseVectors,omitempty"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -122,6 +124,8 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		References:         refs,
 		Vector:             objWithVec.Vector,
 		Vectors:            objWithVec.Vectors,
+		MultiVectors:       mergeMultiVectors(objWithVec.MultiVectors, updates.MultiVectors),
+		SparseVectors:      updates.SparseVectors,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -150,6 +154,22 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	return nil
 }
 
+// mergeMultiVectors combines the multi vectors produced by the vectorizers with
+// the ones provided in the update, the latter take precedence
+func mergeMultiVectors(vectorized, updates models.MultiVectors) models.MultiVectors {
+	if len(vectorized) == 0 {
+		return updates
+	}
+	out := make(models.MultiVectors, len(vectorized)+len(updates))
+	for name, vectors := range vectorized {
+		out[name] = vectors
+	}
+	for name, vectors := range updates {
+		out[name] = vectors
+	}
+	return out
+}
+
 func (m *Manager) validateInputs(updates *models.Object) error {
 	if updates == nil {
 		return fmt.Errorf("empty updates")
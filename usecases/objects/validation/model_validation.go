@@ -90,7 +90,11 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
-	return v.properties(ctx, class, incoming, existing)
+	if err := v.properties(ctx, class, incoming, existing); err != nil {
+		return err
+	}
+
+	return v.partitionKey(class, incoming, existing)
 }
 
 // ValidateSingleRef validates a single ref based on location URL and existence of the object in the database
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// partitionKey validates the partition key of objects of classes sharded by
+// a property. New objects must set it, and it can't be changed afterwards,
+// since that would require moving the object to another shard.
+func (v *Validator) partitionKey(class *models.Class, incoming, existing *models.Object) error {
+	cfg, ok := class.ShardingConfig.(shardingConfig.Config)
+	if !ok || schema.MultiTenancyEnabled(class) ||
+		cfg.Key == "" || cfg.Key == shardingConfig.DefaultKey {
+		return nil
+	}
+
+	dt := schema.DataType("")
+	for _, prop := range class.Properties {
+		if prop.Name == cfg.Key && len(prop.DataType) == 1 {
+			dt = schema.DataType(prop.DataType[0])
+		}
+	}
+
+	value, ok, err := partitionKeyValue(dt, cfg.Key, incoming)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if existing == nil {
+			return fmt.Errorf("missing partition key property %q of class %q", cfg.Key, class.Class)
+		}
+		// merges only contain the properties to update
+		return nil
+	}
+
+	if existing == nil {
+		return nil
+	}
+	prev, ok, err := partitionKeyValue(dt, cfg.Key, existing)
+	if err != nil || !ok {
+		return err
+	}
+	if prev != value {
+		return fmt.Errorf("partition key property %q can't be changed: "+
+			"attempted change from %q to %q", cfg.Key, prev, value)
+	}
+	return nil
+}
+
+func partitionKeyValue(dt schema.DataType, key string, obj *models.Object) (string, bool, error) {
+	props, _ := obj.Properties.(map[string]interface{})
+	raw, ok := props[key]
+	if !ok || raw == nil {
+		return "", false, nil
+	}
+	value, err := schema.PartitionKeyValue(dt, raw)
+	if err != nil {
+		return "", false, fmt.Errorf("partition key property %q: %w", key, err)
+	}
+	return value, true, nil
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestPartitionKey(t *testing.T) {
+	class := &models.Class{
+		Class: "Order",
+		Properties: []*models.Property{
+			{Name: "customerId", DataType: schema.DataTypeInt.PropString()},
+		},
+		ShardingConfig: shardingConfig.Config{Key: "customerId"},
+	}
+	obj := func(props map[string]interface{}) *models.Object {
+		return &models.Object{Class: "Order", Properties: props}
+	}
+
+	specs := map[string]struct {
+		class    *models.Class
+		incoming *models.Object
+		existing *models.Object
+		expErr   string
+	}{
+		"sharded by id": {
+			class:    &models.Class{Class: "Order", ShardingConfig: shardingConfig.Config{Key: shardingConfig.DefaultKey}},
+			incoming: obj(nil),
+		},
+		"new object with partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"customerId": float64(7)}),
+		},
+		"new object without partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"name": "x"}),
+			expErr:   `missing partition key property "customerId"`,
+		},
+		"invalid partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"customerId": 1.5}),
+			expErr:   "invalid int partition key value 1.5",
+		},
+		"update keeping partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"customerId": int64(7)}),
+			existing: obj(map[string]interface{}{"customerId": float64(7)}),
+		},
+		"merge without partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"name": "x"}),
+			existing: obj(map[string]interface{}{"customerId": float64(7)}),
+		},
+		"update changing partition key": {
+			class:    class,
+			incoming: obj(map[string]interface{}{"customerId": float64(8)}),
+			existing: obj(map[string]interface{}{"customerId": float64(7)}),
+			expErr:   `partition key property "customerId" can't be changed`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := (&Validator{}).partitionKey(spec.class, spec.incoming, spec.existing)
+			if spec.expErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, spec.expErr)
+			}
+		})
+	}
+}
@@ -14,8 +14,11 @@ package validation
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 func (v *Validator) vector(ctx context.Context, class *models.Class,
@@ -29,15 +32,68 @@ func (v *Validator) vector(ctx context.Context, class *models.Class,
 		return fmt.Errorf("collection %v is configured without multiple named vectors, but received named vectors: %v", class.Class, incomingObject.Vectors)
 	}
 
+	for targetVector := range incomingObject.MultiVectors {
+		vectorConfig, ok := class.VectorConfig[targetVector]
+		if !ok {
+			return fmt.Errorf("collection %v does not have a named vector %q, but received a multi vector for it", class.Class, targetVector)
+		}
+		if hnswConfig, ok := vectorConfig.VectorIndexConfig.(hnsw.UserConfig); !ok || !hnswConfig.Multivector.Enabled {
+			return fmt.Errorf("named vector %q of collection %v is not configured as a multivector index, but received a multi vector", targetVector, class.Class)
+		}
+	}
+
+	for targetVector := range incomingObject.Vectors {
+		if isSparseTarget(class, targetVector) {
+			return fmt.Errorf("named vector %q of collection %v is configured as a sparse vector, but received a dense vector", targetVector, class.Class)
+		}
+	}
+
+	for targetVector, sparseVector := range incomingObject.SparseVectors {
+		if _, ok := class.VectorConfig[targetVector]; !ok {
+			return fmt.Errorf("collection %v does not have a named vector %q, but received a sparse vector for it", class.Class, targetVector)
+		}
+		if !isSparseTarget(class, targetVector) {
+			return fmt.Errorf("named vector %q of collection %v is not configured as a sparse vector, but received a sparse vector", targetVector, class.Class)
+		}
+		if err := validateSparseVector(sparseVector); err != nil {
+			return fmt.Errorf("sparse vector %q: %w", targetVector, err)
+		}
+	}
+
 	// if there is only one named vector we can assume that the single vector
 	if len(class.VectorConfig) == 1 && len(incomingObject.Vector) > 0 {
 		namedVectorName := ""
 		for key := range class.VectorConfig {
 			namedVectorName = key
 		}
+		if isSparseTarget(class, namedVectorName) {
+			return fmt.Errorf("named vector %q of collection %v is configured as a sparse vector, but received a dense vector", namedVectorName, class.Class)
+		}
 		incomingObject.Vectors = map[string]models.Vector{namedVectorName: models.Vector(incomingObject.Vector)}
 		incomingObject.Vector = nil
 	}
 
 	return nil
 }
+
+func isSparseTarget(class *models.Class, targetVector string) bool {
+	_, ok := class.VectorConfig[targetVector].VectorIndexConfig.(sparse.UserConfig)
+	return ok
+}
+
+func validateSparseVector(vec models.SparseVector) error {
+	if len(vec.Indices) != len(vec.Values) {
+		return fmt.Errorf("got %d indices but %d values", len(vec.Indices), len(vec.Values))
+	}
+	seen := make(map[uint32]struct{}, len(vec.Indices))
+	for i, index := range vec.Indices {
+		if _, ok := seen[index]; ok {
+			return fmt.Errorf("index %d is listed more than once", index)
+		}
+		seen[index] = struct{}{}
+		if value := float64(vec.Values[i]); math.IsNaN(value) || math.IsInf(value, 0) {
+			return fmt.Errorf("value of index %d is not a finite number", index)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 func TestVectors(t *testing.T) {
@@ -63,6 +65,107 @@ func TestVectors(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"multi vector for multivector index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{
+					"colbert": {VectorIndexConfig: hnsw.UserConfig{Multivector: hnsw.MultivectorConfig{Enabled: true}}},
+				},
+			},
+			obj: &models.Object{
+				MultiVectors: models.MultiVectors{"colbert": {{1, 2}, {3, 4}}},
+			},
+			expErr: false,
+		},
+		"multi vector for regular index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{
+					"first": {VectorIndexConfig: hnsw.UserConfig{}},
+				},
+			},
+			obj: &models.Object{
+				MultiVectors: models.MultiVectors{"first": {{1, 2}, {3, 4}}},
+			},
+			expErr: true,
+		},
+		"multi vector for unknown named vector": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"first": {}}, // content does not matter
+			},
+			obj: &models.Object{
+				MultiVectors: models.MultiVectors{"second": {{1, 2}, {3, 4}}},
+			},
+			expErr: true,
+		},
+		"sparse vector for sparse index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{
+					"splade": {VectorIndexConfig: sparse.UserConfig{}},
+					"dense":  {VectorIndexConfig: hnsw.UserConfig{}},
+				},
+			},
+			obj: &models.Object{
+				Vectors:       models.Vectors{"dense": []float32{1, 2, 3}},
+				SparseVectors: models.SparseVectors{"splade": {Indices: []uint32{4, 2}, Values: []float32{0.5, 1}}},
+			},
+			expErr: false,
+		},
+		"sparse vector for regular index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{
+					"first": {VectorIndexConfig: hnsw.UserConfig{}},
+				},
+			},
+			obj: &models.Object{
+				SparseVectors: models.SparseVectors{"first": {Indices: []uint32{1}, Values: []float32{1}}},
+			},
+			expErr: true,
+		},
+		"sparse vector for unknown named vector": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"first": {VectorIndexConfig: sparse.UserConfig{}}},
+			},
+			obj: &models.Object{
+				SparseVectors: models.SparseVectors{"second": {Indices: []uint32{1}, Values: []float32{1}}},
+			},
+			expErr: true,
+		},
+		"sparse vector with mismatching lengths": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"splade": {VectorIndexConfig: sparse.UserConfig{}}},
+			},
+			obj: &models.Object{
+				SparseVectors: models.SparseVectors{"splade": {Indices: []uint32{1, 2}, Values: []float32{1}}},
+			},
+			expErr: true,
+		},
+		"sparse vector with duplicate indices": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"splade": {VectorIndexConfig: sparse.UserConfig{}}},
+			},
+			obj: &models.Object{
+				SparseVectors: models.SparseVectors{"splade": {Indices: []uint32{1, 1}, Values: []float32{1, 2}}},
+			},
+			expErr: true,
+		},
+		"dense vector for sparse index": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{
+					"splade": {VectorIndexConfig: sparse.UserConfig{}},
+					"dense":  {VectorIndexConfig: hnsw.UserConfig{}},
+				},
+			},
+			obj: &models.Object{
+				Vectors: models.Vectors{"splade": []float32{1, 2, 3}},
+			},
+			expErr: true,
+		},
+		"single vector with single sparse named vector": {
+			class: &models.Class{
+				VectorConfig: map[string]models.VectorConfig{"splade": {VectorIndexConfig: sparse.UserConfig{}}},
+			},
+			obj:    &models.Object{Vector: []float32{1, 2, 3}},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -25,12 +25,16 @@ type (
 	nodeShardDist map[string]ShardDist
 )
 
-// distributions returns shard distribution for local node as well as remote nodes
+// distributions returns shard distribution for local node as well as remote nodes.
+// Shards which don't get any new replica are left out.
 func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	localDist := make(ShardDist, len(before.Physical))
 	nodeDist := make(map[string]ShardDist)
 	for name := range before.Physical {
 		newNodes := difference(after.Physical[name].BelongsToNodes, before.Physical[name].BelongsToNodes)
+		if len(newNodes) == 0 {
+			continue
+		}
 		if before.IsLocalShard(name) {
 			localDist[name] = newNodes
 		} else {
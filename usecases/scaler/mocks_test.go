@@ -14,6 +14,7 @@ package scaler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/sirupsen/logrus"
@@ -80,9 +81,25 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 type fakeShardingState struct {
 	LocalNode string
 	M         map[string][]string
+	// State is returned instead of a state built from M if set
+	State *sharding.State
+	// Version is the applied schema version, waiting for a later one fails
+	Version uint64
+}
+
+func (f *fakeShardingState) WaitForUpdate(ctx context.Context, version uint64) error {
+	if version > f.Version {
+		return fmt.Errorf("schema version %d not applied, at %d", version, f.Version)
+	}
+	return nil
 }
 
 func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
+	if f.State != nil {
+		state := f.State.DeepCopy()
+		state.SetLocalName(f.LocalNode)
+		return &state
+	}
 	if len(f.M) == 0 {
 		return nil
 	}
@@ -110,6 +127,11 @@ func newFakeNodeResolver(localNode string, nodeHostMap map[string]string) *fakeN
 	return &fakeNodeResolver{NodeName: localNode, M: nodeHostMap, NodeSelector: mocks.NewMockNodeSelector(names...)}
 }
 
+// LocalName needed to override the common cluster.NodeSelector
+func (r *fakeNodeResolver) LocalName() string {
+	return r.NodeName
+}
+
 // NodeHostname needed to override the common cluster.NodeSelector
 func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
 	host, ok := r.M[nodeName]
@@ -160,3 +182,35 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (s *fakeSource) StartReshard(ctx context.Context, class string, shards, copyShards []string,
+	state *sharding.State,
+) error {
+	args := s.Called(ctx, class, shards, copyShards, state)
+	return args.Error(0)
+}
+
+func (s *fakeSource) FinishReshard(ctx context.Context, class string, state *sharding.State) error {
+	args := s.Called(ctx, class, state)
+	return args.Error(0)
+}
+
+func (s *fakeSource) AbortReshard(ctx context.Context, class string, state *sharding.State) error {
+	args := s.Called(ctx, class, state)
+	return args.Error(0)
+}
+
+func (f *fakeClient) Reshard(ctx context.Context, host, class string, req ReshardRequest) error {
+	args := f.Called(ctx, host, class, req)
+	return args.Error(0)
+}
+
+func (s *fakeSource) SyncShardReplicas(ctx context.Context, class, shard string) error {
+	args := s.Called(ctx, class, shard)
+	return args.Error(0)
+}
+
+func (f *fakeClient) SyncReplica(ctx context.Context, host, class, shard string) error {
+	args := f.Called(ctx, host, class, shard)
+	return args.Error(0)
+}
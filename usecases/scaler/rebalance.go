@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"sort"
+)
+
+// ReplicaLoad is the number of objects of a shard replica on a node
+type ReplicaLoad struct {
+	Class   string
+	Shard   string
+	Node    string
+	Objects int64
+	// Pinned replicas count towards the load of their node but are not moved
+	Pinned bool
+}
+
+// ReplicaMove moves the replica of a shard from one node to another
+type ReplicaMove struct {
+	Class   string
+	Shard   string
+	Source  string
+	Target  string
+	Objects int64
+}
+
+// PlanRebalance proposes up to maxMoves replica moves which even out the
+// number of objects stored by nodes.
+//
+// Each move takes a replica from the node storing the most objects to the
+// node storing the fewest objects which doesn't hold a replica of the same
+// shard yet. The replica is chosen to bring both nodes as close as possible
+// to each other, moves which wouldn't reduce the difference are not
+// proposed. Replicas on nodes missing from nodes are left in place and such
+// nodes don't receive replicas.
+func PlanRebalance(nodes []string, replicas []ReplicaLoad, maxMoves int) []ReplicaMove {
+	load := make(map[string]int64, len(nodes))
+	for _, node := range nodes {
+		load[node] = 0
+	}
+	type shardKey struct{ class, shard string }
+	holders := make(map[shardKey]map[string]bool)
+	byNode := make(map[string][]ReplicaLoad)
+	for _, r := range replicas {
+		key := shardKey{r.Class, r.Shard}
+		if holders[key] == nil {
+			holders[key] = make(map[string]bool)
+		}
+		holders[key][r.Node] = true
+		if _, ok := load[r.Node]; !ok {
+			continue
+		}
+		load[r.Node] += r.Objects
+		byNode[r.Node] = append(byNode[r.Node], r)
+	}
+
+	var moves []ReplicaMove
+	for len(moves) < maxMoves {
+		// nodes by descending load, ties broken by name for stable plans
+		sorted := make([]string, 0, len(load))
+		for node := range load {
+			sorted = append(sorted, node)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			if load[sorted[i]] != load[sorted[j]] {
+				return load[sorted[i]] > load[sorted[j]]
+			}
+			return sorted[i] < sorted[j]
+		})
+		if len(sorted) < 2 {
+			break
+		}
+
+		source := sorted[0]
+		best, bestTarget, bestGap := -1, "", int64(0)
+		for i, r := range byNode[source] {
+			if r.Pinned || r.Objects == 0 {
+				continue
+			}
+			// the least loaded node not holding the shard yet
+			for j := len(sorted) - 1; j > 0; j-- {
+				target := sorted[j]
+				if holders[shardKey{r.Class, r.Shard}][target] {
+					continue
+				}
+				gap := load[source] - load[target]
+				if r.Objects >= gap {
+					break
+				}
+				// the difference between both nodes after the move
+				after := abs(gap - 2*r.Objects)
+				if best < 0 || after < bestGap {
+					best, bestTarget, bestGap = i, target, after
+				}
+				break
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		r := byNode[source][best]
+		moves = append(moves, ReplicaMove{
+			Class:   r.Class,
+			Shard:   r.Shard,
+			Source:  source,
+			Target:  bestTarget,
+			Objects: r.Objects,
+		})
+		byNode[source] = append(byNode[source][:best:best], byNode[source][best+1:]...)
+		r.Node = bestTarget
+		byNode[bestTarget] = append(byNode[bestTarget], r)
+		delete(holders[shardKey{r.Class, r.Shard}], source)
+		holders[shardKey{r.Class, r.Shard}][bestTarget] = true
+		load[source] -= r.Objects
+		load[bestTarget] += r.Objects
+	}
+	return moves
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRebalance(t *testing.T) {
+	t.Run("BalancedCluster", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 100},
+			{Class: "C", Shard: "S2", Node: "N2", Objects: 100},
+		}
+		assert.Empty(t, PlanRebalance([]string{"N1", "N2"}, replicas, 10))
+	})
+
+	t.Run("MovesToEmptyNode", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 100},
+			{Class: "C", Shard: "S2", Node: "N1", Objects: 60},
+			{Class: "C", Shard: "S3", Node: "N1", Objects: 40},
+			{Class: "C", Shard: "S4", Node: "N2", Objects: 100},
+		}
+		moves := PlanRebalance([]string{"N1", "N2", "N3"}, replicas, 10)
+		assert.Equal(t, []ReplicaMove{
+			{Class: "C", Shard: "S1", Source: "N1", Target: "N3", Objects: 100},
+		}, moves)
+	})
+
+	t.Run("MaxMoves", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 10},
+			{Class: "C", Shard: "S2", Node: "N1", Objects: 10},
+			{Class: "C", Shard: "S3", Node: "N1", Objects: 10},
+			{Class: "C", Shard: "S4", Node: "N1", Objects: 10},
+		}
+		assert.Len(t, PlanRebalance([]string{"N1", "N2"}, replicas, 2), 2)
+		assert.Len(t, PlanRebalance([]string{"N1", "N2"}, replicas, 1), 1)
+	})
+
+	t.Run("NoTwoReplicasOnSameNode", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 100},
+			{Class: "C", Shard: "S1", Node: "N2", Objects: 100},
+		}
+		assert.Empty(t, PlanRebalance([]string{"N1", "N2"}, replicas, 10))
+	})
+
+	t.Run("PinnedReplicasStay", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 100, Pinned: true},
+			{Class: "D", Shard: "S1", Node: "N1", Objects: 50},
+		}
+		assert.Equal(t, []ReplicaMove{
+			{Class: "D", Shard: "S1", Source: "N1", Target: "N2", Objects: 50},
+		}, PlanRebalance([]string{"N1", "N2"}, replicas, 10))
+	})
+
+	t.Run("UnhealthyNodesReceiveNothing", func(t *testing.T) {
+		replicas := []ReplicaLoad{
+			{Class: "C", Shard: "S1", Node: "N1", Objects: 100},
+			{Class: "C", Shard: "S2", Node: "N1", Objects: 100},
+		}
+		// N3 is not passed as a node
+		assert.Empty(t, PlanRebalance([]string{"N1"}, replicas, 10))
+	})
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ErrInvalidReplicaMove a replica cannot be copied or moved as requested
+var ErrInvalidReplicaMove = errors.New("invalid replica move")
+
+// ReplicaSyncer brings the replicas of a shard in sync
+type ReplicaSyncer interface {
+	// SyncShardReplicas propagates the objects of a local shard which are
+	// missing or outdated on its other replicas
+	SyncShardReplicas(ctx context.Context, className, shard string) error
+}
+
+// CopyReplica copies the replica of a shard on source to target.
+//
+// The shard files are pushed from a snapshot of the source replica as in
+// scaleOut. It returns the sharding state in which target holds a replica of
+// the shard as well. The caller must commit that state, after which target
+// receives all writes to the shard. Writes between the snapshot and the
+// commit are propagated by async replication, see SyncReplica.
+func (s *Scaler) CopyReplica(ctx context.Context, className, shard, source, target string,
+) (*sharding.State, error) {
+	ssBefore := s.schemaReader.CopyShardingState(className)
+	if ssBefore == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+	phys, ok := ssBefore.Physical[shard]
+	if !ok {
+		return nil, fmt.Errorf("%w: class %q has no shard %q", ErrInvalidReplicaMove, className, shard)
+	}
+	if ssBefore.PartitioningEnabled && phys.ActivityStatus() != models.TenantActivityStatusHOT {
+		return nil, fmt.Errorf("%w: tenant %q is not active", ErrInvalidReplicaMove, shard)
+	}
+	if !slices.Contains(phys.BelongsToNodes, source) {
+		return nil, fmt.Errorf("%w: node %q holds no replica of shard %q", ErrInvalidReplicaMove, source, shard)
+	}
+	if slices.Contains(phys.BelongsToNodes, target) {
+		return nil, fmt.Errorf("%w: node %q already holds a replica of shard %q", ErrInvalidReplicaMove, target, shard)
+	}
+	if !slices.Contains(s.cluster.StorageCandidates(), target) {
+		return nil, fmt.Errorf("%w: node %q is not a storage node", ErrInvalidReplicaMove, target)
+	}
+
+	dist := ShardDist{shard: {target}}
+	if source == s.cluster.LocalName() {
+		err := s.LocalScaleOut(ctx, className, dist)
+		if err != nil {
+			return nil, fmt.Errorf("copy shard %q to node %q: %w", shard, target, err)
+		}
+	} else {
+		host, ok := s.cluster.NodeHostname(source)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnresolvedName, source)
+		}
+		if err := s.client.IncreaseReplicationFactor(ctx, host, className, dist); err != nil {
+			return nil, fmt.Errorf("copy shard %q from node %q to node %q: %w", shard, source, target, err)
+		}
+	}
+
+	ssAfter := ssBefore.DeepCopy()
+	phys = ssAfter.Physical[shard]
+	phys.BelongsToNodes = append(slices.Clone(phys.BelongsToNodes), target)
+	ssAfter.Physical[shard] = phys
+	return &ssAfter, nil
+}
+
+// SyncReplica propagates the objects of the replica of a shard on node which
+// are missing or outdated on the other replicas of the shard. It requires
+// async replication to be enabled for the class.
+func (s *Scaler) SyncReplica(ctx context.Context, className, shard, node string) error {
+	if node == s.cluster.LocalName() {
+		return s.LocalSyncReplica(ctx, className, shard)
+	}
+	host, ok := s.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
+	}
+	return s.client.SyncReplica(ctx, host, className, shard)
+}
+
+// LocalSyncReplica propagates the objects of a local shard which are missing
+// or outdated on its other replicas
+func (s *Scaler) LocalSyncReplica(ctx context.Context, className, shard string) error {
+	return s.source.SyncShardReplicas(ctx, className, shard)
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestScalerCopyReplica(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NoShardingState", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.M = nil
+		_, err := f.Scaler("").CopyReplica(ctx, "C", "S1", "N1", "N2")
+		assert.ErrorContains(t, err, "no sharding state")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		scaler := newFakeFactory().Scaler("")
+		for _, tc := range []struct {
+			shard, source, target, msg string
+		}{
+			{"S2", "N1", "N2", "has no shard"},
+			{"S1", "N2", "N3", "holds no replica"},
+			{"S3", "N3", "N4", "already holds a replica"},
+			{"S1", "N1", "N5", "not a storage node"},
+		} {
+			_, err := scaler.CopyReplica(ctx, "C", tc.shard, tc.source, tc.target)
+			assert.ErrorIs(t, err, ErrInvalidReplicaMove)
+			assert.ErrorContains(t, err, tc.msg)
+		}
+	})
+
+	t.Run("RemoteSource", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H4", "C", ShardDist{"S3": {"N2"}}).Return(nil)
+
+		ss, err := f.Scaler("").CopyReplica(ctx, "C", "S3", "N4", "N2")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"N3", "N4", "N2"}, ss.Physical["S3"].BelongsToNodes)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("LocalSourceFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Source.On("ShardsBackup", anyVal, anyVal, "C", []string{"S1"}).
+			Return(backup.ClassDescriptor{}, errAny)
+
+		_, err := f.Scaler("").CopyReplica(ctx, "C", "S1", "N1", "N2")
+		assert.ErrorIs(t, err, errAny)
+		f.Client.AssertNotCalled(t, "IncreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+	})
+}
+
+func TestScalerSyncReplica(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeFactory()
+	f.Source.On("SyncShardReplicas", ctx, "C", "S1").Return(nil)
+	f.Client.On("SyncReplica", ctx, "H3", "C", "S3").Return(errAny)
+	scaler := f.Scaler("")
+
+	assert.Nil(t, scaler.SyncReplica(ctx, "C", "S1", "N1"))
+	assert.ErrorIs(t, scaler.SyncReplica(ctx, "C", "S3", "N3"), errAny)
+	assert.ErrorIs(t, scaler.SyncReplica(ctx, "C", "S3", "N9"), ErrUnresolvedName)
+}
@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ReshardPhase is a step of resharding a class
+type ReshardPhase string
+
+const (
+	// ReshardStart starts copying objects to their new shards
+	ReshardStart ReshardPhase = "start"
+	// ReshardAwait waits until the node applied the committed state
+	ReshardAwait ReshardPhase = "await"
+	// ReshardFinish deletes objects from the shards they moved away from
+	ReshardFinish ReshardPhase = "finish"
+	// ReshardAbort drops the new shards
+	ReshardAbort ReshardPhase = "abort"
+)
+
+// ReshardRequest asks a node to execute a phase of resharding a class
+type ReshardRequest struct {
+	Phase ReshardPhase `json:"phase"`
+	// Shards are the local shards whose changed objects are mirrored (start only)
+	Shards []string `json:"shards,omitempty"`
+	// Copy are the shards of Shards whose existing objects are copied by
+	// this node (start only)
+	Copy []string `json:"copy,omitempty"`
+	// State is the sharding state after resharding
+	State *sharding.State `json:"state"`
+	// Version is the schema version which committed State (await only)
+	Version uint64 `json:"version,omitempty"`
+}
+
+// reshardAwaitTimeout bounds the wait for all nodes to apply the resharded
+// state
+const reshardAwaitTimeout = time.Minute
+
+// Resharder moves the objects of class shards to other shards
+type Resharder interface {
+	// StartReshard mirrors the changes of the objects of local shards which
+	// move to another shard according to state. The existing objects of
+	// copyShards are copied as well.
+	StartReshard(ctx context.Context, className string, shards, copyShards []string, state *sharding.State) error
+	// FinishReshard stops mirroring and deletes the objects local shards
+	// don't own anymore according to state
+	FinishReshard(ctx context.Context, className string, state *sharding.State) error
+	// AbortReshard stops mirroring and drops the local shards created for state
+	AbortReshard(ctx context.Context, className string, state *sharding.State) error
+}
+
+// Reshard splits the shards of a non multi-tenant class into count shards
+// while the class keeps serving reads and writes:
+//
+// * It assigns the virtual shards to count physical shards, new physical
+// shards are placed on the least loaded storage nodes
+// * Every replica of a shard losing virtual shards mirrors the changes of the
+// affected objects to all replicas of their new shard, so writes reaching any
+// replica are moved. The primary replica copies the existing objects as well.
+//
+// It returns the resharded state once all objects are copied. The caller
+// must then commit the state and call FinishReshard, or AbortReshard if the
+// commit fails. Only one reshard of a class may run at a time.
+func (s *Scaler) Reshard(ctx context.Context, className string, count int,
+	replFactor int64,
+) (*sharding.State, error) {
+	ssBefore := s.schemaReader.CopyShardingState(className)
+	if ssBefore == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+	ssAfter, err := ssBefore.Reshard(count, s.cluster.StorageCandidates(), replFactor)
+	if err != nil {
+		return nil, fmt.Errorf("reshard class %q: %w", className, err)
+	}
+
+	// changes are mirrored by all replicas of each shard losing virtual
+	// shards, the existing objects are copied by the primary replica
+	sources := make(map[string]*ReshardRequest)
+	for name, before := range ssBefore.Physical {
+		if len(ssAfter.Physical[name].OwnsVirtual) >= len(before.OwnsVirtual) {
+			continue
+		}
+		for _, node := range before.BelongsToNodes {
+			req, ok := sources[node]
+			if !ok {
+				req = &ReshardRequest{Phase: ReshardStart, State: &ssAfter}
+				sources[node] = req
+			}
+			req.Shards = append(req.Shards, name)
+			if node == before.BelongsToNode() {
+				req.Copy = append(req.Copy, name)
+			}
+		}
+	}
+
+	eg, gctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
+	for node, req := range sources {
+		node, req := node, req
+		sort.Strings(req.Shards)
+		sort.Strings(req.Copy)
+		eg.Go(func() error {
+			if err := s.reshardOnNode(gctx, node, className, *req); err != nil {
+				return fmt.Errorf("copy shards %v of class %q on node %q: %w", req.Shards, className, node, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		if abortErr := s.AbortReshard(context.Background(), className, &ssAfter); abortErr != nil {
+			s.logger.WithField("action", "reshard").WithField("class", className).
+				WithError(abortErr).Error("abort reshard")
+		}
+		return nil, err
+	}
+	return &ssAfter, nil
+}
+
+// FinishReshard waits until all nodes applied the schema version which
+// committed state. Until then nodes may still route writes to the previous
+// shards, so mirroring must go on. It then tells all nodes holding shards of
+// state to stop mirroring and to delete the objects their shards don't own
+// anymore.
+func (s *Scaler) FinishReshard(ctx context.Context, className string,
+	state *sharding.State, version uint64,
+) error {
+	nodes := slices.Concat(s.cluster.StorageCandidates(), s.cluster.NonStorageNodes())
+	actx, cancel := context.WithTimeout(ctx, reshardAwaitTimeout)
+	defer cancel()
+	await := ReshardRequest{Phase: ReshardAwait, State: state, Version: version}
+	if err := s.reshardOnEachNode(actx, className, nodes, await); err != nil {
+		return err
+	}
+
+	return s.reshardOnNodes(ctx, className, ReshardRequest{Phase: ReshardFinish, State: state})
+}
+
+// AbortReshard tells all nodes holding shards of state to stop mirroring and
+// to drop the shards created for state
+func (s *Scaler) AbortReshard(ctx context.Context, className string, state *sharding.State) error {
+	return s.reshardOnNodes(ctx, className, ReshardRequest{Phase: ReshardAbort, State: state})
+}
+
+func (s *Scaler) reshardOnNodes(ctx context.Context, className string, req ReshardRequest) error {
+	var nodes []string
+	for _, shard := range req.State.Physical {
+		for _, node := range shard.BelongsToNodes {
+			if !slices.Contains(nodes, node) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return s.reshardOnEachNode(ctx, className, nodes, req)
+}
+
+func (s *Scaler) reshardOnEachNode(ctx context.Context, className string,
+	nodes []string, req ReshardRequest,
+) error {
+	nodes = slices.Clone(nodes)
+	sort.Strings(nodes)
+	nodes = slices.Compact(nodes)
+
+	eg := enterrors.NewErrorGroupWrapper(s.logger)
+	for _, node := range nodes {
+		node := node
+		eg.Go(func() error {
+			if err := s.reshardOnNode(ctx, node, className, req); err != nil {
+				return fmt.Errorf("%s reshard of class %q on node %q: %w", req.Phase, className, node, err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+func (s *Scaler) reshardOnNode(ctx context.Context, node, className string, req ReshardRequest) error {
+	if node == s.cluster.LocalName() {
+		return s.LocalReshard(ctx, className, req)
+	}
+	host, ok := s.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
+	}
+	return s.client.Reshard(ctx, host, className, req)
+}
+
+// LocalReshard executes a phase of resharding a class on this node
+func (s *Scaler) LocalReshard(ctx context.Context, className string, req ReshardRequest) error {
+	if req.State == nil {
+		return fmt.Errorf("reshard class %q: missing sharding state", className)
+	}
+	switch req.Phase {
+	case ReshardStart:
+		return s.source.StartReshard(ctx, className, req.Shards, req.Copy, req.State)
+	case ReshardAwait:
+		return s.schemaReader.WaitForUpdate(ctx, req.Version)
+	case ReshardFinish:
+		return s.source.FinishReshard(ctx, className, req.State)
+	case ReshardAbort:
+		return s.source.AbortReshard(ctx, className, req.State)
+	default:
+		return fmt.Errorf("reshard class %q: unknown phase %q", className, req.Phase)
+	}
+}
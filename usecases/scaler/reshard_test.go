@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// reshardFactory returns a factory for class "C" with shards on N1 and N2
+func reshardFactory(t *testing.T) (*fakeFactory, []string) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, 4)
+	require.Nil(t, err)
+	state, err := sharding.InitState("C", cfg, localNode, []string{"N1", "N2"}, 1, false)
+	require.Nil(t, err)
+	names := state.AllPhysicalShards()
+	sort.Strings(names)
+	for i, node := range []string{"N1", "N2"} {
+		shard := state.Physical[names[i]]
+		shard.BelongsToNodes = []string{node}
+		state.Physical[names[i]] = shard
+	}
+
+	f := newFakeFactory()
+	f.ShardingState.State = state
+	return f, names
+}
+
+func TestScalerReshard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NoShardingState", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.M = nil
+		_, err := f.Scaler("").Reshard(ctx, "C", 4, 1)
+		assert.ErrorContains(t, err, "no sharding state")
+	})
+
+	t.Run("InvalidCount", func(t *testing.T) {
+		f, _ := reshardFactory(t)
+		_, err := f.Scaler("").Reshard(ctx, "C", 2, 1)
+		assert.ErrorContains(t, err, "can only be increased")
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		f, names := reshardFactory(t)
+		isStart := mock.MatchedBy(func(r ReshardRequest) bool {
+			return r.Phase == ReshardStart && len(r.Shards) == 1 && r.Shards[0] == names[1]
+		})
+		f.Source.On("StartReshard", anyVal, "C", []string{names[0]}, []string{names[0]}, anyVal).Return(nil)
+		f.Client.On("Reshard", anyVal, "H2", "C", isStart).Return(nil)
+
+		ss, err := f.Scaler("").Reshard(ctx, "C", 4, 1)
+		require.Nil(t, err)
+		require.NotNil(t, ss)
+		assert.Len(t, ss.Physical, 4)
+		assert.Equal(t, 4, ss.Config.DesiredCount)
+		f.Source.AssertExpectations(t)
+		f.Client.AssertExpectations(t)
+		f.Source.AssertNotCalled(t, "AbortReshard", anyVal, anyVal, anyVal)
+	})
+
+	t.Run("CopyFails", func(t *testing.T) {
+		f, names := reshardFactory(t)
+		isPhase := func(p ReshardPhase) interface{} {
+			return mock.MatchedBy(func(r ReshardRequest) bool { return r.Phase == p })
+		}
+		f.Source.On("StartReshard", anyVal, "C", []string{names[0]}, []string{names[0]}, anyVal).Return(nil)
+		f.Client.On("Reshard", anyVal, "H2", "C", isPhase(ReshardStart)).Return(errAny)
+		// all nodes holding shards after resharding drop the new ones
+		f.Source.On("AbortReshard", anyVal, "C", anyVal).Return(nil)
+		f.Client.On("Reshard", anyVal, anyVal, "C", isPhase(ReshardAbort)).Return(nil)
+
+		_, err := f.Scaler("").Reshard(ctx, "C", 4, 1)
+		assert.ErrorIs(t, err, errAny)
+		f.Source.AssertCalled(t, "AbortReshard", anyVal, "C", anyVal)
+		f.Client.AssertCalled(t, "Reshard", anyVal, "H2", "C", isPhase(ReshardAbort))
+	})
+
+	t.Run("AllReplicasMirror", func(t *testing.T) {
+		f, names := reshardFactory(t)
+		for _, name := range names {
+			shard := f.ShardingState.State.Physical[name]
+			shard.BelongsToNodes = append(shard.BelongsToNodes, "N3")
+			f.ShardingState.State.Physical[name] = shard
+		}
+		// N3 holds a replica of both shards, but is the primary of neither
+		isStart := mock.MatchedBy(func(r ReshardRequest) bool {
+			return r.Phase == ReshardStart && len(r.Shards) == 2 && len(r.Copy) == 0
+		})
+		f.Source.On("StartReshard", anyVal, "C", []string{names[0]}, []string{names[0]}, anyVal).Return(nil)
+		f.Client.On("Reshard", anyVal, "H2", "C", mock.Anything).Return(nil)
+		f.Client.On("Reshard", anyVal, "H3", "C", isStart).Return(nil)
+
+		_, err := f.Scaler("").Reshard(ctx, "C", 4, 2)
+		require.Nil(t, err)
+		f.Source.AssertExpectations(t)
+		f.Client.AssertExpectations(t)
+	})
+}
+
+func TestScalerFinishReshard(t *testing.T) {
+	ctx := context.Background()
+	isPhase := func(p ReshardPhase) interface{} {
+		return mock.MatchedBy(func(r ReshardRequest) bool { return r.Phase == p })
+	}
+	state := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {BelongsToNodes: []string{"N1"}},
+		"S2": {BelongsToNodes: []string{"N2"}},
+	}}
+
+	t.Run("AllNodesApplyTheStateFirst", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.Version = 7
+		isAwait := mock.MatchedBy(func(r ReshardRequest) bool {
+			return r.Phase == ReshardAwait && r.Version == 7
+		})
+		for _, host := range []string{"H2", "H3", "H4"} {
+			f.Client.On("Reshard", anyVal, host, "C", isAwait).Return(nil).Once()
+		}
+		f.Source.On("FinishReshard", anyVal, "C", state).Return(nil)
+		f.Client.On("Reshard", anyVal, "H2", "C", isPhase(ReshardFinish)).Return(nil).Once()
+
+		require.Nil(t, f.Scaler("").FinishReshard(ctx, "C", state, 7))
+		f.Source.AssertExpectations(t)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("NodeBehind", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.Version = 6
+		f.Client.On("Reshard", anyVal, anyVal, "C", isPhase(ReshardAwait)).Return(nil)
+
+		err := f.Scaler("").FinishReshard(ctx, "C", state, 7)
+		assert.ErrorContains(t, err, "not applied")
+		// mirroring goes on and no object is deleted
+		f.Source.AssertNotCalled(t, "FinishReshard", anyVal, anyVal, anyVal)
+		f.Client.AssertNotCalled(t, "Reshard", anyVal, anyVal, "C", isPhase(ReshardFinish))
+	})
+}
+
+func TestScalerLocalReshard(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		state = &sharding.State{}
+	)
+	f := newFakeFactory()
+	scaler := f.Scaler("")
+	f.Source.On("StartReshard", ctx, "C", []string{"S1"}, []string{"S1"}, state).Return(nil)
+	f.Source.On("FinishReshard", ctx, "C", state).Return(nil)
+	f.Source.On("AbortReshard", ctx, "C", state).Return(errAny)
+
+	assert.Nil(t, scaler.LocalReshard(ctx, "C",
+		ReshardRequest{Phase: ReshardStart, Shards: []string{"S1"}, Copy: []string{"S1"}, State: state}))
+	assert.Nil(t, scaler.LocalReshard(ctx, "C", ReshardRequest{Phase: ReshardAwait, State: state}))
+	assert.Nil(t, scaler.LocalReshard(ctx, "C", ReshardRequest{Phase: ReshardFinish, State: state}))
+	assert.ErrorIs(t, scaler.LocalReshard(ctx, "C", ReshardRequest{Phase: ReshardAbort, State: state}), errAny)
+	assert.ErrorContains(t, scaler.LocalReshard(ctx, "C", ReshardRequest{Phase: "split", State: state}), "unknown phase")
+	assert.ErrorContains(t, scaler.LocalReshard(ctx, "C", ReshardRequest{Phase: ReshardFinish}), "missing sharding state")
+}
@@ -41,6 +41,13 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// Reshard executes a phase of resharding a class on the remote node
+	Reshard(ctx context.Context, host, class string, req ReshardRequest) error
+
+	// SyncReplica propagates the objects of a shard replica on the remote node
+	// which are missing or outdated on the other replicas
+	SyncReplica(ctx context.Context, host, class, shard string) error
 }
 
 // rsync synchronizes shards with remote nodes
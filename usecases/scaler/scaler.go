@@ -15,6 +15,8 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"slices"
+	"sort"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
@@ -33,29 +36,31 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
 	ErrUnresolvedName = errors.New("cannot resolve node name")
-	_NUMCPU           = runtime.NumCPU()
+	// ErrNoDrainTarget no other storage node can hold a replica of a drained node
+	ErrNoDrainTarget = errors.New("no storage node left to hold replica")
+	_NUMCPU          = runtime.NumCPU()
 )
 
-// Scaler scales out/in class replicas.
+// Scaler scales out/in class replicas and reshards classes.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas and scales
+// it in by removing replicas from the sharding state. Nodes drop the local
+// shards they no longer own once the new state has been applied.
 type Scaler struct {
 	schemaReader    SchemaReader
 	cluster         cluster.NodeSelector
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          Source // data source
+	client          client // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
 }
 
 // New returns a new instance of Scaler
-func New(cl cluster.NodeSelector, source BackUpper,
+func New(cl cluster.NodeSelector, source Source,
 	c client, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
@@ -67,6 +72,13 @@ func New(cl cluster.NodeSelector, source BackUpper,
 	}
 }
 
+// Source is the local data the scaler copies to other nodes
+type Source interface {
+	BackUpper
+	Resharder
+	ReplicaSyncer
+}
+
 // BackUpper is used to back up shards of a specific class
 type BackUpper interface {
 	// ShardsBackup returns class backup descriptor for a list of shards
@@ -78,6 +90,8 @@ type BackUpper interface {
 // SchemaReader is used by the scaler to get and update sharding states
 type SchemaReader interface {
 	CopyShardingState(class string) *sharding.State
+	// WaitForUpdate waits until the local schema caught up to version
+	WaitForUpdate(ctx context.Context, version uint64) error
 }
 
 func (s *Scaler) SetSchemaReader(sr SchemaReader) {
@@ -104,12 +118,89 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	}
 
 	if newReplFactor < prevReplFactor {
-		return nil, errors.Errorf("scaling in not supported yet")
+		return s.scaleIn(className, ssBefore, updated, newReplFactor)
 	}
 
 	return nil, nil
 }
 
+// scaleIn removes replicas of class shards until each shard belongs to
+// replFactor nodes.
+//
+// No data needs to be moved, the remaining replicas already hold a copy of
+// each shard. The removed replicas delete their local copy when the returned
+// state is applied on them.
+func (s *Scaler) scaleIn(className string, ssBefore *sharding.State,
+	updated config.Config, replFactor int64,
+) (*sharding.State, error) {
+	if replFactor < 1 {
+		return nil, fmt.Errorf("scale in class %q: replication factor must be at least 1, got %d",
+			className, replFactor)
+	}
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+	for name, shard := range ssAfter.Physical {
+		if err := shard.AdjustReplicas(int(replFactor), s.cluster); err != nil {
+			return nil, err
+		}
+		ssAfter.Physical[name] = shard
+	}
+	return &ssAfter, nil
+}
+
+// PlanDrain picks a new node for each replica of a class shard on node. The
+// replicas are spread over the storage nodes which don't hold the shard yet,
+// favoring the nodes holding the fewest replicas of the class.
+//
+// Nothing is copied, the caller moves each replica to its target. Replicas of
+// inactive tenants cannot be copied and are returned as skipped instead.
+func (s *Scaler) PlanDrain(className, node string) (targets map[string]string, skipped []string, err error) {
+	ss := s.schemaReader.CopyShardingState(className)
+	if ss == nil {
+		return nil, nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+
+	// count replicas per node so that moved replicas are spread evenly
+	load := make(map[string]int)
+	names := make([]string, 0, len(ss.Physical))
+	for name, shard := range ss.Physical {
+		for _, n := range shard.BelongsToNodes {
+			load[n]++
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	targets = make(map[string]string)
+	candidates := s.cluster.StorageCandidates()
+	for _, name := range names {
+		shard := ss.Physical[name]
+		if !slices.Contains(shard.BelongsToNodes, node) {
+			continue
+		}
+		if ss.PartitioningEnabled && shard.ActivityStatus() != models.TenantActivityStatusHOT {
+			skipped = append(skipped, name)
+			continue
+		}
+		target := ""
+		for _, c := range candidates {
+			if c == node || slices.Contains(shard.BelongsToNodes, c) {
+				continue
+			}
+			if target == "" || load[c] < load[target] {
+				target = c
+			}
+		}
+		if target == "" {
+			return nil, nil, fmt.Errorf("%w: drain node %q: shard %q of class %q, "+
+				"reduce the replication factor first", ErrNoDrainTarget, node, name, className)
+		}
+		targets[name] = target
+		load[target]++
+	}
+	return targets, skipped, nil
+}
+
 // scaleOut replicate class shards on new replicas (nodes):
 //
 // * It calculates new sharding state
@@ -133,13 +224,32 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		ssAfter.Physical[name] = shard
 	}
-	lDist, nodeDist := distributions(ssBefore, &ssAfter)
+	if err := s.replicate(ctx, className, ssBefore, &ssAfter); err != nil {
+		return nil, err
+	}
+
+	// Finally, return sharding state back to schema manager. The schema manager
+	// will then broadcast this updated state to the cluster. This is essentially
+	// what will take the new replication shards live: On the new nodes, if
+	// traffic is incoming, IsShardLocal() would have returned false before. But
+	// now that a copy of the local shard is present it will return true and
+	// serve the traffic.
+	return &ssAfter, nil
+}
+
+// replicate copies each shard to the nodes it belongs to in ssAfter but not
+// in ssBefore. Local shards are pushed by this node, remote ones by their
+// owners.
+func (s *Scaler) replicate(ctx context.Context, className string,
+	ssBefore, ssAfter *sharding.State,
+) error {
+	lDist, nodeDist := distributions(ssBefore, ssAfter)
 	g, ctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
 	// resolve hosts beforehand
 	nodes := nodeDist.nodes()
 	hosts, err := hosts(nodes, s.cluster)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for i, node := range nodes {
 		dist := nodeDist[node]
@@ -159,17 +269,7 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		return nil
 	})
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
-
-	// Finally, return sharding state back to schema manager. The schema manager
-	// will then broadcast this updated state to the cluster. This is essentially
-	// what will take the new replication shards live: On the new nodes, if
-	// traffic is incoming, IsShardLocal() would have returned false before. But
-	// now that a copy of the local shard is present it will return true and
-	// serve the traffic.
-	return &ssAfter, nil
+	return g.Wait()
 }
 
 // LocalScaleOut syncs local shards with new replicas.
@@ -19,7 +19,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
@@ -40,12 +43,84 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
+	t.Run("ScaleInToZero", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
 		old := config.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
+		_, err := scaler.Scale(ctx, "C", old, 2, 0)
 		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+		assert.Contains(t, err.Error(), "at least 1")
+	})
+}
+
+func TestScalerScaleIn(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		updated = config.Config{DesiredCount: 3}
+	)
+	f := newFakeFactory()
+	f.ShardingState.M["S4"] = []string{"N2", "N3", "N4"}
+	scaler := f.Scaler("")
+
+	// no data is copied when removing replicas
+	ss, err := scaler.Scale(ctx, "C", updated, 3, 1)
+	require.Nil(t, err)
+	require.NotNil(t, ss)
+	assert.Equal(t, updated, ss.Config)
+	assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+	assert.Equal(t, []string{"N3"}, ss.Physical["S3"].BelongsToNodes)
+	assert.Equal(t, []string{"N2"}, ss.Physical["S4"].BelongsToNodes)
+	f.Source.AssertNotCalled(t, "ShardsBackup", anyVal, anyVal, anyVal, anyVal)
+	f.Client.AssertNotCalled(t, "IncreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+}
+
+func TestScalerPlanDrain(t *testing.T) {
+	cls := "C"
+
+	t.Run("NoShardingState", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.M = nil
+		_, _, err := f.Scaler(t.TempDir()).PlanDrain(cls, "N1")
+		assert.ErrorContains(t, err, "no sharding state")
+	})
+
+	t.Run("NodeWithoutReplicas", func(t *testing.T) {
+		f := newFakeFactory()
+		targets, skipped, err := f.Scaler(t.TempDir()).PlanDrain(cls, "N2")
+		assert.Nil(t, err)
+		assert.Empty(t, targets)
+		assert.Empty(t, skipped)
+	})
+
+	t.Run("NoTarget", func(t *testing.T) {
+		f := newFakeFactory()
+		f.NodeHostMap = map[string]string{"N3": "H3", "N4": "H4"}
+		_, _, err := f.Scaler(t.TempDir()).PlanDrain(cls, "N3")
+		assert.ErrorIs(t, err, ErrNoDrainTarget)
+	})
+
+	t.Run("LeastLoadedTarget", func(t *testing.T) {
+		f := newFakeFactory()
+		targets, skipped, err := f.Scaler(t.TempDir()).PlanDrain(cls, "N3")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"S3": "N2"}, targets)
+		assert.Empty(t, skipped)
+		f.Client.AssertNotCalled(t, "IncreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+		f.Source.AssertNotCalled(t, "ShardsBackup", anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("InactiveTenantsAreSkipped", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.State = &sharding.State{
+			PartitioningEnabled: true,
+			Physical: map[string]sharding.Physical{
+				"T1": {BelongsToNodes: []string{"N1"}, Status: models.TenantActivityStatusHOT},
+				"T2": {BelongsToNodes: []string{"N1"}, Status: models.TenantActivityStatusCOLD},
+			},
+		}
+		targets, skipped, err := f.Scaler(t.TempDir()).PlanDrain(cls, "N1")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"T1": "N2"}, targets)
+		assert.Equal(t, []string{"T2"}, skipped)
 	})
 }
 
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ErrAsyncReplicationDisabled is returned for async replication operations
+// on classes which don't have it enabled.
+var ErrAsyncReplicationDisabled = errors.New("async replication is disabled")
+
+// asyncReplicationRepairConcurrency is the number of shards of a class
+// repaired at the same time
+const asyncReplicationRepairConcurrency = 4
+
+// RepairAsyncReplication starts to bring the replicas of the shards of a
+// class, or of the given shard only, in sync. Each replica propagates the
+// objects which are missing or outdated on the others, as the hashbeater
+// would do. The repair runs in the background, its progress is reported by
+// the async replication status of each replica. Shards of inactive tenants
+// are skipped.
+func (h *Handler) RepairAsyncReplication(ctx context.Context, principal *models.Principal,
+	className, shard string,
+) (*models.AsyncReplicationRepairResponse, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster())
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := h.asyncReplicatedClass(className)
+	if err != nil {
+		return nil, err
+	}
+	state := h.schemaReader.CopyShardingState(class.Class)
+	if state == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", class.Class)
+	}
+
+	shards := state.AllPhysicalShards()
+	if shard != "" {
+		if _, ok := state.Physical[shard]; !ok {
+			return nil, fmt.Errorf("shard %q of class %q: %w", shard, class.Class, ErrNotFound)
+		}
+		shards = []string{shard}
+	}
+
+	resp := &models.AsyncReplicationRepairResponse{Class: class.Class, Shards: []string{}}
+	replicas := make(map[string][]string, len(shards))
+	for _, name := range shards {
+		physical := state.Physical[name]
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+		resp.Shards = append(resp.Shards, name)
+		replicas[name] = physical.BelongsToNodes
+	}
+
+	// the repair must not be bound to the request, it may take much longer
+	enterrors.GoWrapper(func() {
+		h.repairShards(context.Background(), class.Class, resp.Shards, replicas)
+	}, h.logger)
+	return resp, nil
+}
+
+func (h *Handler) repairShards(ctx context.Context, className string,
+	shards []string, replicas map[string][]string,
+) {
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(asyncReplicationRepairConcurrency)
+	for _, shard := range shards {
+		shard := shard
+		eg.Go(func() error {
+			for _, node := range replicas[shard] {
+				if err := h.scaleOut.SyncReplica(ctx, className, shard, node); err != nil {
+					h.logger.WithFields(logrus.Fields{
+						"action": "repair_async_replication",
+						"class":  className,
+						"shard":  shard,
+						"node":   node,
+					}).WithError(err).Error("repair shard replica")
+				}
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "repair_async_replication",
+		"class":  className,
+		"shards": len(shards),
+	}).Info("repair of shard replicas finished")
+}
+
+// PauseAsyncReplication pauses or resumes async replication of a class.
+// While paused, the replicas keep track of their changes but are not
+// compared and repaired in the background.
+func (h *Handler) PauseAsyncReplication(ctx context.Context, principal *models.Principal,
+	className string, paused bool,
+) (*models.ReplicationConfig, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(className)...)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := h.asyncReplicatedClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := *class.ReplicationConfig
+	if cfg.AsyncPaused == paused {
+		return &cfg, nil
+	}
+	cfg.AsyncPaused = paused
+
+	updated := *class
+	updated.ReplicationConfig = &cfg
+	if _, err := h.schemaManager.UpdateClass(ctx, &updated, nil); err != nil {
+		return nil, fmt.Errorf("update replication config of class %q: %w", class.Class, err)
+	}
+	return &cfg, nil
+}
+
+func (h *Handler) asyncReplicatedClass(className string) (*models.Class, error) {
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	if class.ReplicationConfig == nil || !class.ReplicationConfig.AsyncEnabled {
+		return nil, fmt.Errorf("class %q: %w", className, ErrAsyncReplicationDisabled)
+	}
+	return class, nil
+}
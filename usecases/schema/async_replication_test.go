@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeReplicaRepairer struct {
+	fakeScaleOutManager
+	sync.Mutex
+	synced []string
+}
+
+func (f *fakeReplicaRepairer) SyncReplica(ctx context.Context, className, shard, node string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.synced = append(f.synced, shard+"@"+node)
+	return nil
+}
+
+func (f *fakeReplicaRepairer) syncedReplicas() []string {
+	f.Lock()
+	defer f.Unlock()
+	synced := slices.Clone(f.synced)
+	slices.Sort(synced)
+	return synced
+}
+
+func Test_RepairAsyncReplication(t *testing.T) {
+	ctx := context.Background()
+	state := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"node-1", "node-2"}},
+			"S2": {Name: "S2", BelongsToNodes: []string{"node-2"}},
+			"S3": {Name: "S3", BelongsToNodes: []string{"node-1"}, Status: models.TenantActivityStatusCOLD},
+		},
+	}
+	asyncClass := &models.Class{
+		Class:             "A",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 2, AsyncEnabled: true},
+	}
+	newHandler := func(t *testing.T, class *models.Class) (*Handler, *fakeSchemaManager, *fakeReplicaRepairer) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		repairer := &fakeReplicaRepairer{}
+		handler.scaleOut = repairer
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class)
+		return handler, fakeSchemaManager, repairer
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, _, _ := newHandler(t, nil)
+		_, err := handler.RepairAsyncReplication(ctx, nil, "A", "")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("async replication disabled", func(t *testing.T) {
+		handler, _, _ := newHandler(t, &models.Class{
+			Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 2},
+		})
+		_, err := handler.RepairAsyncReplication(ctx, nil, "A", "")
+		assert.ErrorIs(t, err, ErrAsyncReplicationDisabled)
+	})
+
+	t.Run("unknown shard", func(t *testing.T) {
+		handler, fakeSchemaManager, _ := newHandler(t, asyncClass)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(state)
+		_, err := handler.RepairAsyncReplication(ctx, nil, "A", "S4")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("all active shards", func(t *testing.T) {
+		handler, fakeSchemaManager, repairer := newHandler(t, asyncClass)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(state)
+		resp, err := handler.RepairAsyncReplication(ctx, nil, "A", "")
+		require.Nil(t, err)
+		assert.Equal(t, &models.AsyncReplicationRepairResponse{
+			Class: "A", Shards: []string{"S1", "S2"},
+		}, resp)
+		expected := []string{"S1@node-1", "S1@node-2", "S2@node-2"}
+		assert.Eventually(t, func() bool {
+			return slices.Equal(expected, repairer.syncedReplicas())
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("single shard", func(t *testing.T) {
+		handler, fakeSchemaManager, repairer := newHandler(t, asyncClass)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(state)
+		resp, err := handler.RepairAsyncReplication(ctx, nil, "A", "S2")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"S2"}, resp.Shards)
+		assert.Eventually(t, func() bool {
+			return slices.Equal([]string{"S2@node-2"}, repairer.syncedReplicas())
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func Test_PauseAsyncReplication(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("async replication disabled", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(&models.Class{Class: "A"})
+		_, err := handler.PauseAsyncReplication(ctx, nil, "A", true)
+		assert.ErrorIs(t, err, ErrAsyncReplicationDisabled)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		class := &models.Class{
+			Class:             "A",
+			ReplicationConfig: &models.ReplicationConfig{Factor: 2, AsyncEnabled: true},
+		}
+		paused := *class
+		paused.ReplicationConfig = &models.ReplicationConfig{Factor: 2, AsyncEnabled: true, AsyncPaused: true}
+
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class).Once()
+		fakeSchemaManager.On("UpdateClass", &paused, (*sharding.State)(nil)).Return(nil).Once()
+		cfg, err := handler.PauseAsyncReplication(ctx, nil, "A", true)
+		require.Nil(t, err)
+		assert.True(t, cfg.AsyncPaused)
+		assert.False(t, class.ReplicationConfig.AsyncPaused, "read-only class must not be modified")
+
+		// resuming a running class is a no-op
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class).Once()
+		cfg, err = handler.PauseAsyncReplication(ctx, nil, "A", false)
+		require.Nil(t, err)
+		assert.False(t, cfg.AsyncPaused)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
@@ -78,6 +78,48 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Collections("class"),
 		},
+		{
+			methodName:        "ReindexVectorIndex",
+			additionalArgs:    []interface{}{"class", &models.VectorIndexReindexRequest{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("class"),
+		},
+		{
+			methodName:        "Reshard",
+			additionalArgs:    []interface{}{"class", &models.ReshardRequest{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("class"),
+		},
+		{
+			methodName:        "MoveReplica",
+			additionalArgs:    []interface{}{&models.ReplicaMoveRequest{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "Rebalance",
+			additionalArgs:    []interface{}{[]*models.NodeStatus{}, &models.ClusterRebalanceRequest{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "RepairAsyncReplication",
+			additionalArgs:    []interface{}{"className", ""},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "PauseAsyncReplication",
+			additionalArgs:    []interface{}{"className", true},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("className"),
+		},
+		{
+			methodName:        "DrainNode",
+			additionalArgs:    []interface{}{"node1"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
 		{
 			methodName:        "DeleteClass",
 			additionalArgs:    []interface{}{"somename"},
@@ -30,7 +30,9 @@ import (
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -127,11 +129,19 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 		return nil, 0, err
 	}
 
+	if err := validatePartitionKey(cls); err != nil {
+		return nil, 0, err
+	}
+
 	err = h.invertedConfigValidator(cls.InvertedIndexConfig)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if err := validateObjectTTLConfig(cls); err != nil {
+		return nil, 0, err
+	}
+
 	shardState, err := sharding.InitState(cls.Class,
 		cls.ShardingConfig.(shardingcfg.Config),
 		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), cls.ReplicationConfig.Factor,
@@ -275,6 +285,121 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 	return err
 }
 
+// ReindexVectorIndex rebuilds the vector index of a class, or of one of its
+// target vectors, with a new config. Unlike UpdateClass, the index type and
+// the settings that cannot be changed in place can be changed. The rebuild
+// happens in the background of every shard, the class is returned with the
+// new config as soon as the rebuild started.
+func (h *Handler) ReindexVectorIndex(ctx context.Context, principal *models.Principal,
+	className string, req *models.VectorIndexReindexRequest,
+) (*models.Class, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(className)...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("reindex request is required")
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return nil, ErrNotFound
+	}
+
+	// work on a copy with unparsed configs, as the update is parsed again
+	// when applied
+	b, err := json.Marshal(initial)
+	if err != nil {
+		return nil, fmt.Errorf("copy class %q: %w", className, err)
+	}
+	updated := &models.Class{}
+	if err := json.Unmarshal(b, updated); err != nil {
+		return nil, fmt.Errorf("copy class %q: %w", className, err)
+	}
+
+	var current interface{}
+	vectorIndexType := req.VectorIndexType
+	if req.TargetVector == "" {
+		if hasTargetVectors(initial) {
+			return nil, fmt.Errorf("class %q has named vectors, a target vector is required", className)
+		}
+		current = initial.VectorIndexConfig
+		if vectorIndexType == "" {
+			vectorIndexType = initial.VectorIndexType
+		}
+	} else {
+		vectorConfig, ok := initial.VectorConfig[req.TargetVector]
+		if !ok {
+			return nil, fmt.Errorf("target vector %q not found in class %q", req.TargetVector, className)
+		}
+		current = vectorConfig.VectorIndexConfig
+		if vectorIndexType == "" {
+			vectorIndexType = vectorConfig.VectorIndexType
+		}
+		if vectorConfig.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			return nil, fmt.Errorf("reindexing sparse vector indexes is not supported")
+		}
+	}
+	if vectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+		return nil, fmt.Errorf("reindexing sparse vector indexes is not supported")
+	}
+
+	vectorIndexConfig, err := reindexVectorIndexConfig(req.VectorIndexConfig, current)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := h.parser.parseGivenVectorIndexConfig(vectorIndexType, vectorIndexConfig)
+	if err != nil {
+		return nil, err
+	}
+	if isMultiVectorConfig(current) || isMultiVectorConfig(parsed) {
+		return nil, fmt.Errorf("reindexing multi vector indexes is not supported")
+	}
+
+	if req.TargetVector == "" {
+		updated.VectorIndexType = vectorIndexType
+		updated.VectorIndexConfig = vectorIndexConfig
+	} else {
+		vectorConfig := updated.VectorConfig[req.TargetVector]
+		vectorConfig.VectorIndexType = vectorIndexType
+		vectorConfig.VectorIndexConfig = vectorIndexConfig
+		updated.VectorConfig[req.TargetVector] = vectorConfig
+	}
+
+	if _, err := h.schemaManager.ReindexVectorIndex(ctx, updated, req.TargetVector); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// reindexVectorIndexConfig returns the config of a rebuilt vector index. The
+// distance of the current index is kept unless a new one is given, as the
+// rebuilt index has to rank the existing vectors the same way.
+func reindexVectorIndexConfig(given, current interface{}) (map[string]interface{}, error) {
+	cfg := map[string]interface{}{}
+	if given != nil {
+		asMap, ok := given.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("vector index config must be an object, got %T", given)
+		}
+		for k, v := range asMap {
+			cfg[k] = v
+		}
+	}
+
+	if _, ok := cfg["distance"]; !ok {
+		if currentCfg, ok := current.(schemaConfig.VectorIndexConfig); ok && currentCfg.DistanceName() != "" {
+			cfg["distance"] = currentCfg.DistanceName()
+		}
+	}
+	return cfg, nil
+}
+
+func isMultiVectorConfig(cfg interface{}) bool {
+	hnswCfg, ok := cfg.(hnsw.UserConfig)
+	return ok && hnswCfg.Multivector.Enabled
+}
+
 func (m *Handler) setNewClassDefaults(class *models.Class, globalCfg replication.GlobalConfig) error {
 	if err := m.setClassDefaults(class, globalCfg); err != nil {
 		return err
@@ -315,6 +440,7 @@ func (h *Handler) setClassDefaults(class *models.Class, globalCfg replication.Gl
 	}
 
 	setInvertedConfigDefaults(class)
+	setObjectTTLConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
@@ -724,6 +850,19 @@ func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 			}
 		}
 	}
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			if (prop.IndexSearchable != nil && !*prop.IndexSearchable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
 
 	return nil
 }
@@ -736,6 +875,10 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(class.VectorIndexType); err != nil {
 			return err
 		}
+		if class.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			return fmt.Errorf("vectorIndexType %q is only supported for target vectors configured in class.vectorConfig",
+				vectorindex.VectorIndexTypeSPARSE)
+		}
 		return nil
 	}
 
@@ -759,6 +902,18 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(cfg.VectorIndexType); err != nil {
 			return fmt.Errorf("target vector %q: %w", name, err)
 		}
+		if cfg.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			// there are no modules producing sparse vectors yet, they always
+			// have to be provided on import
+			if vm, ok := cfg.Vectorizer.(map[string]interface{}); ok {
+				for vectorizer := range vm {
+					if vectorizer != config.VectorizerModuleNone {
+						return fmt.Errorf("target vector %q: vectorizer %q can not be used with vectorIndexType %q, use %q",
+							name, vectorizer, vectorindex.VectorIndexTypeSPARSE, config.VectorizerModuleNone)
+					}
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -777,7 +932,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeIVF, vectorindex.VectorIndexTypeSPARSE:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
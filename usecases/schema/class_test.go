@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -389,6 +390,24 @@ func Test_AddClass(t *testing.T) {
 			}},
 		})
 		assert.EqualError(t, err, "target vector \"custom\": vectorizer: invalid vectorizer \"invalid\"")
+
+		// sparse VectorIndexType without VectorConfig
+		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+			Class:           "NewClass",
+			VectorIndexType: "sparse",
+		})
+		assert.EqualError(t, err, "vectorIndexType \"sparse\" is only supported for target vectors configured in class.vectorConfig")
+
+		// sparse VectorConfig with a vectorizer module
+		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+			Class: "NewClass",
+			VectorConfig: map[string]models.VectorConfig{"splade": {
+				VectorIndexType:   "sparse",
+				VectorIndexConfig: sparse.UserConfig{},
+				Vectorizer:        map[string]interface{}{"text2vec-contextionary": map[string]interface{}{}},
+			}},
+		})
+		assert.EqualError(t, err, "target vector \"splade\": vectorizer \"text2vec-contextionary\" can not be used with vectorIndexType \"sparse\", use \"none\"")
 	})
 }
 
@@ -1365,6 +1384,124 @@ func Test_UpdateClass(t *testing.T) {
 	})
 }
 
+func Test_ReindexVectorIndex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ClassNotFound", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "WrongClass").Return(nil)
+
+		_, err := handler.ReindexVectorIndex(ctx, nil, "WrongClass", &models.VectorIndexReindexRequest{})
+		assert.Equal(t, ErrNotFound, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("legacy vector with new index type", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Class").Return(&models.Class{
+			Class:             "Class",
+			VectorIndexType:   "hnsw",
+			VectorIndexConfig: fakeVectorConfig{},
+		})
+		fakeSchemaManager.On("ReindexVectorIndex", mock.MatchedBy(func(c *models.Class) bool {
+			cfg, ok := c.VectorIndexConfig.(map[string]interface{})
+			// the distance of the current index is kept
+			return c.VectorIndexType == "flat" && ok &&
+				cfg["distance"] == "cosine" && cfg["vectorCacheMaxObjects"] == float64(100)
+		}), "").Return(nil)
+
+		class, err := handler.ReindexVectorIndex(ctx, nil, "Class", &models.VectorIndexReindexRequest{
+			VectorIndexType:   "flat",
+			VectorIndexConfig: map[string]interface{}{"vectorCacheMaxObjects": float64(100)},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "flat", class.VectorIndexType)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("target vector keeps its index type", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Class").Return(&models.Class{
+			Class: "Class",
+			VectorConfig: map[string]models.VectorConfig{
+				"first":  {VectorIndexType: "hnsw", VectorIndexConfig: fakeVectorConfig{}},
+				"second": {VectorIndexType: "flat", VectorIndexConfig: fakeVectorConfig{}},
+			},
+		})
+		fakeSchemaManager.On("ReindexVectorIndex", mock.MatchedBy(func(c *models.Class) bool {
+			cfg, ok := c.VectorConfig["first"].VectorIndexConfig.(map[string]interface{})
+			return c.VectorConfig["first"].VectorIndexType == "hnsw" && ok &&
+				cfg["efConstruction"] == float64(256) && cfg["distance"] == "l2-squared" &&
+				c.VectorConfig["second"].VectorIndexType == "flat"
+		}), "first").Return(nil)
+
+		_, err := handler.ReindexVectorIndex(ctx, nil, "Class", &models.VectorIndexReindexRequest{
+			TargetVector:      "first",
+			VectorIndexConfig: map[string]interface{}{"efConstruction": float64(256), "distance": "l2-squared"},
+		})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		namedVectors := &models.Class{
+			Class: "Class",
+			VectorConfig: map[string]models.VectorConfig{
+				"first":  {VectorIndexType: "hnsw", VectorIndexConfig: fakeVectorConfig{}},
+				"sparse": {VectorIndexType: "sparse", VectorIndexConfig: map[string]interface{}{}},
+			},
+		}
+		tests := []struct {
+			name          string
+			req           *models.VectorIndexReindexRequest
+			expectedError string
+		}{
+			{
+				name:          "missing target vector",
+				req:           &models.VectorIndexReindexRequest{},
+				expectedError: "class \"Class\" has named vectors, a target vector is required",
+			},
+			{
+				name:          "unknown target vector",
+				req:           &models.VectorIndexReindexRequest{TargetVector: "unknown"},
+				expectedError: "target vector \"unknown\" not found in class \"Class\"",
+			},
+			{
+				name:          "unsupported index type",
+				req:           &models.VectorIndexReindexRequest{TargetVector: "first", VectorIndexType: "unknown"},
+				expectedError: "parse vector index config: unsupported vector index type: \"unknown\"",
+			},
+			{
+				name:          "config is not an object",
+				req:           &models.VectorIndexReindexRequest{TargetVector: "first", VectorIndexConfig: "hnsw"},
+				expectedError: "vector index config must be an object, got string",
+			},
+			{
+				name:          "sparse target vector",
+				req:           &models.VectorIndexReindexRequest{TargetVector: "sparse", VectorIndexType: "hnsw"},
+				expectedError: "reindexing sparse vector indexes is not supported",
+			},
+			{
+				name:          "to sparse index type",
+				req:           &models.VectorIndexReindexRequest{TargetVector: "first", VectorIndexType: "sparse"},
+				expectedError: "reindexing sparse vector indexes is not supported",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+				fakeSchemaManager.On("ReadOnlyClass", "Class").Return(namedVectors)
+
+				_, err := handler.ReindexVectorIndex(ctx, nil, "Class", test.req)
+				require.NotNil(t, err)
+				assert.EqualError(t, err, test.expectedError)
+				fakeSchemaManager.AssertNotCalled(t, "ReindexVectorIndex", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
 func TestRestoreClass_WithCircularRefs(t *testing.T) {
 	// When restoring a class, there could be circular refs between the classes,
 	// thus any validation that checks if linked classes exist would fail on the
@@ -89,6 +89,18 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 	className := req.Class.Class
 	ctx := context.Background()
 
+	if req.ReindexTargetVector != nil {
+		targetVector := *req.ReindexTargetVector
+		cfg := asVectorIndexConfig(req.Class)
+		if targetVector != "" {
+			cfg = asVectorIndexConfigs(req.Class)[targetVector]
+		}
+		if err := e.migrator.ReindexVectorIndex(ctx, className, targetVector, cfg); err != nil {
+			return fmt.Errorf("vector index reindex: %w", err)
+		}
+		return nil
+	}
+
 	if hasTargetVectors(req.Class) {
 		if err := e.migrator.UpdateVectorIndexConfigs(ctx, className, asVectorIndexConfigs(req.Class)); err != nil {
 			return fmt.Errorf("vector index configs update: %w", err)
@@ -109,6 +121,18 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("update replication config: %w", err)
 	}
 
+	// shards added by resharding are created on the nodes owning them
+	if req.State != nil && !req.State.PartitioningEnabled {
+		if err := e.migrator.UpdateIndex(ctx, req.Class, req.State); err != nil {
+			return fmt.Errorf("add new shards: %w", err)
+		}
+	}
+
+	// replicas removed by a scale in or a node drain are deleted from disk
+	if err := e.migrator.DropUnownedShards(ctx, className, req.State); err != nil {
+		return fmt.Errorf("drop unowned shards: %w", err)
+	}
+
 	return nil
 }
 
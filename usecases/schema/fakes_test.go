@@ -45,6 +45,11 @@ func (f *fakeSchemaManager) UpdateClass(_ context.Context, cls *models.Class, ss
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) ReindexVectorIndex(_ context.Context, cls *models.Class, targetVector string) (uint64, error) {
+	args := f.Called(cls, targetVector)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) DeleteClass(_ context.Context, name string) (uint64, error) {
 	args := f.Called(name)
 	return 0, args.Error(0)
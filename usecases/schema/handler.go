@@ -42,6 +42,7 @@ type SchemaManager interface {
 	AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
+	ReindexVectorIndex(ctx context.Context, cls *models.Class, targetVector string) (uint64, error)
 	DeleteClass(ctx context.Context, name string) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
 	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
@@ -146,6 +146,38 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	return nil, nil
 }
 
+func (f *fakeScaleOutManager) PlanDrain(className, node string) (map[string]string, []string, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeScaleOutManager) Reshard(ctx context.Context, className string,
+	count int, replFactor int64,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
+func (f *fakeScaleOutManager) FinishReshard(ctx context.Context, className string,
+	state *sharding.State, version uint64,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) AbortReshard(ctx context.Context, className string,
+	state *sharding.State,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) CopyReplica(ctx context.Context, className, shard,
+	source, target string,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
+func (f *fakeScaleOutManager) SyncReplica(ctx context.Context, className, shard, node string) error {
+	return nil
+}
+
 func (f *fakeScaleOutManager) SetSchemaReader(sr scaler.SchemaReader) {
 }
 
@@ -317,6 +349,13 @@ func (*fakeMigrator) UpdateVectorIndexConfigs(ctx context.Context, className str
 	return nil
 }
 
+func (f *fakeMigrator) ReindexVectorIndex(ctx context.Context, className, targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) error {
+	args := f.Called(ctx, className, targetVector, updated)
+	return args.Error(0)
+}
+
 func (*fakeMigrator) ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -330,6 +369,10 @@ func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className st
 	return nil
 }
 
+func (f *fakeMigrator) DropUnownedShards(ctx context.Context, className string, state *sharding.State) error {
+	return nil
+}
+
 func (f *fakeMigrator) WaitForStartup(ctx context.Context) error {
 	args := f.Called(ctx)
 	return args.Error(0)
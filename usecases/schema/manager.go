@@ -191,6 +191,12 @@ type scaleOut interface {
 	SetSchemaReader(sr scaler.SchemaReader)
 	Scale(ctx context.Context, className string,
 		updated shardingConfig.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	PlanDrain(className, node string) (targets map[string]string, skipped []string, err error)
+	Reshard(ctx context.Context, className string, count int, replFactor int64) (*sharding.State, error)
+	FinishReshard(ctx context.Context, className string, state *sharding.State, version uint64) error
+	AbortReshard(ctx context.Context, className string, state *sharding.State) error
+	CopyReplica(ctx context.Context, className, shard, source, target string) (*sharding.State, error)
+	SyncReplica(ctx context.Context, className, shard, node string) error
 }
 
 // NewManager creates a new manager
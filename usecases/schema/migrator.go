@@ -56,11 +56,14 @@ type Migrator interface {
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, className string,
 		updated map[string]schemaConfig.VectorIndexConfig) error
+	ReindexVectorIndex(ctx context.Context, className, targetVector string,
+		updated schemaConfig.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
 	UpdateReplicationConfig(ctx context.Context, className string,
 		updated *models.ReplicationConfig) error
+	DropUnownedShards(ctx context.Context, className string, state *sharding.State) error
 	WaitForStartup(context.Context) error
 	Shutdown(context.Context) error
 }
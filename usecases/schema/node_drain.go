@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/scaler"
+)
+
+// DrainNode moves all shard replicas off a node, so that it can be removed
+// from the cluster without losing data.
+//
+// The targets of all replicas are planned before anything is moved. Each
+// replica is then moved like by MoveReplica: the target is added to the
+// replicas of the shard, synced from the drained node and only then the
+// drained node is removed, which makes it delete its local copy. Writes made
+// while copying are thus not lost, which is why every class with a replica on
+// the node needs async replication. Replicas of inactive tenants can't be
+// copied, they are skipped and listed in the response. If a move fails, the
+// replicas moved before stay moved.
+func (h *Handler) DrainNode(ctx context.Context, principal *models.Principal,
+	node string,
+) (*models.NodeDrainResponse, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster())
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(h.clusterState.AllNames(), node) {
+		return nil, fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+
+	classes := h.schemaReader.ReadOnlySchema().Classes
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Class < classes[j].Class })
+
+	resp := &models.NodeDrainResponse{
+		Node:          node,
+		MovedShards:   []*models.NodeDrainMovedShard{},
+		SkippedShards: []*models.NodeDrainSkippedShard{},
+	}
+	plans := make([]map[string]string, len(classes))
+	for i, class := range classes {
+		targets, skipped, err := h.scaleOut.PlanDrain(class.Class, node)
+		if err != nil {
+			return nil, fmt.Errorf("drain class %q: %w", class.Class, err)
+		}
+		if len(targets) > 0 && (class.ReplicationConfig == nil || !class.ReplicationConfig.AsyncEnabled) {
+			return nil, fmt.Errorf("%w: drain node %q: moving the replicas of class %q "+
+				"requires async replication", scaler.ErrInvalidReplicaMove, node, class.Class)
+		}
+		plans[i] = targets
+		for _, shard := range skipped {
+			resp.SkippedShards = append(resp.SkippedShards, &models.NodeDrainSkippedShard{
+				Class:  class.Class,
+				Shard:  shard,
+				Reason: "tenant is not active",
+			})
+		}
+	}
+
+	for i, class := range classes {
+		shards := make([]string, 0, len(plans[i]))
+		for shard := range plans[i] {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+
+		for _, shard := range shards {
+			target := plans[i][shard]
+			if err := h.moveReplica(ctx, class, shard, node, target, false); err != nil {
+				return nil, fmt.Errorf("move replica of shard %q of class %q to node %q: %w",
+					shard, class.Class, target, err)
+			}
+			resp.MovedShards = append(resp.MovedShards, &models.NodeDrainMovedShard{
+				Class:      class.Class,
+				Shard:      shard,
+				TargetNode: target,
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeNodeDrainer struct {
+	fakeReplicaMover
+	targets map[string]map[string]string
+	skipped map[string][]string
+}
+
+func (f *fakeNodeDrainer) PlanDrain(className, node string) (map[string]string, []string, error) {
+	return f.targets[className], f.skipped[className], nil
+}
+
+func Test_DrainNode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown node", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		_, err := handler.DrainNode(ctx, nil, "node-2")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("draining requires async replication", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		classA := &models.Class{Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 2}}
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{
+			Classes: []*models.Class{classA},
+		})
+		handler.scaleOut = &fakeNodeDrainer{
+			targets: map[string]map[string]string{"A": {"S1": "node-3"}},
+		}
+
+		_, err := handler.DrainNode(ctx, nil, "node-1")
+		assert.ErrorIs(t, err, scaler.ErrInvalidReplicaMove)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("replicas are synced before the drained node is removed", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		classA := &models.Class{
+			Class:             "A",
+			ReplicationConfig: &models.ReplicationConfig{Factor: 2, AsyncEnabled: true},
+		}
+		classB := &models.Class{Class: "B"}
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{
+			Classes: []*models.Class{classB, classA},
+		})
+		copied := &sharding.State{
+			Physical: map[string]sharding.Physical{
+				"S1": {BelongsToNodes: []string{"node-1", "node-2", "node-3"}},
+			},
+		}
+		moved := &sharding.State{
+			Physical: map[string]sharding.Physical{
+				"S1": {BelongsToNodes: []string{"node-2", "node-3"}},
+			},
+		}
+		drainer := &fakeNodeDrainer{
+			fakeReplicaMover: fakeReplicaMover{copied: copied},
+			// B has no replica on the drained node, its state is left untouched
+			targets: map[string]map[string]string{"A": {"S1": "node-3"}},
+			skipped: map[string][]string{"A": {"T1"}},
+		}
+		handler.scaleOut = drainer
+		fakeSchemaManager.On("UpdateClass", classA, copied).Return(nil).Once()
+		fakeSchemaManager.On("CopyShardingState", "A").Return(copied).Once()
+		fakeSchemaManager.On("UpdateClass", classA, moved).Return(nil).Once()
+
+		resp, err := handler.DrainNode(ctx, nil, "node-1")
+		require.Nil(t, err)
+		assert.Equal(t, "node-1", resp.Node)
+		assert.Equal(t, []*models.NodeDrainMovedShard{
+			{Class: "A", Shard: "S1", TargetNode: "node-3"},
+		}, resp.MovedShards)
+		assert.Equal(t, []*models.NodeDrainSkippedShard{
+			{Class: "A", Shard: "T1", Reason: "tenant is not active"},
+		}, resp.SkippedShards)
+		assert.Equal(t, []string{"S1@node-1"}, drainer.synced)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setObjectTTLConfigDefaults(class *models.Class) {
+	if class.ObjectTTLConfig != nil && class.ObjectTTLConfig.DeleteOn == "" {
+		class.ObjectTTLConfig.DeleteOn = filters.InternalPropCreationTimeUnix
+	}
+}
+
+// validateObjectTTLConfig makes sure expired objects of the class can be
+// found with a filter on the timestamp the TTL is based on
+func validateObjectTTLConfig(class *models.Class) error {
+	cfg := class.ObjectTTLConfig
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.DefaultTTL <= 0 {
+		return fmt.Errorf("object ttl config: defaultTtl must be a positive number of seconds, got %d", cfg.DefaultTTL)
+	}
+
+	switch cfg.DeleteOn {
+	case filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix:
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return fmt.Errorf("object ttl config: deleteOn %q requires invertedIndexConfig.indexTimestamps to be enabled",
+				cfg.DeleteOn)
+		}
+		return nil
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name != cfg.DeleteOn {
+			continue
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+			return fmt.Errorf("object ttl config: deleteOn property %q must be of data type %q",
+				cfg.DeleteOn, schema.DataTypeDate)
+		}
+		if prop.IndexFilterable != nil && !*prop.IndexFilterable {
+			return fmt.Errorf("object ttl config: deleteOn property %q must be filterable", cfg.DeleteOn)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("object ttl config: deleteOn property %q does not exist", cfg.DeleteOn)
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidateObjectTTLConfig(t *testing.T) {
+	vFalse := false
+	newClass := func(ttl *models.ObjectTTLConfig, indexTimestamps bool) *models.Class {
+		class := &models.Class{
+			Class:               "Memory",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: indexTimestamps},
+			ObjectTTLConfig:     ttl,
+			Properties: []*models.Property{
+				{Name: "expiresAt", DataType: schema.DataTypeDate.PropString()},
+				{Name: "text", DataType: schema.DataTypeText.PropString()},
+				{Name: "unfiltered", DataType: schema.DataTypeDate.PropString(), IndexFilterable: &vFalse},
+			},
+		}
+		setObjectTTLConfigDefaults(class)
+		return class
+	}
+
+	tests := []struct {
+		name            string
+		ttl             *models.ObjectTTLConfig
+		indexTimestamps bool
+		expectedErr     string
+	}{
+		{name: "not configured"},
+		{name: "disabled", ttl: &models.ObjectTTLConfig{DeleteOn: "missing"}},
+		{
+			name:            "creation time by default",
+			ttl:             &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+			indexTimestamps: true,
+		},
+		{
+			name:            "last update time",
+			ttl:             &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "_lastUpdateTimeUnix"},
+			indexTimestamps: true,
+		},
+		{
+			name:        "timestamps not indexed",
+			ttl:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+			expectedErr: "requires invertedIndexConfig.indexTimestamps",
+		},
+		{
+			name: "date property",
+			ttl:  &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+		},
+		{
+			name:        "non date property",
+			ttl:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "text"},
+			expectedErr: "must be of data type",
+		},
+		{
+			name:        "non filterable property",
+			ttl:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "unfiltered"},
+			expectedErr: "must be filterable",
+		},
+		{
+			name:        "missing property",
+			ttl:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "missing"},
+			expectedErr: "does not exist",
+		},
+		{
+			name:            "missing ttl",
+			ttl:             &models.ObjectTTLConfig{Enabled: true},
+			indexTimestamps: true,
+			expectedErr:     "defaultTtl must be a positive number",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateObjectTTLConfig(newClass(tt.ttl, tt.indexTimestamps))
+			if tt.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -194,7 +194,9 @@ func (p *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 func (p *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{},
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC &&
+		vectorIndexType != vectorindex.VectorIndexTypeDISKANN && vectorIndexType != vectorindex.VectorIndexTypeIVF &&
+		vectorIndexType != vectorindex.VectorIndexTypeSPARSE {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -268,6 +270,10 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, fmt.Errorf("inverted index config: %w", err)
 	}
 
+	if err := validateObjectTTLConfig(update); err != nil {
+		return nil, err
+	}
+
 	return update, nil
 }
 
@@ -474,5 +480,50 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool) error
 			"attempted change from \"%d\" to \"%d\"", first.VirtualPerPhysical,
 			second.VirtualPerPhysical)
 	}
+
+	if first.Key != second.Key {
+		return fmt.Errorf("sharding key is immutable: "+
+			"attempted change from %q to %q", first.Key, second.Key)
+	}
+	return nil
+}
+
+// validatePartitionKey checks that the sharding key of a class sharded by a
+// property names a property objects can be routed by. Filters need to match
+// whole values to be routed to a single shard, so text properties must use
+// field tokenization.
+func validatePartitionKey(class *models.Class) error {
+	cfg, ok := class.ShardingConfig.(shardingConfig.Config)
+	if !ok || schema.MultiTenancyEnabled(class) || cfg.Key == shardingConfig.DefaultKey {
+		return nil
+	}
+
+	name := schema.LowercaseFirstLetter(cfg.Key)
+	var prop *models.Property
+	for _, p := range class.Properties {
+		if p.Name == name {
+			prop = p
+			break
+		}
+	}
+	if prop == nil {
+		return fmt.Errorf("sharding key %q is not a property of class %q", cfg.Key, class.Class)
+	}
+
+	dt := schema.DataType("")
+	if len(prop.DataType) == 1 {
+		dt = schema.DataType(prop.DataType[0])
+	}
+	if !schema.IsPartitionKeyDataType(dt) {
+		return fmt.Errorf("sharding key %q must be a text, int or uuid property, got %v",
+			name, prop.DataType)
+	}
+	if dt == schema.DataTypeText && prop.Tokenization != models.PropertyTokenizationField {
+		return fmt.Errorf("sharding key %q must use %q tokenization, got %q",
+			name, models.PropertyTokenizationField, prop.Tokenization)
+	}
+
+	cfg.Key = name
+	class.ShardingConfig = cfg
 	return nil
 }
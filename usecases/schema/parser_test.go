@@ -147,3 +147,71 @@ func (m fakeModulesProvider) IsReranker(name string) bool {
 func (m fakeModulesProvider) IsGenerative(name string) bool {
 	return strings.Contains(name, "generative")
 }
+
+func TestValidatePartitionKey(t *testing.T) {
+	class := func(key string, props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class:          "Order",
+			Properties:     props,
+			ShardingConfig: config.Config{Key: key},
+		}
+	}
+	textProp := func(tokenization string) *models.Property {
+		return &models.Property{Name: "customerId", DataType: []string{"text"}, Tokenization: tokenization}
+	}
+
+	tests := []struct {
+		name        string
+		class       *models.Class
+		expectedKey string
+		expectedErr string
+	}{
+		{
+			name:        "sharded by id",
+			class:       class(config.DefaultKey),
+			expectedKey: config.DefaultKey,
+		},
+		{
+			name:        "text property with field tokenization",
+			class:       class("CustomerId", textProp(models.PropertyTokenizationField)),
+			expectedKey: "customerId",
+		},
+		{
+			name:        "int property",
+			class:       class("customerId", &models.Property{Name: "customerId", DataType: []string{"int"}}),
+			expectedKey: "customerId",
+		},
+		{
+			name:        "unknown property",
+			class:       class("customerId"),
+			expectedErr: "is not a property",
+		},
+		{
+			name:        "text property with word tokenization",
+			class:       class("customerId", textProp(models.PropertyTokenizationWord)),
+			expectedErr: "must use \"field\" tokenization",
+		},
+		{
+			name:        "array property",
+			class:       class("customerId", &models.Property{Name: "customerId", DataType: []string{"int[]"}}),
+			expectedErr: "must be a text, int or uuid property",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePartitionKey(test.class)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedKey, test.class.ShardingConfig.(config.Config).Key)
+		})
+	}
+
+	t.Run("sharding key is immutable", func(t *testing.T) {
+		err := validateShardingConfig(class("customerId"), class("orderId"), false)
+		require.ErrorContains(t, err, "sharding key is immutable")
+	})
+}
@@ -715,6 +715,56 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		type testCase struct {
+			name            string
+			dataType        schema.DataType
+			indexSearchable *bool
+			indexPositions  *bool
+			expectedErr     string
+		}
+
+		testCases := []testCase{
+			{name: "text", dataType: schema.DataTypeText, indexPositions: &vTrue},
+			{name: "text[]", dataType: schema.DataTypeTextArray, indexPositions: &vTrue},
+			{
+				name: "text searchable", dataType: schema.DataTypeText,
+				indexSearchable: &vTrue, indexPositions: &vTrue,
+			},
+			{
+				name: "text not searchable", dataType: schema.DataTypeText,
+				indexSearchable: &vFalse, indexPositions: &vTrue,
+				expectedErr: "`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{
+				name: "text not searchable without positions", dataType: schema.DataTypeText,
+				indexSearchable: &vFalse, indexPositions: &vFalse,
+			},
+			{
+				name: "int", dataType: schema.DataTypeInt, indexPositions: &vTrue,
+				expectedErr: "`indexPositions` is allowed only for text/text[] data types",
+			},
+			{name: "int without positions", dataType: schema.DataTypeInt, indexPositions: &vFalse},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := handler.validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexSearchable: tc.indexSearchable,
+					IndexPositions:  tc.indexPositions,
+				})
+
+				if tc.expectedErr == "" {
+					require.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.expectedErr)
+				}
+			})
+		}
+	})
 }
 
 type fakePropertyDataType struct {
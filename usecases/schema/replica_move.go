@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// defaultRebalanceMaxMoves is the number of replicas moved by a rebalance
+// unless requested otherwise
+const defaultRebalanceMaxMoves = 10
+
+// MoveReplica moves or copies the replica of a shard to another node.
+//
+// The replica is copied from a snapshot of the source replica and the target
+// node is added to the replicas of the shard. If async replication is enabled
+// for the class, the writes made while copying are propagated from the source
+// replica afterwards. A moved replica is then removed from the source node,
+// which is why moving requires async replication.
+func (h *Handler) MoveReplica(ctx context.Context, principal *models.Principal,
+	req *models.ReplicaMoveRequest,
+) (*models.ReplicaMoveResponse, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster())
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("replica move request is required")
+	}
+
+	class := h.schemaReader.ReadOnlyClass(req.Class)
+	if class == nil {
+		return nil, fmt.Errorf("class %q: %w", req.Class, ErrNotFound)
+	}
+	for _, node := range []string{req.SourceNode, req.TargetNode} {
+		if !slices.Contains(h.clusterState.AllNames(), node) {
+			return nil, fmt.Errorf("node %q: %w", node, ErrNotFound)
+		}
+	}
+
+	if err := h.moveReplica(ctx, class, req.Shard, req.SourceNode, req.TargetNode, req.Copy); err != nil {
+		return nil, err
+	}
+
+	resp := &models.ReplicaMoveResponse{Class: req.Class, Shard: req.Shard, Nodes: []string{}}
+	if state := h.schemaReader.CopyShardingState(req.Class); state != nil {
+		resp.Nodes = append(resp.Nodes, state.Physical[req.Shard].BelongsToNodes...)
+	}
+	return resp, nil
+}
+
+func (h *Handler) moveReplica(ctx context.Context, class *models.Class,
+	shard, source, target string, copyOnly bool,
+) error {
+	asyncEnabled := class.ReplicationConfig != nil && class.ReplicationConfig.AsyncEnabled
+	if !copyOnly && !asyncEnabled {
+		return fmt.Errorf("%w: moving a replica of class %q requires async replication, "+
+			"copy it instead", scaler.ErrInvalidReplicaMove, class.Class)
+	}
+
+	after, err := h.scaleOut.CopyReplica(ctx, class.Class, shard, source, target)
+	if err != nil {
+		return err
+	}
+	if _, err := h.schemaManager.UpdateClass(ctx, class, after); err != nil {
+		return fmt.Errorf("update sharding state of class %q: %w", class.Class, err)
+	}
+	if !asyncEnabled {
+		return nil
+	}
+
+	// both replicas receive all writes now, only the writes made while
+	// copying might be missing on the target
+	if err := h.scaleOut.SyncReplica(ctx, class.Class, shard, source); err != nil {
+		return fmt.Errorf("sync replica of shard %q on node %q: %w", shard, source, err)
+	}
+	if copyOnly {
+		return nil
+	}
+
+	final := h.schemaReader.CopyShardingState(class.Class)
+	if final == nil {
+		return fmt.Errorf("no sharding state for class %q", class.Class)
+	}
+	phys := final.Physical[shard]
+	phys.BelongsToNodes = slices.DeleteFunc(slices.Clone(phys.BelongsToNodes),
+		func(node string) bool { return node == source })
+	final.Physical[shard] = phys
+	if _, err := h.schemaManager.UpdateClass(ctx, class, final); err != nil {
+		return fmt.Errorf("update sharding state of class %q: %w", class.Class, err)
+	}
+	return nil
+}
+
+// Rebalance evens out the number of objects stored by nodes by moving shard
+// replicas from the nodes storing the most objects to the ones storing the
+// fewest, see scaler.PlanRebalance. nodes is the status reported by the nodes
+// API, only healthy nodes receive replicas. Replicas of classes without async
+// replication and of inactive tenants are not moved.
+//
+// The moves are only proposed for a dry run. Otherwise they are executed one
+// after another and the moves executed before a failing one stay in place.
+func (h *Handler) Rebalance(ctx context.Context, principal *models.Principal,
+	nodes []*models.NodeStatus, req *models.ClusterRebalanceRequest,
+) (*models.ClusterRebalanceResponse, error) {
+	if req == nil {
+		req = &models.ClusterRebalanceRequest{}
+	}
+	verb := authorization.UPDATE
+	if req.DryRun {
+		verb = authorization.READ
+	}
+	if err := h.Authorizer.Authorize(principal, verb, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	maxMoves := int(req.MaxMoves)
+	if maxMoves <= 0 {
+		maxMoves = defaultRebalanceMaxMoves
+	}
+
+	states := map[string]*sharding.State{}
+	movable := func(className, shard string) bool {
+		class := h.schemaReader.ReadOnlyClass(className)
+		if class == nil || class.ReplicationConfig == nil || !class.ReplicationConfig.AsyncEnabled {
+			return false
+		}
+		state, ok := states[className]
+		if !ok {
+			state = h.schemaReader.CopyShardingState(className)
+			states[className] = state
+		}
+		if state == nil {
+			return false
+		}
+		phys, ok := state.Physical[shard]
+		if !ok {
+			return false
+		}
+		return !state.PartitioningEnabled || phys.ActivityStatus() == models.TenantActivityStatusHOT
+	}
+
+	var (
+		healthy  []string
+		replicas []scaler.ReplicaLoad
+	)
+	for _, node := range nodes {
+		if node.Status != nil && *node.Status == models.NodeStatusStatusHEALTHY {
+			healthy = append(healthy, node.Name)
+		}
+		for _, shard := range node.Shards {
+			replicas = append(replicas, scaler.ReplicaLoad{
+				Class:   shard.Class,
+				Shard:   shard.Name,
+				Node:    node.Name,
+				Objects: shard.ObjectCount,
+				Pinned:  !movable(shard.Class, shard.Name),
+			})
+		}
+	}
+
+	moves := scaler.PlanRebalance(healthy, replicas, maxMoves)
+	resp := &models.ClusterRebalanceResponse{
+		DryRun: req.DryRun,
+		Moves:  make([]*models.ClusterRebalanceMove, 0, len(moves)),
+	}
+	for _, m := range moves {
+		resp.Moves = append(resp.Moves, &models.ClusterRebalanceMove{
+			Class:       m.Class,
+			Shard:       m.Shard,
+			SourceNode:  m.Source,
+			TargetNode:  m.Target,
+			ObjectCount: m.Objects,
+		})
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	for _, m := range moves {
+		class := h.schemaReader.ReadOnlyClass(m.Class)
+		if class == nil {
+			return nil, fmt.Errorf("class %q: %w", m.Class, ErrNotFound)
+		}
+		if err := h.moveReplica(ctx, class, m.Shard, m.Source, m.Target, false); err != nil {
+			return nil, fmt.Errorf("move replica of shard %q of class %q from node %q to node %q: %w",
+				m.Shard, m.Class, m.Source, m.Target, err)
+		}
+	}
+	return resp, nil
+}
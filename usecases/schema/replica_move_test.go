@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeReplicaMover struct {
+	fakeScaleOutManager
+	copied *sharding.State
+	synced []string
+}
+
+func (f *fakeReplicaMover) CopyReplica(ctx context.Context, className, shard,
+	source, target string,
+) (*sharding.State, error) {
+	return f.copied, nil
+}
+
+func (f *fakeReplicaMover) SyncReplica(ctx context.Context, className, shard, node string) error {
+	f.synced = append(f.synced, shard+"@"+node)
+	return nil
+}
+
+func Test_MoveReplica(t *testing.T) {
+	ctx := context.Background()
+	copied := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {BelongsToNodes: []string{"node-1", "node-2"}},
+		},
+	}
+	moved := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {BelongsToNodes: []string{"node-2"}},
+		},
+	}
+	newHandler := func(t *testing.T, class *models.Class) (*Handler, *fakeSchemaManager, *fakeReplicaMover) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.clusterState = fakes.NewFakeClusterState("node-1", "node-2")
+		mover := &fakeReplicaMover{copied: copied}
+		handler.scaleOut = mover
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class)
+		return handler, fakeSchemaManager, mover
+	}
+
+	t.Run("unknown node", func(t *testing.T) {
+		handler, _, _ := newHandler(t, &models.Class{Class: "A"})
+		_, err := handler.MoveReplica(ctx, nil, &models.ReplicaMoveRequest{
+			Class: "A", Shard: "S1", SourceNode: "node-1", TargetNode: "node-3",
+		})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("move requires async replication", func(t *testing.T) {
+		handler, _, _ := newHandler(t, &models.Class{
+			Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		})
+		_, err := handler.MoveReplica(ctx, nil, &models.ReplicaMoveRequest{
+			Class: "A", Shard: "S1", SourceNode: "node-1", TargetNode: "node-2",
+		})
+		assert.ErrorIs(t, err, scaler.ErrInvalidReplicaMove)
+	})
+
+	t.Run("copy without async replication", func(t *testing.T) {
+		class := &models.Class{Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+		handler, fakeSchemaManager, mover := newHandler(t, class)
+		fakeSchemaManager.On("UpdateClass", class, copied).Return(nil)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(copied)
+
+		resp, err := handler.MoveReplica(ctx, nil, &models.ReplicaMoveRequest{
+			Class: "A", Shard: "S1", SourceNode: "node-1", TargetNode: "node-2", Copy: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"node-1", "node-2"}, resp.Nodes)
+		assert.Empty(t, mover.synced)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("move is synced and removes the source replica", func(t *testing.T) {
+		class := &models.Class{
+			Class:             "A",
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1, AsyncEnabled: true},
+		}
+		handler, fakeSchemaManager, mover := newHandler(t, class)
+		fakeSchemaManager.On("UpdateClass", class, copied).Return(nil).Once()
+		fakeSchemaManager.On("CopyShardingState", "A").Return(copied).Once()
+		fakeSchemaManager.On("UpdateClass", class, moved).Return(nil).Once()
+		fakeSchemaManager.On("CopyShardingState", "A").Return(moved).Once()
+
+		resp, err := handler.MoveReplica(ctx, nil, &models.ReplicaMoveRequest{
+			Class: "A", Shard: "S1", SourceNode: "node-1", TargetNode: "node-2",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"node-2"}, resp.Nodes)
+		assert.Equal(t, []string{"S1@node-1"}, mover.synced)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
+
+func Test_Rebalance(t *testing.T) {
+	ctx := context.Background()
+	healthy := models.NodeStatusStatusHEALTHY
+	unhealthy := models.NodeStatusStatusUNHEALTHY
+	nodes := []*models.NodeStatus{
+		{Name: "node-1", Status: &healthy, Shards: []*models.NodeShardStatus{
+			{Class: "A", Name: "S1", ObjectCount: 100},
+			{Class: "A", Name: "S2", ObjectCount: 100},
+			{Class: "B", Name: "S1", ObjectCount: 500},
+		}},
+		{Name: "node-2", Status: &healthy},
+		{Name: "node-3", Status: &unhealthy},
+	}
+
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ReadOnlyClass", "A").Return(&models.Class{
+		Class:             "A",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1, AsyncEnabled: true},
+	})
+	// B can't be moved without async replication
+	fakeSchemaManager.On("ReadOnlyClass", "B").Return(&models.Class{
+		Class:             "B",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	})
+	fakeSchemaManager.On("CopyShardingState", "A").Return(&sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {BelongsToNodes: []string{"node-1"}},
+			"S2": {BelongsToNodes: []string{"node-1"}},
+		},
+	})
+
+	resp, err := handler.Rebalance(ctx, nil, nodes, &models.ClusterRebalanceRequest{DryRun: true})
+	require.Nil(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, []*models.ClusterRebalanceMove{
+		{Class: "A", Shard: "S1", SourceNode: "node-1", TargetNode: "node-2", ObjectCount: 100},
+		{Class: "A", Shard: "S2", SourceNode: "node-1", TargetNode: "node-2", ObjectCount: 100},
+	}, resp.Moves)
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// Reshard changes the number of shards of a class without multi-tenancy.
+//
+// The objects moving to the new shards are copied while the class keeps
+// serving reads and writes, then the new sharding state is committed. Once
+// all nodes applied it, writes reaching the previous shards stop being
+// mirrored and the moved objects are deleted from there. Until then searches
+// may briefly return moved objects twice. Only one reshard of a class may run
+// at a time.
+func (h *Handler) Reshard(ctx context.Context, principal *models.Principal,
+	className string, req *models.ReshardRequest,
+) (*models.ReshardResponse, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(className)...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("reshard request is required")
+	}
+
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+	if schema.MultiTenancyEnabled(class) {
+		return nil, fmt.Errorf("reshard class %q: multi-tenant classes cannot be resharded", className)
+	}
+	before := h.schemaReader.CopyShardingState(className)
+	if before == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+
+	after, err := h.scaleOut.Reshard(ctx, className, int(req.ShardCount), class.ReplicationConfig.Factor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := h.schemaManager.UpdateClass(ctx, class, after)
+	if err != nil {
+		if abortErr := h.scaleOut.AbortReshard(context.Background(), className, after); abortErr != nil {
+			h.logger.WithField("action", "reshard").WithField("class", className).
+				WithError(abortErr).Error("abort reshard")
+		}
+		return nil, fmt.Errorf("update sharding state of class %q: %w", className, err)
+	}
+	// the state is committed, the moved objects must be deleted even if the
+	// request is cancelled
+	if err := h.scaleOut.FinishReshard(context.WithoutCancel(ctx), className, after, version); err != nil {
+		return nil, fmt.Errorf("delete moved objects of class %q: %w", className, err)
+	}
+
+	resp := &models.ReshardResponse{
+		Class:      className,
+		ShardCount: int64(len(after.Physical)),
+		NewShards:  []string{},
+	}
+	for name := range after.Physical {
+		if _, ok := before.Physical[name]; !ok {
+			resp.NewShards = append(resp.NewShards, name)
+		}
+	}
+	sort.Strings(resp.NewShards)
+	return resp, nil
+}
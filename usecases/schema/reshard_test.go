@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeResharder struct {
+	fakeScaleOutManager
+	state     *sharding.State
+	err       error
+	finished  bool
+	abortedBy *sharding.State
+}
+
+func (f *fakeResharder) Reshard(ctx context.Context, className string,
+	count int, replFactor int64,
+) (*sharding.State, error) {
+	return f.state, f.err
+}
+
+func (f *fakeResharder) FinishReshard(ctx context.Context, className string,
+	state *sharding.State, version uint64,
+) error {
+	f.finished = true
+	return nil
+}
+
+func (f *fakeResharder) AbortReshard(ctx context.Context, className string,
+	state *sharding.State,
+) error {
+	f.abortedBy = state
+	return nil
+}
+
+func Test_Reshard(t *testing.T) {
+	ctx := context.Background()
+	before := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {BelongsToNodes: []string{"node-1"}},
+		},
+	}
+	after := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			"S1": {BelongsToNodes: []string{"node-1"}},
+			"S3": {BelongsToNodes: []string{"node-1"}},
+			"S2": {BelongsToNodes: []string{"node-1"}},
+		},
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(nil)
+		_, err := handler.Reshard(ctx, nil, "A", &models.ReshardRequest{ShardCount: 3})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("multi-tenant class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(&models.Class{
+			Class:              "A",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		})
+		_, err := handler.Reshard(ctx, nil, "A", &models.ReshardRequest{ShardCount: 3})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "multi-tenant")
+	})
+
+	t.Run("state is committed and moved objects deleted", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := &models.Class{Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(before)
+		fakeSchemaManager.On("UpdateClass", class, after).Return(nil)
+		resharder := &fakeResharder{state: after}
+		handler.scaleOut = resharder
+
+		resp, err := handler.Reshard(ctx, nil, "A", &models.ReshardRequest{ShardCount: 3})
+		require.Nil(t, err)
+		assert.Equal(t, &models.ReshardResponse{
+			Class:      "A",
+			ShardCount: 3,
+			NewShards:  []string{"S2", "S3"},
+		}, resp)
+		assert.True(t, resharder.finished)
+		assert.Nil(t, resharder.abortedBy)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("failed commit is aborted", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := &models.Class{Class: "A", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+		fakeSchemaManager.On("ReadOnlyClass", "A").Return(class)
+		fakeSchemaManager.On("CopyShardingState", "A").Return(before)
+		fakeSchemaManager.On("UpdateClass", class, after).Return(errors.New("no leader"))
+		resharder := &fakeResharder{state: after}
+		handler.scaleOut = resharder
+
+		_, err := handler.Reshard(ctx, nil, "A", &models.ReshardRequest{ShardCount: 3})
+		require.NotNil(t, err)
+		assert.False(t, resharder.finished)
+		assert.Equal(t, after, resharder.abortedBy)
+	})
+}
@@ -197,6 +197,8 @@ func (ccc *classConfigComparison) diff() []string {
 		ccc.right.ModuleConfig, "module config")
 	ccc.compare(ccc.left.ReplicationConfig,
 		ccc.right.ReplicationConfig, "replication config")
+	ccc.compare(ccc.left.ObjectTTLConfig,
+		ccc.right.ObjectTTLConfig, "object ttl config")
 	ccc.compare(ccc.left.ShardingConfig,
 		ccc.right.ShardingConfig, "sharding config")
 	ccc.compare(ccc.left.VectorIndexConfig,
@@ -51,9 +51,10 @@ func (c *Config) setDefaults(nodeCount int) {
 }
 
 func (c *Config) validate() error {
-	if c.Key != "_id" {
-		return errors.Errorf("sharding only supported on key '_id' for now, "+
-			"got: %s", c.Key)
+	// any other key names a property, which is checked against the class
+	// properties by the schema
+	if c.Key == "" {
+		return errors.Errorf("sharding key must not be empty")
 	}
 
 	if c.Strategy != "hash" {
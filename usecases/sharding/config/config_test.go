@@ -91,14 +91,33 @@ func Test_Config(t *testing.T) {
 		},
 
 		{
-			name: "unsupported sharding key",
+			name: "property as sharding key",
 			input: map[string]interface{}{
-				"key":      "myCustomField",
+				"desiredCount": json.Number("3"),
+				"key":          "myCustomField",
+				"strategy":     "hash",
+				"function":     "murmur3",
+			},
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        3,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 3,
+				ActualCount:         3,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 3,
+				Key:                 "myCustomField",
+				Strategy:            "hash",
+				Function:            "murmur3",
+			},
+		},
+
+		{
+			name: "empty sharding key",
+			input: map[string]interface{}{
+				"key":      "",
 				"strategy": "hash",
 				"function": "murmur3",
 			},
-			expectedErr: errors.New("sharding only supported on key '_id' " +
-				"for now, got: myCustomField"),
+			expectedErr: errors.New("sharding key must not be empty"),
 		},
 
 		{
@@ -0,0 +1,20 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import "github.com/weaviate/weaviate/usecases/sharding/config"
+
+// UsesPartitionKey returns true if objects are assigned to shards by the
+// value of the property set as sharding key rather than by their id.
+func (s *State) UsesPartitionKey() bool {
+	return !s.PartitioningEnabled && s.Config.Key != "" && s.Config.Key != config.DefaultKey
+}
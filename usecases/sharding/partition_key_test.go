@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestStateShardByPartitionKey(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{
+		"desiredCount": float64(4),
+		"key":          "customerId",
+	}, 1)
+	require.Nil(t, err)
+	state, err := InitState("my-index", cfg, "node1", []string{"node1"}, 1, false)
+	require.Nil(t, err)
+	require.True(t, state.UsesPartitionKey())
+
+	shards := map[string]struct{}{}
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("customer-%d", i)
+		shard := state.Shard(key, "")
+		require.Contains(t, state.Physical, shard)
+		// the object id is irrelevant
+		assert.Equal(t, shard, state.Shard(key, fmt.Sprintf("object-%d", i)))
+		shards[shard] = struct{}{}
+	}
+	assert.Len(t, shards, 4, "keys should be spread across all shards")
+
+	t.Run("sharded by id", func(t *testing.T) {
+		byID := state.DeepCopy()
+		byID.Config.Key = config.DefaultKey
+		assert.False(t, byID.UsesPartitionKey())
+		assert.Equal(t, byID.PhysicalShard([]byte("object")), byID.Shard("customer", "object"))
+	})
+
+	t.Run("multi-tenant", func(t *testing.T) {
+		mt := state.DeepCopy()
+		mt.PartitioningEnabled = true
+		assert.False(t, mt.UsesPartitionKey())
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/dto"
 
 	"github.com/go-openapi/strfmt"
@@ -97,6 +98,10 @@ type RemoteIndexClient interface {
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
+	ReadChanges(ctx context.Context, hostName, indexName, shardName string,
+		after uint64, limit int, withObjects bool) ([]changefeed.Change, error)
+	OpenExportObjects(ctx context.Context, hostName, indexName, shardName,
+		exportID string) (ObjectStream, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -366,6 +371,69 @@ func (ri *RemoteIndex) DeleteObjectBatch(ctx context.Context, shardName string,
 	return ri.client.DeleteObjectBatch(ctx, host, ri.class, shardName, uuids, deletionTime, dryRun, schemaVersion)
 }
 
+// BatchPutObjectsOnNode stores objs in the shard on the given node. Unlike
+// BatchPutObjects the shard doesn't need to be part of the sharding state yet,
+// which is the case for the new shards of a class being resharded.
+func (ri *RemoteIndex) BatchPutObjectsOnNode(ctx context.Context, node, shardName string,
+	objs []*storobj.Object, schemaVersion uint64,
+) []error {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return duplicateErr(fmt.Errorf("resolve node name %q to host", node), len(objs))
+	}
+
+	return ri.client.BatchPutObjects(ctx, host, ri.class, shardName, objs, nil, schemaVersion)
+}
+
+// DeleteObjectBatchOnNode deletes uuids from the shard on the given node, see
+// BatchPutObjectsOnNode.
+func (ri *RemoteIndex) DeleteObjectBatchOnNode(ctx context.Context, node, shardName string,
+	uuids []strfmt.UUID, deletionTime time.Time, schemaVersion uint64,
+) objects.BatchSimpleObjects {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		err := fmt.Errorf("resolve node name %q to host", node)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	return ri.client.DeleteObjectBatch(ctx, host, ri.class, shardName, uuids, deletionTime, false, schemaVersion)
+}
+
+// ReadChangesOnNode reads the change feed of the shard replica on the given
+// node. Sequences are assigned by each replica, so a feed has to be followed
+// on the same node.
+func (ri *RemoteIndex) ReadChangesOnNode(ctx context.Context, node, shardName string,
+	after uint64, limit int, withObjects bool,
+) ([]changefeed.Change, error) {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.ReadChanges(ctx, host, ri.class, shardName, after, limit, withObjects)
+}
+
+// ObjectStream is an open view of the objects of a shard
+type ObjectStream interface {
+	// Read passes the objects of the stream to fn
+	Read(fn func(*storobj.Object) error) error
+	Close() error
+}
+
+// OpenExportObjectsOnNode opens a stream of the objects of the shard replica
+// on the given node. It returns once the replica has taken the snapshot of
+// the shard, the replica keeps its backup state reserved for exportID until
+// the stream is closed.
+func (ri *RemoteIndex) OpenExportObjectsOnNode(ctx context.Context, node, shardName, exportID string,
+) (ObjectStream, error) {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.OpenExportObjects(ctx, host, ri.class, shardName, exportID)
+}
+
 func (ri *RemoteIndex) GetShardQueueSize(ctx context.Context, shardName string) (int64, error) {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/changefeed"
 	"github.com/weaviate/weaviate/entities/dto"
 
 	"github.com/go-openapi/strfmt"
@@ -77,6 +78,10 @@ type RemoteIndexIncomingRepo interface {
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
+	IncomingReadChanges(ctx context.Context, shardName string, after uint64, limit int,
+		withObjects bool) ([]changefeed.Change, error)
+	IncomingExportObjects(ctx context.Context, exportID, shardName string,
+		onSnapshot func() error, fn func(*storobj.Object) error) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
 		vobjects []*objects.VObject) ([]replica.RepairResponse, error)
 	IncomingDigestObjects(ctx context.Context, shardName string,
@@ -255,6 +260,28 @@ func (rii *RemoteIndexIncoming) GetShardQueueSize(ctx context.Context,
 	return index.IncomingGetShardQueueSize(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) ReadChanges(ctx context.Context,
+	indexName, shardName string, after uint64, limit int, withObjects bool,
+) ([]changefeed.Change, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingReadChanges(ctx, shardName, after, limit, withObjects)
+}
+
+func (rii *RemoteIndexIncoming) ExportObjects(ctx context.Context,
+	indexName, shardName, exportID string, onSnapshot func() error, fn func(*storobj.Object) error,
+) error {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingExportObjects(ctx, exportID, shardName, onSnapshot, fn)
+}
+
 func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	indexName, shardName string,
 ) (string, error) {
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Reshard returns a copy of the state with count physical shards.
+//
+// The new physical shards are placed on the nodes holding the fewest shards.
+// They take over whole virtual shards from the physical shards owning the
+// most, until each physical shard owns about the same number of virtual
+// shards. The token ranges of the virtual shards don't change, so an object
+// either stays in its shard or moves to exactly one new shard.
+func (s *State) Reshard(count int, nodes []string, replFactor int64) (State, error) {
+	if s.PartitioningEnabled {
+		return State{}, fmt.Errorf("resharding is not supported for multi-tenant classes")
+	}
+	if s.UsesPartitionKey() {
+		return State{}, fmt.Errorf("resharding is not supported for classes sharded by property %q", s.Config.Key)
+	}
+	if current := len(s.Physical); count <= current {
+		return State{}, fmt.Errorf("shard count can only be increased: have %d, want %d", current, count)
+	}
+	if count > len(s.Virtual) {
+		return State{}, fmt.Errorf("shard count %d exceeds the number of virtual shards %d", count, len(s.Virtual))
+	}
+	if len(nodes) == 0 {
+		return State{}, fmt.Errorf("list of storage nodes is empty")
+	}
+	if replFactor < 1 || replFactor > int64(len(nodes)) {
+		return State{}, fmt.Errorf("not enough replicas: found %d want %d", len(nodes), replFactor)
+	}
+
+	out := s.DeepCopy()
+
+	// existing shards first, so that they keep the most virtual shards
+	existing := make([]string, 0, len(out.Physical))
+	for name := range out.Physical {
+		existing = append(existing, name)
+	}
+	sort.Slice(existing, func(a, b int) bool {
+		na, nb := len(out.Physical[existing[a]].OwnsVirtual), len(out.Physical[existing[b]].OwnsVirtual)
+		if na != nb {
+			return na > nb
+		}
+		return existing[a] < existing[b]
+	})
+
+	load := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		load[node] = 0
+	}
+	for _, p := range out.Physical {
+		for _, node := range p.BelongsToNodes {
+			load[node]++
+		}
+	}
+	added := make([]string, 0, count-len(out.Physical))
+	for len(out.Physical) < count {
+		name := generateShardName()
+		if _, ok := out.Physical[name]; ok {
+			continue
+		}
+		owners := leastLoaded(nodes, load, int(replFactor))
+		for _, node := range owners {
+			load[node]++
+		}
+		out.Physical[name] = Physical{Name: name, BelongsToNodes: owners}
+		added = append(added, name)
+	}
+	sort.Strings(added)
+	order := append(existing, added...)
+
+	quota := make(map[string]int, count)
+	for i, name := range order {
+		quota[name] = len(out.Virtual) / count
+		if i < len(out.Virtual)%count {
+			quota[name]++
+		}
+	}
+
+	var pool []string
+	for _, name := range order {
+		p := out.Physical[name]
+		if surplus := len(p.OwnsVirtual) - quota[name]; surplus > 0 {
+			pool = append(pool, p.OwnsVirtual[len(p.OwnsVirtual)-surplus:]...)
+			p.OwnsVirtual = p.OwnsVirtual[:len(p.OwnsVirtual)-surplus]
+			out.Physical[name] = p
+		}
+	}
+	for _, name := range order {
+		p := out.Physical[name]
+		if deficit := quota[name] - len(p.OwnsVirtual); deficit > 0 {
+			p.OwnsVirtual = append(p.OwnsVirtual, pool[:deficit]...)
+			pool = pool[deficit:]
+			out.Physical[name] = p
+		}
+	}
+
+	for name, p := range out.Physical {
+		p.OwnsPercentage = 0
+		for _, vname := range p.OwnsVirtual {
+			virtual := out.VirtualByName(vname)
+			virtual.AssignedToPhysical = name
+			p.OwnsPercentage += virtual.OwnsPercentage
+		}
+		out.Physical[name] = p
+	}
+	out.Config.DesiredCount = count
+	out.Config.ActualCount = count
+
+	return out, nil
+}
+
+// leastLoaded returns n nodes holding the fewest shards, ties are broken by
+// name
+func leastLoaded(nodes []string, load map[string]int, n int) []string {
+	sorted := make([]string, len(nodes))
+	copy(sorted, nodes)
+	sort.Slice(sorted, func(a, b int) bool {
+		if load[sorted[a]] != load[sorted[b]] {
+			return load[sorted[a]] < load[sorted[b]]
+		}
+		return sorted[a] < sorted[b]
+	})
+	return sorted[:n]
+}
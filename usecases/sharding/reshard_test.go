@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestStateReshard(t *testing.T) {
+	nodes := []string{"node1", "node2", "node3"}
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, len(nodes))
+	require.Nil(t, err)
+	before, err := InitState("my-index", cfg, "node1", nodes[:2], 2, false)
+	require.Nil(t, err)
+
+	t.Run("invalid shard counts", func(t *testing.T) {
+		_, err := before.Reshard(2, nodes, 2)
+		assert.ErrorContains(t, err, "can only be increased")
+		_, err = before.Reshard(len(before.Virtual)+1, nodes, 2)
+		assert.ErrorContains(t, err, "exceeds the number of virtual shards")
+		_, err = before.Reshard(3, nodes, 4)
+		assert.ErrorContains(t, err, "not enough replicas")
+	})
+
+	t.Run("multi-tenant class", func(t *testing.T) {
+		mt := before.DeepCopy()
+		mt.PartitioningEnabled = true
+		_, err := mt.Reshard(3, nodes, 2)
+		assert.ErrorContains(t, err, "not supported")
+	})
+
+	t.Run("class sharded by property", func(t *testing.T) {
+		byProp := before.DeepCopy()
+		byProp.Config.Key = "customerId"
+		_, err := byProp.Reshard(3, nodes, 2)
+		assert.ErrorContains(t, err, "not supported")
+	})
+
+	after, err := before.Reshard(5, nodes, 2)
+	require.Nil(t, err)
+
+	t.Run("before is not modified", func(t *testing.T) {
+		assert.Len(t, before.Physical, 2)
+		assert.Equal(t, 2, before.Config.DesiredCount)
+	})
+
+	t.Run("physical shards", func(t *testing.T) {
+		require.Len(t, after.Physical, 5)
+		assert.Equal(t, 5, after.Config.DesiredCount)
+		assert.Equal(t, 5, after.Config.ActualCount)
+
+		load := map[string]int{}
+		totalPercentage := 0.0
+		for name, p := range after.Physical {
+			if old, ok := before.Physical[name]; ok {
+				assert.Equal(t, old.BelongsToNodes, p.BelongsToNodes)
+			}
+			assert.Len(t, p.BelongsToNodes, 2)
+			for _, node := range p.BelongsToNodes {
+				load[node]++
+			}
+			// virtual shards are spread evenly
+			n := len(p.OwnsVirtual)
+			assert.True(t, n == len(after.Virtual)/5 || n == len(after.Virtual)/5+1, "shard %s owns %d", name, n)
+			for _, vname := range p.OwnsVirtual {
+				assert.Equal(t, name, after.VirtualByName(vname).AssignedToPhysical)
+			}
+			totalPercentage += p.OwnsPercentage
+		}
+		assert.InDelta(t, 1.0, totalPercentage, 1e-9)
+		// the new replicas are placed on the nodes with the fewest shards
+		require.Len(t, load, 3)
+		for node, n := range load {
+			assert.True(t, n == 3 || n == 4, "node %s holds %d replicas", node, n)
+		}
+	})
+
+	t.Run("objects move to new shards only", func(t *testing.T) {
+		moved := 0
+		for i := 0; i < 1000; i++ {
+			id := uuid.NewString()
+			from, to := before.Shard("", id), after.Shard("", id)
+			if from == to {
+				continue
+			}
+			_, existed := before.Physical[to]
+			assert.False(t, existed, fmt.Sprintf("object %s moved from %s to existing shard %s", id, from, to))
+			moved++
+		}
+		// roughly 3/5 of the objects move
+		assert.InDelta(t, 600, moved, 150)
+	})
+
+	t.Run("virtual token ranges are kept", func(t *testing.T) {
+		require.Len(t, after.Virtual, len(before.Virtual))
+		for i := range before.Virtual {
+			assert.Equal(t, before.Virtual[i].Upper, after.Virtual[i].Upper)
+			assert.False(t, math.IsNaN(after.Virtual[i].OwnsPercentage))
+		}
+	})
+}
@@ -153,7 +153,10 @@ func InitState(id string, config config.Config, nodeLocalName string, names []st
 	return out, nil
 }
 
-// Shard returns the shard name if it exits and empty string otherwise
+// Shard returns the shard name if it exits and empty string otherwise.
+// The partition key is the tenant name for multi-tenant classes, and the
+// canonical value of the sharding key property (see PartitionKeyValue) for
+// classes sharded by a property.
 func (s *State) Shard(partitionKey, objectID string) string {
 	if s.PartitioningEnabled {
 		if _, ok := s.Physical[partitionKey]; ok {
@@ -161,6 +164,9 @@ func (s *State) Shard(partitionKey, objectID string) string {
 		}
 		return ""
 	}
+	if s.UsesPartitionKey() {
+		return s.PhysicalShard([]byte(partitionKey))
+	}
 	return s.PhysicalShard([]byte(objectID))
 }
 
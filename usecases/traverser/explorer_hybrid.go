@@ -29,12 +29,26 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a search of a sparse target vector if a sparse vector
+// is given.  The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
-	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+	name := "keyword,bm25"
+	if params.HybridSearch.SparseVector != nil {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: params.HybridSearch.SparseVector,
+			TargetVector: params.HybridSearch.SparseTargetVector,
+		}
+		name = "keyword,sparse"
+	} else {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Query:             params.HybridSearch.Query,
+			Type:              "bm25",
+			Properties:        params.HybridSearch.Properties,
+			Fuzziness:         params.HybridSearch.Fuzziness,
+			FuzzyPrefixLength: params.HybridSearch.FuzzyPrefixLength,
+			Prefix:            params.HybridSearch.Prefix,
+		}
 	}
 
 	params.Group = nil
@@ -67,7 +81,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, name, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
@@ -216,6 +230,17 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		return nil, err
 	}
 
+	if params.HybridSearch.SparseVector != nil {
+		sparseTargetVector, err := e.targetParamHelper.GetSparseTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+			params.ClassName, params.HybridSearch.SparseTargetVector)
+		if err != nil {
+			return nil, err
+		}
+		hybridSearch := *params.HybridSearch
+		hybridSearch.SparseTargetVector = sparseTargetVector
+		keywordParams.HybridSearch = &hybridSearch
+	}
+
 	// If the user has given any weight to the vector search, choose 1 of three possible vector searches
 	//
 	// 1. If the user hase provided nearText parameters, use them in a nearText search
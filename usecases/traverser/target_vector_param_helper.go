@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 type TargetVectorParamHelper struct{}
@@ -29,14 +30,21 @@ func (t *TargetVectorParamHelper) GetTargetVectorOrDefault(sch schema.Schema, cl
 	if len(targetVectors) == 0 {
 		class := sch.FindClassByName(schema.ClassName(className))
 
-		if len(class.VectorConfig) > 1 {
+		// sparse vectors are not searched through vector indexes and never
+		// picked as the default target
+		var dense []string
+		for name, cfg := range class.VectorConfig {
+			if _, ok := cfg.VectorIndexConfig.(sparse.UserConfig); !ok {
+				dense = append(dense, name)
+			}
+		}
+
+		if len(dense) > 1 {
 			return []string{}, fmt.Errorf("multiple vectorizers configuration found, please specify target vector name")
 		}
 
-		if len(class.VectorConfig) == 1 {
-			for name := range class.VectorConfig {
-				return []string{name}, nil
-			}
+		if len(dense) == 1 {
+			return dense, nil
 		}
 
 		return []string{""}, nil
@@ -44,6 +52,38 @@ func (t *TargetVectorParamHelper) GetTargetVectorOrDefault(sch schema.Schema, cl
 	return targetVectors, nil
 }
 
+// GetSparseTargetVectorOrDefault returns the given target vector if it holds
+// sparse vectors, or the only sparse target vector of the class if none is
+// given
+func (t *TargetVectorParamHelper) GetSparseTargetVectorOrDefault(sch schema.Schema, className, targetVector string) (string, error) {
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return "", fmt.Errorf("class %q not found", className)
+	}
+
+	if targetVector != "" {
+		if _, ok := class.VectorConfig[targetVector].VectorIndexConfig.(sparse.UserConfig); !ok {
+			return "", fmt.Errorf("target vector %q is not a sparse target vector", targetVector)
+		}
+		return targetVector, nil
+	}
+
+	var found []string
+	for name, cfg := range class.VectorConfig {
+		if _, ok := cfg.VectorIndexConfig.(sparse.UserConfig); ok {
+			found = append(found, name)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("class %q has no sparse target vector", className)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("multiple sparse target vectors found, please specify the sparse target vector name")
+	}
+}
+
 func (t *TargetVectorParamHelper) GetTargetVectorsFromParams(params dto.GetParams) []string {
 	if params.NearObject != nil && len(params.NearObject.TargetVectors) >= 1 {
 		return params.NearObject.TargetVectors